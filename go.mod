@@ -25,6 +25,7 @@ require (
 	github.com/pquerna/otp v1.3.0
 	github.com/shopspring/decimal v1.3.1
 	golang.org/x/crypto v0.0.0-20210421170649-83a5a9bb288b
+	golang.org/x/tools v0.0.0-20200713011307-fd294ab11aed
 	gopkg.in/yaml.v2 v2.4.0
 )
 
@@ -0,0 +1,431 @@
+package acctest
+
+import (
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testRoundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f testRoundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// testCassetteSession returns a session whose requests to a non-local endpoint
+// are recorded to, or replayed from, the active cassette.
+// Recorded requests are forwarded to the stub server.
+func testCassetteSession(t *testing.T, s *StubServer, endpointKey string) *session.Session {
+	t.Helper()
+
+	transport := &cassetteTransport{
+		next: testRoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			r.URL.Host = strings.TrimPrefix(s.URL, "http://")
+
+			return http.DefaultTransport.RoundTrip(r)
+		}),
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("stub", "stub", ""),
+		Endpoint:    aws.String("http://cassette.example.com/" + endpointKey),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String(StubRegion),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	sess.Config.HTTPClient = &http.Client{Transport: transport}
+
+	return sess
+}
+
+func testSetActiveCassette(t *testing.T, c *cassette) {
+	t.Helper()
+
+	activeCassetteLock.Lock()
+	activeCassette = c
+	activeCassetteLock.Unlock()
+
+	t.Cleanup(func() {
+		activeCassetteLock.Lock()
+		activeCassette = nil
+		activeCassetteLock.Unlock()
+	})
+}
+
+func TestCassetteRecordReplay(t *testing.T) {
+	testResetCassetteAccountIDs(t)
+
+	path := filepath.Join(t.TempDir(), "TestCassetteRecordReplay.json")
+
+	s := NewStubServer(t)
+	s.Service("sts", StubProtocolQuery).Handle("GetCallerIdentity", func(r *StubRequest) StubResponse {
+		return StubResponse{
+			Output: &sts.GetCallerIdentityOutput{
+				Account: aws.String("111122223333"),
+				Arn:     aws.String("arn:aws:iam::111122223333:user/test"), //lintignore:AWSAT005
+				UserId:  aws.String("AIDACKCEVSQ6C2EXAMPLE"),
+			},
+		}
+	})
+	s.Service("sqs", StubProtocolQuery).
+		Handle("CreateQueue", func(r *StubRequest) StubResponse {
+			return StubResponse{Output: &sqs.CreateQueueOutput{QueueUrl: aws.String("https://sqs.us-west-2.amazonaws.com/111122223333/" + r.Params.Get("QueueName"))}} //lintignore:AWSAT003
+		}).
+		Handle("GetQueueAttributes", StubResponses(
+			StubErrorResponse(sqs.ErrCodeQueueDoesNotExist, "The specified queue does not exist."),
+			StubResponse{Output: &sqs.GetQueueAttributesOutput{Attributes: aws.StringMap(map[string]string{"DelaySeconds": "0"})}},
+		))
+
+	recorder := &cassette{mode: cassetteModeRecord, path: path, t: t}
+	testSetActiveCassette(t, recorder)
+
+	name := recorder.random(func() string { return sdkacctest.RandomWithPrefix("tf-acc-test") })
+
+	if _, err := sts.New(testCassetteSession(t, s, "sts")).GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conn := sqs.New(testCassetteSession(t, s, "sqs"))
+	output, err := conn.CreateQueue(&sqs.CreateQueueInput{QueueName: aws.String(name)})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	queueURL := aws.StringValue(output.QueueUrl)
+
+	for i := 0; i < 2; i++ {
+		conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: aws.String(queueURL)}) // nolint:errcheck
+	}
+
+	if err := recorder.save(); err != nil {
+		t.Fatalf("error saving cassette: %s", err)
+	}
+
+	player := &cassette{mode: cassetteModeReplay, path: path, t: t}
+
+	if err := player.load(); err != nil {
+		t.Fatalf("error loading cassette: %s", err)
+	}
+
+	testSetActiveCassette(t, player)
+
+	if got, expected := player.random(nil), name; got != expected {
+		t.Errorf("got name %s, expected %s", got, expected)
+	}
+
+	identity, err := sts.New(testCassetteSession(t, s, "sts")).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(identity.Account), "123456789012"; got != expected {
+		t.Errorf("got account ID %s, expected %s", got, expected)
+	}
+
+	conn = sqs.New(testCassetteSession(t, s, "sqs"))
+	output, err = conn.CreateQueue(&sqs.CreateQueueInput{QueueName: aws.String(name)})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.QueueUrl), strings.ReplaceAll(queueURL, "111122223333", "123456789012"); got != expected {
+		t.Errorf("got queue URL %s, expected %s", got, expected)
+	}
+
+	queueURL = aws.StringValue(output.QueueUrl)
+
+	// Polling is fast-forwarded to the last recorded response.
+	attributes, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: aws.String(queueURL)})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(attributes.Attributes["DelaySeconds"]), "0"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got, expected := s.Service("sqs", StubProtocolQuery).Calls("CreateQueue"), 1; got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+}
+
+func TestCassetteReplayServiceWaiter(t *testing.T) {
+	defer func(hook func(*resource.StateChangeConf)) {
+		tfresource.StateChangeConfHook = hook
+	}(tfresource.StateChangeConfHook)
+
+	tfresource.StateChangeConfHook = replayStateChangeConf
+
+	path := filepath.Join(t.TempDir(), "TestCassetteReplayServiceWaiter.json")
+
+	s := NewStubServer(t)
+	s.Service("cloudformation", StubProtocolQuery).Handle("DescribeStackSetOperation", StubResponses(
+		StubResponse{Output: &cloudformation.DescribeStackSetOperationOutput{StackSetOperation: &cloudformation.StackSetOperation{Status: aws.String(cloudformation.StackSetOperationStatusRunning)}}},
+		StubResponse{Output: &cloudformation.DescribeStackSetOperationOutput{StackSetOperation: &cloudformation.StackSetOperation{Status: aws.String(cloudformation.StackSetOperationStatusSucceeded)}}},
+	))
+
+	recorder := &cassette{mode: cassetteModeRecord, path: path, t: t}
+	testSetActiveCassette(t, recorder)
+
+	conn := cloudformation.New(testCassetteSession(t, s, "cloudformation"))
+	input := &cloudformation.DescribeStackSetOperationInput{OperationId: aws.String("test"), StackSetName: aws.String("test")}
+
+	for i := 0; i < 2; i++ {
+		if _, err := conn.DescribeStackSetOperation(input); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := recorder.save(); err != nil {
+		t.Fatalf("error saving cassette: %s", err)
+	}
+
+	player := &cassette{mode: cassetteModeReplay, path: path, t: t}
+
+	if err := player.load(); err != nil {
+		t.Fatalf("error loading cassette: %s", err)
+	}
+
+	testSetActiveCassette(t, player)
+
+	// The waiter delays its first refresh by 5 seconds and calls resource.StateChangeConf
+	// through tfresource.WaitForState, which applies the replay timing.
+	start := time.Now()
+	output, err := tfcloudformation.WaitStackSetOperationSucceeded(cloudformation.New(testCassetteSession(t, s, "cloudformation")), "test", "test", time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("waited %s, expected no delay", elapsed)
+	}
+
+	if got, expected := aws.StringValue(output.Status), cloudformation.StackSetOperationStatusSucceeded; got != expected {
+		t.Errorf("got status %s, expected %s", got, expected)
+	}
+
+	if got, expected := s.Service("cloudformation", StubProtocolQuery).Calls("DescribeStackSetOperation"), 2; got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+}
+
+// testSetCassette makes the specified cassette the test's cassette.
+func testSetCassette(t *testing.T, c *cassette) {
+	t.Helper()
+
+	mode := cassetteMode
+	cassetteMode = c.mode
+
+	cassettesLock.Lock()
+	cassettes[t.Name()] = c
+	cassettesLock.Unlock()
+
+	t.Cleanup(func() {
+		cassetteMode = mode
+
+		cassettesLock.Lock()
+		delete(cassettes, t.Name())
+		cassettesLock.Unlock()
+	})
+}
+
+func TestCassetteRandomValues(t *testing.T) {
+	random := func(t *testing.T) []string {
+		domain := RandomDomainName(t)
+		publicKey, privateKey, err := RandSSHKeyPair(t, DefaultEmailAddress)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return []string{domain, RandomSubdomain(t), RandomEmailAddress(t, domain), publicKey, privateKey}
+	}
+
+	recorder := &cassette{mode: cassetteModeRecord, t: t}
+	var recorded []string
+
+	t.Run("record", func(t *testing.T) {
+		testSetCassette(t, recorder)
+
+		recorded = random(t)
+	})
+
+	player := &cassette{mode: cassetteModeReplay, RandomValues: recorder.RandomValues, t: t}
+
+	t.Run("replay", func(t *testing.T) {
+		testSetCassette(t, player)
+
+		if got, expected := random(t), recorded; !reflect.DeepEqual(got, expected) {
+			t.Errorf("got %v, expected %v", got, expected)
+		}
+	})
+}
+
+func TestCassetteReplayMiss(t *testing.T) {
+	c := &cassette{mode: cassetteModeReplay}
+
+	_, err := c.replay(cassetteRequest{Method: http.MethodPost, URL: "https://sqs.us-west-2.amazonaws.com/", Operation: "CreateQueue"}) //lintignore:AWSAT003
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if err, ok := err.(interface{ Temporary() bool }); !ok || err.Temporary() {
+		t.Errorf("expected error that is not temporary, got %v", err)
+	}
+}
+
+func TestCassetteReplayFastForward(t *testing.T) {
+	describe := cassetteRequest{Method: http.MethodPost, URL: "https://rds.amazonaws.com/", Operation: "DescribeDBInstances", Body: "Action=DescribeDBInstances"}
+	modify := cassetteRequest{Method: http.MethodPost, URL: "https://rds.amazonaws.com/", Operation: "ModifyDBInstance", Body: "Action=ModifyDBInstance"}
+	tags := cassetteRequest{Method: http.MethodPost, URL: "https://rds.amazonaws.com/", Operation: "ListTagsForResource", Body: "Action=ListTagsForResource"}
+
+	c := &cassette{mode: cassetteModeReplay}
+
+	for i, request := range []cassetteRequest{describe, describe, tags, describe, modify, describe, describe} {
+		c.Interactions = append(c.Interactions, &cassetteInteraction{Request: request, Response: cassetteResponse{StatusCode: 200 + i}})
+	}
+
+	for _, testCase := range []struct {
+		request            cassetteRequest
+		expectedStatusCode int
+	}{
+		{describe, 203},
+		{tags, 202},
+		{modify, 204},
+		{describe, 206},
+		{describe, 206},
+	} {
+		v, err := c.replay(testCase.request)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, expected := v.Response.StatusCode, testCase.expectedStatusCode; got != expected {
+			t.Errorf("%s: got interaction %d, expected %d", testCase.request.Operation, got-200, expected-200)
+		}
+	}
+}
+
+// testResetCassetteAccountIDs forgets the learned account IDs before and after the test.
+func testResetCassetteAccountIDs(t *testing.T) {
+	reset := func() {
+		cassetteAccountIDsLock.Lock()
+		cassetteAccountIDs = make(map[string]string)
+		cassetteAccountIDsLock.Unlock()
+	}
+
+	reset()
+	t.Cleanup(reset)
+}
+
+func TestCassetteScrub(t *testing.T) {
+	testResetCassetteAccountIDs(t)
+
+	c := &cassette{
+		Interactions: []*cassetteInteraction{
+			{
+				Request:  cassetteRequest{Operation: "GetCallerIdentity", Body: "Action=GetCallerIdentity"},
+				Response: cassetteResponse{Body: "<GetCallerIdentityResult><Account>111122223333</Account></GetCallerIdentityResult>"},
+			},
+			{
+				Request:  cassetteRequest{Body: `{"MasterUserPassword":"hunter2","Name":"test","SecretString":"hunter2"}`},
+				Response: cassetteResponse{Body: `{"ARN":"arn:aws:secretsmanager:us-west-2:111122223333:secret:test"}`}, //lintignore:AWSAT003,AWSAT005
+			},
+			{
+				Request:  cassetteRequest{Body: "Action=CreateDBInstance&MasterUserPassword=hunter2&Password=hunter2"},
+				Response: cassetteResponse{Body: "<Credentials><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials>"},
+			},
+		},
+	}
+
+	c.scrub()
+
+	for i, expected := range []string{
+		"Action=GetCallerIdentity",
+		"<GetCallerIdentityResult><Account>123456789012</Account></GetCallerIdentityResult>",
+		`{"MasterUserPassword":"REDACTED","Name":"test","SecretString":"REDACTED"}`,
+		`{"ARN":"arn:aws:secretsmanager:us-west-2:123456789012:secret:test"}`, //lintignore:AWSAT003,AWSAT005
+		"Action=CreateDBInstance&MasterUserPassword=REDACTED&Password=REDACTED",
+		"<Credentials><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials>",
+	} {
+		v := c.Interactions[i/2]
+		got := v.Request.Body
+
+		if i%2 == 1 {
+			got = v.Response.Body
+		}
+
+		if got != expected {
+			t.Errorf("got %s, expected %s", got, expected)
+		}
+	}
+}
+
+func TestCassetteScrubLearnedAccountID(t *testing.T) {
+	testResetCassetteAccountIDs(t)
+
+	// The provider requests the caller identity once, e.g. in an earlier test.
+	learnAccountIDs(cassetteRequest{Operation: "GetCallerIdentity"}, []byte(`{"Account":"111122223333","Arn":"arn:aws:iam::111122223333:user/test","UserId":"AIDACKCEVSQ6C2EXAMPLE"}`)) //lintignore:AWSAT005
+	learnAccountIDs(cassetteRequest{Operation: "DescribeInstances"}, []byte(`{"OwnerId":"444455556666"}`))
+
+	c := &cassette{
+		Interactions: []*cassetteInteraction{
+			{
+				Request: cassetteRequest{
+					URL:  "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/arn%3Aaws%3Alambda%3Aus-west-2%3A111122223333%3Afunction%3Atest", //lintignore:AWSAT003
+					Body: `{"Role":"arn:aws:iam::111122223333:role/test"}`,                                                                              //lintignore:AWSAT005
+				},
+				Response: cassetteResponse{
+					Headers: http.Header{"X-Amzn-Requestid": []string{"111122223333"}},
+					Body:    `{"FunctionArn":"arn:aws:lambda:us-west-2:111122223333:function:test","Owner":{"AccountId":"111122223333"},"OwnerId":"444455556666"}`, //lintignore:AWSAT003,AWSAT005
+				},
+			},
+		},
+	}
+
+	c.scrub()
+
+	v := c.Interactions[0]
+
+	if got, expected := v.Request.URL, "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/arn%3Aaws%3Alambda%3Aus-west-2%3A123456789012%3Afunction%3Atest"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got URL %s, expected %s", got, expected)
+	}
+
+	if got, expected := v.Request.Body, `{"Role":"arn:aws:iam::123456789012:role/test"}`; got != expected { //lintignore:AWSAT005
+		t.Errorf("got request body %s, expected %s", got, expected)
+	}
+
+	if got, expected := v.Response.Headers.Get("X-Amzn-Requestid"), "123456789012"; got != expected {
+		t.Errorf("got header %s, expected %s", got, expected)
+	}
+
+	// Only the account IDs of the credentials used are scrubbed.
+	if got, expected := v.Response.Body, `{"FunctionArn":"arn:aws:lambda:us-west-2:123456789012:function:test","Owner":{"AccountId":"123456789012"},"OwnerId":"444455556666"}`; got != expected { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("got response body %s, expected %s", got, expected)
+	}
+}
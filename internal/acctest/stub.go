@@ -0,0 +1,920 @@
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/private/protocol/xml/xmlutil"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// Protocols spoken by the AWS APIs that a StubServer stands in for.
+const (
+	StubProtocolEC2      = "ec2"
+	StubProtocolJSON     = "json"
+	StubProtocolQuery    = "query"
+	StubProtocolRESTJSON = "rest-json"
+	StubProtocolRESTXML  = "rest-xml"
+)
+
+const (
+	// StubAccountID is the account ID returned by a StubServer's STS GetCallerIdentity.
+	StubAccountID = "123456789012"
+
+	// StubRegion is the region of providers configured against a StubServer.
+	StubRegion = "us-west-2" //lintignore:AWSAT003
+
+	stubRequestID = "00000000-0000-0000-0000-000000000000"
+	stubRFC822    = "Mon, 2 Jan 2006 15:04:05 GMT"
+)
+
+// StubServer is an in-process HTTP server that stands in for AWS APIs in offline tests.
+// Each stubbed service is served below the path of its provider endpoints key, e.g. /sqs,
+// and responds to each operation with the StubResponse returned by its registered handler.
+// Requests for operations without a handler fail the test.
+type StubServer struct {
+	*httptest.Server
+
+	t *testing.T
+
+	lock     sync.Mutex
+	services map[string]*StubService
+
+	provider     *schema.Provider
+	providerLock sync.Mutex
+}
+
+// StubService is an AWS service served by a StubServer.
+type StubService struct {
+	endpointKey string
+	protocol    string
+
+	lock     sync.Mutex
+	calls    map[string]int
+	handlers map[string]StubHandler
+	routes   []stubRoute
+}
+
+// StubHandler returns the response to a request for an operation.
+type StubHandler func(r *StubRequest) StubResponse
+
+// StubRequest is a request for an operation received by a StubServer.
+type StubRequest struct {
+	*http.Request
+
+	// Body is the request body.
+	Body []byte
+	// Operation is the name of the requested operation, e.g. CreateQueue.
+	Operation string
+	// Params are the query protocol parameters or query string of the request.
+	Params url.Values
+	// PathParams are the values of the {Name} segments of the route of a REST operation.
+	PathParams map[string]string
+}
+
+// DecodeJSON decodes the body of a JSON or REST-JSON request into an AWS SDK input struct.
+func (r *StubRequest) DecodeJSON(v interface{}) error {
+	if len(r.Body) == 0 {
+		return nil
+	}
+
+	return jsonutil.UnmarshalJSON(v, bytes.NewReader(r.Body))
+}
+
+// DecodeXML decodes the body of a REST-XML request into an AWS SDK input struct.
+func (r *StubRequest) DecodeXML(v interface{}) error {
+	if len(r.Body) == 0 {
+		return nil
+	}
+
+	return xmlutil.UnmarshalXML(v, xml.NewDecoder(bytes.NewReader(r.Body)), "")
+}
+
+// ParamList returns the values of a list serialized as query protocol parameters,
+// e.g. TagKey for the parameters TagKey.1, TagKey.2, ... of a flattened list or
+// AttributeNames.member for a non-flattened list.
+func (r *StubRequest) ParamList(name string) []string {
+	var values []string
+
+	for i := 1; ; i++ {
+		v, ok := r.Params[fmt.Sprintf("%s.%d", name, i)]
+
+		if !ok {
+			return values
+		}
+
+		values = append(values, v...)
+	}
+}
+
+// ParamMap returns the entries of a map serialized as query protocol parameters,
+// e.g. Attribute, Name and Value for the parameters Attribute.1.Name, Attribute.1.Value, ...
+func (r *StubRequest) ParamMap(name, keyName, valueName string) map[string]string {
+	values := make(map[string]string)
+
+	for i := 1; ; i++ {
+		k, ok := r.Params[fmt.Sprintf("%s.%d.%s", name, i, keyName)]
+
+		if !ok {
+			return values
+		}
+
+		values[k[0]] = r.Params.Get(fmt.Sprintf("%s.%d.%s", name, i, valueName))
+	}
+}
+
+// StubResponse is the response of a StubServer to a request.
+type StubResponse struct {
+	// Output is the AWS SDK output struct encoded in the response, e.g. *sqs.CreateQueueOutput.
+	Output interface{}
+	// Error, if set, is returned instead of Output.
+	Error *StubError
+	// StatusCode defaults to 200 OK, or 400 Bad Request for errors.
+	StatusCode int
+}
+
+// StubError is an AWS API error returned by a StubServer.
+type StubError struct {
+	Code    string
+	Message string
+}
+
+// StubErrorResponse returns a response with the specified AWS API error.
+func StubErrorResponse(code, message string) StubResponse {
+	return StubResponse{
+		Error: &StubError{
+			Code:    code,
+			Message: message,
+		},
+	}
+}
+
+// StubResponses returns a handler that responds with each of the specified responses in turn,
+// repeating the last response for any further requests.
+// It is used to script the responses seen by waiters.
+func StubResponses(responses ...StubResponse) StubHandler {
+	var (
+		i    int
+		lock sync.Mutex
+	)
+
+	return func(r *StubRequest) StubResponse {
+		lock.Lock()
+		defer lock.Unlock()
+
+		response := responses[i]
+
+		if i < len(responses)-1 {
+			i++
+		}
+
+		return response
+	}
+}
+
+// NewStubServer starts a StubServer that is closed when the test and its subtests complete.
+// STS is stubbed so that providers can be configured against the server.
+func NewStubServer(t *testing.T) *StubServer {
+	t.Helper()
+
+	s := &StubServer{
+		t:        t,
+		services: make(map[string]*StubService),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	s.Service("sts", StubProtocolQuery).Handle("GetCallerIdentity", func(r *StubRequest) StubResponse {
+		return StubResponse{
+			Output: &sts.GetCallerIdentityOutput{
+				Account: aws.String(StubAccountID),
+				Arn:     aws.String(fmt.Sprintf("arn:aws:iam::%s:user/stub", StubAccountID)), //lintignore:AWSAT005
+				UserId:  aws.String("AIDACKCEVSQ6C2EXAMPLE"),
+			},
+		}
+	})
+
+	return s
+}
+
+// Service returns the stubbed service with the specified provider endpoints key, e.g. sqs,
+// creating it with the specified protocol if necessary.
+func (s *StubServer) Service(endpointKey, protocol string) *StubService {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if v, ok := s.services[endpointKey]; ok {
+		return v
+	}
+
+	v := &StubService{
+		calls:       make(map[string]int),
+		endpointKey: endpointKey,
+		handlers:    make(map[string]StubHandler),
+		protocol:    protocol,
+	}
+	s.services[endpointKey] = v
+
+	return v
+}
+
+// Endpoint returns the URL of the stubbed service with the specified provider endpoints key.
+func (s *StubServer) Endpoint(endpointKey string) string {
+	return s.URL + "/" + endpointKey
+}
+
+// Provider returns a provider configured to direct all stubbed services to the server.
+// Services must be stubbed before the provider is first requested.
+func (s *StubServer) Provider() *schema.Provider {
+	s.t.Helper()
+
+	// The lock on the services is not held as configuring the provider sends requests to the server.
+	s.providerLock.Lock()
+	defer s.providerLock.Unlock()
+
+	if s.provider != nil {
+		return s.provider
+	}
+
+	endpoints := make(map[string]interface{})
+
+	for _, k := range s.endpointKeys() {
+		endpoints[k] = s.Endpoint(k)
+	}
+
+	p := provider.Provider()
+	raw := map[string]interface{}{
+		"access_key":              "stub",
+		"secret_key":              "stub",
+		"region":                  StubRegion,
+		"skip_get_ec2_platforms":  true,
+		"skip_metadata_api_check": true,
+		"skip_region_validation":  true,
+		"endpoints":               []interface{}{endpoints},
+	}
+
+	if err := stubDiagnosticsError(p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))); err != nil {
+		s.t.Fatalf("error configuring provider: %s", err)
+	}
+
+	s.provider = p
+
+	return p
+}
+
+// ProviderFactories returns the provider factories for resource.UnitTest cases that exercise resources offline.
+// The provider is configured with conns.Config.Endpoints directing all stubbed services to the server,
+// so test step configurations need no provider block.
+// Services must be stubbed before the first test step.
+func (s *StubServer) ProviderFactories() map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		ProviderName: func() (*schema.Provider, error) { //nolint:unparam
+			p := provider.Provider()
+
+			// The region is configured from the server rather than the configuration.
+			p.Schema["region"].Required = false
+			p.Schema["region"].Optional = true
+
+			p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				config := conns.Config{
+					AccessKey:            "stub",
+					Endpoints:            make(map[string]string),
+					Region:               StubRegion,
+					SecretKey:            "stub",
+					SkipGetEC2Platforms:  true,
+					SkipMetadataApiCheck: true,
+					SkipRegionValidation: true,
+					TerraformVersion:     p.TerraformVersion,
+				}
+
+				for _, k := range s.endpointKeys() {
+					config.Endpoints[k] = s.Endpoint(k)
+				}
+
+				client, err := config.Client()
+
+				if err != nil {
+					return nil, diag.FromErr(err)
+				}
+
+				return client, nil
+			}
+
+			return p, nil
+		},
+	}
+}
+
+// PreCheckTerraformCLI skips offline tests run with resource.UnitTest unless Terraform CLI is available locally,
+// either at the path in TF_ACC_TERRAFORM_PATH or on the PATH, as resource.UnitTest would otherwise download it.
+func PreCheckTerraformCLI(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("skipping offline test; Terraform CLI not found")
+	}
+}
+
+// CheckStubCalls checks the number of requests received by a stubbed service for each of the specified operations.
+func CheckStubCalls(svc *StubService, expected map[string]int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		for operation, n := range expected {
+			if got := svc.Calls(operation); got != n {
+				return fmt.Errorf("got %d %s calls, expected %d", got, operation, n)
+			}
+		}
+
+		return nil
+	}
+}
+
+// ApplyResource plans and applies the specified configuration of a resource, e.g. aws_sqs_queue, against the server
+// without Terraform CLI, calling the resource's Create, Update or Delete function, and returns the refreshed state.
+// A nil configuration destroys the resource, returning nil.
+func (s *StubServer) ApplyResource(typeName string, state *terraform.InstanceState, config map[string]interface{}) *terraform.InstanceState {
+	s.t.Helper()
+
+	ctx := context.Background()
+	p := s.Provider()
+	r, ok := p.ResourcesMap[typeName]
+
+	if !ok {
+		s.t.Fatalf("resource (%s) not found", typeName)
+	}
+
+	diff := &terraform.InstanceDiff{Destroy: true}
+
+	if config != nil {
+		var err error
+
+		diff, err = r.Diff(ctx, state, terraform.NewResourceConfigRaw(config), p.Meta())
+
+		if err != nil {
+			s.t.Fatalf("error planning %s: %s", typeName, err)
+		}
+	}
+
+	state, diags := r.Apply(ctx, state, diff, p.Meta())
+
+	if err := stubDiagnosticsError(diags); err != nil {
+		s.t.Fatalf("error applying %s: %s", typeName, err)
+	}
+
+	if state == nil || state.ID == "" {
+		return nil
+	}
+
+	state, diags = r.RefreshWithoutUpgrade(ctx, state, p.Meta())
+
+	if err := stubDiagnosticsError(diags); err != nil {
+		s.t.Fatalf("error reading %s: %s", typeName, err)
+	}
+
+	return state
+}
+
+func (s *StubServer) endpointKeys() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	keys := make([]string, 0, len(s.services))
+
+	for k := range s.services {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (s *StubServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	endpointKey, path := r.URL.Path, "/"
+
+	if i := strings.Index(endpointKey[1:], "/"); i >= 0 {
+		endpointKey, path = endpointKey[:i+1], endpointKey[i+1:]
+	}
+
+	s.lock.Lock()
+	service, ok := s.services[strings.TrimPrefix(endpointKey, "/")]
+	s.lock.Unlock()
+
+	if !ok {
+		s.t.Errorf("request for unstubbed service: %s %s", r.Method, r.URL)
+		http.Error(w, "unstubbed service", http.StatusNotFound)
+
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+
+	if err != nil {
+		s.t.Errorf("error reading request body: %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	request := &StubRequest{
+		Request: r,
+		Body:    body,
+		Params:  r.URL.Query(),
+	}
+	handler := service.handler(request, path)
+
+	if handler == nil {
+		s.t.Errorf("request for unstubbed %s operation: %s %s %s", service.endpointKey, request.Operation, r.Method, r.URL)
+		service.writeResponse(w, request, StubErrorResponse("StubNotImplemented", "operation not stubbed"))
+
+		return
+	}
+
+	service.writeResponse(w, request, handler(request))
+}
+
+// Handle registers the handler for the specified operation of a JSON, query or EC2 protocol service.
+func (svc *StubService) Handle(operation string, handler StubHandler) *StubService {
+	svc.lock.Lock()
+	defer svc.lock.Unlock()
+
+	svc.handlers[operation] = handler
+
+	return svc
+}
+
+// HandleREST registers the handler for the specified operation of a REST-JSON or REST-XML service.
+// The operation is routed by its HTTP method and request URI pattern, e.g. /2015-03-31/functions/{FunctionName}.
+// {Name+} segments match the rest of the path.
+func (svc *StubService) HandleREST(operation, method, pattern string, handler StubHandler) *StubService {
+	svc.lock.Lock()
+	defer svc.lock.Unlock()
+
+	if i := strings.Index(pattern, "?"); i >= 0 {
+		pattern = pattern[:i]
+	}
+
+	svc.handlers[operation] = handler
+	svc.routes = append(svc.routes, stubRoute{
+		method:    method,
+		operation: operation,
+		segments:  strings.Split(strings.Trim(pattern, "/"), "/"),
+	})
+
+	return svc
+}
+
+// Calls returns the number of requests received for the specified operation.
+func (svc *StubService) Calls(operation string) int {
+	svc.lock.Lock()
+	defer svc.lock.Unlock()
+
+	return svc.calls[operation]
+}
+
+func (svc *StubService) handler(r *StubRequest, path string) StubHandler {
+	switch svc.protocol {
+	case StubProtocolEC2, StubProtocolQuery:
+		if params, err := url.ParseQuery(string(r.Body)); err == nil {
+			r.Params = params
+		}
+
+		r.Operation = r.Params.Get("Action")
+	case StubProtocolJSON:
+		target := r.Header.Get("X-Amz-Target")
+		r.Operation = target[strings.LastIndex(target, ".")+1:]
+	case StubProtocolRESTJSON, StubProtocolRESTXML:
+		svc.lock.Lock()
+		routes := svc.routes
+		svc.lock.Unlock()
+
+		for _, route := range routes {
+			if params, ok := route.match(r.Method, path); ok {
+				r.Operation = route.operation
+				r.PathParams = params
+
+				break
+			}
+		}
+	}
+
+	svc.lock.Lock()
+	defer svc.lock.Unlock()
+
+	svc.calls[r.Operation]++
+
+	return svc.handlers[r.Operation]
+}
+
+func (svc *StubService) writeResponse(w http.ResponseWriter, r *StubRequest, response StubResponse) {
+	var body []byte
+
+	statusCode := response.StatusCode
+
+	if response.Error != nil {
+		if statusCode == 0 {
+			statusCode = http.StatusBadRequest
+		}
+
+		body = svc.errorBody(w.Header(), response.Error)
+	} else {
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+
+		var err error
+
+		body, err = svc.outputBody(w.Header(), r.Operation, response.Output)
+
+		if err != nil {
+			http.Error(w, fmt.Sprintf("error encoding %s output: %s", r.Operation, err), http.StatusInternalServerError)
+
+			return
+		}
+	}
+
+	w.Header().Set("X-Amzn-Requestid", stubRequestID)
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
+
+func (svc *StubService) errorBody(header http.Header, err *StubError) []byte {
+	var b bytes.Buffer
+
+	switch svc.protocol {
+	case StubProtocolJSON, StubProtocolRESTJSON:
+		header.Set("Content-Type", "application/x-amz-json-1.1")
+		header.Set("X-Amzn-Errortype", err.Code)
+		fmt.Fprintf(&b, `{"__type":%q,"message":%q}`, err.Code, err.Message)
+	case StubProtocolEC2:
+		header.Set("Content-Type", "text/xml")
+		b.WriteString("<Response><Errors><Error>")
+		stubWriteXMLElement(&b, "Code", err.Code)
+		stubWriteXMLElement(&b, "Message", err.Message)
+		b.WriteString("</Error></Errors>")
+		stubWriteXMLElement(&b, "RequestID", stubRequestID)
+		b.WriteString("</Response>")
+	default:
+		header.Set("Content-Type", "text/xml")
+		b.WriteString("<ErrorResponse><Error>")
+		stubWriteXMLElement(&b, "Code", err.Code)
+		stubWriteXMLElement(&b, "Message", err.Message)
+		b.WriteString("</Error>")
+		stubWriteXMLElement(&b, "RequestId", stubRequestID)
+		b.WriteString("</ErrorResponse>")
+	}
+
+	return b.Bytes()
+}
+
+func (svc *StubService) outputBody(header http.Header, operation string, output interface{}) ([]byte, error) {
+	v := reflect.Indirect(reflect.ValueOf(output))
+
+	if v.IsValid() && (svc.protocol == StubProtocolRESTJSON || svc.protocol == StubProtocolRESTXML) {
+		if err := stubSetHeaders(header, v); err != nil {
+			return nil, err
+		}
+	}
+
+	switch svc.protocol {
+	case StubProtocolJSON, StubProtocolRESTJSON:
+		header.Set("Content-Type", "application/x-amz-json-1.1")
+
+		if !v.IsValid() {
+			return []byte("{}"), nil
+		}
+
+		return jsonutil.BuildJSON(output)
+	}
+
+	var b bytes.Buffer
+
+	header.Set("Content-Type", "text/xml")
+
+	switch svc.protocol {
+	case StubProtocolEC2:
+		b.WriteString("<" + operation + "Response>")
+		stubWriteXMLFields(&b, v)
+		stubWriteXMLElement(&b, "requestId", stubRequestID)
+		b.WriteString("</" + operation + "Response>")
+	case StubProtocolQuery:
+		b.WriteString("<" + operation + "Response><" + operation + "Result>")
+		stubWriteXMLFields(&b, v)
+		b.WriteString("</" + operation + "Result><ResponseMetadata>")
+		stubWriteXMLElement(&b, "RequestId", stubRequestID)
+		b.WriteString("</ResponseMetadata></" + operation + "Response>")
+	case StubProtocolRESTXML:
+		if v.IsValid() {
+			if field, ok := v.Type().FieldByName("_"); ok && field.Tag.Get("payload") != "" {
+				v = reflect.Indirect(v.FieldByName(field.Tag.Get("payload")))
+
+				switch payload := v.Interface().(type) {
+				case []byte:
+					return payload, nil
+				case string:
+					return []byte(payload), nil
+				}
+			}
+		}
+
+		b.WriteString("<" + operation + "Result>")
+		stubWriteXMLFields(&b, v)
+		b.WriteString("</" + operation + "Result>")
+	}
+
+	return b.Bytes(), nil
+}
+
+type stubRoute struct {
+	method    string
+	operation string
+	segments  []string
+}
+
+func (r stubRoute) match(method, path string) (map[string]string, bool) {
+	if method != r.method {
+		return nil, false
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	params := make(map[string]string)
+
+	for i, pattern := range r.segments {
+		if strings.HasPrefix(pattern, "{") && strings.HasSuffix(pattern, "+}") {
+			if i >= len(segments) {
+				return nil, false
+			}
+
+			params[strings.Trim(pattern, "{+}")] = stubUnescapePath(strings.Join(segments[i:], "/"))
+
+			return params, true
+		}
+
+		if i >= len(segments) {
+			return nil, false
+		}
+
+		if strings.HasPrefix(pattern, "{") && strings.HasSuffix(pattern, "}") {
+			params[strings.Trim(pattern, "{}")] = stubUnescapePath(segments[i])
+
+			continue
+		}
+
+		if pattern != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, len(segments) == len(r.segments)
+}
+
+func stubUnescapePath(s string) string {
+	if v, err := url.PathUnescape(s); err == nil {
+		return v
+	}
+
+	return s
+}
+
+func stubDiagnosticsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			if d.Detail != "" {
+				return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+			}
+
+			return fmt.Errorf("%s", d.Summary)
+		}
+	}
+
+	return nil
+}
+
+// stubSetHeaders sets the response headers bound to fields of a REST output struct.
+func stubSetHeaders(header http.Header, v reflect.Value) error {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := reflect.Indirect(v.Field(i))
+
+		if !value.IsValid() {
+			continue
+		}
+
+		switch field.Tag.Get("location") {
+		case "header":
+			s, err := stubScalarString(value, field.Tag.Get("timestampFormat"), stubRFC822)
+
+			if err != nil {
+				return err
+			}
+
+			header.Set(field.Tag.Get("locationName"), s)
+		case "headers":
+			iter := value.MapRange()
+
+			for iter.Next() {
+				header.Set(field.Tag.Get("locationName")+iter.Key().String(), reflect.Indirect(iter.Value()).String())
+			}
+		}
+	}
+
+	return nil
+}
+
+// stubWriteXMLFields writes the body fields of an AWS SDK struct as XML elements,
+// following the rules with which the AWS SDK unmarshals XML responses.
+func stubWriteXMLFields(b *bytes.Buffer, v reflect.Value) {
+	v = reflect.Indirect(v)
+
+	if !v.IsValid() {
+		return
+	}
+
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		if field.PkgPath != "" || field.Name == "_" || field.Tag.Get("location") != "" {
+			continue
+		}
+
+		name := field.Name
+
+		if field.Tag.Get("flattened") != "" && field.Tag.Get("locationNameList") != "" {
+			name = field.Tag.Get("locationNameList")
+		} else if v := field.Tag.Get("locationName"); v != "" {
+			name = v
+		}
+
+		stubWriteXMLValue(b, name, v.Field(i), field.Tag)
+	}
+}
+
+func stubWriteXMLValue(b *bytes.Buffer, name string, v reflect.Value, tag reflect.StructTag) {
+	if v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if _, ok := v.Interface().(time.Time); ok {
+			break
+		}
+
+		b.WriteString("<" + name + ">")
+		stubWriteXMLFields(b, v)
+		b.WriteString("</" + name + ">")
+
+		return
+	case reflect.Slice:
+		if _, ok := v.Interface().([]byte); ok {
+			break
+		}
+
+		if v.IsNil() {
+			return
+		}
+
+		if tag.Get("flattened") != "" {
+			for i := 0; i < v.Len(); i++ {
+				stubWriteXMLValue(b, name, v.Index(i), "")
+			}
+
+			return
+		}
+
+		member := "member"
+
+		if v := tag.Get("locationNameList"); v != "" {
+			member = v
+		}
+
+		b.WriteString("<" + name + ">")
+
+		for i := 0; i < v.Len(); i++ {
+			stubWriteXMLValue(b, member, v.Index(i), "")
+		}
+
+		b.WriteString("</" + name + ">")
+
+		return
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+
+		keyName, valueName := "key", "value"
+
+		if v := tag.Get("locationNameKey"); v != "" {
+			keyName = v
+		}
+
+		if v := tag.Get("locationNameValue"); v != "" {
+			valueName = v
+		}
+
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+
+		flattened := tag.Get("flattened") != ""
+
+		if !flattened {
+			b.WriteString("<" + name + ">")
+		}
+
+		for _, key := range keys {
+			entryName := "entry"
+
+			if flattened {
+				entryName = name
+			}
+
+			b.WriteString("<" + entryName + ">")
+			stubWriteXMLElement(b, keyName, key.String())
+			stubWriteXMLValue(b, valueName, v.MapIndex(key), "")
+			b.WriteString("</" + entryName + ">")
+		}
+
+		if !flattened {
+			b.WriteString("</" + name + ">")
+		}
+
+		return
+	}
+
+	s, err := stubScalarString(v, tag.Get("timestampFormat"), "2006-01-02T15:04:05Z")
+
+	if err != nil {
+		return
+	}
+
+	stubWriteXMLElement(b, name, s)
+}
+
+func stubWriteXMLElement(b *bytes.Buffer, name, value string) {
+	b.WriteString("<" + name + ">")
+	xml.EscapeText(b, []byte(value))
+	b.WriteString("</" + name + ">")
+}
+
+func stubScalarString(v reflect.Value, timestampFormat, defaultTimeLayout string) (string, error) {
+	switch value := v.Interface().(type) {
+	case string:
+		return value, nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case int64:
+		return strconv.FormatInt(value, 10), nil
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(value), nil
+	case time.Time:
+		switch timestampFormat {
+		case "unixTimestamp":
+			return strconv.FormatInt(value.Unix(), 10), nil
+		case "rfc822":
+			return value.UTC().Format(stubRFC822), nil
+		case "iso8601":
+			return value.UTC().Format("2006-01-02T15:04:05Z"), nil
+		}
+
+		return value.UTC().Format(defaultTimeLayout), nil
+	}
+
+	return "", fmt.Errorf("unsupported type: %s", v.Type())
+}
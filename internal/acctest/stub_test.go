@@ -0,0 +1,291 @@
+package acctest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testStubSession(t *testing.T, s *StubServer, endpointKey string) *session.Session {
+	t.Helper()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("stub", "stub", ""),
+		Endpoint:    aws.String(s.Endpoint(endpointKey)),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String(StubRegion),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sess
+}
+
+func TestStubServerQuery(t *testing.T) {
+	s := NewStubServer(t)
+	s.Service("sqs", StubProtocolQuery).
+		Handle("GetQueueAttributes", StubResponses(
+			StubResponse{
+				Output: &sqs.GetQueueAttributesOutput{
+					Attributes: aws.StringMap(map[string]string{
+						"DelaySeconds":      "0",
+						"VisibilityTimeout": "30",
+					}),
+				},
+			},
+			StubErrorResponse(sqs.ErrCodeQueueDoesNotExist, "The specified queue does not exist."),
+		)).
+		Handle("UntagQueue", func(r *StubRequest) StubResponse {
+			if got, expected := r.ParamList("TagKey"), []string{"key1", "key2"}; !reflect.DeepEqual(got, expected) {
+				t.Errorf("got tag keys %v, expected %v", got, expected)
+			}
+
+			return StubResponse{}
+		})
+
+	conn := sqs.New(testStubSession(t, s, "sqs"))
+
+	output, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValueMap(output.Attributes), map[string]string{"DelaySeconds": "0", "VisibilityTimeout": "30"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	_, err = conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{QueueUrl: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		t.Errorf("got error %v, expected %s", err, sqs.ErrCodeQueueDoesNotExist)
+	}
+
+	_, err = conn.UntagQueue(&sqs.UntagQueueInput{QueueUrl: aws.String("test"), TagKeys: aws.StringSlice([]string{"key1", "key2"})})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := s.Service("sqs", StubProtocolQuery).Calls("GetQueueAttributes"), 2; got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+}
+
+func TestStubServerEC2(t *testing.T) {
+	s := NewStubServer(t)
+	s.Service("ec2", StubProtocolEC2).Handle("DescribeVpcs", func(r *StubRequest) StubResponse {
+		if got, expected := r.ParamList("VpcId"), []string{"vpc-12345678"}; !reflect.DeepEqual(got, expected) {
+			return StubErrorResponse("InvalidVpcID.NotFound", "The vpc ID does not exist")
+		}
+
+		return StubResponse{
+			Output: &ec2.DescribeVpcsOutput{
+				Vpcs: []*ec2.Vpc{{
+					CidrBlock: aws.String("10.0.0.0/16"),
+					Tags:      []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("test")}},
+					VpcId:     aws.String("vpc-12345678"),
+				}},
+			},
+		}
+	})
+
+	conn := ec2.New(testStubSession(t, s, "ec2"))
+
+	output, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc-12345678"})})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(output.Vpcs), 1; got != expected {
+		t.Fatalf("got %d VPCs, expected %d", got, expected)
+	}
+
+	if got, expected := aws.StringValue(output.Vpcs[0].Tags[0].Value), "test"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	_, err = conn.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: aws.StringSlice([]string{"vpc-87654321"})})
+
+	if !tfawserr.ErrCodeEquals(err, "InvalidVpcID.NotFound") {
+		t.Errorf("got error %v, expected InvalidVpcID.NotFound", err)
+	}
+}
+
+func TestStubServerJSON(t *testing.T) {
+	s := NewStubServer(t)
+	s.Service("kms", StubProtocolJSON).Handle("DescribeKey", func(r *StubRequest) StubResponse {
+		var input kms.DescribeKeyInput
+
+		if err := r.DecodeJSON(&input); err != nil {
+			t.Errorf("error decoding input: %s", err)
+		}
+
+		if aws.StringValue(input.KeyId) != "test" {
+			return StubErrorResponse(kms.ErrCodeNotFoundException, "Key not found")
+		}
+
+		return StubResponse{
+			Output: &kms.DescribeKeyOutput{
+				KeyMetadata: &kms.KeyMetadata{
+					KeyId:    input.KeyId,
+					KeyState: aws.String(kms.KeyStateEnabled),
+				},
+			},
+		}
+	})
+
+	conn := kms.New(testStubSession(t, s, "kms"))
+
+	output, err := conn.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.KeyMetadata.KeyState), kms.KeyStateEnabled; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	_, err = conn.DescribeKey(&kms.DescribeKeyInput{KeyId: aws.String("other")})
+
+	if !tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
+		t.Errorf("got error %v, expected %s", err, kms.ErrCodeNotFoundException)
+	}
+}
+
+func TestStubServerRESTJSON(t *testing.T) {
+	s := NewStubServer(t)
+	s.Service("lambda", StubProtocolRESTJSON).HandleREST("GetFunctionConfiguration", "GET", "/2015-03-31/functions/{FunctionName}/configuration", func(r *StubRequest) StubResponse {
+		if r.PathParams["FunctionName"] != "test" {
+			return StubErrorResponse(lambda.ErrCodeResourceNotFoundException, "Function not found")
+		}
+
+		return StubResponse{
+			Output: &lambda.FunctionConfiguration{
+				FunctionName: aws.String(r.PathParams["FunctionName"]),
+				MemorySize:   aws.Int64(128),
+			},
+		}
+	})
+
+	conn := lambda.New(testStubSession(t, s, "lambda"))
+
+	output, err := conn.GetFunctionConfiguration(&lambda.GetFunctionConfigurationInput{FunctionName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.Int64Value(output.MemorySize), int64(128); got != expected {
+		t.Errorf("got %d, expected %d", got, expected)
+	}
+
+	_, err = conn.GetFunctionConfiguration(&lambda.GetFunctionConfigurationInput{FunctionName: aws.String("other")})
+
+	if !tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		t.Errorf("got error %v, expected %s", err, lambda.ErrCodeResourceNotFoundException)
+	}
+}
+
+func TestStubServerRESTXML(t *testing.T) {
+	s := NewStubServer(t)
+	s.Service("route53", StubProtocolRESTXML).HandleREST("GetHostedZone", "GET", "/2013-04-01/hostedzone/{Id}", func(r *StubRequest) StubResponse {
+		if r.PathParams["Id"] != "Z123" {
+			return StubErrorResponse(route53.ErrCodeNoSuchHostedZone, "No hosted zone found")
+		}
+
+		return StubResponse{
+			Output: &route53.GetHostedZoneOutput{
+				DelegationSet: &route53.DelegationSet{
+					NameServers: aws.StringSlice([]string{"ns-1.example.com", "ns-2.example.com"}),
+				},
+				HostedZone: &route53.HostedZone{
+					Id:   aws.String("/hostedzone/Z123"),
+					Name: aws.String("example.com."),
+				},
+			},
+		}
+	})
+
+	conn := route53.New(testStubSession(t, s, "route53"))
+
+	output, err := conn.GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String("Z123")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValueSlice(output.DelegationSet.NameServers), []string{"ns-1.example.com", "ns-2.example.com"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	if got, expected := aws.StringValue(output.HostedZone.Name), "example.com."; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	_, err = conn.GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String("Z456")})
+
+	if !tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		t.Errorf("got error %v, expected %s", err, route53.ErrCodeNoSuchHostedZone)
+	}
+}
+
+func TestStubServerProvider(t *testing.T) {
+	s := NewStubServer(t)
+	s.Service("sqs", StubProtocolQuery)
+
+	client := s.Provider().Meta().(*conns.AWSClient)
+
+	if got, expected := client.AccountID, StubAccountID; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got, expected := client.Region, StubRegion; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got, expected := client.SQSConn().Endpoint, s.Endpoint("sqs"); got != expected {
+		t.Errorf("got SQS endpoint %s, expected %s", got, expected)
+	}
+}
+
+func TestStubServerProviderFactories(t *testing.T) {
+	s := NewStubServer(t)
+	s.Service("sqs", StubProtocolQuery)
+
+	p, err := s.ProviderFactories()[ProviderName]()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := stubDiagnosticsError(p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{}))); err != nil {
+		t.Fatalf("error configuring provider: %s", err)
+	}
+
+	client := p.Meta().(*conns.AWSClient)
+
+	if got, expected := client.AccountID, StubAccountID; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if got, expected := client.SQSConn().Endpoint, s.Endpoint("sqs"); got != expected {
+		t.Errorf("got SQS endpoint %s, expected %s", got, expected)
+	}
+}
@@ -0,0 +1,256 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	apiTraceEventCall    = "api_call"
+	apiTraceEventSummary = "summary"
+
+	// apiTraceUnknownResource is the summary key for calls that cannot be attributed to a resource.
+	apiTraceUnknownResource = "(unknown)"
+)
+
+// APITraceRecord is a single traced AWS API call.
+type APITraceRecord struct {
+	Event        string    `json:"event"`
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region,omitempty"`
+	LatencyMS    int64     `json:"latency_ms"`
+	RetryCount   int       `json:"retry_count"`
+	HTTPStatus   int       `json:"http_status,omitempty"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+}
+
+// APITraceSummary is the number of AWS API calls made on behalf of a resource type.
+type APITraceSummary struct {
+	Event        string         `json:"event"`
+	ResourceType string         `json:"resource_type"`
+	Calls        int            `json:"calls"`
+	Operations   map[string]int `json:"operations"`
+}
+
+// apiTraceResource identifies the Terraform resource on whose behalf API calls are made.
+type apiTraceResource struct {
+	typeName string
+	id       string
+}
+
+type apiTraceResourceKey struct{}
+
+// NewAPITraceContext returns a context that attributes traced API calls made with it
+// to the specified Terraform resource.
+func NewAPITraceContext(ctx context.Context, typeName, id string) context.Context {
+	return context.WithValue(ctx, apiTraceResourceKey{}, apiTraceResource{
+		typeName: typeName,
+		id:       id,
+	})
+}
+
+// apiTraceResourceFromContext returns the Terraform resource to which API calls made with the context are attributed.
+func apiTraceResourceFromContext(ctx context.Context) apiTraceResource {
+	if resource, ok := ctx.Value(apiTraceResourceKey{}).(apiTraceResource); ok {
+		return resource
+	}
+
+	if typeName, ok := ResourceTypeNameFromContext(ctx); ok {
+		return apiTraceResource{typeName: typeName}
+	}
+
+	return apiTraceResource{}
+}
+
+// APITracer writes one JSON line per AWS API call to a file and keeps
+// per-resource type call counts for an end-of-run summary.
+type APITracer struct {
+	lock  sync.Mutex
+	file  *os.File
+	enc   *json.Encoder
+	calls map[string]map[string]int
+}
+
+var (
+	apiTracers     = make(map[string]*APITracer)
+	apiTracersLock sync.Mutex
+)
+
+// OpenAPITracer returns the tracer writing to the specified file, creating it if necessary.
+// All provider configurations in the process that trace to the same file share a tracer.
+func OpenAPITracer(path string) (*APITracer, error) {
+	apiTracersLock.Lock()
+	defer apiTracersLock.Unlock()
+
+	if tracer, ok := apiTracers[path]; ok {
+		return tracer, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening API trace file (%s): %w", path, err)
+	}
+
+	tracer := &APITracer{
+		file:  file,
+		enc:   json.NewEncoder(file),
+		calls: make(map[string]map[string]int),
+	}
+
+	apiTracers[path] = tracer
+
+	return tracer, nil
+}
+
+// CloseAPITracers writes the end-of-run summary to, and closes, all open API trace files.
+func CloseAPITracers() {
+	apiTracersLock.Lock()
+	defer apiTracersLock.Unlock()
+
+	for path, tracer := range apiTracers {
+		if err := tracer.Close(); err != nil {
+			log.Printf("[WARN] Error closing API trace file (%s): %s", path, err)
+		}
+
+		delete(apiTracers, path)
+	}
+}
+
+// Summary returns the number of calls made per resource type, ordered by resource type.
+func (t *APITracer) Summary() []APITraceSummary {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var summaries []APITraceSummary
+
+	for typeName, operations := range t.calls {
+		summary := APITraceSummary{
+			Event:        apiTraceEventSummary,
+			ResourceType: typeName,
+			Operations:   make(map[string]int, len(operations)),
+		}
+
+		for operation, n := range operations {
+			summary.Calls += n
+			summary.Operations[operation] = n
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].ResourceType < summaries[j].ResourceType
+	})
+
+	return summaries
+}
+
+// Close writes the end-of-run summary and closes the trace file.
+func (t *APITracer) Close() error {
+	for _, summary := range t.Summary() {
+		log.Printf("[INFO] AWS API calls for %s: %d", summary.ResourceType, summary.Calls)
+
+		t.write(summary)
+	}
+
+	return t.file.Close()
+}
+
+func (t *APITracer) write(v interface{}) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if err := t.enc.Encode(v); err != nil {
+		log.Printf("[WARN] Error writing API trace: %s", err)
+	}
+}
+
+// record traces a completed request.
+func (t *APITracer) record(r *request.Request, resource apiTraceResource) {
+	record := APITraceRecord{
+		Event:        apiTraceEventCall,
+		Time:         r.Time.UTC(),
+		Service:      r.ClientInfo.ServiceID,
+		Operation:    r.Operation.Name,
+		Region:       aws.StringValue(r.Config.Region),
+		LatencyMS:    time.Since(r.Time).Milliseconds(),
+		RetryCount:   r.RetryCount,
+		ResourceType: resource.typeName,
+		ResourceID:   resource.id,
+	}
+
+	if r.HTTPResponse != nil {
+		record.HTTPStatus = r.HTTPResponse.StatusCode
+	}
+
+	if err, ok := r.Error.(awserr.Error); ok {
+		record.ErrorCode = err.Code()
+	}
+
+	t.write(record)
+
+	typeName := resource.typeName
+	if typeName == "" {
+		typeName = apiTraceUnknownResource
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	operations, ok := t.calls[typeName]
+	if !ok {
+		operations = make(map[string]int)
+		t.calls[typeName] = operations
+	}
+	operations[fmt.Sprintf("%s:%s", record.Service, record.Operation)]++
+}
+
+// addAPITraceHandlers adds a handler that traces each request once it has completed, including all retries.
+// Requests are attributed to the Terraform resource identified by the request's context, if any,
+// and otherwise to the specified resource.
+func addAPITraceHandlers(handlers *request.Handlers, tracer *APITracer, resource apiTraceResource) {
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APITrace",
+		Fn: func(r *request.Request) {
+			v := apiTraceResourceFromContext(r.Context())
+
+			if v.typeName == "" {
+				v = resource
+			}
+
+			tracer.record(r, v)
+		},
+	})
+}
+
+// ForAPITraceResource returns a copy of the client whose service clients attribute traced API calls
+// to the specified Terraform resource, including calls made without a context.
+// The copy builds its own service clients, so the receiver is returned if API calls are not traced.
+func (client *AWSClient) ForAPITraceResource(typeName, id string) *AWSClient {
+	if client.apiTracer == nil {
+		return client
+	}
+
+	c := client.clone()
+	c.apiTraceResource = apiTraceResource{
+		typeName: typeName,
+		id:       id,
+	}
+
+	return c
+}
@@ -0,0 +1,137 @@
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestAWSClientAPITrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, testGetCallerIdentityResponse)
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tracer, err := OpenAPITracer(path)
+
+	if err != nil {
+		t.Fatalf("error opening API tracer: %s", err)
+	}
+
+	client := &AWSClient{
+		apiTracer: tracer,
+		endpoints: map[string]string{
+			"sts": server.URL,
+		},
+		session: sess,
+	}
+
+	for i := 0; i < 2; i++ {
+		ctx := NewAPITraceContext(context.Background(), "aws_example_thing", fmt.Sprintf("thing-%d", i))
+
+		if _, err := client.STSConn().GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	CloseAPITracers()
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("error opening API trace file: %s", err)
+	}
+	defer file.Close()
+
+	var records []APITraceRecord
+	summaries := make(map[string]APITraceSummary)
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		var event struct {
+			Event string `json:"event"`
+		}
+
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("error decoding %q: %s", scanner.Text(), err)
+		}
+
+		switch event.Event {
+		case apiTraceEventCall:
+			var record APITraceRecord
+			if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+				t.Fatalf("error decoding %q: %s", scanner.Text(), err)
+			}
+			records = append(records, record)
+		case apiTraceEventSummary:
+			var summary APITraceSummary
+			if err := json.Unmarshal(scanner.Bytes(), &summary); err != nil {
+				t.Fatalf("error decoding %q: %s", scanner.Text(), err)
+			}
+			summaries[summary.ResourceType] = summary
+		default:
+			t.Errorf("unexpected event %q", event.Event)
+		}
+	}
+
+	if got, expected := len(records), 3; got != expected {
+		t.Fatalf("got %d API call records, expected %d", got, expected)
+	}
+
+	record := records[1]
+
+	if got, expected := record.Service, "STS"; got != expected {
+		t.Errorf("got service %q, expected %q", got, expected)
+	}
+
+	if got, expected := record.Operation, "GetCallerIdentity"; got != expected {
+		t.Errorf("got operation %q, expected %q", got, expected)
+	}
+
+	if got, expected := record.HTTPStatus, http.StatusOK; got != expected {
+		t.Errorf("got HTTP status %d, expected %d", got, expected)
+	}
+
+	if got, expected := record.ResourceType, "aws_example_thing"; got != expected {
+		t.Errorf("got resource type %q, expected %q", got, expected)
+	}
+
+	if got, expected := record.ResourceID, "thing-1"; got != expected {
+		t.Errorf("got resource ID %q, expected %q", got, expected)
+	}
+
+	if got, expected := summaries["aws_example_thing"].Calls, 2; got != expected {
+		t.Errorf("got %d calls for aws_example_thing, expected %d", got, expected)
+	}
+
+	if got, expected := summaries[apiTraceUnknownResource].Operations["STS:GetCallerIdentity"], 1; got != expected {
+		t.Errorf("got %d unattributed STS:GetCallerIdentity calls, expected %d", got, expected)
+	}
+}
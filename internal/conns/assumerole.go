@@ -0,0 +1,174 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// AssumeRole is an IAM role assumed by the provider.
+type AssumeRole struct {
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	RoleARN           string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// AssumeRoleWithWebIdentity is an IAM role assumed by the provider using an OpenID Connect (OIDC) token.
+type AssumeRoleWithWebIdentity struct {
+	DurationSeconds      int
+	PolicyARNs           []string
+	RoleARN              string
+	SessionName          string
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+}
+
+// webIdentityToken is a web identity token provided inline.
+type webIdentityToken string
+
+func (t webIdentityToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
+
+// stsConfig returns the configuration for STS clients used to assume roles.
+func (c *Config) stsConfig(httpClient *http.Client) *aws.Config {
+	config := &aws.Config{
+		HTTPClient: httpClient,
+	}
+
+	if v := c.Endpoints["sts"]; v != "" {
+		config.Endpoint = aws.String(v)
+	}
+
+	return config
+}
+
+// assumeRoleChainCredentials returns credentials for the last of the configured roles.
+// Each role is assumed using the credentials of the previous role, starting with the session's credentials.
+func (c *Config) assumeRoleChainCredentials(sess *session.Session, httpClient *http.Client) (*credentials.Credentials, error) {
+	creds := sess.Config.Credentials
+
+	for i, role := range c.AssumeRole {
+		var err error
+
+		creds, err = c.assumeRoleCredentials(sess.Copy(&aws.Config{Credentials: creds}), role, httpClient)
+
+		if err != nil {
+			return nil, fmt.Errorf("assume_role (%d): %w", i, err)
+		}
+	}
+
+	return creds, nil
+}
+
+// assumeRoleCredentials returns credentials for the role, assumed using the session's credentials.
+func (c *Config) assumeRoleCredentials(sess *session.Session, role AssumeRole, httpClient *http.Client) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", role.RoleARN, role.SessionName, role.ExternalID)
+
+	provider := &stscreds.AssumeRoleProvider{
+		Client:  sts.New(sess, c.stsConfig(httpClient)),
+		RoleARN: role.RoleARN,
+	}
+
+	if role.DurationSeconds > 0 {
+		provider.Duration = time.Duration(role.DurationSeconds) * time.Second
+	}
+
+	if role.ExternalID != "" {
+		provider.ExternalID = aws.String(role.ExternalID)
+	}
+
+	if role.Policy != "" {
+		provider.Policy = aws.String(role.Policy)
+	}
+
+	for _, policyARN := range role.PolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	if role.SessionName != "" {
+		provider.RoleSessionName = role.SessionName
+	}
+
+	for k, v := range role.Tags {
+		provider.Tags = append(provider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(role.TransitiveTagKeys) > 0 {
+		provider.TransitiveTagKeys = aws.StringSlice(role.TransitiveTagKeys)
+	}
+
+	creds := credentials.NewCredentials(provider)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("error assuming role (%s): %w", role.RoleARN, err)
+	}
+
+	return creds, nil
+}
+
+// webIdentityCredentials returns credentials for the role assumed with a web identity token.
+// No other credentials are needed.
+func (c *Config) webIdentityCredentials(httpClient *http.Client) (*credentials.Credentials, error) {
+	role := c.AssumeRoleWithWebIdentity
+
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)", role.RoleARN, role.SessionName)
+
+	var tokenFetcher stscreds.TokenFetcher
+
+	switch {
+	case role.WebIdentityToken != "":
+		tokenFetcher = webIdentityToken(role.WebIdentityToken)
+	case role.WebIdentityTokenFile != "":
+		tokenFetcher = stscreds.FetchTokenPath(role.WebIdentityTokenFile)
+	default:
+		return nil, fmt.Errorf("assume_role_with_web_identity: one of web_identity_token or web_identity_token_file must be set")
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		MaxRetries:  aws.Int(c.MaxRetries),
+		Region:      aws.String(c.Region),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating web identity session: %w", err)
+	}
+
+	provider := stscreds.NewWebIdentityRoleProviderWithToken(sts.New(sess, c.stsConfig(httpClient)), role.RoleARN, role.SessionName, tokenFetcher)
+
+	if role.DurationSeconds > 0 {
+		provider.Duration = time.Duration(role.DurationSeconds) * time.Second
+	}
+
+	for _, policyARN := range role.PolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	creds := credentials.NewCredentials(provider)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("error assuming role (%s) with web identity: %w", role.RoleARN, err)
+	}
+
+	return creds, nil
+}
@@ -0,0 +1,167 @@
+package conns
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+const testAssumeRoleWithWebIdentityResponse = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <SubjectFromWebIdentityToken>repo:example/example:ref:refs/heads/main</SubjectFromWebIdentityToken>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/ci/session</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:session</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>WebIdentityAccessKey</AccessKeyId>
+      <SecretAccessKey>secretKey</SecretAccessKey>
+      <SessionToken>sessionToken</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`
+
+func TestConfigClientAssumeRoleChain(t *testing.T) {
+	server := newTestCredentialsServer(t)
+
+	config := testCredentialsConfig(t, server)
+	config.AccessKey = "StaticAccessKey"
+	config.SecretKey = "secretKey"
+	config.AssumeRole = []AssumeRole{
+		{RoleARN: "arn:aws:iam::111111111111:role/hub"},
+		{RoleARN: "arn:aws:iam::222222222222:role/spoke", ExternalID: "example"},
+		{RoleARN: "arn:aws:iam::333333333333:role/workload"},
+	}
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.AccountID, "333333333333"; got != expected {
+		t.Errorf("got account ID %s, expected %s", got, expected)
+	}
+
+	if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := server.roleARNs, []string{
+		"arn:aws:iam::111111111111:role/hub",
+		"arn:aws:iam::222222222222:role/spoke",
+		"arn:aws:iam::333333333333:role/workload",
+	}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got roles assumed %v, expected %v", got, expected)
+	}
+
+	// Each role is assumed using the credentials of the previous role.
+	if got, expected := server.roleAccessKeys, []string{"StaticAccessKey", "AssumedAccessKey1", "AssumedAccessKey2"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got AssumeRole access keys %v, expected %v", got, expected)
+	}
+
+	if got, expected := server.lastSTSAccessKey(), "AssumedAccessKey3"; got != expected {
+		t.Errorf("got access key %s, expected %s", got, expected)
+	}
+}
+
+func TestConfigClientAssumeRoleWithWebIdentity(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		WebIdentityToken     string
+		WebIdentityTokenFile string
+		AssumeRole           []AssumeRole
+		ExpectedRoleARNs     []string
+		ExpectedAccessKey    string
+	}{
+		{
+			TestName:          "token",
+			WebIdentityToken:  "token",
+			ExpectedRoleARNs:  []string{"arn:aws:iam::123456789012:role/ci"},
+			ExpectedAccessKey: "WebIdentityAccessKey",
+		},
+		{
+			TestName:             "token file",
+			WebIdentityTokenFile: "token",
+			ExpectedRoleARNs:     []string{"arn:aws:iam::123456789012:role/ci"},
+			ExpectedAccessKey:    "WebIdentityAccessKey",
+		},
+		{
+			TestName:         "assume role",
+			WebIdentityToken: "token",
+			AssumeRole: []AssumeRole{
+				{RoleARN: "arn:aws:iam::222222222222:role/deploy"},
+			},
+			ExpectedRoleARNs:  []string{"arn:aws:iam::123456789012:role/ci", "arn:aws:iam::222222222222:role/deploy"},
+			ExpectedAccessKey: "AssumedAccessKey1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			server := newTestCredentialsServer(t)
+
+			config := testCredentialsConfig(t, server)
+			config.AssumeRole = testCase.AssumeRole
+			config.AssumeRoleWithWebIdentity = &AssumeRoleWithWebIdentity{
+				RoleARN:          "arn:aws:iam::123456789012:role/ci",
+				SessionName:      "ci",
+				WebIdentityToken: testCase.WebIdentityToken,
+			}
+
+			if testCase.WebIdentityTokenFile != "" {
+				path := filepath.Join(t.TempDir(), "token")
+
+				if err := ioutil.WriteFile(path, []byte(testCase.WebIdentityTokenFile), 0600); err != nil {
+					t.Fatalf("error writing token file: %s", err)
+				}
+
+				config.AssumeRoleWithWebIdentity.WebIdentityTokenFile = path
+			}
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if _, err := raw.(*AWSClient).STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := server.roleARNs, testCase.ExpectedRoleARNs; !reflect.DeepEqual(got, expected) {
+				t.Errorf("got roles assumed %v, expected %v", got, expected)
+			}
+
+			if got, expected := server.webIdentityTokens, []string{"token"}; !reflect.DeepEqual(got, expected) {
+				t.Errorf("got web identity tokens %v, expected %v", got, expected)
+			}
+
+			if got, expected := server.lastSTSAccessKey(), testCase.ExpectedAccessKey; got != expected {
+				t.Errorf("got access key %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigClientAssumeRoleWithWebIdentityNoToken(t *testing.T) {
+	server := newTestCredentialsServer(t)
+
+	config := testCredentialsConfig(t, server)
+	config.AssumeRoleWithWebIdentity = &AssumeRoleWithWebIdentity{
+		RoleARN: "arn:aws:iam::123456789012:role/ci",
+	}
+
+	if _, err := config.Client(); err == nil {
+		t.Fatal("expected error")
+	}
+}
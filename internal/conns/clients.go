@@ -0,0 +1,1551 @@
+package conns
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dataexchange"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/s3outposts"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/signer"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/readcache"
+)
+
+// lazyConn holds a service client that is built at most once, on first use.
+type lazyConn struct {
+	once sync.Once
+	conn interface{}
+}
+
+// conn returns the memoized service client registered under name,
+// calling build to construct it the first time it is requested.
+// It is safe for concurrent use.
+func (client *AWSClient) conn(name string, build func() interface{}) interface{} {
+	client.connsLock.Lock()
+	if client.conns == nil {
+		client.conns = make(map[string]*lazyConn)
+	}
+	lc, ok := client.conns[name]
+	if !ok {
+		lc = &lazyConn{}
+		client.conns[name] = lc
+	}
+	client.connsLock.Unlock()
+
+	lc.once.Do(func() {
+		lc.conn = build()
+	})
+
+	return lc.conn
+}
+
+// serviceSession returns a copy of the provider session for the service
+// identified by the custom endpoint key, with any additional configuration applied.
+func (client *AWSClient) serviceSession(endpointKey string, configs ...*aws.Config) *session.Session {
+	configs = append([]*aws.Config{{
+		Endpoint:   aws.String(client.endpoints[endpointKey]),
+		HTTPClient: client.httpClients.forService(endpointKey),
+	}}, configs...)
+
+	sess := client.session.Copy(configs...)
+
+	if limiter, ok := client.rateLimiters[endpointKey]; ok {
+		addRateLimitHandlers(&sess.Handlers, limiter)
+	}
+
+	if client.readCache {
+		readcache.AddInvalidationHandlers(&sess.Handlers)
+	}
+
+	if client.readOnly {
+		addReadOnlyHandlers(&sess.Handlers)
+	}
+
+	if client.apiTracer != nil {
+		addAPITraceHandlers(&sess.Handlers, client.apiTracer, client.apiTraceResource)
+	}
+
+	return sess
+}
+
+func (client *AWSClient) AccessAnalyzerConn() *accessanalyzer.AccessAnalyzer {
+	return client.conn("AccessAnalyzer", func() interface{} {
+		return accessanalyzer.New(client.serviceSession("accessanalyzer"))
+	}).(*accessanalyzer.AccessAnalyzer)
+}
+
+func (client *AWSClient) ACMConn() *acm.ACM {
+	return client.conn("ACM", func() interface{} {
+		return acm.New(client.serviceSession("acm"))
+	}).(*acm.ACM)
+}
+
+func (client *AWSClient) ACMPCAConn() *acmpca.ACMPCA {
+	return client.conn("ACMPCA", func() interface{} {
+		return acmpca.New(client.serviceSession("acmpca"))
+	}).(*acmpca.ACMPCA)
+}
+
+func (client *AWSClient) AmplifyConn() *amplify.Amplify {
+	return client.conn("Amplify", func() interface{} {
+		return amplify.New(client.serviceSession("amplify"))
+	}).(*amplify.Amplify)
+}
+
+func (client *AWSClient) APIGatewayConn() *apigateway.APIGateway {
+	return client.conn("APIGateway", func() interface{} {
+		conn := apigateway.New(client.serviceSession("apigateway"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Many operations can return an error such as:
+			//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+			// Handle them all globally for the service client.
+			if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*apigateway.APIGateway)
+}
+
+func (client *AWSClient) APIGatewayV2Conn() *apigatewayv2.ApiGatewayV2 {
+	return client.conn("APIGatewayV2", func() interface{} {
+		return apigatewayv2.New(client.serviceSession("apigateway"))
+	}).(*apigatewayv2.ApiGatewayV2)
+}
+
+func (client *AWSClient) AppAutoScalingConn() *applicationautoscaling.ApplicationAutoScaling {
+	return client.conn("AppAutoScaling", func() interface{} {
+		conn := applicationautoscaling.New(client.serviceSession("applicationautoscaling"))
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+				return
+			}
+			if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
+
+func (client *AWSClient) AppConfigConn() *appconfig.AppConfig {
+	return client.conn("AppConfig", func() interface{} {
+		conn := appconfig.New(client.serviceSession("appconfig"))
+
+		// StartDeployment operations can return a ConflictException
+		// if ongoing deployments are in-progress, thus we handle them
+		// here for the service client.
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "StartDeployment" {
+				if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*appconfig.AppConfig)
+}
+
+func (client *AWSClient) ApplicationInsightsConn() *applicationinsights.ApplicationInsights {
+	return client.conn("ApplicationInsights", func() interface{} {
+		return applicationinsights.New(client.serviceSession("applicationinsights"))
+	}).(*applicationinsights.ApplicationInsights)
+}
+
+func (client *AWSClient) AppMeshConn() *appmesh.AppMesh {
+	return client.conn("AppMesh", func() interface{} {
+		return appmesh.New(client.serviceSession("appmesh"))
+	}).(*appmesh.AppMesh)
+}
+
+func (client *AWSClient) AppRunnerConn() *apprunner.AppRunner {
+	return client.conn("AppRunner", func() interface{} {
+		return apprunner.New(client.serviceSession("apprunner"))
+	}).(*apprunner.AppRunner)
+}
+
+func (client *AWSClient) AppStreamConn() *appstream.AppStream {
+	return client.conn("AppStream", func() interface{} {
+		return appstream.New(client.serviceSession("appstream"))
+	}).(*appstream.AppStream)
+}
+
+func (client *AWSClient) AppSyncConn() *appsync.AppSync {
+	return client.conn("AppSync", func() interface{} {
+		conn := appsync.New(client.serviceSession("appsync"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateGraphqlApi" {
+				if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*appsync.AppSync)
+}
+
+func (client *AWSClient) AthenaConn() *athena.Athena {
+	return client.conn("Athena", func() interface{} {
+		return athena.New(client.serviceSession("athena"))
+	}).(*athena.Athena)
+}
+
+func (client *AWSClient) AuditManagerConn() *auditmanager.AuditManager {
+	return client.conn("AuditManager", func() interface{} {
+		return auditmanager.New(client.serviceSession("auditmanager"))
+	}).(*auditmanager.AuditManager)
+}
+
+func (client *AWSClient) AutoScalingConn() *autoscaling.AutoScaling {
+	return client.conn("AutoScaling", func() interface{} {
+		return autoscaling.New(client.serviceSession("autoscaling"))
+	}).(*autoscaling.AutoScaling)
+}
+
+func (client *AWSClient) AutoScalingPlansConn() *autoscalingplans.AutoScalingPlans {
+	return client.conn("AutoScalingPlans", func() interface{} {
+		return autoscalingplans.New(client.serviceSession("autoscalingplans"))
+	}).(*autoscalingplans.AutoScalingPlans)
+}
+
+func (client *AWSClient) BackupConn() *backup.Backup {
+	return client.conn("Backup", func() interface{} {
+		return backup.New(client.serviceSession("backup"))
+	}).(*backup.Backup)
+}
+
+func (client *AWSClient) BatchConn() *batch.Batch {
+	return client.conn("Batch", func() interface{} {
+		return batch.New(client.serviceSession("batch"))
+	}).(*batch.Batch)
+}
+
+func (client *AWSClient) BudgetsConn() *budgets.Budgets {
+	return client.conn("Budgets", func() interface{} {
+		return budgets.New(client.serviceSession("budgets"))
+	}).(*budgets.Budgets)
+}
+
+func (client *AWSClient) CloudFormationConn() *cloudformation.CloudFormation {
+	return client.conn("CloudFormation", func() interface{} {
+		conn := cloudformation.New(client.serviceSession("cloudformation"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*cloudformation.CloudFormation)
+}
+
+func (client *AWSClient) ChimeConn() *chime.Chime {
+	return client.conn("Chime", func() interface{} {
+		conn := chime.New(client.serviceSession("chime"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling CreateVoiceConnector across multiple resources,
+			// the API can randomly return a BadRequestException without explanation
+			if r.Operation.Name == "CreateVoiceConnector" {
+				if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*chime.Chime)
+}
+
+func (client *AWSClient) Cloud9Conn() *cloud9.Cloud9 {
+	return client.conn("Cloud9", func() interface{} {
+		return cloud9.New(client.serviceSession("cloud9"))
+	}).(*cloud9.Cloud9)
+}
+
+func (client *AWSClient) CloudControlConn() *cloudcontrolapi.CloudControlApi {
+	return client.conn("CloudControl", func() interface{} {
+		return cloudcontrolapi.New(client.serviceSession("cloudcontrolapi"))
+	}).(*cloudcontrolapi.CloudControlApi)
+}
+
+func (client *AWSClient) CloudFrontConn() *cloudfront.CloudFront {
+	return client.conn("CloudFront", func() interface{} {
+		return cloudfront.New(client.serviceSession("cloudfront"))
+	}).(*cloudfront.CloudFront)
+}
+
+func (client *AWSClient) CloudHSMV2Conn() *cloudhsmv2.CloudHSMV2 {
+	return client.conn("CloudHSMV2", func() interface{} {
+		conn := cloudhsmv2.New(client.serviceSession("cloudhsm"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*cloudhsmv2.CloudHSMV2)
+}
+
+func (client *AWSClient) CloudSearchConn() *cloudsearch.CloudSearch {
+	return client.conn("CloudSearch", func() interface{} {
+		return cloudsearch.New(client.serviceSession("cloudsearch"))
+	}).(*cloudsearch.CloudSearch)
+}
+
+func (client *AWSClient) CloudTrailConn() *cloudtrail.CloudTrail {
+	return client.conn("CloudTrail", func() interface{} {
+		return cloudtrail.New(client.serviceSession("cloudtrail"))
+	}).(*cloudtrail.CloudTrail)
+}
+
+func (client *AWSClient) CloudWatchConn() *cloudwatch.CloudWatch {
+	return client.conn("CloudWatch", func() interface{} {
+		return cloudwatch.New(client.serviceSession("cloudwatch"))
+	}).(*cloudwatch.CloudWatch)
+}
+
+func (client *AWSClient) CloudWatchEventsConn() *cloudwatchevents.CloudWatchEvents {
+	return client.conn("CloudWatchEvents", func() interface{} {
+		return cloudwatchevents.New(client.serviceSession("cloudwatchevents"))
+	}).(*cloudwatchevents.CloudWatchEvents)
+}
+
+func (client *AWSClient) CloudWatchLogsConn() *cloudwatchlogs.CloudWatchLogs {
+	return client.conn("CloudWatchLogs", func() interface{} {
+		return cloudwatchlogs.New(client.serviceSession("cloudwatchlogs"))
+	}).(*cloudwatchlogs.CloudWatchLogs)
+}
+
+func (client *AWSClient) CodeArtifactConn() *codeartifact.CodeArtifact {
+	return client.conn("CodeArtifact", func() interface{} {
+		return codeartifact.New(client.serviceSession("codeartifact"))
+	}).(*codeartifact.CodeArtifact)
+}
+
+func (client *AWSClient) CodeBuildConn() *codebuild.CodeBuild {
+	return client.conn("CodeBuild", func() interface{} {
+		return codebuild.New(client.serviceSession("codebuild"))
+	}).(*codebuild.CodeBuild)
+}
+
+func (client *AWSClient) CodeCommitConn() *codecommit.CodeCommit {
+	return client.conn("CodeCommit", func() interface{} {
+		return codecommit.New(client.serviceSession("codecommit"))
+	}).(*codecommit.CodeCommit)
+}
+
+func (client *AWSClient) CodeDeployConn() *codedeploy.CodeDeploy {
+	return client.conn("CodeDeploy", func() interface{} {
+		return codedeploy.New(client.serviceSession("codedeploy"))
+	}).(*codedeploy.CodeDeploy)
+}
+
+func (client *AWSClient) CodePipelineConn() *codepipeline.CodePipeline {
+	return client.conn("CodePipeline", func() interface{} {
+		return codepipeline.New(client.serviceSession("codepipeline"))
+	}).(*codepipeline.CodePipeline)
+}
+
+func (client *AWSClient) CodeStarConnectionsConn() *codestarconnections.CodeStarConnections {
+	return client.conn("CodeStarConnections", func() interface{} {
+		return codestarconnections.New(client.serviceSession("codestarconnections"))
+	}).(*codestarconnections.CodeStarConnections)
+}
+
+func (client *AWSClient) CodeStarNotificationsConn() *codestarnotifications.CodeStarNotifications {
+	return client.conn("CodeStarNotifications", func() interface{} {
+		return codestarnotifications.New(client.serviceSession("codestarnotifications"))
+	}).(*codestarnotifications.CodeStarNotifications)
+}
+
+func (client *AWSClient) CognitoIdentityConn() *cognitoidentity.CognitoIdentity {
+	return client.conn("CognitoIdentity", func() interface{} {
+		return cognitoidentity.New(client.serviceSession("cognitoidentity"))
+	}).(*cognitoidentity.CognitoIdentity)
+}
+
+func (client *AWSClient) CognitoIDPConn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return client.conn("CognitoIDP", func() interface{} {
+		return cognitoidentityprovider.New(client.serviceSession("cognitoidp"))
+	}).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (client *AWSClient) ConfigConn() *configservice.ConfigService {
+	return client.conn("Config", func() interface{} {
+		conn := configservice.New(client.serviceSession("configservice"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling Config Organization Rules API actions immediately
+			// after Organization creation, the API can randomly return the
+			// OrganizationAccessDeniedException error for a few minutes, even
+			// after succeeding a few requests.
+			switch r.Operation.Name {
+			case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
+				if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
+				if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
+					if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
+						r.Retryable = aws.Bool(true)
+					}
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			}
+		})
+
+		return conn
+	}).(*configservice.ConfigService)
+}
+
+func (client *AWSClient) ConnectConn() *connect.Connect {
+	return client.conn("Connect", func() interface{} {
+		return connect.New(client.serviceSession("connect"))
+	}).(*connect.Connect)
+}
+
+func (client *AWSClient) CURConn() *costandusagereportservice.CostandUsageReportService {
+	return client.conn("CUR", func() interface{} {
+		return costandusagereportservice.New(client.serviceSession("cur"))
+	}).(*costandusagereportservice.CostandUsageReportService)
+}
+
+func (client *AWSClient) DataExchangeConn() *dataexchange.DataExchange {
+	return client.conn("DataExchange", func() interface{} {
+		return dataexchange.New(client.serviceSession("dataexchange"))
+	}).(*dataexchange.DataExchange)
+}
+
+func (client *AWSClient) DataPipelineConn() *datapipeline.DataPipeline {
+	return client.conn("DataPipeline", func() interface{} {
+		return datapipeline.New(client.serviceSession("datapipeline"))
+	}).(*datapipeline.DataPipeline)
+}
+
+func (client *AWSClient) DataSyncConn() *datasync.DataSync {
+	return client.conn("DataSync", func() interface{} {
+		return datasync.New(client.serviceSession("datasync"))
+	}).(*datasync.DataSync)
+}
+
+func (client *AWSClient) DAXConn() *dax.DAX {
+	return client.conn("DAX", func() interface{} {
+		return dax.New(client.serviceSession("dax"))
+	}).(*dax.DAX)
+}
+
+func (client *AWSClient) DetectiveConn() *detective.Detective {
+	return client.conn("Detective", func() interface{} {
+		return detective.New(client.serviceSession("detective"))
+	}).(*detective.Detective)
+}
+
+func (client *AWSClient) DeviceFarmConn() *devicefarm.DeviceFarm {
+	return client.conn("DeviceFarm", func() interface{} {
+		return devicefarm.New(client.serviceSession("devicefarm"))
+	}).(*devicefarm.DeviceFarm)
+}
+
+func (client *AWSClient) DLMConn() *dlm.DLM {
+	return client.conn("DLM", func() interface{} {
+		return dlm.New(client.serviceSession("dlm"))
+	}).(*dlm.DLM)
+}
+
+func (client *AWSClient) DMSConn() *databasemigrationservice.DatabaseMigrationService {
+	return client.conn("DMS", func() interface{} {
+		return databasemigrationservice.New(client.serviceSession("dms"))
+	}).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (client *AWSClient) DocDBConn() *docdb.DocDB {
+	return client.conn("DocDB", func() interface{} {
+		return docdb.New(client.serviceSession("docdb"))
+	}).(*docdb.DocDB)
+}
+
+func (client *AWSClient) DirectoryServiceConn() *directoryservice.DirectoryService {
+	return client.conn("DirectoryService", func() interface{} {
+		return directoryservice.New(client.serviceSession("ds"))
+	}).(*directoryservice.DirectoryService)
+}
+
+func (client *AWSClient) DirectConnectConn() *directconnect.DirectConnect {
+	return client.conn("DirectConnect", func() interface{} {
+		return directconnect.New(client.serviceSession("directconnect"))
+	}).(*directconnect.DirectConnect)
+}
+
+func (client *AWSClient) DynamoDBConn() *dynamodb.DynamoDB {
+	return client.conn("DynamoDB", func() interface{} {
+		conn := dynamodb.New(client.serviceSession("dynamodb"))
+
+		// See https://github.com/aws/aws-sdk-go/pull/1276
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+				return
+			}
+			if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*dynamodb.DynamoDB)
+}
+
+func (client *AWSClient) EC2Conn() *ec2.EC2 {
+	return client.conn("EC2", func() interface{} {
+		conn := ec2.New(client.serviceSession("ec2"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateClientVpnEndpoint" {
+				if tfawserr.ErrMessageContains(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnConnection" {
+				if tfawserr.ErrMessageContains(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnGateway" {
+				if tfawserr.ErrMessageContains(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
+				if tfawserr.ErrMessageContains(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ec2.EC2)
+}
+
+func (client *AWSClient) ECRConn() *ecr.ECR {
+	return client.conn("ECR", func() interface{} {
+		return ecr.New(client.serviceSession("ecr"))
+	}).(*ecr.ECR)
+}
+
+func (client *AWSClient) ECRPublicConn() *ecrpublic.ECRPublic {
+	return client.conn("ECRPublic", func() interface{} {
+		return ecrpublic.New(client.serviceSession("ecrpublic"))
+	}).(*ecrpublic.ECRPublic)
+}
+
+func (client *AWSClient) ECSConn() *ecs.ECS {
+	return client.conn("ECS", func() interface{} {
+		return ecs.New(client.serviceSession("ecs"))
+	}).(*ecs.ECS)
+}
+
+func (client *AWSClient) EFSConn() *efs.EFS {
+	return client.conn("EFS", func() interface{} {
+		return efs.New(client.serviceSession("efs"))
+	}).(*efs.EFS)
+}
+
+func (client *AWSClient) EKSConn() *eks.EKS {
+	return client.conn("EKS", func() interface{} {
+		return eks.New(client.serviceSession("eks"))
+	}).(*eks.EKS)
+}
+
+func (client *AWSClient) ElastiCacheConn() *elasticache.ElastiCache {
+	return client.conn("ElastiCache", func() interface{} {
+		return elasticache.New(client.serviceSession("elasticache"))
+	}).(*elasticache.ElastiCache)
+}
+
+func (client *AWSClient) ElasticBeanstalkConn() *elasticbeanstalk.ElasticBeanstalk {
+	return client.conn("ElasticBeanstalk", func() interface{} {
+		return elasticbeanstalk.New(client.serviceSession("elasticbeanstalk"))
+	}).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (client *AWSClient) ElasticTranscoderConn() *elastictranscoder.ElasticTranscoder {
+	return client.conn("ElasticTranscoder", func() interface{} {
+		return elastictranscoder.New(client.serviceSession("elastictranscoder"))
+	}).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (client *AWSClient) ELBConn() *elb.ELB {
+	return client.conn("ELB", func() interface{} {
+		return elb.New(client.serviceSession("elb"))
+	}).(*elb.ELB)
+}
+
+func (client *AWSClient) ELBV2Conn() *elbv2.ELBV2 {
+	return client.conn("ELBV2", func() interface{} {
+		return elbv2.New(client.serviceSession("elb"))
+	}).(*elbv2.ELBV2)
+}
+
+func (client *AWSClient) EMRConn() *emr.EMR {
+	return client.conn("EMR", func() interface{} {
+		return emr.New(client.serviceSession("emr"))
+	}).(*emr.EMR)
+}
+
+func (client *AWSClient) EMRContainersConn() *emrcontainers.EMRContainers {
+	return client.conn("EMRContainers", func() interface{} {
+		return emrcontainers.New(client.serviceSession("emrcontainers"))
+	}).(*emrcontainers.EMRContainers)
+}
+
+func (client *AWSClient) ElasticSearchConn() *elasticsearch.ElasticsearchService {
+	return client.conn("ElasticSearch", func() interface{} {
+		return elasticsearch.New(client.serviceSession("es"))
+	}).(*elasticsearch.ElasticsearchService)
+}
+
+func (client *AWSClient) FirehoseConn() *firehose.Firehose {
+	return client.conn("Firehose", func() interface{} {
+		return firehose.New(client.serviceSession("firehose"))
+	}).(*firehose.Firehose)
+}
+
+func (client *AWSClient) FMSConn() *fms.FMS {
+	return client.conn("FMS", func() interface{} {
+		conn := fms.New(client.serviceSession("fms"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Acceptance testing creates and deletes resources in quick succession.
+			// The FMS onboarding process into Organizations is opaque to consumers.
+			// Since we cannot reasonably check this status before receiving the error,
+			// set the operation as retryable.
+			switch r.Operation.Name {
+			case "AssociateAdminAccount":
+				if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
+					r.Retryable = aws.Bool(true)
+				}
+			case "DisassociateAdminAccount":
+				if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*fms.FMS)
+}
+
+func (client *AWSClient) ForecastConn() *forecastservice.ForecastService {
+	return client.conn("Forecast", func() interface{} {
+		return forecastservice.New(client.serviceSession("forecast"))
+	}).(*forecastservice.ForecastService)
+}
+
+func (client *AWSClient) FSxConn() *fsx.FSx {
+	return client.conn("FSx", func() interface{} {
+		return fsx.New(client.serviceSession("fsx"))
+	}).(*fsx.FSx)
+}
+
+func (client *AWSClient) GameLiftConn() *gamelift.GameLift {
+	return client.conn("GameLift", func() interface{} {
+		return gamelift.New(client.serviceSession("gamelift"))
+	}).(*gamelift.GameLift)
+}
+
+func (client *AWSClient) GlacierConn() *glacier.Glacier {
+	return client.conn("Glacier", func() interface{} {
+		return glacier.New(client.serviceSession("glacier"))
+	}).(*glacier.Glacier)
+}
+
+func (client *AWSClient) GlobalAcceleratorConn() *globalaccelerator.GlobalAccelerator {
+	return client.conn("GlobalAccelerator", func() interface{} {
+		config := &aws.Config{}
+
+		// Force "global" services to correct regions
+		if client.Partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		}
+
+		return globalaccelerator.New(client.serviceSession("globalaccelerator", config))
+	}).(*globalaccelerator.GlobalAccelerator)
+}
+
+func (client *AWSClient) GlueConn() *glue.Glue {
+	return client.conn("Glue", func() interface{} {
+		return glue.New(client.serviceSession("glue"))
+	}).(*glue.Glue)
+}
+
+func (client *AWSClient) GuardDutyConn() *guardduty.GuardDuty {
+	return client.conn("GuardDuty", func() interface{} {
+		return guardduty.New(client.serviceSession("guardduty"))
+	}).(*guardduty.GuardDuty)
+}
+
+func (client *AWSClient) GreengrassConn() *greengrass.Greengrass {
+	return client.conn("Greengrass", func() interface{} {
+		return greengrass.New(client.serviceSession("greengrass"))
+	}).(*greengrass.Greengrass)
+}
+
+func (client *AWSClient) IAMConn() *iam.IAM {
+	return client.conn("IAM", func() interface{} {
+		return iam.New(client.serviceSession("iam"))
+	}).(*iam.IAM)
+}
+
+func (client *AWSClient) IdentityStoreConn() *identitystore.IdentityStore {
+	return client.conn("IdentityStore", func() interface{} {
+		return identitystore.New(client.serviceSession("identitystore"))
+	}).(*identitystore.IdentityStore)
+}
+
+func (client *AWSClient) ImageBuilderConn() *imagebuilder.Imagebuilder {
+	return client.conn("ImageBuilder", func() interface{} {
+		return imagebuilder.New(client.serviceSession("imagebuilder"))
+	}).(*imagebuilder.Imagebuilder)
+}
+
+func (client *AWSClient) InspectorConn() *inspector.Inspector {
+	return client.conn("Inspector", func() interface{} {
+		return inspector.New(client.serviceSession("inspector"))
+	}).(*inspector.Inspector)
+}
+
+func (client *AWSClient) IoTConn() *iot.IoT {
+	return client.conn("IoT", func() interface{} {
+		return iot.New(client.serviceSession("iot"))
+	}).(*iot.IoT)
+}
+
+func (client *AWSClient) IoTAnalyticsConn() *iotanalytics.IoTAnalytics {
+	return client.conn("IoTAnalytics", func() interface{} {
+		return iotanalytics.New(client.serviceSession("iotanalytics"))
+	}).(*iotanalytics.IoTAnalytics)
+}
+
+func (client *AWSClient) IoTEventsConn() *iotevents.IoTEvents {
+	return client.conn("IoTEvents", func() interface{} {
+		return iotevents.New(client.serviceSession("iotevents"))
+	}).(*iotevents.IoTEvents)
+}
+
+func (client *AWSClient) KafkaConn() *kafka.Kafka {
+	return client.conn("Kafka", func() interface{} {
+		conn := kafka.New(client.serviceSession("kafka"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*kafka.Kafka)
+}
+
+func (client *AWSClient) KinesisAnalyticsConn() *kinesisanalytics.KinesisAnalytics {
+	return client.conn("KinesisAnalytics", func() interface{} {
+		return kinesisanalytics.New(client.serviceSession("kinesisanalytics"))
+	}).(*kinesisanalytics.KinesisAnalytics)
+}
+
+func (client *AWSClient) KinesisAnalyticsV2Conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return client.conn("KinesisAnalyticsV2", func() interface{} {
+		return kinesisanalyticsv2.New(client.serviceSession("kinesisanalyticsv2"))
+	}).(*kinesisanalyticsv2.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) KinesisConn() *kinesis.Kinesis {
+	return client.conn("Kinesis", func() interface{} {
+		conn := kinesis.New(client.serviceSession("kinesis"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateStream" {
+				if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+			if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+				if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*kinesis.Kinesis)
+}
+
+func (client *AWSClient) KinesisVideoConn() *kinesisvideo.KinesisVideo {
+	return client.conn("KinesisVideo", func() interface{} {
+		return kinesisvideo.New(client.serviceSession("kinesisvideo"))
+	}).(*kinesisvideo.KinesisVideo)
+}
+
+func (client *AWSClient) KMSConn() *kms.KMS {
+	return client.conn("KMS", func() interface{} {
+		return kms.New(client.serviceSession("kms"))
+	}).(*kms.KMS)
+}
+
+func (client *AWSClient) LakeFormationConn() *lakeformation.LakeFormation {
+	return client.conn("LakeFormation", func() interface{} {
+		return lakeformation.New(client.serviceSession("lakeformation"))
+	}).(*lakeformation.LakeFormation)
+}
+
+func (client *AWSClient) LambdaConn() *lambda.Lambda {
+	return client.conn("Lambda", func() interface{} {
+		return lambda.New(client.serviceSession("lambda"))
+	}).(*lambda.Lambda)
+}
+
+func (client *AWSClient) LexModelBuildingConn() *lexmodelbuildingservice.LexModelBuildingService {
+	return client.conn("LexModelBuilding", func() interface{} {
+		return lexmodelbuildingservice.New(client.serviceSession("lexmodels"))
+	}).(*lexmodelbuildingservice.LexModelBuildingService)
+}
+
+func (client *AWSClient) LicenseManagerConn() *licensemanager.LicenseManager {
+	return client.conn("LicenseManager", func() interface{} {
+		return licensemanager.New(client.serviceSession("licensemanager"))
+	}).(*licensemanager.LicenseManager)
+}
+
+func (client *AWSClient) LightsailConn() *lightsail.Lightsail {
+	return client.conn("Lightsail", func() interface{} {
+		return lightsail.New(client.serviceSession("lightsail"))
+	}).(*lightsail.Lightsail)
+}
+
+func (client *AWSClient) LocationConn() *locationservice.LocationService {
+	return client.conn("Location", func() interface{} {
+		return locationservice.New(client.serviceSession("location"))
+	}).(*locationservice.LocationService)
+}
+
+func (client *AWSClient) MacieConn() *macie.Macie {
+	return client.conn("Macie", func() interface{} {
+		return macie.New(client.serviceSession("macie"))
+	}).(*macie.Macie)
+}
+
+func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
+	return client.conn("Macie2", func() interface{} {
+		return macie2.New(client.serviceSession("macie2"))
+	}).(*macie2.Macie2)
+}
+
+func (client *AWSClient) ManagedBlockchainConn() *managedblockchain.ManagedBlockchain {
+	return client.conn("ManagedBlockchain", func() interface{} {
+		return managedblockchain.New(client.serviceSession("managedblockchain"))
+	}).(*managedblockchain.ManagedBlockchain)
+}
+
+func (client *AWSClient) MarketplaceCatalogConn() *marketplacecatalog.MarketplaceCatalog {
+	return client.conn("MarketplaceCatalog", func() interface{} {
+		return marketplacecatalog.New(client.serviceSession("marketplacecatalog"))
+	}).(*marketplacecatalog.MarketplaceCatalog)
+}
+
+func (client *AWSClient) MediaConnectConn() *mediaconnect.MediaConnect {
+	return client.conn("MediaConnect", func() interface{} {
+		return mediaconnect.New(client.serviceSession("mediaconnect"))
+	}).(*mediaconnect.MediaConnect)
+}
+
+func (client *AWSClient) MediaConvertConn() *mediaconvert.MediaConvert {
+	return client.conn("MediaConvert", func() interface{} {
+		return mediaconvert.New(client.serviceSession("mediaconvert"))
+	}).(*mediaconvert.MediaConvert)
+}
+
+func (client *AWSClient) MediaLiveConn() *medialive.MediaLive {
+	return client.conn("MediaLive", func() interface{} {
+		return medialive.New(client.serviceSession("medialive"))
+	}).(*medialive.MediaLive)
+}
+
+func (client *AWSClient) MediaPackageConn() *mediapackage.MediaPackage {
+	return client.conn("MediaPackage", func() interface{} {
+		return mediapackage.New(client.serviceSession("mediapackage"))
+	}).(*mediapackage.MediaPackage)
+}
+
+func (client *AWSClient) MediaStoreConn() *mediastore.MediaStore {
+	return client.conn("MediaStore", func() interface{} {
+		return mediastore.New(client.serviceSession("mediastore"))
+	}).(*mediastore.MediaStore)
+}
+
+func (client *AWSClient) MediaStoreDataConn() *mediastoredata.MediaStoreData {
+	return client.conn("MediaStoreData", func() interface{} {
+		return mediastoredata.New(client.serviceSession("mediastoredata"))
+	}).(*mediastoredata.MediaStoreData)
+}
+
+func (client *AWSClient) MemoryDBConn() *memorydb.MemoryDB {
+	return client.conn("MemoryDB", func() interface{} {
+		return memorydb.New(client.serviceSession("memorydb"))
+	}).(*memorydb.MemoryDB)
+}
+
+func (client *AWSClient) MQConn() *mq.MQ {
+	return client.conn("MQ", func() interface{} {
+		return mq.New(client.serviceSession("mq"))
+	}).(*mq.MQ)
+}
+
+func (client *AWSClient) MWAAConn() *mwaa.MWAA {
+	return client.conn("MWAA", func() interface{} {
+		return mwaa.New(client.serviceSession("mwaa"))
+	}).(*mwaa.MWAA)
+}
+
+func (client *AWSClient) NeptuneConn() *neptune.Neptune {
+	return client.conn("Neptune", func() interface{} {
+		return neptune.New(client.serviceSession("neptune"))
+	}).(*neptune.Neptune)
+}
+
+func (client *AWSClient) NetworkFirewallConn() *networkfirewall.NetworkFirewall {
+	return client.conn("NetworkFirewall", func() interface{} {
+		return networkfirewall.New(client.serviceSession("networkfirewall"))
+	}).(*networkfirewall.NetworkFirewall)
+}
+
+func (client *AWSClient) NetworkManagerConn() *networkmanager.NetworkManager {
+	return client.conn("NetworkManager", func() interface{} {
+		return networkmanager.New(client.serviceSession("networkmanager"))
+	}).(*networkmanager.NetworkManager)
+}
+
+func (client *AWSClient) OpsWorksConn() *opsworks.OpsWorks {
+	return client.conn("OpsWorks", func() interface{} {
+		return opsworks.New(client.serviceSession("opsworks"))
+	}).(*opsworks.OpsWorks)
+}
+
+func (client *AWSClient) OrganizationsConn() *organizations.Organizations {
+	return client.conn("Organizations", func() interface{} {
+		conn := organizations.New(client.serviceSession("organizations"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Retry on the following error:
+			// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+			if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*organizations.Organizations)
+}
+
+func (client *AWSClient) OutpostsConn() *outposts.Outposts {
+	return client.conn("Outposts", func() interface{} {
+		return outposts.New(client.serviceSession("outposts"))
+	}).(*outposts.Outposts)
+}
+
+func (client *AWSClient) PersonalizeConn() *personalize.Personalize {
+	return client.conn("Personalize", func() interface{} {
+		return personalize.New(client.serviceSession("personalize"))
+	}).(*personalize.Personalize)
+}
+
+func (client *AWSClient) PrometheusConn() *prometheusservice.PrometheusService {
+	return client.conn("Prometheus", func() interface{} {
+		return prometheusservice.New(client.serviceSession("prometheusservice"))
+	}).(*prometheusservice.PrometheusService)
+}
+
+func (client *AWSClient) PinpointConn() *pinpoint.Pinpoint {
+	return client.conn("Pinpoint", func() interface{} {
+		return pinpoint.New(client.serviceSession("pinpoint"))
+	}).(*pinpoint.Pinpoint)
+}
+
+func (client *AWSClient) PricingConn() *pricing.Pricing {
+	return client.conn("Pricing", func() interface{} {
+		return pricing.New(client.serviceSession("pricing"))
+	}).(*pricing.Pricing)
+}
+
+func (client *AWSClient) QLDBConn() *qldb.QLDB {
+	return client.conn("QLDB", func() interface{} {
+		return qldb.New(client.serviceSession("qldb"))
+	}).(*qldb.QLDB)
+}
+
+func (client *AWSClient) QuickSightConn() *quicksight.QuickSight {
+	return client.conn("QuickSight", func() interface{} {
+		return quicksight.New(client.serviceSession("quicksight"))
+	}).(*quicksight.QuickSight)
+}
+
+func (client *AWSClient) Route53Conn() *route53.Route53 {
+	return client.conn("Route53", func() interface{} {
+		config := &aws.Config{}
+
+		// Force "global" services to correct regions
+		switch client.Partition {
+		case endpoints.AwsPartitionID:
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		case endpoints.AwsCnPartitionID:
+			// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+			// This can likely be removed in the future.
+			if client.endpoints["route53"] == "" {
+				config.Endpoint = aws.String("https://api.route53.cn")
+			}
+			config.Region = aws.String(endpoints.CnNorthwest1RegionID)
+		case endpoints.AwsUsGovPartitionID:
+			config.Region = aws.String(endpoints.UsGovWest1RegionID)
+		}
+
+		return route53.New(client.serviceSession("route53", config))
+	}).(*route53.Route53)
+}
+
+func (client *AWSClient) RAMConn() *ram.RAM {
+	return client.conn("RAM", func() interface{} {
+		return ram.New(client.serviceSession("ram"))
+	}).(*ram.RAM)
+}
+
+func (client *AWSClient) RDSConn() *rds.RDS {
+	return client.conn("RDS", func() interface{} {
+		return rds.New(client.serviceSession("rds"))
+	}).(*rds.RDS)
+}
+
+func (client *AWSClient) RedshiftConn() *redshift.Redshift {
+	return client.conn("Redshift", func() interface{} {
+		return redshift.New(client.serviceSession("redshift"))
+	}).(*redshift.Redshift)
+}
+
+func (client *AWSClient) ResourceGroupsConn() *resourcegroups.ResourceGroups {
+	return client.conn("ResourceGroups", func() interface{} {
+		return resourcegroups.New(client.serviceSession("resourcegroups"))
+	}).(*resourcegroups.ResourceGroups)
+}
+
+func (client *AWSClient) ResourceGroupsTaggingConn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return client.conn("ResourceGroupsTagging", func() interface{} {
+		return resourcegroupstaggingapi.New(client.serviceSession("resourcegroupstaggingapi"))
+	}).(*resourcegroupstaggingapi.ResourceGroupsTaggingAPI)
+}
+
+func (client *AWSClient) Route53DomainsConn() *route53domains.Route53Domains {
+	return client.conn("Route53Domains", func() interface{} {
+		return route53domains.New(client.serviceSession("route53domains"))
+	}).(*route53domains.Route53Domains)
+}
+
+func (client *AWSClient) Route53RecoveryControlConfigConn() *route53recoverycontrolconfig.Route53RecoveryControlConfig {
+	return client.conn("Route53RecoveryControlConfig", func() interface{} {
+		config := &aws.Config{}
+
+		// Force "global" services to correct regions
+		if client.Partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		}
+
+		return route53recoverycontrolconfig.New(client.serviceSession("route53recoverycontrolconfig", config))
+	}).(*route53recoverycontrolconfig.Route53RecoveryControlConfig)
+}
+
+func (client *AWSClient) Route53RecoveryReadinessConn() *route53recoveryreadiness.Route53RecoveryReadiness {
+	return client.conn("Route53RecoveryReadiness", func() interface{} {
+		config := &aws.Config{}
+
+		// Force "global" services to correct regions
+		if client.Partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		}
+
+		return route53recoveryreadiness.New(client.serviceSession("route53recoveryreadiness", config))
+	}).(*route53recoveryreadiness.Route53RecoveryReadiness)
+}
+
+func (client *AWSClient) Route53ResolverConn() *route53resolver.Route53Resolver {
+	return client.conn("Route53Resolver", func() interface{} {
+		return route53resolver.New(client.serviceSession("route53resolver"))
+	}).(*route53resolver.Route53Resolver)
+}
+
+func (client *AWSClient) S3Conn() *s3.S3 {
+	return client.conn("S3", func() interface{} {
+		return s3.New(client.serviceSession("s3", &aws.Config{
+			S3ForcePathStyle: aws.Bool(client.s3ForcePathStyle),
+		}))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) S3ConnURICleaningDisabled() *s3.S3 {
+	return client.conn("S3URICleaningDisabled", func() interface{} {
+		return s3.New(client.serviceSession("s3", &aws.Config{
+			DisableRestProtocolURICleaning: aws.Bool(true),
+			S3ForcePathStyle:               aws.Bool(client.s3ForcePathStyle),
+		}))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) S3ControlConn() *s3control.S3Control {
+	return client.conn("S3Control", func() interface{} {
+		return s3control.New(client.serviceSession("s3control"))
+	}).(*s3control.S3Control)
+}
+
+func (client *AWSClient) S3OutpostsConn() *s3outposts.S3Outposts {
+	return client.conn("S3Outposts", func() interface{} {
+		return s3outposts.New(client.serviceSession("s3outposts"))
+	}).(*s3outposts.S3Outposts)
+}
+
+func (client *AWSClient) SageMakerConn() *sagemaker.SageMaker {
+	return client.conn("SageMaker", func() interface{} {
+		return sagemaker.New(client.serviceSession("sagemaker"))
+	}).(*sagemaker.SageMaker)
+}
+
+func (client *AWSClient) ServiceCatalogConn() *servicecatalog.ServiceCatalog {
+	return client.conn("ServiceCatalog", func() interface{} {
+		return servicecatalog.New(client.serviceSession("servicecatalog"))
+	}).(*servicecatalog.ServiceCatalog)
+}
+
+func (client *AWSClient) SchemasConn() *schemas.Schemas {
+	return client.conn("Schemas", func() interface{} {
+		return schemas.New(client.serviceSession("schemas"))
+	}).(*schemas.Schemas)
+}
+
+func (client *AWSClient) ServiceDiscoveryConn() *servicediscovery.ServiceDiscovery {
+	return client.conn("ServiceDiscovery", func() interface{} {
+		return servicediscovery.New(client.serviceSession("servicediscovery"))
+	}).(*servicediscovery.ServiceDiscovery)
+}
+
+func (client *AWSClient) SecretsManagerConn() *secretsmanager.SecretsManager {
+	return client.conn("SecretsManager", func() interface{} {
+		return secretsmanager.New(client.serviceSession("secretsmanager"))
+	}).(*secretsmanager.SecretsManager)
+}
+
+func (client *AWSClient) SecurityHubConn() *securityhub.SecurityHub {
+	return client.conn("SecurityHub", func() interface{} {
+		conn := securityhub.New(client.serviceSession("securityhub"))
+
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			switch r.Operation.Name {
+			case "EnableOrganizationAdminAccount":
+				if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*securityhub.SecurityHub)
+}
+
+func (client *AWSClient) ServerlessAppRepoConn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return client.conn("ServerlessAppRepo", func() interface{} {
+		return serverlessapplicationrepository.New(client.serviceSession("serverlessrepo"))
+	}).(*serverlessapplicationrepository.ServerlessApplicationRepository)
+}
+
+func (client *AWSClient) ServiceQuotasConn() *servicequotas.ServiceQuotas {
+	return client.conn("ServiceQuotas", func() interface{} {
+		return servicequotas.New(client.serviceSession("servicequotas"))
+	}).(*servicequotas.ServiceQuotas)
+}
+
+func (client *AWSClient) SESConn() *ses.SES {
+	return client.conn("SES", func() interface{} {
+		return ses.New(client.serviceSession("ses"))
+	}).(*ses.SES)
+}
+
+func (client *AWSClient) SFNConn() *sfn.SFN {
+	return client.conn("SFN", func() interface{} {
+		return sfn.New(client.serviceSession("stepfunctions"))
+	}).(*sfn.SFN)
+}
+
+func (client *AWSClient) ShieldConn() *shield.Shield {
+	return client.conn("Shield", func() interface{} {
+		config := &aws.Config{}
+
+		// Force "global" services to correct regions
+		if client.Partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		}
+
+		return shield.New(client.serviceSession("shield", config))
+	}).(*shield.Shield)
+}
+
+func (client *AWSClient) SignerConn() *signer.Signer {
+	return client.conn("Signer", func() interface{} {
+		return signer.New(client.serviceSession("signer"))
+	}).(*signer.Signer)
+}
+
+func (client *AWSClient) SimpleDBConn() *simpledb.SimpleDB {
+	return client.conn("SimpleDB", func() interface{} {
+		return simpledb.New(client.serviceSession("sdb"))
+	}).(*simpledb.SimpleDB)
+}
+
+func (client *AWSClient) SNSConn() *sns.SNS {
+	return client.conn("SNS", func() interface{} {
+		return sns.New(client.serviceSession("sns"))
+	}).(*sns.SNS)
+}
+
+func (client *AWSClient) SQSConn() *sqs.SQS {
+	return client.conn("SQS", func() interface{} {
+		return sqs.New(client.serviceSession("sqs"))
+	}).(*sqs.SQS)
+}
+
+func (client *AWSClient) SSMConn() *ssm.SSM {
+	return client.conn("SSM", func() interface{} {
+		return ssm.New(client.serviceSession("ssm"))
+	}).(*ssm.SSM)
+}
+
+func (client *AWSClient) SSOAdminConn() *ssoadmin.SSOAdmin {
+	return client.conn("SSOAdmin", func() interface{} {
+		conn := ssoadmin.New(client.serviceSession("ssoadmin"))
+
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
+				if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ssoadmin.SSOAdmin)
+}
+
+func (client *AWSClient) StorageGatewayConn() *storagegateway.StorageGateway {
+	return client.conn("StorageGateway", func() interface{} {
+		conn := storagegateway.New(client.serviceSession("storagegateway"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+			if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*storagegateway.StorageGateway)
+}
+
+func (client *AWSClient) STSConn() *sts.STS {
+	return client.conn("STS", func() interface{} {
+		return sts.New(client.serviceSession("sts"))
+	}).(*sts.STS)
+}
+
+func (client *AWSClient) SWFConn() *swf.SWF {
+	return client.conn("SWF", func() interface{} {
+		return swf.New(client.serviceSession("swf"))
+	}).(*swf.SWF)
+}
+
+func (client *AWSClient) SyntheticsConn() *synthetics.Synthetics {
+	return client.conn("Synthetics", func() interface{} {
+		return synthetics.New(client.serviceSession("synthetics"))
+	}).(*synthetics.Synthetics)
+}
+
+func (client *AWSClient) TimestreamWriteConn() *timestreamwrite.TimestreamWrite {
+	return client.conn("TimestreamWrite", func() interface{} {
+		return timestreamwrite.New(client.serviceSession("timestreamwrite"))
+	}).(*timestreamwrite.TimestreamWrite)
+}
+
+func (client *AWSClient) TransferConn() *transfer.Transfer {
+	return client.conn("Transfer", func() interface{} {
+		return transfer.New(client.serviceSession("transfer"))
+	}).(*transfer.Transfer)
+}
+
+func (client *AWSClient) WAFConn() *waf.WAF {
+	return client.conn("WAF", func() interface{} {
+		return waf.New(client.serviceSession("waf"))
+	}).(*waf.WAF)
+}
+
+func (client *AWSClient) WAFRegionalConn() *wafregional.WAFRegional {
+	return client.conn("WAFRegional", func() interface{} {
+		return wafregional.New(client.serviceSession("wafregional"))
+	}).(*wafregional.WAFRegional)
+}
+
+func (client *AWSClient) WAFV2Conn() *wafv2.WAFV2 {
+	return client.conn("WAFV2", func() interface{} {
+		conn := wafv2.New(client.serviceSession("wafv2"))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
+				r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
+				// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+				if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+				if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*wafv2.WAFV2)
+}
+
+func (client *AWSClient) WorkLinkConn() *worklink.WorkLink {
+	return client.conn("WorkLink", func() interface{} {
+		return worklink.New(client.serviceSession("worklink"))
+	}).(*worklink.WorkLink)
+}
+
+func (client *AWSClient) WorkMailConn() *workmail.WorkMail {
+	return client.conn("WorkMail", func() interface{} {
+		return workmail.New(client.serviceSession("workmail"))
+	}).(*workmail.WorkMail)
+}
+
+func (client *AWSClient) WorkSpacesConn() *workspaces.WorkSpaces {
+	return client.conn("WorkSpaces", func() interface{} {
+		return workspaces.New(client.serviceSession("workspaces"))
+	}).(*workspaces.WorkSpaces)
+}
+
+func (client *AWSClient) XRayConn() *xray.XRay {
+	return client.conn("XRay", func() interface{} {
+		return xray.New(client.serviceSession("xray"))
+	}).(*xray.XRay)
+}
@@ -0,0 +1,160 @@
+package conns
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestAWSClientConnMemoization(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	client := &AWSClient{
+		endpoints: map[string]string{
+			"ec2": "http://localhost:4566",
+		},
+		session: sess,
+	}
+
+	if got := len(client.conns); got != 0 {
+		t.Fatalf("got %d service clients before first use, expected 0", got)
+	}
+
+	const n = 16
+	results := make([]*ec2.EC2, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = client.EC2Conn()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, conn := range results {
+		if conn != results[0] {
+			t.Errorf("EC2Conn() call %d returned a different client", i)
+		}
+	}
+
+	if got, expected := aws.StringValue(results[0].Config.Endpoint), "http://localhost:4566"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+
+	if got := len(client.conns); got != 1 {
+		t.Errorf("got %d service clients after first use, expected 1", got)
+	}
+}
+
+func TestAWSClientForRegion(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	client := &AWSClient{
+		Partition: endpoints.AwsPartitionID,
+		Region:    "us-west-2", //lintignore:AWSAT003
+		session:   sess,
+	}
+	client.regions = newRegionalClients(client)
+
+	for _, region := range []string{"", "us-west-2"} { //lintignore:AWSAT003
+		if c, err := client.ForRegion(region); err != nil {
+			t.Errorf("unexpected error for region %q: %s", region, err)
+		} else if c != client {
+			t.Errorf("ForRegion(%q) returned a different client", region)
+		}
+	}
+
+	regional, err := client.ForRegion("eu-west-1") //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := regional.Region, "eu-west-1"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got region %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(regional.EC2Conn().Config.Region), "eu-west-1"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got EC2 client region %s, expected %s", got, expected)
+	}
+
+	if regional.EC2Conn().Config.Credentials != client.EC2Conn().Config.Credentials {
+		t.Error("expected regional client to share credentials")
+	}
+
+	if c, _ := client.ForRegion("eu-west-1"); c != regional { //lintignore:AWSAT003
+		t.Error("expected ForRegion to return the same client for each call")
+	}
+
+	if c, _ := regional.ForRegion("us-west-2"); c != client { //lintignore:AWSAT003
+		t.Error("expected ForRegion on a regional client to return the provider's client")
+	}
+
+	if _, err := client.ForRegion("cn-north-1"); err == nil { //lintignore:AWSAT003
+		t.Error("expected error for region in another partition")
+	}
+
+	if _, err := client.ForRegion("not-a-region"); err == nil {
+		t.Error("expected error for invalid region")
+	}
+}
+
+func TestAWSClientForRegionEndpoints(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	client := &AWSClient{
+		Partition: endpoints.AwsPartitionID,
+		Region:    "us-west-2", //lintignore:AWSAT003
+		endpoints: map[string]string{
+			"ec2": "https://ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			"sqs": "http://localhost:4566",
+		},
+		session: sess,
+	}
+	client.regions = newRegionalClients(client)
+
+	regional, err := client.ForRegion("eu-west-1") //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := regional.EC2Conn().Endpoint, "https://ec2.eu-west-1.amazonaws.com"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got regional EC2 endpoint %s, expected %s", got, expected)
+	}
+
+	if got, expected := client.EC2Conn().Endpoint, "https://ec2.us-west-2.amazonaws.com"; got != expected { //lintignore:AWSAT003
+		t.Errorf("got provider EC2 endpoint %s, expected %s", got, expected)
+	}
+
+	if got, expected := regional.SQSConn().Endpoint, "http://localhost:4566"; got != expected {
+		t.Errorf("got regional SQS endpoint %s, expected %s", got, expected)
+	}
+}
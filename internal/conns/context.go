@@ -0,0 +1,41 @@
+package conns
+
+import (
+	"context"
+)
+
+type resourceTypeNameKey struct{}
+
+// NewResourceContext returns a context identifying the type of the Terraform resource
+// on whose behalf a function is called.
+func NewResourceContext(ctx context.Context, typeName string) context.Context {
+	return context.WithValue(ctx, resourceTypeNameKey{}, typeName)
+}
+
+// ResourceTypeNameFromContext returns the type of the Terraform resource in the context, if any.
+func ResourceTypeNameFromContext(ctx context.Context) (string, bool) {
+	typeName, ok := ctx.Value(resourceTypeNameKey{}).(string)
+
+	return typeName, ok
+}
+
+// StoppableContext returns a copy of the context that is also canceled when the provider is stopped,
+// so that long running operations return promptly when Terraform is interrupted.
+// The returned cancel function must be called when the operation is complete.
+func (client *AWSClient) StoppableContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	if client.stopContext == nil {
+		return ctx, cancel
+	}
+
+	go func() {
+		select {
+		case <-client.stopContext.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
@@ -0,0 +1,43 @@
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAWSClientStoppableContext(t *testing.T) {
+	stopContext, stop := context.WithCancel(context.Background())
+	client := (&AWSClient{stopContext: stopContext}).clone()
+
+	ctx, cancel := client.StoppableContext(NewResourceContext(context.Background(), "aws_example"))
+	defer cancel()
+
+	if typeName, ok := ResourceTypeNameFromContext(ctx); !ok || typeName != "aws_example" {
+		t.Errorf("expected context values to be preserved, got %q", typeName)
+	}
+
+	stop()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected context to be canceled when the provider is stopped")
+	}
+}
+
+func TestAWSClientStoppableContextNoStopContext(t *testing.T) {
+	client := &AWSClient{}
+
+	ctx, cancel := client.StoppableContext(context.Background())
+
+	if err := ctx.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cancel()
+
+	if err := ctx.Err(); err != context.Canceled {
+		t.Errorf("expected context canceled, got %v", err)
+	}
+}
@@ -0,0 +1,265 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// DefaultCredentialsExpiryWindow is how long before they expire that
+// temporary credentials are refreshed, so that requests made during
+// long-running operations are not signed with credentials about to expire.
+const DefaultCredentialsExpiryWindow = 5 * time.Minute
+
+// now returns the current time and may be replaced in tests.
+var now = time.Now
+
+// expiryWindowProvider refreshes credentials that expire before their
+// provider would otherwise refresh them.
+// Credentials that do not expire are never refreshed.
+type expiryWindowProvider struct {
+	credentials.Expiry
+
+	creds   *credentials.Credentials
+	expires bool
+	window  time.Duration
+}
+
+// newExpiryWindowCredentials returns credentials that are refreshed the specified
+// time before the wrapped credentials expire, or half way through their lifetime
+// if that is sooner.
+func newExpiryWindowCredentials(creds *credentials.Credentials, window time.Duration) *credentials.Credentials {
+	return credentials.NewCredentials(&expiryWindowProvider{
+		Expiry: credentials.Expiry{
+			CurrentTime: func() time.Time { return now() },
+		},
+		creds:  creds,
+		window: window,
+	})
+}
+
+func (p *expiryWindowProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+func (p *expiryWindowProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	if p.expires {
+		// The wrapped credentials have not yet expired, so force a refresh.
+		p.creds.Expire()
+	}
+
+	v, err := p.creds.GetWithContext(ctx)
+
+	if err != nil {
+		return credentials.Value{}, err
+	}
+
+	expiresAt, err := p.creds.ExpiresAt()
+	p.expires = err == nil && !expiresAt.IsZero()
+
+	if p.expires {
+		window := p.window
+
+		if lifetime := expiresAt.Sub(now()); lifetime < 2*window {
+			window = lifetime / 2
+		}
+
+		log.Printf("[DEBUG] AWS credentials (%s) expire at %s, refreshing %s before", v.ProviderName, expiresAt, window)
+		p.SetExpiration(expiresAt, window)
+	}
+
+	return v, nil
+}
+
+func (p *expiryWindowProvider) IsExpired() bool {
+	return p.expires && p.Expiry.IsExpired()
+}
+
+func (c *Config) credentialsExpiryWindow() time.Duration {
+	if c.CredentialsExpiryWindow > 0 {
+		return c.CredentialsExpiryWindow
+	}
+
+	return DefaultCredentialsExpiryWindow
+}
+
+// externalCredentials returns the credentials obtained by assuming a role with a web identity,
+// from the configured credential process, or from the settings of the configured profile that
+// aws-sdk-go-base does not resolve, e.g. a credential process or IAM Identity Center (SSO).
+// Returns nil if credentials are configured any other way.
+func (c *Config) externalCredentials(httpClients *httpClients) (*credentials.Credentials, error) {
+	if c.AssumeRoleWithWebIdentity != nil {
+		return c.webIdentityCredentials(httpClients.forService("sts"))
+	}
+
+	if c.CredentialProcess != "" {
+		return processCredentials(c.CredentialProcess)
+	}
+
+	// Static and environment credentials take precedence over the profile.
+	if c.AccessKey != "" || os.Getenv("AWS_ACCESS_KEY_ID") != "" {
+		return nil, nil
+	}
+
+	return c.profileCredentials(httpClients.forService("sts"))
+}
+
+func processCredentials(command string) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Retrieving AWS credentials from credential process")
+
+	creds := processcreds.NewCredentials(command)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("error retrieving credentials from credential process: %w", err)
+	}
+
+	return creds, nil
+}
+
+// profileCredentials returns the credentials of the configured profile, resolved by the AWS SDK
+// from the shared credentials and config files, so that all of the SDK's profile settings are supported,
+// e.g. role_arn with source_profile or credential_source, credential_process, and IAM Identity Center (SSO).
+// Returns nil if the profile has static credentials in the shared credentials file, or no credentials,
+// as aws-sdk-go-base resolves those.
+func (c *Config) profileCredentials(httpClient *http.Client) (*credentials.Credentials, error) {
+	profile := c.Profile
+	if profile == "" {
+		profile = GetEnvVarWithDefault("AWS_PROFILE", "default")
+	}
+
+	credsFilename, err := homedir.Expand(c.sharedCredentialsFilename())
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding shared credentials filename: %w", err)
+	}
+
+	configFilename, err := homedir.Expand(c.sharedConfigFilename())
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding shared config filename: %w", err)
+	}
+
+	if _, err := credentials.NewSharedCredentials(credsFilename, profile).Get(); err == nil {
+		return nil, nil
+	}
+
+	// aws-sdk-go-base disables the EC2 metadata API for the process once the session is configured.
+	// Disable it now, so that resolving a profile without credentials does not wait for the API.
+	if c.SkipMetadataApiCheck {
+		os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			EndpointResolver:              c.credentialsEndpointResolver(),
+			HTTPClient:                    httpClient,
+			MaxRetries:                    aws.Int(c.MaxRetries),
+			Region:                        aws.String(c.Region),
+		},
+		Profile: profile,
+		// Settings in the shared credentials file take precedence.
+		SharedConfigFiles: []string{configFilename, credsFilename},
+		SharedConfigState: session.SharedConfigEnable,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error loading profile (%s): %w", profile, err)
+	}
+
+	creds := sess.Config.Credentials
+	v, err := creds.Get()
+
+	if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+		return nil, nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, ssocreds.ErrCodeSSOProviderInvalidToken) {
+		return nil, fmt.Errorf("error retrieving credentials for SSO profile (%[1]s), the cached SSO token has expired or is missing, run \"aws sso login --profile %[1]s\": %w", profile, err)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving credentials for profile (%s): %w", profile, err)
+	}
+
+	switch v.ProviderName {
+	case ec2rolecreds.ProviderName, endpointcreds.ProviderName:
+		// The profile has no credentials, so the SDK fell back to the container or EC2 instance credentials.
+		return nil, nil
+	}
+
+	log.Printf("[INFO] Retrieved AWS credentials for profile (%s) from %s", profile, v.ProviderName)
+
+	return creds, nil
+}
+
+// credentialsEndpointResolver returns a resolver for the STS and SSO portal API endpoints
+// called to retrieve profile credentials that uses any custom endpoints.
+func (c *Config) credentialsEndpointResolver() endpoints.Resolver {
+	return endpoints.ResolverFunc(func(service, region string, optFns ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		resolvedEndpoint, err := endpoints.DefaultResolver().EndpointFor(service, region, optFns...)
+
+		if err != nil {
+			return resolvedEndpoint, err
+		}
+
+		var endpointKey string
+
+		switch service {
+		case sso.EndpointsID:
+			endpointKey = "sso"
+		case sts.EndpointsID:
+			endpointKey = "sts"
+		}
+
+		if v := c.Endpoints[endpointKey]; v != "" {
+			resolvedEndpoint.URL = v
+		}
+
+		return resolvedEndpoint, nil
+	})
+}
+
+func (c *Config) sharedCredentialsFilename() string {
+	if c.CredsFilename != "" {
+		return c.CredsFilename
+	}
+
+	return GetEnvVarWithDefault("AWS_SHARED_CREDENTIALS_FILE", filepath.Join("~", ".aws", "credentials"))
+}
+
+func (c *Config) sharedConfigFilename() string {
+	if c.SharedConfigFile != "" {
+		return c.SharedConfigFile
+	}
+
+	return GetEnvVarWithDefault("AWS_CONFIG_FILE", filepath.Join("~", ".aws", "config"))
+}
+
+// accountIDAndPartitionFromARN returns the account ID and partition of the specified ARN.
+func accountIDAndPartitionFromARN(v string) (string, string, error) {
+	arn, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	return arn.AccountID, arn.Partition, nil
+}
@@ -0,0 +1,488 @@
+package conns
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+const testAssumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/test/session</Arn>
+      <AssumedRoleId>ARO123EXAMPLE123:session</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>%s</AccessKeyId>
+      <SecretAccessKey>secretKey</SecretAccessKey>
+      <SessionToken>sessionToken</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
+
+var testAccessKeyRegexp = regexp.MustCompile(`Credential=([^/]+)/`)
+
+// testSetenv sets an environment variable for the duration of the test.
+func testSetenv(t *testing.T, key, value string) {
+	t.Helper()
+
+	old, ok := os.LookupEnv(key)
+	os.Setenv(key, value)
+
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+// testUnsetCredentialsEnv clears any credentials configured in the environment.
+func testUnsetCredentialsEnv(t *testing.T) {
+	t.Helper()
+
+	for _, k := range []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "AWS_PROFILE", "AWS_CONFIG_FILE", "AWS_SHARED_CREDENTIALS_FILE"} {
+		testSetenv(t, k, "")
+	}
+}
+
+// testCredentialsServer is a local stand-in for the STS and SSO portal APIs.
+type testCredentialsServer struct {
+	*httptest.Server
+
+	lock              sync.Mutex
+	assumeRoles       int
+	roleAccessKeys    []string // Access keys used to sign AssumeRole requests
+	roleARNs          []string // Roles assumed, in order
+	stsAccessKeys     []string // Access keys used to sign other STS requests
+	webIdentityTokens []string
+}
+
+func newTestCredentialsServer(t *testing.T) *testCredentialsServer {
+	t.Helper()
+
+	s := &testCredentialsServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		if r.URL.Path == "/federation/credentials" {
+			if r.Header.Get("X-Amz-Sso_bearer_token") != "ssoAccessToken" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"SSOAccessKey","secretAccessKey":"secretKey","sessionToken":"sessionToken","expiration":%d}}`, time.Now().Add(time.Hour).UnixNano()/int64(time.Millisecond))
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var accessKey string
+		if m := testAccessKeyRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+			accessKey = m[1]
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch r.Form.Get("Action") {
+		case "AssumeRole":
+			s.assumeRoles++
+			s.roleAccessKeys = append(s.roleAccessKeys, accessKey)
+			s.roleARNs = append(s.roleARNs, r.Form.Get("RoleArn"))
+			fmt.Fprintf(w, testAssumeRoleResponse, fmt.Sprintf("AssumedAccessKey%d", s.assumeRoles), time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		case "AssumeRoleWithWebIdentity":
+			if accessKey != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			s.roleARNs = append(s.roleARNs, r.Form.Get("RoleArn"))
+			s.webIdentityTokens = append(s.webIdentityTokens, r.Form.Get("WebIdentityToken"))
+			fmt.Fprintf(w, testAssumeRoleWithWebIdentityResponse, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		case "GetCallerIdentity":
+			s.stsAccessKeys = append(s.stsAccessKeys, accessKey)
+			fmt.Fprint(w, testGetCallerIdentityResponse)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *testCredentialsServer) lastSTSAccessKey() string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.stsAccessKeys) == 0 {
+		return ""
+	}
+
+	return s.stsAccessKeys[len(s.stsAccessKeys)-1]
+}
+
+// testCredentialProcess writes a fake credential process and returns its command
+// and the path of a file that has a line appended each time the process is run.
+func testCredentialProcess(t *testing.T, expiration time.Time) (string, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	calls := filepath.Join(dir, "calls")
+	script := filepath.Join(dir, "credential-process.sh")
+	content := fmt.Sprintf(`#!/bin/sh
+echo run >> %q
+echo '{"Version": 1, "AccessKeyId": "ProcessAccessKey", "SecretAccessKey": "secretKey", "SessionToken": "sessionToken", "Expiration": "%s"}'
+`, calls, expiration.UTC().Format(time.RFC3339))
+
+	if err := ioutil.WriteFile(script, []byte(content), 0700); err != nil {
+		t.Fatalf("error writing credential process: %s", err)
+	}
+
+	return script, calls
+}
+
+func testFileLines(t *testing.T, path string) int {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("error reading %s: %s", path, err)
+	}
+
+	return strings.Count(string(b), "\n")
+}
+
+func testCredentialsConfig(t *testing.T, server *testCredentialsServer) *Config {
+	t.Helper()
+
+	testUnsetCredentialsEnv(t)
+
+	dir := t.TempDir()
+
+	return &Config{
+		CredsFilename:        filepath.Join(dir, "credentials"),
+		Endpoints:            map[string]string{"iam": server.URL, "sso": server.URL, "sts": server.URL},
+		MaxRetries:           1,
+		Region:               "us-east-1", //lintignore:AWSAT003
+		SharedConfigFile:     filepath.Join(dir, "config"),
+		SkipGetEC2Platforms:  true,
+		SkipMetadataApiCheck: true,
+	}
+}
+
+// testExpiringProvider returns credentials that expire after a fixed lifetime.
+type testExpiringProvider struct {
+	credentials.Expiry
+
+	lifetime  time.Duration
+	retrieved int
+}
+
+func (p *testExpiringProvider) Retrieve() (credentials.Value, error) {
+	p.retrieved++
+
+	if p.lifetime > 0 {
+		p.SetExpiration(now().Add(p.lifetime), 0)
+	}
+
+	return credentials.Value{AccessKeyID: fmt.Sprintf("AccessKey%d", p.retrieved), ProviderName: "test"}, nil
+}
+
+func (p *testExpiringProvider) IsExpired() bool {
+	return p.lifetime > 0 && p.Expiry.IsExpired()
+}
+
+func TestExpiryWindowCredentials(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		Lifetime          time.Duration
+		Elapsed           time.Duration
+		ExpectedRetrieved int
+	}{
+		{
+			TestName:          "before window",
+			Lifetime:          time.Hour,
+			Elapsed:           50 * time.Minute,
+			ExpectedRetrieved: 1,
+		},
+		{
+			TestName:          "in window",
+			Lifetime:          time.Hour,
+			Elapsed:           56 * time.Minute,
+			ExpectedRetrieved: 2,
+		},
+		{
+			TestName:          "short lifetime",
+			Lifetime:          6 * time.Minute,
+			Elapsed:           4 * time.Minute,
+			ExpectedRetrieved: 2,
+		},
+		{
+			TestName:          "no expiry",
+			Elapsed:           24 * time.Hour,
+			ExpectedRetrieved: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			defer func(f func() time.Time) { now = f }(now)
+			start := time.Now()
+			now = func() time.Time { return start }
+
+			provider := &testExpiringProvider{lifetime: testCase.Lifetime}
+			provider.CurrentTime = func() time.Time { return now() }
+			creds := newExpiryWindowCredentials(credentials.NewCredentials(provider), DefaultCredentialsExpiryWindow)
+
+			if _, err := creds.Get(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			now = func() time.Time { return start.Add(testCase.Elapsed) }
+
+			v, err := creds.Get()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if provider.retrieved != testCase.ExpectedRetrieved {
+				t.Errorf("got %d retrievals, expected %d", provider.retrieved, testCase.ExpectedRetrieved)
+			}
+
+			if got, expected := v.AccessKeyID, fmt.Sprintf("AccessKey%d", testCase.ExpectedRetrieved); got != expected {
+				t.Errorf("got access key %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigClientCredentialProcessAssumeRole(t *testing.T) {
+	server := newTestCredentialsServer(t)
+	command, calls := testCredentialProcess(t, time.Now().Add(time.Hour))
+
+	config := testCredentialsConfig(t, server)
+	config.AssumeRole = []AssumeRole{{RoleARN: "arn:aws:iam::123456789012:role/test"}}
+	config.CredentialProcess = command
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.AccountID, "123456789012"; got != expected {
+		t.Errorf("got account ID %s, expected %s", got, expected)
+	}
+
+	if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := server.lastSTSAccessKey(), "AssumedAccessKey1"; got != expected {
+		t.Errorf("got access key %s, expected %s", got, expected)
+	}
+
+	// The assumed role credentials are refreshed before they expire.
+	defer func(f func() time.Time) { now = f }(now)
+	later := time.Now().Add(56 * time.Minute)
+	now = func() time.Time { return later }
+
+	if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := server.lastSTSAccessKey(), "AssumedAccessKey2"; got != expected {
+		t.Errorf("got access key %s after refresh, expected %s", got, expected)
+	}
+
+	for _, accessKey := range server.roleAccessKeys {
+		if got, expected := accessKey, "ProcessAccessKey"; got != expected {
+			t.Errorf("got AssumeRole access key %s, expected %s", got, expected)
+		}
+	}
+
+	if got, expected := testFileLines(t, calls), 1; got != expected {
+		t.Errorf("got %d credential process runs, expected %d", got, expected)
+	}
+}
+
+func TestConfigClientCredentialProcessProfile(t *testing.T) {
+	server := newTestCredentialsServer(t)
+	command, _ := testCredentialProcess(t, time.Now().Add(time.Hour))
+
+	config := testCredentialsConfig(t, server)
+	config.Profile = "process"
+
+	content := fmt.Sprintf("[profile process]\ncredential_process = %s\n", command)
+	if err := ioutil.WriteFile(config.SharedConfigFile, []byte(content), 0600); err != nil {
+		t.Fatalf("error writing config file: %s", err)
+	}
+
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := server.lastSTSAccessKey(), "ProcessAccessKey"; got != expected {
+		t.Errorf("got access key %s, expected %s", got, expected)
+	}
+}
+
+func TestConfigClientSourceProfileAssumeRole(t *testing.T) {
+	server := newTestCredentialsServer(t)
+	command, _ := testCredentialProcess(t, time.Now().Add(time.Hour))
+
+	config := testCredentialsConfig(t, server)
+	config.Profile = "role"
+
+	content := fmt.Sprintf(`[profile process]
+credential_process = %s
+
+[profile role]
+role_arn = arn:aws:iam::123456789012:role/test
+source_profile = process
+`, command)
+	if err := ioutil.WriteFile(config.SharedConfigFile, []byte(content), 0600); err != nil {
+		t.Fatalf("error writing config file: %s", err)
+	}
+
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := server.lastSTSAccessKey(), "AssumedAccessKey1"; got != expected {
+		t.Errorf("got access key %s, expected %s", got, expected)
+	}
+
+	if got, expected := strings.Join(server.roleAccessKeys, ","), "ProcessAccessKey"; got != expected {
+		t.Errorf("got AssumeRole access keys %s, expected %s", got, expected)
+	}
+}
+
+func TestConfigClientSSOProfile(t *testing.T) {
+	const startURL = "https://example.awsapps.com/start"
+
+	testCases := []struct {
+		TestName      string
+		TokenExpires  time.Time
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			TestName:     "valid token",
+			TokenExpires: time.Now().Add(time.Hour),
+		},
+		{
+			TestName:      "expired token",
+			TokenExpires:  time.Now().Add(-time.Hour),
+			ExpectedError: regexp.MustCompile(`aws sso login --profile sso`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			server := newTestCredentialsServer(t)
+			config := testCredentialsConfig(t, server)
+			config.Profile = "sso"
+
+			content := fmt.Sprintf(`[profile sso]
+sso_start_url = %s
+sso_region = us-east-1
+sso_account_id = 123456789012
+sso_role_name = Test
+`, startURL)
+			if err := ioutil.WriteFile(config.SharedConfigFile, []byte(content), 0600); err != nil {
+				t.Fatalf("error writing config file: %s", err)
+			}
+
+			home := t.TempDir()
+			testSetenv(t, "HOME", home)
+
+			cacheDir := filepath.Join(home, ".aws", "sso", "cache")
+			if err := os.MkdirAll(cacheDir, 0700); err != nil {
+				t.Fatalf("error creating SSO cache: %s", err)
+			}
+
+			hash := sha1.Sum([]byte(startURL))
+			token := fmt.Sprintf(`{"accessToken": "ssoAccessToken", "expiresAt": "%s", "region": "us-east-1", "startUrl": "%s"}`, testCase.TokenExpires.UTC().Format(time.RFC3339), startURL)
+			if err := ioutil.WriteFile(filepath.Join(cacheDir, hex.EncodeToString(hash[:])+".json"), []byte(token), 0600); err != nil {
+				t.Fatalf("error writing SSO token: %s", err)
+			}
+
+			_, err := config.Client()
+
+			if testCase.ExpectedError != nil {
+				if err == nil || !testCase.ExpectedError.MatchString(err.Error()) {
+					t.Fatalf("got error %v, expected error matching %q", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := server.lastSTSAccessKey(), "SSOAccessKey"; got != expected {
+				t.Errorf("got access key %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigClientStaticCredentialsNotRefreshed(t *testing.T) {
+	server := newTestCredentialsServer(t)
+	config := testCredentialsConfig(t, server)
+	config.AccessKey = "StaticAccessKey"
+	config.SecretKey = "secretKey"
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer func(f func() time.Time) { now = f }(now)
+	later := time.Now().Add(24 * time.Hour)
+	now = func() time.Time { return later }
+
+	if _, err := raw.(*AWSClient).STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := server.lastSTSAccessKey(), "StaticAccessKey"; got != expected {
+		t.Errorf("got access key %s, expected %s", got, expected)
+	}
+
+	if got := aws.StringValue(raw.(*AWSClient).STSConn().Config.Endpoint); got != server.URL {
+		t.Errorf("got STS endpoint %s, expected %s", got, server.URL)
+	}
+}
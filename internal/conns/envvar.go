@@ -54,6 +54,13 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For recording the AWS API calls of acceptance tests to cassette files (record) or replaying them (replay)
+	EnvVarAccCassetteMode = "TF_ACC_CASSETTE_MODE"
+
+	// The directory of acceptance test cassette files, relative to the package directory
+	// Defaults to testdata/cassettes
+	EnvVarAccCassetteDir = "TF_ACC_CASSETTE_DIR"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -0,0 +1,76 @@
+package conns
+
+import (
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
+)
+
+// RateLimit configures client-side request rate limiting for a service.
+// Zero values disable the corresponding limit.
+type RateLimit struct {
+	Burst             int
+	MaxInFlight       int
+	RequestsPerSecond float64
+}
+
+// newRateLimiters returns a limiter for each configured service, keyed by custom endpoint key.
+func newRateLimiters(rateLimits map[string]RateLimit) map[string]*ratelimit.Limiter {
+	limiters := make(map[string]*ratelimit.Limiter, len(rateLimits))
+
+	for service, rateLimit := range rateLimits {
+		limiters[service] = ratelimit.NewLimiter(rateLimit.RequestsPerSecond, rateLimit.Burst, rateLimit.MaxInFlight)
+	}
+
+	return limiters
+}
+
+// addRateLimitHandlers adds handlers that enforce the limiter to each
+// attempt of a request, including retries.
+// The limiter is acquired after the request is signed and released once the
+// attempt completes, or once the request completes if the attempt is never
+// sent, e.g. because a later Sign handler failed.
+func addRateLimitHandlers(handlers *request.Handlers, limiter *ratelimit.Limiter) {
+	// acquired holds the requests whose current attempt has acquired the limiter.
+	var acquired sync.Map
+
+	release := func(r *request.Request) {
+		if _, ok := acquired.LoadAndDelete(r); ok {
+			limiter.Release()
+		}
+	}
+
+	handlers.Sign.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimitAcquire",
+		Fn: func(r *request.Request) {
+			// Presigned requests are never sent.
+			if r.Error != nil || r.ExpireTime > 0 {
+				return
+			}
+
+			if _, ok := acquired.Load(r); ok {
+				return
+			}
+
+			if err := limiter.Acquire(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request rate limit wait canceled", err)
+
+				return
+			}
+
+			acquired.Store(r, struct{}{})
+		},
+	})
+
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimitReleaseAttempt",
+		Fn:   release,
+	})
+
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimitRelease",
+		Fn:   release,
+	})
+}
@@ -0,0 +1,150 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+const testGetCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/Alice</Arn>
+    <UserId>AKIAI44QH8DHBEXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+
+// newRateLimitTestClient returns an AWSClient whose STS endpoint is a local
+// HTTP server that records the maximum number of concurrent requests.
+func newRateLimitTestClient(t *testing.T, rateLimits map[string]RateLimit) (*AWSClient, *int32, func()) {
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, testGetCallerIdentityResponse)
+	}))
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})
+
+	if err != nil {
+		server.Close()
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	client := &AWSClient{
+		endpoints: map[string]string{
+			"sts": server.URL,
+		},
+		rateLimiters: newRateLimiters(rateLimits),
+		session:      sess,
+	}
+
+	return client, &maxInFlight, server.Close
+}
+
+func TestAWSClientRateLimitMaxInFlight(t *testing.T) {
+	client, maxInFlight, closeFunc := newRateLimitTestClient(t, map[string]RateLimit{
+		"sts": {MaxInFlight: 2},
+	})
+	defer closeFunc()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(maxInFlight); got > 2 {
+		t.Errorf("got %d concurrent requests, expected at most 2", got)
+	}
+}
+
+func TestAWSClientRateLimitRequestsPerSecond(t *testing.T) {
+	client, _, closeFunc := newRateLimitTestClient(t, map[string]RateLimit{
+		"sts": {Burst: 1, RequestsPerSecond: 20},
+	})
+	defer closeFunc()
+
+	start := time.Now()
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// The first request uses the burst token, the remaining 4 wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("5 requests completed in %s, expected at least 200ms", elapsed)
+	}
+}
+
+func TestAWSClientRateLimitSignFailureReleases(t *testing.T) {
+	client, _, closeFunc := newRateLimitTestClient(t, map[string]RateLimit{
+		"sts": {MaxInFlight: 1},
+	})
+	defer closeFunc()
+
+	conn := client.STSConn()
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+
+		req, _ := conn.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+		req.SetContext(ctx)
+		req.Handlers.Sign.PushBack(func(r *request.Request) {
+			if r.Error == nil {
+				r.Error = errors.New("sign failed")
+			}
+		})
+
+		err := req.Send()
+		cancel()
+
+		if err == nil || err.Error() != "sign failed" {
+			t.Fatalf("request %d: got error %v, expected sign failed", i, err)
+		}
+	}
+
+	if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
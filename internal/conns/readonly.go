@@ -0,0 +1,25 @@
+package conns
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-provider-aws/internal/readcache"
+)
+
+// ErrCodeReadOnly is the error code of API calls failed by a read-only client.
+const ErrCodeReadOnly = "ReadOnlyClient"
+
+// addReadOnlyHandlers adds a handler that fails each request for an operation that may modify resources
+// before it is sent.
+func addReadOnlyHandlers(handlers *request.Handlers) {
+	handlers.Validate.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.ReadOnly",
+		Fn: func(r *request.Request) {
+			if !readcache.IsReadOnlyOperation(r.Operation.Name) {
+				r.Error = awserr.New(ErrCodeReadOnly, fmt.Sprintf("%s is not allowed by a read-only client", r.Operation.Name), nil)
+			}
+		},
+	})
+}
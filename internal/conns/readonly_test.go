@@ -0,0 +1,45 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+func TestAWSClientReadOnly(t *testing.T) {
+	server := newTestCredentialsServer(t)
+
+	config := testCredentialsConfig(t, server)
+	config.AccessKey = "StaticAccessKey"
+	config.SecretKey = "secretKey"
+	config.ReadOnly = true
+
+	raw, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conn := raw.(*AWSClient).STSConn()
+
+	if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	assumeRoles := server.assumeRoles
+
+	_, err = conn.AssumeRole(&sts.AssumeRoleInput{
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/test"), //lintignore:AWSAT005
+		RoleSessionName: aws.String("test"),
+	})
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeReadOnly) {
+		t.Errorf("got error %v, expected %s", err, ErrCodeReadOnly)
+	}
+
+	if server.assumeRoles != assumeRoles {
+		t.Error("expected read-only client not to send request")
+	}
+}
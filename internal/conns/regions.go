@@ -0,0 +1,129 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+// regionalClients holds the AWSClient for each region used by a provider configuration.
+type regionalClients struct {
+	clients map[string]*AWSClient
+	lock    sync.Mutex
+}
+
+func newRegionalClients(client *AWSClient) *regionalClients {
+	return &regionalClients{
+		clients: map[string]*AWSClient{
+			client.Region: client,
+		},
+	}
+}
+
+// ForRegion returns an AWSClient whose service clients make API calls in the specified region.
+// The client is created on first use and shares the provider's credentials, rate limits
+// and tagging configuration. Custom endpoints specific to the provider's region are not used.
+// If region is empty or the client's own region the receiver is returned unchanged.
+func (client *AWSClient) ForRegion(region string) (*AWSClient, error) {
+	if region == "" || region == client.Region {
+		return client, nil
+	}
+
+	if client.regions == nil {
+		return nil, fmt.Errorf("region (%s) is not supported by this provider configuration", region)
+	}
+
+	client.regions.lock.Lock()
+	defer client.regions.lock.Unlock()
+
+	if c, ok := client.regions.clients[region]; ok {
+		return c, nil
+	}
+
+	c, err := client.newRegionalClient(region)
+
+	if err != nil {
+		return nil, err
+	}
+
+	client.regions.clients[region] = c
+
+	return c, nil
+}
+
+func (client *AWSClient) newRegionalClient(region string) (*AWSClient, error) {
+	if !client.skipRegionValidation {
+		if err := awsbase.ValidateRegion(region); err != nil {
+			return nil, err
+		}
+	}
+
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && client.Partition != "" && p.ID() != client.Partition {
+		return nil, fmt.Errorf("region (%s) is in partition (%s), expected partition (%s)", region, p.ID(), client.Partition)
+	}
+
+	c := client.clone()
+	c.MediaConvertAccountConn = nil
+	c.Region = region
+	c.endpoints = regionalEndpoints(client.endpoints, client.Region, region)
+	c.session = client.session.Copy(&aws.Config{Region: aws.String(region)})
+
+	return c, nil
+}
+
+// regionalEndpoints returns the custom endpoints to use in region.
+// An endpoint that names the provider's region, e.g. https://ec2.us-west-2.amazonaws.com,
+// is dropped so that the service's default endpoint for region is used instead.
+func regionalEndpoints(custom map[string]string, providerRegion, region string) map[string]string {
+	endpoints := make(map[string]string, len(custom))
+
+	for key, endpoint := range custom {
+		if endpoint == "" {
+			continue
+		}
+
+		if providerRegion != "" && strings.Contains(endpoint, providerRegion) {
+			log.Printf("[DEBUG] Not using custom %s endpoint (%s) in region (%s)", key, endpoint, region)
+			continue
+		}
+
+		log.Printf("[INFO] Using custom %s endpoint (%s) in region (%s)", key, endpoint, region)
+		endpoints[key] = endpoint
+	}
+
+	return endpoints
+}
+
+// clone returns a copy of the client without any service clients.
+func (client *AWSClient) clone() *AWSClient {
+	return &AWSClient{
+		AccountID:               client.AccountID,
+		DefaultTagsConfig:       client.DefaultTagsConfig,
+		DNSSuffix:               client.DNSSuffix,
+		IgnoreTagsConfig:        client.IgnoreTagsConfig,
+		ManagedTagKeysOnly:      client.ManagedTagKeysOnly,
+		MediaConvertAccountConn: client.MediaConvertAccountConn,
+		Partition:               client.Partition,
+		Region:                  client.Region,
+		ReverseDNSPrefix:        client.ReverseDNSPrefix,
+		SupportedPlatforms:      client.SupportedPlatforms,
+		TagPolicyConfig:         client.TagPolicyConfig,
+		TerraformVersion:        client.TerraformVersion,
+		apiTracer:               client.apiTracer,
+		endpoints:               client.endpoints,
+		httpClients:             client.httpClients,
+		rateLimiters:            client.rateLimiters,
+		readCache:               client.readCache,
+		readOnly:                client.readOnly,
+		regions:                 client.regions,
+		s3ForcePathStyle:        client.s3ForcePathStyle,
+		session:                 client.session,
+		skipRegionValidation:    client.skipRegionValidation,
+		stopContext:             client.stopContext,
+	}
+}
@@ -0,0 +1,287 @@
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// EnvVarCustomCABundle is the environment variable used by the AWS SDKs to configure a custom CA bundle.
+const EnvVarCustomCABundle = "AWS_CA_BUNDLE"
+
+// HTTPTransportWrapper, if set, wraps the transport of all HTTP clients used for AWS API calls.
+// Acceptance tests use it to record and replay AWS API calls.
+var HTTPTransportWrapper func(http.RoundTripper) http.RoundTripper
+
+// httpClients are the HTTP clients used for AWS API calls.
+type httpClients struct {
+	defaultClient *http.Client
+	// serviceClients are the HTTP clients for services whose proxy is overridden, keyed by custom endpoint key.
+	serviceClients map[string]*http.Client
+}
+
+// forService returns the HTTP client for the service identified by the custom endpoint key.
+// Returns nil, the default HTTP client of the AWS SDK, if none are configured.
+func (h *httpClients) forService(endpointKey string) *http.Client {
+	if h == nil {
+		return nil
+	}
+
+	if v, ok := h.serviceClients[endpointKey]; ok {
+		return v
+	}
+
+	return h.defaultClient
+}
+
+// newHTTPClients returns the HTTP clients for the configured TLS and proxy settings.
+func (c *Config) newHTTPClients() (*httpClients, error) {
+	tlsConfig, err := c.tlsConfig()
+
+	if err != nil {
+		return nil, err
+	}
+
+	noProxy := parseNoProxy(c.NoProxy)
+
+	var proxy *string
+	if c.HTTPProxy != "" {
+		proxy = &c.HTTPProxy
+	}
+
+	defaultClient, err := newHTTPClient(tlsConfig, proxy, noProxy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	clients := &httpClients{
+		defaultClient:  defaultClient,
+		serviceClients: make(map[string]*http.Client, len(c.ProxyOverrides)),
+	}
+
+	for endpointKey, v := range c.ProxyOverrides {
+		v := v
+		serviceClient, err := newHTTPClient(tlsConfig, &v, noProxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("proxy override (%s): %w", endpointKey, err)
+		}
+
+		clients.serviceClients[endpointKey] = serviceClient
+	}
+
+	return clients, nil
+}
+
+// newHTTPClient returns an HTTP client that connects through the specified proxy,
+// directly if the proxy is empty, or through the proxy configured in the environment if the proxy is nil.
+// Hosts matching any of the no proxy patterns are always connected to directly.
+func newHTTPClient(tlsConfig *tls.Config, proxy *string, noProxy []string) (*http.Client, error) {
+	var proxyURL *url.URL
+
+	if proxy != nil && *proxy != "" {
+		var err error
+		proxyURL, err = url.Parse(*proxy)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+	}
+
+	httpClient := cleanhttp.DefaultClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.TLSClientConfig = tlsConfig.Clone()
+	transport.Proxy = func(r *http.Request) (*url.URL, error) {
+		if matchNoProxy(noProxy, r.URL.Host) {
+			return nil, nil
+		}
+
+		if proxy == nil {
+			return http.ProxyFromEnvironment(r)
+		}
+
+		return proxyURL, nil
+	}
+
+	if HTTPTransportWrapper != nil {
+		httpClient.Transport = HTTPTransportWrapper(httpClient.Transport)
+	}
+
+	return httpClient, nil
+}
+
+// tlsConfig returns the TLS configuration for connections to AWS API endpoints.
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	caBundle := c.CustomCABundle
+	if caBundle == "" {
+		caBundle = os.Getenv(EnvVarCustomCABundle)
+	}
+
+	if caBundle != "" {
+		pool, err := loadCertPool(caBundle)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.ClientCertificate != "" || c.ClientPrivateKey != "" {
+		if c.ClientCertificate == "" || c.ClientPrivateKey == "" {
+			return nil, fmt.Errorf("both a client certificate and a client private key must be configured")
+		}
+
+		certificate, err := loadX509KeyPair(c.ClientCertificate, c.ClientPrivateKey)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// loadCertPool returns the system certificate pool with the certificates in the PEM file added.
+func loadCertPool(filename string) (*x509.CertPool, error) {
+	b, err := readPEMFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading custom CA bundle: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("error reading custom CA bundle (%s): no certificates found", filename)
+	}
+
+	return pool, nil
+}
+
+func loadX509KeyPair(certFilename, keyFilename string) (tls.Certificate, error) {
+	certPEM, err := readPEMFile(certFilename)
+
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("error reading client certificate: %w", err)
+	}
+
+	keyPEM, err := readPEMFile(keyFilename)
+
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("error reading client private key: %w", err)
+	}
+
+	certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("error loading client certificate (%s) and private key (%s): %w", certFilename, keyFilename, err)
+	}
+
+	return certificate, nil
+}
+
+func readPEMFile(filename string) ([]byte, error) {
+	path, err := homedir.Expand(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding filename (%s): %w", filename, err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading file (%s): %w", filename, err)
+	}
+
+	return b, nil
+}
+
+// parseNoProxy splits a comma-separated list of no proxy patterns.
+func parseNoProxy(v string) []string {
+	var patterns []string
+
+	for _, pattern := range strings.Split(v, ",") {
+		if pattern = strings.ToLower(strings.TrimSpace(pattern)); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	return patterns
+}
+
+// matchNoProxy returns whether the host (with optional port) matches any of the no proxy patterns.
+// Patterns follow the NO_PROXY environment variable conventions:
+// "*" matches all hosts, an IP address or CIDR block matches IP addresses,
+// and a domain name matches the domain and its subdomains, with any leading "." or "*." ignored.
+// A pattern may include a port, in which case only that port matches.
+func matchNoProxy(patterns []string, host string) bool {
+	host = strings.ToLower(host)
+	hostname, port := host, ""
+
+	if h, p, err := net.SplitHostPort(host); err == nil {
+		hostname, port = h, p
+	}
+
+	ip := net.ParseIP(hostname)
+
+	for _, pattern := range patterns {
+		if pattern == "*" {
+			return true
+		}
+
+		if _, ipNet, err := net.ParseCIDR(pattern); err == nil {
+			if ip != nil && ipNet.Contains(ip) {
+				return true
+			}
+
+			continue
+		}
+
+		patternHostname, patternPort := pattern, ""
+
+		if h, p, err := net.SplitHostPort(pattern); err == nil {
+			patternHostname, patternPort = h, p
+		}
+
+		if patternPort != "" && patternPort != port {
+			continue
+		}
+
+		if patternIP := net.ParseIP(patternHostname); patternIP != nil {
+			if patternIP.Equal(ip) {
+				return true
+			}
+
+			continue
+		}
+
+		patternHostname = strings.TrimPrefix(strings.TrimPrefix(patternHostname, "*"), ".")
+
+		if hostname == patternHostname || strings.HasSuffix(hostname, "."+patternHostname) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,346 @@
+package conns
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestMatchNoProxy(t *testing.T) {
+	testCases := []struct {
+		NoProxy  string
+		Host     string
+		Expected bool
+	}{
+		{
+			NoProxy:  "",
+			Host:     "sts.amazonaws.com",
+			Expected: false,
+		},
+		{
+			NoProxy:  "*",
+			Host:     "sts.amazonaws.com",
+			Expected: true,
+		},
+		{
+			NoProxy:  "amazonaws.com",
+			Host:     "sts.amazonaws.com",
+			Expected: true,
+		},
+		{
+			NoProxy:  ".amazonaws.com",
+			Host:     "amazonaws.com",
+			Expected: true,
+		},
+		{
+			NoProxy:  "*.amazonaws.com",
+			Host:     "ec2.us-west-2.amazonaws.com:443", //lintignore:AWSAT003
+			Expected: true,
+		},
+		{
+			NoProxy:  "amazonaws.com",
+			Host:     "notamazonaws.com",
+			Expected: false,
+		},
+		{
+			NoProxy:  "example.com, STS.AMAZONAWS.COM",
+			Host:     "sts.amazonaws.com",
+			Expected: true,
+		},
+		{
+			NoProxy:  "vpce.amazonaws.com:8443",
+			Host:     "vpce-1234.vpce.amazonaws.com:8443",
+			Expected: true,
+		},
+		{
+			NoProxy:  "vpce.amazonaws.com:8443",
+			Host:     "vpce-1234.vpce.amazonaws.com:443",
+			Expected: false,
+		},
+		{
+			NoProxy:  "10.0.0.0/8",
+			Host:     "10.1.2.3:443",
+			Expected: true,
+		},
+		{
+			NoProxy:  "10.0.0.0/8",
+			Host:     "192.168.0.1",
+			Expected: false,
+		},
+		{
+			NoProxy:  "127.0.0.1",
+			Host:     "127.0.0.1:8080",
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := matchNoProxy(parseNoProxy(testCase.NoProxy), testCase.Host); got != testCase.Expected {
+			t.Errorf("NoProxy %q, Host %q: got %t, expected %t", testCase.NoProxy, testCase.Host, got, testCase.Expected)
+		}
+	}
+}
+
+// testWritePEM writes a PEM block to a file and returns its path.
+func testWritePEM(t *testing.T, name, blockType string, b []byte) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: b}), 0600); err != nil {
+		t.Fatalf("error writing %s: %s", path, err)
+	}
+
+	return path
+}
+
+// testClientCertificate returns a self-signed client certificate and the paths of its certificate and private key files.
+func testClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatalf("error generating key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatalf("error creating certificate: %s", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		t.Fatalf("error parsing certificate: %s", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+
+	if err != nil {
+		t.Fatalf("error marshaling private key: %s", err)
+	}
+
+	return certificate, testWritePEM(t, "client.crt", "CERTIFICATE", der), testWritePEM(t, "client.key", "EC PRIVATE KEY", keyDER)
+}
+
+// testTLSCredentialsServer returns a TLS server for the credentials server's API and the path of its CA bundle.
+func testTLSCredentialsServer(t *testing.T, server *testCredentialsServer, clientCA *x509.Certificate) (*httptest.Server, string) {
+	t.Helper()
+
+	tlsServer := httptest.NewUnstartedServer(server.Config.Handler)
+
+	if clientCA != nil {
+		pool := x509.NewCertPool()
+		pool.AddCert(clientCA)
+
+		tlsServer.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  pool,
+		}
+	}
+
+	tlsServer.StartTLS()
+	t.Cleanup(tlsServer.Close)
+
+	return tlsServer, testWritePEM(t, "ca.pem", "CERTIFICATE", tlsServer.Certificate().Raw)
+}
+
+func TestConfigClientCustomCABundle(t *testing.T) {
+	server := newTestCredentialsServer(t)
+	tlsServer, caBundle := testTLSCredentialsServer(t, server, nil)
+
+	config := testCredentialsConfig(t, server)
+	config.AccessKey = "StaticAccessKey"
+	config.SecretKey = "secretKey"
+	config.Endpoints["sts"] = tlsServer.URL
+	testSetenv(t, EnvVarCustomCABundle, "")
+
+	if _, err := config.Client(); err == nil {
+		t.Fatal("expected error without custom CA bundle")
+	}
+
+	config.CustomCABundle = caBundle
+
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	config.CustomCABundle = ""
+	testSetenv(t, EnvVarCustomCABundle, caBundle)
+
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("unexpected error with %s: %s", EnvVarCustomCABundle, err)
+	}
+}
+
+func TestConfigClientClientCertificate(t *testing.T) {
+	server := newTestCredentialsServer(t)
+	clientCertificate, certFile, keyFile := testClientCertificate(t)
+	tlsServer, caBundle := testTLSCredentialsServer(t, server, clientCertificate)
+
+	config := testCredentialsConfig(t, server)
+	config.AccessKey = "StaticAccessKey"
+	config.SecretKey = "secretKey"
+	config.CustomCABundle = caBundle
+	config.Endpoints["sts"] = tlsServer.URL
+
+	if _, err := config.Client(); err == nil {
+		t.Fatal("expected error without client certificate")
+	}
+
+	config.ClientCertificate = certFile
+
+	if _, err := config.Client(); err == nil {
+		t.Fatal("expected error without client private key")
+	}
+
+	config.ClientPrivateKey = keyFile
+
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+// testProxy is a forward proxy that records the hosts of the requests made through it.
+type testProxy struct {
+	*httptest.Server
+
+	lock  sync.Mutex
+	hosts []string
+}
+
+func newTestProxy(t *testing.T, target *testCredentialsServer) *testProxy {
+	t.Helper()
+
+	p := &testProxy{}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.lock.Lock()
+		p.hosts = append(p.hosts, r.URL.Host)
+		p.lock.Unlock()
+
+		target.Config.Handler.ServeHTTP(w, r)
+	}))
+
+	t.Cleanup(p.Close)
+
+	return p
+}
+
+func (p *testProxy) requests() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return len(p.hosts)
+}
+
+func TestConfigClientProxy(t *testing.T) {
+	server := newTestCredentialsServer(t)
+	defaultProxy := newTestProxy(t, server)
+	stsProxy := newTestProxy(t, server)
+
+	// The proxies forward requests for any host to the credentials server.
+	const endpoint = "http://aws.proxy.test"
+
+	serverURL, err := url.Parse(server.URL)
+
+	if err != nil {
+		t.Fatalf("error parsing URL: %s", err)
+	}
+
+	testCases := []struct {
+		Name                 string
+		NoProxy              string
+		ProxyOverrides       map[string]string
+		STSEndpoint          string
+		ExpectedDefaultProxy bool
+		ExpectedSTSProxy     bool
+	}{
+		{
+			Name:                 "default proxy",
+			STSEndpoint:          endpoint,
+			ExpectedDefaultProxy: true,
+		},
+		{
+			Name:             "service proxy",
+			ProxyOverrides:   map[string]string{"sts": stsProxy.URL},
+			STSEndpoint:      endpoint,
+			ExpectedSTSProxy: true,
+		},
+		{
+			Name:           "service direct",
+			ProxyOverrides: map[string]string{"sts": ""},
+			STSEndpoint:    server.URL,
+		},
+		{
+			Name:        "no proxy",
+			NoProxy:     serverURL.Hostname(),
+			STSEndpoint: server.URL,
+		},
+		{
+			Name:           "no proxy service proxy",
+			NoProxy:        serverURL.Hostname(),
+			ProxyOverrides: map[string]string{"sts": stsProxy.URL},
+			STSEndpoint:    server.URL,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := testCredentialsConfig(t, server)
+			config.AccessKey = "StaticAccessKey"
+			config.SecretKey = "secretKey"
+			config.Endpoints["sts"] = testCase.STSEndpoint
+			config.HTTPProxy = defaultProxy.URL
+			config.NoProxy = testCase.NoProxy
+			config.ProxyOverrides = testCase.ProxyOverrides
+
+			defaultRequests, stsRequests := defaultProxy.requests(), stsProxy.requests()
+
+			raw, err := config.Client()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if _, err := raw.(*AWSClient).STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := defaultProxy.requests() > defaultRequests, testCase.ExpectedDefaultProxy; got != expected {
+				t.Errorf("got default proxy used %t, expected %t", got, expected)
+			}
+
+			if got, expected := stsProxy.requests() > stsRequests, testCase.ExpectedSTSProxy; got != expected {
+				t.Errorf("got STS proxy used %t, expected %t", got, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package coverage
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Baseline is the set of known findings, keyed by their string representation.
+type Baseline map[string]bool
+
+// ReadBaseline reads a baseline of one finding per line. Blank lines and lines starting with # are ignored.
+func ReadBaseline(r io.Reader) (Baseline, error) {
+	baseline := make(Baseline)
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		baseline[strings.Join(strings.Fields(line), " ")] = true
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return baseline, nil
+}
+
+// WriteBaseline writes the findings as a baseline.
+func WriteBaseline(w io.Writer, findings []Finding) error {
+	if _, err := fmt.Fprintln(w, "# Known schema coverage findings. Regenerate with: go run internal/coverage/main.go -update-baseline"); err != nil {
+		return err
+	}
+
+	for _, finding := range findings {
+		if _, err := fmt.Fprintln(w, finding); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Compare returns the findings missing from the baseline and the baseline entries
+// no longer found, both sorted.
+func (b Baseline) Compare(findings []Finding) ([]Finding, []string) {
+	var added []Finding
+
+	found := make(map[string]bool, len(findings))
+
+	for _, finding := range findings {
+		key := finding.String()
+		found[key] = true
+
+		if !b[key] {
+			added = append(added, finding)
+		}
+	}
+
+	var fixed []string
+
+	for key := range b {
+		if !found[key] {
+			fixed = append(fixed, key)
+		}
+	}
+
+	SortFindings(added)
+	sort.Strings(fixed)
+
+	return added, fixed
+}
@@ -0,0 +1,225 @@
+// Package coverage checks that the attributes of resource and data source schemas
+// are documented and referenced by acceptance tests.
+package coverage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Kind is the kind of a coverage finding.
+type Kind string
+
+const (
+	// KindUndocumented is a schema attribute missing from the documentation page.
+	// A finding without attribute means the documentation page is missing.
+	KindUndocumented Kind = "undocumented"
+	// KindOverdocumented is a documented attribute missing from the schema.
+	KindOverdocumented Kind = "overdocumented"
+	// KindUntested is a schema attribute not referenced by any acceptance test check.
+	KindUntested Kind = "untested"
+)
+
+// Finding is a coverage gap of a resource or data source attribute.
+type Finding struct {
+	// Resource is the resource type name, e.g. aws_sqs_queue, or the data source type name
+	// prefixed with "data.", e.g. data.aws_sqs_queue.
+	Resource string
+	Kind     Kind
+	// Attribute is the attribute path, e.g. rule.filter.prefix.
+	Attribute string
+}
+
+// String returns the finding as a baseline line, e.g. "aws_sqs_queue untested kms_master_key_id".
+func (f Finding) String() string {
+	if f.Attribute == "" {
+		return fmt.Sprintf("%s %s", f.Resource, f.Kind)
+	}
+
+	return fmt.Sprintf("%s %s %s", f.Resource, f.Kind, f.Attribute)
+}
+
+// Checker checks the coverage of the provider's resources and data sources.
+type Checker struct {
+	// RootDir is the repository root directory.
+	RootDir string
+	// DocsDir is the website documentation directory, containing the r and d directories,
+	// relative to RootDir.
+	DocsDir string
+	// ProviderFile is the provider source file registering all resources and data sources,
+	// relative to RootDir. It is used to find each resource's test files.
+	ProviderFile string
+	// ProviderAttributes are the attributes the provider adds to resources, e.g. region.
+	// They are documented on the provider's page rather than each resource's.
+	ProviderAttributes []string
+}
+
+// Check returns the sorted findings of all resources and data sources of the provider.
+func (c *Checker) Check(p *schema.Provider) ([]Finding, error) {
+	sourceFiles, err := SourceFiles(c.RootDir, c.ProviderFile)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+
+	for _, v := range []struct {
+		resources map[string]*schema.Resource
+		docsDir   string
+		prefix    string
+	}{
+		{p.ResourcesMap, "r", ""},
+		{p.DataSourcesMap, "d", "data."},
+	} {
+		for typeName, r := range v.resources {
+			name := v.prefix + typeName
+			page := filepath.Join(c.RootDir, c.DocsDir, v.docsDir, strings.TrimPrefix(typeName, "aws_")+".html.markdown")
+
+			var testFiles []string
+
+			if sourceFile, ok := sourceFiles[name]; ok {
+				files, err := TestFiles(c.RootDir, sourceFile)
+
+				if err != nil {
+					return nil, fmt.Errorf("error finding test files of %s: %w", name, err)
+				}
+
+				for _, file := range files {
+					testFiles = append(testFiles, filepath.Join(c.RootDir, file))
+				}
+			}
+
+			if v.prefix == "" && len(c.ProviderAttributes) > 0 {
+				r = withoutAttributes(r, c.ProviderAttributes)
+			}
+
+			resourceFindings, err := CheckResource(name, r, page, testFiles)
+
+			if err != nil {
+				return nil, err
+			}
+
+			findings = append(findings, resourceFindings...)
+		}
+	}
+
+	SortFindings(findings)
+
+	return findings, nil
+}
+
+// withoutAttributes returns a copy of the resource without the specified top-level attributes.
+func withoutAttributes(r *schema.Resource, names []string) *schema.Resource {
+	m := make(map[string]*schema.Schema, len(r.Schema))
+
+	for k, v := range r.Schema {
+		m[k] = v
+	}
+
+	for _, name := range names {
+		delete(m, name)
+	}
+
+	return &schema.Resource{Schema: m}
+}
+
+// CheckResource returns the findings of a resource or data source.
+// A missing documentation page results in a single undocumented finding without attribute.
+func CheckResource(name string, r *schema.Resource, page string, testFiles []string) ([]Finding, error) {
+	var findings []Finding
+
+	attributes := SchemaAttributes(r.Schema)
+
+	documented, err := documentedAttributesFromFile(page)
+
+	switch {
+	case os.IsNotExist(err):
+		findings = append(findings, Finding{Resource: name, Kind: KindUndocumented})
+	case err != nil:
+		return nil, fmt.Errorf("error reading %s: %w", page, err)
+	default:
+		names := make(map[string]bool)
+
+		for _, path := range attributes {
+			leaf := leafName(path)
+			names[leaf] = true
+
+			if !documented[leaf] {
+				findings = append(findings, Finding{Resource: name, Kind: KindUndocumented, Attribute: path})
+			}
+		}
+
+		for v := range documented {
+			if v != "id" && !names[v] {
+				findings = append(findings, Finding{Resource: name, Kind: KindOverdocumented, Attribute: v})
+			}
+		}
+	}
+
+	tested, err := TestedAttributes(testFiles)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range attributes {
+		if !tested[leafName(path)] {
+			findings = append(findings, Finding{Resource: name, Kind: KindUntested, Attribute: path})
+		}
+	}
+
+	SortFindings(findings)
+
+	return findings, nil
+}
+
+// SchemaAttributes returns the paths of all attributes of the schema map, including the attributes
+// of nested blocks, e.g. rule and rule.filter.prefix. The id attribute is excluded.
+func SchemaAttributes(m map[string]*schema.Schema) []string {
+	var paths []string
+
+	schemaAttributes(m, "", &paths)
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+func schemaAttributes(m map[string]*schema.Schema, prefix string, paths *[]string) {
+	for k, v := range m {
+		if prefix == "" && k == "id" {
+			continue
+		}
+
+		*paths = append(*paths, prefix+k)
+
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			schemaAttributes(elem.Schema, prefix+k+".", paths)
+		}
+	}
+}
+
+func leafName(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+// SortFindings sorts the findings by resource, kind and attribute.
+func SortFindings(findings []Finding) {
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Resource != findings[j].Resource {
+			return findings[i].Resource < findings[j].Resource
+		}
+
+		if findings[i].Kind != findings[j].Kind {
+			return findings[i].Kind < findings[j].Kind
+		}
+
+		return findings[i].Attribute < findings[j].Attribute
+	})
+}
@@ -0,0 +1,286 @@
+package coverage
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testDocsPage = `---
+subcategory: "Example"
+---
+
+# Resource: aws_example
+
+## Example Usage
+
+* ` + "`not_an_argument`" + ` - In the example section.
+
+## Argument Reference
+
+* ` + "`name`" + ` - (Required) Name.
+* ` + "`rule`" + ` - (Optional) Rule. See below.
+* ` + "`removed`" + ` - (Optional) No longer in the schema.
+
+### rule
+
+* ` + "`prefix`" + ` - (Optional) Prefix.
+
+### Timeouts
+
+* ` + "`update`" + ` - (Default ` + "`10m`" + `)
+
+` + "```terraform" + `
+# Not a heading
+* ` + "`not_an_argument`" + `
+` + "```" + `
+
+## Attributes Reference
+
+* ` + "`id`" + ` - Name.
+* ` + "`arn`" + ` - ARN.
+
+## Timeouts
+
+* ` + "`create`" + ` - (Default ` + "`10m`" + `)
+
+## Import
+`
+
+const testTestFile = `package example
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccExample_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_example.test", "name", "test"),
+					resource.TestCheckTypeSetElemNestedAttrs("aws_example.test", "rule.*", map[string]string{
+						"enabled": "true",
+					}),
+				),
+				ImportStateVerifyIgnore: []string{"arn"},
+			},
+		},
+	})
+}
+`
+
+func testResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSchemaAttributes(t *testing.T) {
+	expected := []string{"arn", "name", "rule", "rule.enabled", "rule.prefix"}
+
+	if got := SchemaAttributes(testResource().Schema); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestDocumentedAttributes(t *testing.T) {
+	got, err := DocumentedAttributes(strings.NewReader(testDocsPage))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]bool{
+		"arn":     true,
+		"id":      true,
+		"name":    true,
+		"prefix":  true,
+		"removed": true,
+		"rule":    true,
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestCheckResource(t *testing.T) {
+	dir := t.TempDir()
+	page := filepath.Join(dir, "example.html.markdown")
+	testFile := filepath.Join(dir, "example_test.go")
+
+	if err := os.WriteFile(page, []byte(testDocsPage), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(testFile, []byte(testTestFile), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	findings, err := CheckResource("aws_example", testResource(), page, []string{testFile})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got []string
+
+	for _, finding := range findings {
+		got = append(got, finding.String())
+	}
+
+	expected := []string{
+		"aws_example overdocumented removed",
+		"aws_example undocumented rule.enabled",
+		"aws_example untested arn",
+		"aws_example untested rule.prefix",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	findings, err = CheckResource("data.aws_example", testResource(), filepath.Join(dir, "missing.html.markdown"), nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := findings[0].String(), "data.aws_example undocumented"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestBaselineCompare(t *testing.T) {
+	baseline, err := ReadBaseline(strings.NewReader(`
+# Comment
+aws_example untested arn
+aws_example  undocumented   rule.enabled
+aws_other undocumented
+`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	added, fixed := baseline.Compare([]Finding{
+		{Resource: "aws_example", Kind: KindUntested, Attribute: "arn"},
+		{Resource: "aws_example", Kind: KindUndocumented, Attribute: "rule.enabled"},
+		{Resource: "aws_example", Kind: KindUntested, Attribute: "name"},
+	})
+
+	if got, expected := added, []Finding{{Resource: "aws_example", Kind: KindUntested, Attribute: "name"}}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got added %v, expected %v", got, expected)
+	}
+
+	if got, expected := fixed, []string{"aws_other undocumented"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got fixed %v, expected %v", got, expected)
+	}
+}
+
+func TestSourceFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	for filename, src := range map[string]string{
+		"internal/provider/provider.go": `package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/example"
+	ex "github.com/hashicorp/terraform-provider-aws/internal/service/other"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_example": example.DataSourceExample(),
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_example": example.ResourceExample(),
+			"aws_other":   ex.ResourceOther(),
+		},
+	}
+}
+`,
+		"internal/service/example/example.go":             "package example\n\nfunc ResourceExample() {}\n",
+		"internal/service/example/example_data_source.go": "package example\n\nfunc DataSourceExample() {}\n",
+		"internal/service/example/example_test.go":        "package example_test\n",
+		"internal/service/other/other.go":                 "package other\n\nfunc ResourceOther() {}\n",
+		"internal/service/other/other_a_test.go":          "package other\n",
+		"internal/service/other/other_b_test.go":          "package other\n",
+	} {
+		filename = filepath.Join(dir, filename)
+
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filename, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := SourceFiles(dir, "internal/provider/provider.go")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"aws_example":      "internal/service/example/example.go",
+		"aws_other":        "internal/service/other/other.go",
+		"data.aws_example": "internal/service/example/example_data_source.go",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	for sourceFile, expected := range map[string][]string{
+		"internal/service/example/example.go":             {"internal/service/example/example_test.go"},
+		"internal/service/example/example_data_source.go": {"internal/service/example/example_test.go"},
+		"internal/service/other/other.go":                 {"internal/service/other/other_a_test.go", "internal/service/other/other_b_test.go"},
+	} {
+		got, err := TestFiles(dir, sourceFile)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: got %v, expected %v", sourceFile, got, expected)
+		}
+	}
+}
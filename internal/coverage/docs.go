@@ -0,0 +1,96 @@
+package coverage
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var (
+	docsArgumentRegexp = regexp.MustCompile("^\\s*[*-]\\s+`([a-z0-9_]+)`")
+	docsHeadingRegexp  = regexp.MustCompile(`^(#+)\s+(.*)$`)
+)
+
+// docsSkippedSections are the documentation headings whose lists are not attributes.
+var docsSkippedSections = []string{
+	"example",
+	"import",
+	"timeouts",
+}
+
+// DocumentedAttributes returns the names of the arguments and attributes listed in a documentation page.
+// Lists are collected from the Argument Reference heading onwards, except in the Timeouts and Import sections
+// and in code blocks.
+func DocumentedAttributes(r io.Reader) (map[string]bool, error) {
+	names := make(map[string]bool)
+
+	var started, fenced bool
+
+	// skippedLevel is the heading level of the section being skipped, if any.
+	skippedLevel := 0
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			fenced = !fenced
+			continue
+		}
+
+		if fenced {
+			continue
+		}
+
+		if m := docsHeadingRegexp.FindStringSubmatch(line); m != nil {
+			level := len(m[1])
+			heading := strings.ToLower(m[2])
+
+			if level == 2 && strings.HasPrefix(heading, "argument") {
+				started = true
+			}
+
+			if skippedLevel > 0 && level <= skippedLevel {
+				skippedLevel = 0
+			}
+
+			for _, v := range docsSkippedSections {
+				if level >= 2 && strings.HasPrefix(heading, v) {
+					skippedLevel = level
+				}
+			}
+
+			continue
+		}
+
+		if !started || skippedLevel > 0 {
+			continue
+		}
+
+		if m := docsArgumentRegexp.FindStringSubmatch(line); m != nil {
+			names[m[1]] = true
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+func documentedAttributesFromFile(filename string) (map[string]bool, error) {
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	return DocumentedAttributes(f)
+}
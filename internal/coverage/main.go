@@ -0,0 +1,97 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/coverage"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+var (
+	baselineFile   = flag.String("baseline", "internal/coverage/baseline.txt", "name of the baseline file of known findings")
+	docsDir        = flag.String("docs", "website/docs", "name of the website documentation directory")
+	updateBaseline = flag.Bool("update-baseline", false, "whether to write all current findings to the baseline file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	rootDir, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("error getting working directory: %s", err)
+	}
+
+	checker := &coverage.Checker{
+		RootDir:            rootDir,
+		DocsDir:            *docsDir,
+		ProviderFile:       "internal/provider/provider.go",
+		ProviderAttributes: []string{"region", tftags.ManagedKeysAttribute},
+	}
+
+	findings, err := checker.Check(provider.Provider())
+
+	if err != nil {
+		log.Fatalf("error checking schema coverage: %s", err)
+	}
+
+	if *updateBaseline {
+		var buffer bytes.Buffer
+
+		if err := coverage.WriteBaseline(&buffer, findings); err != nil {
+			log.Fatalf("error writing baseline: %s", err)
+		}
+
+		if err := os.WriteFile(*baselineFile, buffer.Bytes(), 0644); err != nil {
+			log.Fatalf("error writing %s: %s", *baselineFile, err)
+		}
+
+		log.Printf("wrote %d findings to %s", len(findings), *baselineFile)
+
+		return
+	}
+
+	f, err := os.Open(*baselineFile)
+
+	if err != nil {
+		log.Fatalf("error opening %s: %s", *baselineFile, err)
+	}
+
+	baseline, err := coverage.ReadBaseline(f)
+	f.Close()
+
+	if err != nil {
+		log.Fatalf("error reading %s: %s", *baselineFile, err)
+	}
+
+	added, fixed := baseline.Compare(findings)
+
+	for _, finding := range added {
+		fmt.Println(finding)
+	}
+
+	for _, key := range fixed {
+		fmt.Printf("%s (fixed, remove from baseline)\n", key)
+	}
+
+	if len(added) > 0 || len(fixed) > 0 {
+		log.Fatalf("\n%d new and %d fixed findings. Fix the new findings, then run 'go run internal/coverage/main.go -update-baseline' and commit.", len(added), len(fixed))
+	}
+}
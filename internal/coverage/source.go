@@ -0,0 +1,208 @@
+package coverage
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const modulePath = "github.com/hashicorp/terraform-provider-aws/"
+
+// SourceFiles returns the names of the files declaring the constructor of each resource and data source
+// registered in the provider source file, e.g. internal/service/sqs/queue.go for aws_sqs_queue and
+// internal/service/sqs/queue_data_source.go for data.aws_sqs_queue. File names are relative to rootDir.
+func SourceFiles(rootDir, providerFile string) (map[string]string, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, filepath.Join(rootDir, providerFile), nil, 0)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", providerFile, err)
+	}
+
+	// Package name or alias to package directory.
+	packageDirs := make(map[string]string)
+
+	for _, importSpec := range f.Imports {
+		path, err := strconv.Unquote(importSpec.Path.Value)
+
+		if err != nil || !strings.HasPrefix(path, modulePath) {
+			continue
+		}
+
+		name := filepath.Base(path)
+
+		if importSpec.Name != nil {
+			name = importSpec.Name.Name
+		}
+
+		packageDirs[name] = strings.TrimPrefix(path, modulePath)
+	}
+
+	files := make(map[string]string)
+	packageFuncs := make(map[string]map[string]string)
+
+	var inspectErr error
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		if inspectErr != nil {
+			return false
+		}
+
+		keyValueExpr, ok := n.(*ast.KeyValueExpr)
+
+		if !ok {
+			return true
+		}
+
+		key, ok := keyValueExpr.Key.(*ast.Ident)
+
+		if !ok || (key.Name != "DataSourcesMap" && key.Name != "ResourcesMap") {
+			return true
+		}
+
+		prefix := ""
+
+		if key.Name == "DataSourcesMap" {
+			prefix = "data."
+		}
+
+		compositeLit, ok := keyValueExpr.Value.(*ast.CompositeLit)
+
+		if !ok {
+			return false
+		}
+
+		for _, elt := range compositeLit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+
+			if !ok {
+				continue
+			}
+
+			basicLit, ok := kv.Key.(*ast.BasicLit)
+
+			if !ok {
+				continue
+			}
+
+			typeName, err := strconv.Unquote(basicLit.Value)
+
+			if err != nil {
+				continue
+			}
+
+			callExpr, ok := kv.Value.(*ast.CallExpr)
+
+			if !ok {
+				continue
+			}
+
+			selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+
+			if !ok {
+				continue
+			}
+
+			pkg, ok := selectorExpr.X.(*ast.Ident)
+
+			if !ok {
+				continue
+			}
+
+			dir, ok := packageDirs[pkg.Name]
+
+			if !ok {
+				continue
+			}
+
+			funcs, ok := packageFuncs[dir]
+
+			if !ok {
+				funcs, err = packageFuncFiles(rootDir, dir)
+
+				if err != nil {
+					inspectErr = err
+					return false
+				}
+
+				packageFuncs[dir] = funcs
+			}
+
+			if filename, ok := funcs[selectorExpr.Sel.Name]; ok {
+				files[prefix+typeName] = filename
+			}
+		}
+
+		return false
+	})
+
+	if inspectErr != nil {
+		return nil, inspectErr
+	}
+
+	return files, nil
+}
+
+// packageFuncFiles returns the names of the files declaring each function of the package directory,
+// relative to rootDir.
+func packageFuncFiles(rootDir, dir string) (map[string]string, error) {
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, filepath.Join(rootDir, dir), func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", dir, err)
+	}
+
+	funcs := make(map[string]string)
+
+	for _, pkg := range pkgs {
+		for filename, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+					funcs[funcDecl.Name.Name] = filepath.Join(dir, filepath.Base(filename))
+				}
+			}
+		}
+	}
+
+	return funcs, nil
+}
+
+// TestFiles returns the test files of a resource's source file, i.e. its _test.go file,
+// or all test files of its package if that does not exist. File names are relative to rootDir.
+func TestFiles(rootDir, sourceFile string) ([]string, error) {
+	testFile := strings.TrimSuffix(sourceFile, ".go") + "_test.go"
+
+	if _, err := os.Stat(filepath.Join(rootDir, testFile)); err == nil {
+		return []string{testFile}, nil
+	}
+
+	matches, err := filepath.Glob(filepath.Join(rootDir, filepath.Dir(sourceFile), "*_test.go"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var testFiles []string
+
+	for _, match := range matches {
+		testFile, err := filepath.Rel(rootDir, match)
+
+		if err != nil {
+			return nil, err
+		}
+
+		testFiles = append(testFiles, testFile)
+	}
+
+	return testFiles, nil
+}
@@ -0,0 +1,80 @@
+package coverage
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var testAttributeNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// TestedAttributes returns the attribute names referenced by the acceptance test checks of the test files,
+// i.e. the string arguments of calls such as resource.TestCheckResourceAttr() and acctest.CheckResourceAttrRegionalARN(),
+// and the map keys of resource.TestCheckTypeSetElemNestedAttrs() calls.
+func TestedAttributes(filenames []string) (map[string]bool, error) {
+	names := make(map[string]bool)
+	fset := token.NewFileSet()
+
+	for _, filename := range filenames {
+		f, err := parser.ParseFile(fset, filename, nil, 0)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", filename, err)
+		}
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok || !isTestCheckFunc(callExpr.Fun) {
+				return true
+			}
+
+			ast.Inspect(callExpr, func(n ast.Node) bool {
+				basicLit, ok := n.(*ast.BasicLit)
+
+				if !ok || basicLit.Kind != token.STRING {
+					return true
+				}
+
+				v, err := strconv.Unquote(basicLit.Value)
+
+				// Skip resource addresses, e.g. aws_sqs_queue.test.
+				if err != nil || strings.HasPrefix(v, "aws_") || strings.HasPrefix(v, "data.") {
+					return true
+				}
+
+				for _, segment := range strings.Split(v, ".") {
+					if testAttributeNameRegexp.MatchString(segment) {
+						names[segment] = true
+					}
+				}
+
+				return true
+			})
+
+			return true
+		})
+	}
+
+	return names, nil
+}
+
+// isTestCheckFunc returns whether the called function checks resource attributes.
+func isTestCheckFunc(e ast.Expr) bool {
+	var name string
+
+	switch e := e.(type) {
+	case *ast.Ident:
+		name = e.Name
+	case *ast.SelectorExpr:
+		name = e.Sel.Name
+	default:
+		return false
+	}
+
+	return strings.Contains(name, "ResourceAttr") || strings.Contains(name, "TypeSetElem")
+}
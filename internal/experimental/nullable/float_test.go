@@ -0,0 +1,171 @@
+package nullable
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestNullableFloat(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectNull    bool
+		expectedValue float64
+		expectedErr   error
+	}{
+		{
+			val:           "1.5",
+			expectNull:    false,
+			expectedValue: 1.5,
+		},
+		{
+			val:           "0",
+			expectNull:    false,
+			expectedValue: 0,
+		},
+		{
+			val:           "-2e3",
+			expectNull:    false,
+			expectedValue: -2000,
+		},
+		{
+			val:           "",
+			expectNull:    true,
+			expectedValue: 0,
+		},
+		{
+			val:           "A",
+			expectNull:    false,
+			expectedValue: 0,
+			expectedErr:   strconv.ErrSyntax,
+		},
+	}
+
+	for i, tc := range cases {
+		v := Float(tc.val)
+
+		if null := v.IsNull(); null != tc.expectNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectNull)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %g, got %g", i, tc.expectedValue, value)
+		}
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if tc.expectedErr == nil && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectedErr != nil {
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
+			}
+		}
+	}
+}
+
+func TestNewFloat(t *testing.T) {
+	for v, expected := range map[float64]Float{
+		0:       "0",
+		1.5:     "1.5",
+		-0.25:   "-0.25",
+		1000000: "1000000",
+		1e-7:    "0.0000001",
+	} {
+		if got := NewFloat(v); got != expected {
+			t.Errorf("expected NewFloat(%g) to be %q, got %q", v, expected, got)
+		}
+	}
+}
+
+func TestValidationFloat(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1.5",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as float: .*`),
+		},
+		{
+			val:         1.5,
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationFloatAtLeast(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1.5",
+			f:   ValidateTypeStringNullableFloatAtLeast(1.5),
+		},
+		{
+			val: "0",
+			f:   ValidateTypeStringNullableFloatAtLeast(0),
+		},
+		{
+			val:         "1",
+			f:           ValidateTypeStringNullableFloatAtLeast(1.5),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at least \(1.5\), got 1`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableFloatAtLeast(2),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationFloatBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "0.5",
+			f:   ValidateTypeStringNullableFloatBetween(0, 1),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableFloatBetween(0, 1),
+		},
+		{
+			val:         "1.5",
+			f:           ValidateTypeStringNullableFloatBetween(0, 1),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at between \(0\) and \(1\), got 1.5`),
+		},
+		{
+			val:         "A",
+			f:           ValidateTypeStringNullableFloatBetween(0, 1),
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'A' as float: .*`),
+		},
+	})
+}
+
+func TestDiffSuppressNullableFloat(t *testing.T) {
+	cases := []struct {
+		o, n     string
+		suppress bool
+	}{
+		{o: "1", n: "1.0", suppress: true},
+		{o: "1000", n: "1e3", suppress: true},
+		{o: "", n: "", suppress: true},
+		{o: "0", n: "", suppress: false},
+		{o: "1", n: "2", suppress: false},
+		{o: "A", n: "A", suppress: false},
+	}
+
+	for i, tc := range cases {
+		if got := DiffSuppressNullableFloat("test_property", tc.o, tc.n, nil); got != tc.suppress {
+			t.Fatalf("expected test case %d (%q, %q) to return %t, got %t", i, tc.o, tc.n, tc.suppress, got)
+		}
+	}
+}
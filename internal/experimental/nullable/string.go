@@ -0,0 +1,67 @@
+package nullable
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableString = schema.TypeString
+)
+
+// String is a string that distinguishes null, i.e. not set, from the empty string.
+//
+// The SDK does not distinguish a string argument that is not set from one set to the empty string,
+// so StringFromResourceData treats the empty string as a value only when it replaces another value.
+type String struct {
+	value string
+	valid bool
+}
+
+func NewString(v string) String {
+	return String{value: v, valid: true}
+}
+
+// StringFromResourceData returns the value of the string argument, which is null if it is empty
+// and unchanged, e.g. when creating a resource, and the empty string if it changes to empty,
+// i.e. when the value set before must be cleared.
+func StringFromResourceData(d *schema.ResourceData, key string) String {
+	v := d.Get(key).(string)
+
+	if v == "" && !d.HasChange(key) {
+		return String{}
+	}
+
+	return NewString(v)
+}
+
+func (s String) IsNull() bool {
+	return !s.valid
+}
+
+func (s String) Value() (string, bool) {
+	return s.value, s.IsNull()
+}
+
+// ValidateTypeStringNullableStringLenBetween provides custom error messaging for TypeString strings
+// Some arguments require a string of the given length or an unspecified, empty field.
+func ValidateTypeStringNullableStringLenBetween(min int, max int) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		if len(value) < min || len(value) > max {
+			es = append(es, fmt.Errorf("expected length of %s to be in the range (%d - %d), got %s", k, min, max, value))
+		}
+
+		return
+	}
+}
@@ -0,0 +1,123 @@
+package nullable
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestNullableString(t *testing.T) {
+	if v, null := (String{}).Value(); !null || v != "" {
+		t.Fatalf("expected zero String to be null, got %q, %t", v, null)
+	}
+
+	if v, null := NewString("").Value(); null || v != "" {
+		t.Fatalf("expected empty String not to be null, got %q, %t", v, null)
+	}
+
+	if v, null := NewString("a").Value(); null || v != "a" {
+		t.Fatalf("expected String to be \"a\", got %q, %t", v, null)
+	}
+}
+
+func TestStringFromResourceData(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"description": {
+			Type:     TypeNullableString,
+			Optional: true,
+		},
+	}
+
+	cases := []struct {
+		state         string
+		newValue      string
+		expectNull    bool
+		expectedValue string
+	}{
+		// Creating.
+		{
+			newValue:   "",
+			expectNull: true,
+		},
+		{
+			newValue:      "a",
+			expectedValue: "a",
+		},
+		// Updating.
+		{
+			state:      "",
+			newValue:   "",
+			expectNull: true,
+		},
+		{
+			state:         "a",
+			newValue:      "a",
+			expectedValue: "a",
+		},
+		{
+			state:         "a",
+			newValue:      "",
+			expectedValue: "",
+		},
+	}
+
+	for i, tc := range cases {
+		var state *terraform.InstanceState
+
+		if tc.state != "" {
+			state = &terraform.InstanceState{
+				ID:         "test",
+				Attributes: map[string]string{"description": tc.state},
+			}
+		}
+
+		diff := &terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{},
+		}
+
+		if tc.state != tc.newValue {
+			diff.Attributes["description"] = &terraform.ResourceAttrDiff{
+				Old: tc.state,
+				New: tc.newValue,
+			}
+		}
+
+		d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+		if err != nil {
+			t.Fatalf("unexpected error creating test case %d resource data: %s", i, err)
+		}
+
+		value, null := StringFromResourceData(d, "description").Value()
+		if null != tc.expectNull {
+			t.Fatalf("expected test case %d null flag to be %t, got %t", i, tc.expectNull, null)
+		}
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d value to be %q, got %q", i, tc.expectedValue, value)
+		}
+	}
+}
+
+func TestValidationStringLenBetween(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "abc",
+			f:   ValidateTypeStringNullableStringLenBetween(1, 3),
+		},
+		{
+			val: "",
+			f:   ValidateTypeStringNullableStringLenBetween(1, 3),
+		},
+		{
+			val:         "abcd",
+			f:           ValidateTypeStringNullableStringLenBetween(1, 3),
+			expectedErr: regexp.MustCompile(`expected length of [\w]+ to be in the range \(1 - 3\), got abcd`),
+		},
+		{
+			val:         1,
+			f:           ValidateTypeStringNullableStringLenBetween(1, 3),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
@@ -1,8 +1,11 @@
 package flex
 
 import (
+	"strconv"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 )
 
 // Takes the result of flatmap.Expand for an array of strings
@@ -76,3 +79,82 @@ func FlattenInt64List(list []*int64) []interface{} {
 	}
 	return vs
 }
+
+// Takes a nullable bool and returns a *bool,
+// or nil if it is null or not a valid bool.
+// Parse errors are not returned, so the attribute's schema must use
+// nullable.ValidateTypeStringNullableBool as its ValidateFunc
+func ExpandNullableBool(v nullable.Bool) *bool {
+	value, null, err := v.Value()
+	if null || err != nil {
+		return nil
+	}
+	return aws.Bool(value)
+}
+
+// Takes a *bool and returns the value of a nullable bool attribute,
+// which is empty if the pointer is nil
+func FlattenNullableBool(v *bool) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatBool(aws.BoolValue(v))
+}
+
+// Takes a nullable int and returns a *int64,
+// or nil if it is null or not a valid int.
+// Parse errors are not returned, so the attribute's schema must use
+// nullable.ValidateTypeStringNullableInt (or a stricter variant) as its ValidateFunc
+func ExpandNullableInt64(v nullable.Int) *int64 {
+	value, null, err := v.Value()
+	if null || err != nil {
+		return nil
+	}
+	return aws.Int64(value)
+}
+
+// Takes a *int64 and returns the value of a nullable int attribute,
+// which is empty if the pointer is nil
+func FlattenNullableInt64(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(aws.Int64Value(v), 10)
+}
+
+// Takes a nullable float and returns a *float64,
+// or nil if it is null or not a valid float.
+// Parse errors are not returned, so the attribute's schema must use
+// nullable.ValidateTypeStringNullableFloat (or a stricter variant) as its ValidateFunc
+func ExpandNullableFloat64(v nullable.Float) *float64 {
+	value, null, err := v.Value()
+	if null || err != nil {
+		return nil
+	}
+	return aws.Float64(value)
+}
+
+// Takes a *float64 and returns the value of a nullable float attribute,
+// which is empty if the pointer is nil
+func FlattenNullableFloat64(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return string(nullable.NewFloat(aws.Float64Value(v)))
+}
+
+// Takes a nullable string and returns a *string,
+// or nil if it is null
+func ExpandNullableString(v nullable.String) *string {
+	value, null := v.Value()
+	if null {
+		return nil
+	}
+	return aws.String(value)
+}
+
+// Takes a *string and returns the value of a nullable string attribute,
+// which is empty if the pointer is nil
+func FlattenNullableString(v *string) string {
+	return aws.StringValue(v)
+}
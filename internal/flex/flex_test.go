@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 )
 
 func TestExpandStringList(t *testing.T) {
@@ -39,3 +40,76 @@ func TestExpandStringListEmptyItems(t *testing.T) {
 			expected)
 	}
 }
+
+func TestExpandNullableBool(t *testing.T) {
+	for v, expected := range map[nullable.Bool]*bool{
+		"":      nil,
+		"true":  aws.Bool(true),
+		"false": aws.Bool(false),
+		"A":     nil,
+	} {
+		if got := ExpandNullableBool(v); !reflect.DeepEqual(got, expected) {
+			t.Errorf("ExpandNullableBool(%q) = %v, expected %v", v, got, expected)
+		}
+	}
+}
+
+func TestExpandNullableInt64(t *testing.T) {
+	for v, expected := range map[nullable.Int]*int64{
+		"":   nil,
+		"0":  aws.Int64(0),
+		"-3": aws.Int64(-3),
+		"A":  nil,
+	} {
+		if got := ExpandNullableInt64(v); !reflect.DeepEqual(got, expected) {
+			t.Errorf("ExpandNullableInt64(%q) = %v, expected %v", v, got, expected)
+		}
+	}
+}
+
+func TestExpandNullableFloat64(t *testing.T) {
+	for v, expected := range map[nullable.Float]*float64{
+		"":    nil,
+		"0":   aws.Float64(0),
+		"1.5": aws.Float64(1.5),
+		"A":   nil,
+	} {
+		if got := ExpandNullableFloat64(v); !reflect.DeepEqual(got, expected) {
+			t.Errorf("ExpandNullableFloat64(%q) = %v, expected %v", v, got, expected)
+		}
+	}
+}
+
+func TestExpandNullableString(t *testing.T) {
+	if got := ExpandNullableString(nullable.String{}); got != nil {
+		t.Errorf("ExpandNullableString(null) = %q, expected nil", aws.StringValue(got))
+	}
+
+	if got := ExpandNullableString(nullable.NewString("")); !reflect.DeepEqual(got, aws.String("")) {
+		t.Errorf("ExpandNullableString(\"\") = %v, expected empty string", got)
+	}
+}
+
+func TestFlattenNullable(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Got      string
+		Expected string
+	}{
+		{Name: "bool nil", Got: FlattenNullableBool(nil), Expected: ""},
+		{Name: "bool false", Got: FlattenNullableBool(aws.Bool(false)), Expected: "false"},
+		{Name: "int64 nil", Got: FlattenNullableInt64(nil), Expected: ""},
+		{Name: "int64 zero", Got: FlattenNullableInt64(aws.Int64(0)), Expected: "0"},
+		{Name: "float64 nil", Got: FlattenNullableFloat64(nil), Expected: ""},
+		{Name: "float64 zero", Got: FlattenNullableFloat64(aws.Float64(0)), Expected: "0"},
+		{Name: "float64 large", Got: FlattenNullableFloat64(aws.Float64(1000000)), Expected: "1000000"},
+		{Name: "string nil", Got: FlattenNullableString(nil), Expected: ""},
+		{Name: "string", Got: FlattenNullableString(aws.String("a")), Expected: "a"},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.Got != testCase.Expected {
+			t.Errorf("%s: got %q, expected %q", testCase.Name, testCase.Got, testCase.Expected)
+		}
+	}
+}
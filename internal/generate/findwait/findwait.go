@@ -0,0 +1,288 @@
+// Package findwait generates the finder, status and waiter functions of a resource
+// from a declarative specification.
+package findwait
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// Spec is the specification of a resource's finder, status and waiter functions.
+type Spec struct {
+	// ServicePackage is the name of the provider package the functions are generated in, e.g. mwaa.
+	ServicePackage string
+	// AWSService is the name of the AWS SDK for Go service package, e.g. mwaa.
+	AWSService string
+	// AWSServiceClient is the name of the AWS SDK for Go service client type, e.g. MWAA.
+	AWSServiceClient string
+
+	// Resource is the name of the resource used in function names, e.g. Environment.
+	Resource string
+	// Operation is the name of the API operation that describes the resource, e.g. GetEnvironment.
+	Operation string
+	// IDField is the name of the operation input field that identifies the resource, e.g. Name.
+	IDField string
+	// IDList is whether the input field is a list of identifiers, e.g. VpcIds.
+	IDList bool
+	// IDName is the name of the identifier used in the finder function name.
+	// Defaults to IDField.
+	IDName string
+	// OutputField is the name of the operation output field containing the resource.
+	// The finder returns the whole operation output if empty.
+	OutputField string
+	// OutputList is whether the output field is a list of resources.
+	OutputList bool
+	// OutputType is the name of the resource's AWS SDK for Go type.
+	// Defaults to Resource, or the operation output type if OutputField is empty.
+	OutputType string
+	// NotFoundCodes are Go expressions for the API error codes returned for missing resources,
+	// e.g. mwaa.ErrCodeResourceNotFoundException.
+	NotFoundCodes []string
+
+	// StatusField is the name of the resource's status field, e.g. Status.
+	// The status and waiter functions are only generated if set.
+	StatusField string
+	// Waiters are the waiter functions.
+	Waiters []Waiter
+}
+
+// Waiter is the specification of a waiter function, e.g. waitEnvironmentCreated.
+type Waiter struct {
+	// Name is the waiter name suffix, e.g. Created.
+	Name string
+	// Pending are Go expressions for the pending states, e.g. mwaa.EnvironmentStatusCreating.
+	Pending []string
+	// Target are Go expressions for the target states, e.g. mwaa.EnvironmentStatusAvailable.
+	// No target states means waiting for the resource to be deleted.
+	Target []string
+}
+
+// Filename returns the name of the file generated for the specification.
+func Filename(spec Spec) string {
+	return fmt.Sprintf("%s_find_wait_gen.go", snakeCase(spec.Resource))
+}
+
+// Generate returns the formatted source of the specification's functions.
+func Generate(spec Spec) ([]byte, error) {
+	if err := spec.validate(); err != nil {
+		return nil, err
+	}
+
+	if spec.IDName == "" {
+		spec.IDName = spec.IDField
+	}
+
+	if spec.OutputType == "" {
+		if spec.OutputField == "" {
+			spec.OutputType = spec.Operation + "Output"
+		} else {
+			spec.OutputType = spec.Resource
+		}
+	}
+
+	tmpl, err := template.New("findwait").Funcs(template.FuncMap{
+		"Join":       strings.Join,
+		"LowerFirst": lowerFirst,
+	}).Parse(templateBody)
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var buffer bytes.Buffer
+
+	if err := tmpl.Execute(&buffer, spec); err != nil {
+		return nil, fmt.Errorf("error executing template: %w", err)
+	}
+
+	src, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated source: %w", err)
+	}
+
+	return src, nil
+}
+
+func (spec Spec) validate() error {
+	for name, v := range map[string]string{
+		"ServicePackage":   spec.ServicePackage,
+		"AWSService":       spec.AWSService,
+		"AWSServiceClient": spec.AWSServiceClient,
+		"Resource":         spec.Resource,
+		"Operation":        spec.Operation,
+		"IDField":          spec.IDField,
+	} {
+		if v == "" {
+			return fmt.Errorf("%s is required", name)
+		}
+	}
+
+	if spec.OutputList && spec.OutputField == "" {
+		return fmt.Errorf("OutputList requires OutputField")
+	}
+
+	if len(spec.Waiters) > 0 && spec.StatusField == "" {
+		return fmt.Errorf("waiters require StatusField")
+	}
+
+	for _, waiter := range spec.Waiters {
+		if waiter.Name == "" {
+			return fmt.Errorf("waiter name is required")
+		}
+
+		if len(waiter.Pending) == 0 {
+			return fmt.Errorf("waiter (%s) requires pending states", waiter.Name)
+		}
+	}
+
+	return nil
+}
+
+// lowerFirst returns the identifier as an unexported Go identifier, e.g. Name -> name and ARN -> arn.
+func lowerFirst(s string) string {
+	if strings.ToUpper(s) == s {
+		return strings.ToLower(s)
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// snakeCase returns the identifier in snake case, e.g. DBInstance -> db_instance.
+func snakeCase(s string) string {
+	runes := []rune(s)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteRune('_')
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+const templateBody = `
+// Code generated by internal/generate/findwait/main.go; DO NOT EDIT.
+
+package {{ .ServicePackage }}
+
+import (
+{{- if .Waiters }}
+	"context"
+	"time"
+
+{{ end }}
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}"
+{{- if .NotFoundCodes }}
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+{{- end }}
+{{- if or .NotFoundCodes .StatusField }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+{{- end }}
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+{{- $id := LowerFirst .IDName }}
+{{- $type := printf "*%s.%s" .AWSService .OutputType }}
+{{- $client := printf "*%s.%s" .AWSService .AWSServiceClient }}
+
+func Find{{ .Resource }}By{{ .IDName }}(conn {{ $client }}, {{ $id }} string) ({{ $type }}, error) {
+	input := &{{ .AWSService }}.{{ .Operation }}Input{
+{{- if .IDList }}
+		{{ .IDField }}: aws.StringSlice([]string{ {{- $id -}} }),
+{{- else }}
+		{{ .IDField }}: aws.String({{ $id }}),
+{{- end }}
+	}
+
+	output, err := conn.{{ .Operation }}(input)
+
+{{- if .NotFoundCodes }}
+
+	if {{ range $i, $code := .NotFoundCodes }}{{ if $i }} || {{ end }}tfawserr.ErrCodeEquals(err, {{ $code }}){{ end }} {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+{{- end }}
+
+	if err != nil {
+		return nil, err
+	}
+{{- if .OutputList }}
+
+	if output == nil || len(output.{{ .OutputField }}) == 0 || output.{{ .OutputField }}[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.{{ .OutputField }}); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.{{ .OutputField }}[0], nil
+{{- else if .OutputField }}
+
+	if output == nil || output.{{ .OutputField }} == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.{{ .OutputField }}, nil
+{{- else }}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+{{- end }}
+}
+{{- if .StatusField }}
+
+func status{{ .Resource }}(conn {{ $client }}, {{ $id }} string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := Find{{ .Resource }}By{{ .IDName }}(conn, {{ $id }})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.{{ .StatusField }}), nil
+	}
+}
+{{- end }}
+
+{{- $resource := .Resource }}
+{{- range .Waiters }}
+
+func wait{{ $resource }}{{ .Name }}(ctx context.Context, conn {{ $client }}, {{ $id }} string, timeout time.Duration) ({{ $type }}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ {{- Join .Pending ", " -}} },
+		Target:  []string{ {{- Join .Target ", " -}} },
+		Refresh: status{{ $resource }}(conn, {{ $id }}),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
+
+	if output, ok := outputRaw.({{ $type }}); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+{{- end }}
+`
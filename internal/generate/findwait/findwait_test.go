@@ -0,0 +1,175 @@
+package findwait
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Spec Spec
+	}{
+		{
+			Name: "mwaa_environment",
+			Spec: Spec{
+				ServicePackage:   "mwaa",
+				AWSService:       "mwaa",
+				AWSServiceClient: "MWAA",
+				Resource:         "Environment",
+				Operation:        "GetEnvironment",
+				IDField:          "Name",
+				OutputField:      "Environment",
+				NotFoundCodes:    []string{"mwaa.ErrCodeResourceNotFoundException"},
+				StatusField:      "Status",
+				Waiters: []Waiter{
+					{Name: "Created", Pending: []string{"mwaa.EnvironmentStatusCreating"}, Target: []string{"mwaa.EnvironmentStatusAvailable"}},
+					{Name: "Deleted", Pending: []string{"mwaa.EnvironmentStatusDeleting"}},
+				},
+			},
+		},
+		{
+			Name: "redshift_cluster",
+			Spec: Spec{
+				ServicePackage:   "redshift",
+				AWSService:       "redshift",
+				AWSServiceClient: "Redshift",
+				Resource:         "Cluster",
+				Operation:        "DescribeClusters",
+				IDField:          "ClusterIdentifier",
+				IDName:           "ID",
+				OutputField:      "Clusters",
+				OutputList:       true,
+				NotFoundCodes:    []string{"redshift.ErrCodeClusterNotFoundFault"},
+				StatusField:      "ClusterStatus",
+				Waiters: []Waiter{
+					{Name: "Deleted", Pending: []string{"clusterStatusAvailable", "clusterStatusDeleting"}},
+				},
+			},
+		},
+		{
+			Name: "ec2_vpc",
+			Spec: Spec{
+				ServicePackage:   "ec2",
+				AWSService:       "ec2",
+				AWSServiceClient: "EC2",
+				Resource:         "VPC",
+				Operation:        "DescribeVpcs",
+				IDField:          "VpcIds",
+				IDList:           true,
+				IDName:           "ID",
+				OutputField:      "Vpcs",
+				OutputList:       true,
+				OutputType:       "Vpc",
+				NotFoundCodes:    []string{"ErrCodeInvalidVpcIDNotFound"},
+			},
+		},
+		{
+			Name: "sfn_state_machine",
+			Spec: Spec{
+				ServicePackage:   "sfn",
+				AWSService:       "sfn",
+				AWSServiceClient: "SFN",
+				Resource:         "StateMachine",
+				Operation:        "DescribeStateMachine",
+				IDField:          "StateMachineArn",
+				IDName:           "ARN",
+				StatusField:      "Status",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := Generate(testCase.Spec)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			golden := filepath.Join("testdata", testCase.Name+".golden")
+
+			if *update {
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatalf("error writing golden file: %s", err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(golden)
+
+			if err != nil {
+				t.Fatalf("error reading golden file: %s", err)
+			}
+
+			if string(got) != string(expected) {
+				t.Errorf("generated source does not match %s, run with -update to update it:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	valid := Spec{
+		ServicePackage:   "mwaa",
+		AWSService:       "mwaa",
+		AWSServiceClient: "MWAA",
+		Resource:         "Environment",
+		Operation:        "GetEnvironment",
+		IDField:          "Name",
+	}
+
+	testCases := []struct {
+		Name   string
+		Modify func(*Spec)
+	}{
+		{
+			Name:   "no operation",
+			Modify: func(spec *Spec) { spec.Operation = "" },
+		},
+		{
+			Name:   "output list without field",
+			Modify: func(spec *Spec) { spec.OutputList = true },
+		},
+		{
+			Name: "waiter without status",
+			Modify: func(spec *Spec) {
+				spec.Waiters = []Waiter{{Name: "Created", Pending: []string{"mwaa.EnvironmentStatusCreating"}}}
+			},
+		},
+		{
+			Name: "waiter without pending states",
+			Modify: func(spec *Spec) {
+				spec.StatusField = "Status"
+				spec.Waiters = []Waiter{{Name: "Created", Target: []string{"mwaa.EnvironmentStatusAvailable"}}}
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			spec := valid
+			testCase.Modify(&spec)
+
+			if _, err := Generate(spec); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestFilename(t *testing.T) {
+	for resource, expected := range map[string]string{
+		"Environment":  "environment_find_wait_gen.go",
+		"DBInstance":   "db_instance_find_wait_gen.go",
+		"StateMachine": "state_machine_find_wait_gen.go",
+		"VPC":          "vpc_find_wait_gen.go",
+	} {
+		if got := Filename(Spec{Resource: resource}); got != expected {
+			t.Errorf("%s: got %s, expected %s", resource, got, expected)
+		}
+	}
+}
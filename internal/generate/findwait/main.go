@@ -0,0 +1,116 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/findwait"
+)
+
+var (
+	awsService       = flag.String("AWSService", "", "name of the AWS SDK for Go service package (default: the package name)")
+	awsServiceClient = flag.String("AWSServiceClient", "", "name of the AWS SDK for Go service client type (default: the upper-cased package name)")
+	resourceName     = flag.String("Resource", "", "name of the resource used in function names")
+	operation        = flag.String("Operation", "", "name of the API operation that describes the resource")
+	idField          = flag.String("IDField", "", "name of the operation input field that identifies the resource")
+	idList           = flag.Bool("IDList", false, "whether the input field is a list of identifiers")
+	idName           = flag.String("IDName", "", "name of the identifier used in the finder function name (default: IDField)")
+	outputField      = flag.String("OutputField", "", "name of the operation output field containing the resource")
+	outputList       = flag.Bool("OutputList", false, "whether the output field is a list of resources")
+	outputType       = flag.String("OutputType", "", "name of the resource's AWS SDK for Go type (default: Resource)")
+	notFoundCodes    = flag.String("NotFoundCodes", "", "comma-separated Go expressions for the API error codes returned for missing resources")
+	statusField      = flag.String("StatusField", "", "name of the resource's status field")
+	created          = flag.String("Created", "", "pending and target states of the Created waiter, as <pending>[,<pending>]:<target>[,<target>]")
+	updated          = flag.String("Updated", "", "pending and target states of the Updated waiter, as <pending>[,<pending>]:<target>[,<target>]")
+	deleted          = flag.String("Deleted", "", "pending states of the Deleted waiter, as <pending>[,<pending>]")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	spec := findwait.Spec{
+		ServicePackage:   servicePackage,
+		AWSService:       *awsService,
+		AWSServiceClient: *awsServiceClient,
+		Resource:         *resourceName,
+		Operation:        *operation,
+		IDField:          *idField,
+		IDList:           *idList,
+		IDName:           *idName,
+		OutputField:      *outputField,
+		OutputList:       *outputList,
+		OutputType:       *outputType,
+		NotFoundCodes:    split(*notFoundCodes),
+		StatusField:      *statusField,
+	}
+
+	if spec.AWSService == "" {
+		spec.AWSService = servicePackage
+	}
+
+	if spec.AWSServiceClient == "" {
+		spec.AWSServiceClient = strings.ToUpper(servicePackage)
+	}
+
+	for _, v := range []struct {
+		name   string
+		states string
+	}{
+		{"Created", *created},
+		{"Updated", *updated},
+		{"Deleted", *deleted},
+	} {
+		if v.states == "" {
+			continue
+		}
+
+		pending, target := v.states, ""
+
+		if i := strings.Index(v.states, ":"); i >= 0 {
+			pending, target = v.states[:i], v.states[i+1:]
+		}
+
+		spec.Waiters = append(spec.Waiters, findwait.Waiter{
+			Name:    v.name,
+			Pending: split(pending),
+			Target:  split(target),
+		})
+	}
+
+	src, err := findwait.Generate(spec)
+
+	if err != nil {
+		log.Fatalf("error generating %s functions: %s", spec.Resource, err)
+	}
+
+	filename := findwait.Filename(spec)
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing %s: %s", filename, err)
+	}
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, ",")
+}
@@ -0,0 +1,140 @@
+package listpages
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Spec Spec
+	}{
+		{
+			Name: "cloudwatchevents",
+			Spec: Spec{
+				ServicePackage: "cloudwatchevents",
+				Parameters:     "-ListOps=ListRules,ListEventBuses",
+				ListOps:        []string{"ListRules", "ListEventBuses"},
+			},
+		},
+		{
+			Name: "apigatewayv2_export",
+			Spec: Spec{
+				ServicePackage: "apigatewayv2",
+				Parameters:     "-ListOps=GetApis -Export",
+				ListOps:        []string{"GetApis"},
+				Export:         true,
+			},
+		},
+		{
+			Name: "cognitoidp_sdk_pages",
+			Spec: Spec{
+				ServicePackage: "cognitoidp",
+				Parameters:     "-ListOps=ListUserPools -ListAll -Filter -PageSizeField=MaxResults -PageSize=60",
+				ListOps:        []string{"ListUserPools"},
+				ListAll:        true,
+				Filter:         true,
+				PageSizeField:  "MaxResults",
+				PageSize:       60,
+			},
+		},
+		{
+			Name: "ecs_item_fields",
+			Spec: Spec{
+				ServicePackage: "ecs",
+				Parameters:     "-ListOps=DescribeCapacityProviders -ListAll -ItemFields=DescribeCapacityProviders:CapacityProviders -PageSizeField=MaxResults -PageSize=10",
+				ListOps:        []string{"DescribeCapacityProviders"},
+				ListAll:        true,
+				ItemFields:     map[string]string{"DescribeCapacityProviders": "CapacityProviders"},
+				PageSizeField:  "MaxResults",
+				PageSize:       10,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := Generate(testCase.Spec)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			golden := filepath.Join("testdata", testCase.Name+".golden")
+
+			if *update {
+				if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+					t.Fatalf("error writing golden file: %s", err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(golden)
+
+			if err != nil {
+				t.Fatalf("error reading golden file: %s", err)
+			}
+
+			if string(got) != string(expected) {
+				t.Errorf("generated source does not match %s, run with -update to update it:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestGenerateInvalid(t *testing.T) {
+	valid := Spec{
+		ServicePackage: "cognitoidp",
+		ListOps:        []string{"ListUserPools"},
+		ListAll:        true,
+	}
+
+	testCases := []struct {
+		Name   string
+		Modify func(*Spec)
+	}{
+		{
+			Name:   "filter without list all",
+			Modify: func(spec *Spec) { spec.ListAll, spec.Filter = false, true },
+		},
+		{
+			Name:   "page size field without page size",
+			Modify: func(spec *Spec) { spec.PageSizeField = "MaxResults" },
+		},
+		{
+			Name:   "page size without page size field",
+			Modify: func(spec *Spec) { spec.PageSize = 60 },
+		},
+		{
+			Name:   "SDK pages without list all",
+			Modify: func(spec *Spec) { spec.ListAll = false },
+		},
+		{
+			Name:   "unknown function",
+			Modify: func(spec *Spec) { spec.ListOps = []string{"ListWidgets"} },
+		},
+		{
+			Name:   "unknown item field",
+			Modify: func(spec *Spec) { spec.ItemFields = map[string]string{"ListUserPools": "Widgets"} },
+		},
+		{
+			Name:   "unknown service",
+			Modify: func(spec *Spec) { spec.ServicePackage = "widgets" },
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			spec := valid
+			testCase.Modify(&spec)
+
+			if _, err := Generate(spec); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
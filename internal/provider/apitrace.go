@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// wrapResourceForAPITrace wraps the CRUD functions of the specified resource or data source
+// so that the AWS API calls they make are attributed to the resource.
+// Calls are attributed by their context and, for calls made without one, by the service
+// clients of a copy of the provider meta that is passed to each CRUD function call.
+func wrapResourceForAPITrace(typeName string, r *schema.Resource) {
+	if f := r.Create; f != nil {
+		r.Create = apiTraceFunc(typeName, f)
+	}
+
+	if f := r.Read; f != nil {
+		r.Read = apiTraceFunc(typeName, f)
+	}
+
+	if f := r.Update; f != nil {
+		r.Update = apiTraceFunc(typeName, f)
+	}
+
+	if f := r.Delete; f != nil {
+		r.Delete = apiTraceFunc(typeName, f)
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = apiTraceContextFunc(typeName, f)
+	}
+
+	if f := r.CreateWithoutTimeout; f != nil {
+		r.CreateWithoutTimeout = apiTraceContextFunc(typeName, f)
+	}
+
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = apiTraceContextFunc(typeName, f)
+	}
+
+	if f := r.ReadWithoutTimeout; f != nil {
+		r.ReadWithoutTimeout = apiTraceContextFunc(typeName, f)
+	}
+
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = apiTraceContextFunc(typeName, f)
+	}
+
+	if f := r.UpdateWithoutTimeout; f != nil {
+		r.UpdateWithoutTimeout = apiTraceContextFunc(typeName, f)
+	}
+
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = apiTraceContextFunc(typeName, f)
+	}
+
+	if f := r.DeleteWithoutTimeout; f != nil {
+		r.DeleteWithoutTimeout = apiTraceContextFunc(typeName, f)
+	}
+}
+
+// apiTraceFunc wraps the specified CRUD function for API call attribution.
+func apiTraceFunc(typeName string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		return f(d, apiTraceMeta(typeName, d, meta))
+	}
+}
+
+// apiTraceContextFunc wraps the specified context-aware CRUD function for API call attribution.
+func apiTraceContextFunc(typeName string, f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(conns.NewAPITraceContext(ctx, typeName, d.Id()), d, apiTraceMeta(typeName, d, meta))
+	}
+}
+
+// apiTraceMeta returns the provider meta to pass to a CRUD function of the specified resource.
+func apiTraceMeta(typeName string, d *schema.ResourceData, meta interface{}) interface{} {
+	if client, ok := meta.(*conns.AWSClient); ok {
+		return client.ForAPITraceResource(typeName, d.Id())
+	}
+
+	return meta
+}
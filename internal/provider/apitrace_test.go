@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestWrapResourceForAPITrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `<DescribeVpcsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><vpcSet/></DescribeVpcsResponse>`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	config := conns.Config{
+		AccessKey:               "accessKey",
+		APITraceFile:            path,
+		Endpoints:               map[string]string{"ec2": server.URL},
+		Region:                  "us-west-2", //lintignore:AWSAT003
+		SecretKey:               "secretKey",
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	meta, err := config.Client()
+
+	if err != nil {
+		t.Fatalf("error configuring client: %s", err)
+	}
+
+	r := &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			conn := meta.(*conns.AWSClient).EC2Conn()
+
+			if _, err := conn.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{}); err != nil {
+				return diag.FromErr(err)
+			}
+
+			// e.g. a finder that does not take a context.
+			if _, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{}); err != nil {
+				return diag.FromErr(err)
+			}
+
+			return nil
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+
+	wrapResourceForAPITrace("aws_example_thing", r)
+
+	for i := 0; i < 2; i++ {
+		d := r.TestResourceData()
+		d.SetId(fmt.Sprintf("thing-%d", i))
+
+		if diags := r.ReadContext(context.Background(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+	}
+
+	other := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			_, err := meta.(*conns.AWSClient).EC2Conn().DescribeVpcs(&ec2.DescribeVpcsInput{})
+
+			return err
+		},
+		Schema: map[string]*schema.Schema{},
+	}
+
+	wrapResourceForAPITrace("aws_example_other", other)
+
+	d := other.TestResourceData()
+	d.SetId("other-0")
+
+	if err := other.Read(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := meta.(*conns.AWSClient).EC2Conn().DescribeVpcs(&ec2.DescribeVpcsInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	conns.CloseAPITracers()
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("error opening API trace file: %s", err)
+	}
+	defer file.Close()
+
+	calls := make(map[string]int)
+	ids := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		var record conns.APITraceRecord
+
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("error decoding %q: %s", scanner.Text(), err)
+		}
+
+		if record.Event == "api_call" && record.Operation == "DescribeVpcs" {
+			calls[record.ResourceType]++
+			ids[record.ResourceID] = true
+		}
+	}
+
+	if got, expected := calls["aws_example_thing"], 4; got != expected {
+		t.Errorf("got %d DescribeVpcs calls attributed to aws_example_thing, expected %d", got, expected)
+	}
+
+	if got, expected := calls[""], 1; got != expected {
+		t.Errorf("got %d unattributed DescribeVpcs calls, expected %d", got, expected)
+	}
+
+	if got, expected := calls["aws_example_other"], 1; got != expected {
+		t.Errorf("got %d DescribeVpcs calls attributed to aws_example_other, expected %d", got, expected)
+	}
+
+	for _, id := range []string{"thing-0", "thing-1", "other-0"} {
+		if !ids[id] {
+			t.Errorf("no DescribeVpcs call attributed to %s", id)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// crudContextFunc is the signature of the context-aware CRUD functions of a resource.
+type crudContextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+
+// resourceContext returns a context identifying the resource type that is also
+// canceled when the provider is stopped.
+func resourceContext(ctx context.Context, typeName string, meta interface{}) (context.Context, context.CancelFunc) {
+	ctx = conns.NewResourceContext(ctx, typeName)
+
+	if client, ok := meta.(*conns.AWSClient); ok {
+		return client.StoppableContext(ctx)
+	}
+
+	return context.WithCancel(ctx)
+}
+
+// wrapResourceForContext wraps the CustomizeDiff and context-aware CRUD functions of the
+// specified resource so that they are called with a context identifying the resource type.
+// The CRUD function contexts are also canceled when the provider is stopped, so that waits
+// for long running operations return promptly when Terraform is interrupted.
+func wrapResourceForContext(typeName string, r *schema.Resource) {
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return f(conns.NewResourceContext(ctx, typeName), diff, meta)
+		}
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = stoppableContextFunc(typeName, f)
+	}
+
+	if f := r.CreateWithoutTimeout; f != nil {
+		r.CreateWithoutTimeout = stoppableContextFunc(typeName, f)
+	}
+
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = stoppableContextFunc(typeName, f)
+	}
+
+	if f := r.ReadWithoutTimeout; f != nil {
+		r.ReadWithoutTimeout = stoppableContextFunc(typeName, f)
+	}
+
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = stoppableContextFunc(typeName, f)
+	}
+
+	if f := r.UpdateWithoutTimeout; f != nil {
+		r.UpdateWithoutTimeout = stoppableContextFunc(typeName, f)
+	}
+
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = stoppableContextFunc(typeName, f)
+	}
+
+	if f := r.DeleteWithoutTimeout; f != nil {
+		r.DeleteWithoutTimeout = stoppableContextFunc(typeName, f)
+	}
+}
+
+// stoppableContextFunc wraps the specified context-aware CRUD function so that it is called
+// with a context identifying the resource type that is canceled when the provider is stopped.
+func stoppableContextFunc(typeName string, f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, cancel := resourceContext(ctx, typeName, meta)
+		defer cancel()
+
+		return f(ctx, d, meta)
+	}
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestWrapResourceForContext(t *testing.T) {
+	var readContext context.Context
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			readContext = ctx
+
+			if err := ctx.Err(); err != nil {
+				return diag.FromErr(err)
+			}
+
+			return nil
+		},
+	}
+
+	wrapResourceForContext("aws_example", r)
+
+	if diags := r.ReadWithoutTimeout(context.Background(), r.TestResourceData(), &conns.AWSClient{}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if typeName, ok := conns.ResourceTypeNameFromContext(readContext); !ok || typeName != "aws_example" {
+		t.Errorf("got resource type %q, expected aws_example", typeName)
+	}
+
+	if readContext.Err() == nil {
+		t.Error("expected context to be canceled after the function returned")
+	}
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// isTaggableResource returns whether the specified resource merges provider-level tags.
+func isTaggableResource(r *schema.Resource) bool {
+	_, tags := r.Schema["tags"]
+	_, tagsAll := r.Schema["tags_all"]
+
+	return tags && tagsAll
+}
+
+// configuredTagKeys returns the keys of the tags that a resource's Create or Update function applies,
+// before the function is called.
+func configuredTagKeys(d *schema.ResourceData, client *conns.AWSClient) tftags.KeyValueTags {
+	tags := tftags.New(d.Get("tags").(map[string]interface{}))
+
+	return client.DefaultTagsConfig.MergeTags(tags).IgnoreConfig(client.IgnoreTagsConfig)
+}
+
+// recordedTagKeys returns the tag keys recorded in a resource's state, before its Read function is called.
+// If no keys are recorded, as managed_tag_keys_only has just been enabled, the keys of the tags in state,
+// i.e. the tags last applied, are managed. If there are no tags in state, as the resource is being imported,
+// false is returned and all of the tags read are managed.
+func recordedTagKeys(d *schema.ResourceData) (tftags.KeyValueTags, bool) {
+	if _, ok := d.GetOkExists(tftags.ManagedKeysAttribute); ok {
+		return tftags.New(d.Get(tftags.ManagedKeysAttribute).(*schema.Set).List()), true
+	}
+
+	if _, ok := d.GetOkExists("tags_all"); ok {
+		return tftags.New(d.Get("tags_all").(map[string]interface{})), true
+	}
+
+	return nil, false
+}
+
+// setManagedTags records the tag keys managed by the resource and removes the tags with other keys from its state.
+// If the managed tag keys are not known, all of the tags in state are managed.
+//
+// The generated UpdateTags functions add or update the tags that are in the new tags but not the old tags,
+// and remove the tags that are in the old tags but not the new tags. The old tags are those in state,
+// so keeping unmanaged tags out of state means they are never removed, and nothing else needs to change.
+func setManagedTags(d *schema.ResourceData, managedKeys tftags.KeyValueTags, known bool) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if !known {
+		managedKeys = tftags.New(d.Get("tags_all").(map[string]interface{}))
+	}
+
+	if err := d.Set(tftags.ManagedKeysAttribute, managedKeys.Keys()); err != nil {
+		return fmt.Errorf("error setting %s: %w", tftags.ManagedKeysAttribute, err)
+	}
+
+	for _, k := range []string{"tags", "tags_all"} {
+		tags := tftags.New(d.Get(k).(map[string]interface{})).Only(managedKeys)
+
+		if err := d.Set(k, tags.Map()); err != nil {
+			return fmt.Errorf("error setting %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// managedTagKeysOnly returns the provider's client if resources only manage their own tag keys.
+func managedTagKeysOnly(meta interface{}) (*conns.AWSClient, bool) {
+	client, ok := meta.(*conns.AWSClient)
+
+	return client, ok && client.ManagedTagKeysOnly
+}
+
+// wrapResourceForManagedTagKeys adds the attribute recording the tag keys managed by
+// the specified resource and wraps its CRUD functions to keep other tag keys out of state.
+//
+// The provider's schema cannot depend on its configuration, so the attribute is added
+// whether or not managed_tag_keys_only is enabled, but it is only set while it is.
+func wrapResourceForManagedTagKeys(r *schema.Resource) {
+	r.Schema[tftags.ManagedKeysAttribute] = tftags.ManagedKeysSchema()
+
+	if f := r.Create; f != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			client, ok := managedTagKeysOnly(meta)
+
+			if !ok {
+				return f(d, meta)
+			}
+
+			managedKeys := configuredTagKeys(d, client)
+
+			if err := f(d, meta); err != nil {
+				return err
+			}
+
+			return setManagedTags(d, managedKeys, true)
+		}
+	}
+
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if _, ok := managedTagKeysOnly(meta); !ok {
+				return f(d, meta)
+			}
+
+			managedKeys, ok := recordedTagKeys(d)
+
+			if err := f(d, meta); err != nil {
+				return err
+			}
+
+			return setManagedTags(d, managedKeys, ok)
+		}
+	}
+
+	if f := r.Update; f != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			client, ok := managedTagKeysOnly(meta)
+
+			if !ok {
+				return f(d, meta)
+			}
+
+			managedKeys := configuredTagKeys(d, client)
+
+			if err := f(d, meta); err != nil {
+				return err
+			}
+
+			return setManagedTags(d, managedKeys, true)
+		}
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = managedTagsApplyContextFunc(f)
+	}
+
+	if f := r.CreateWithoutTimeout; f != nil {
+		r.CreateWithoutTimeout = managedTagsApplyContextFunc(f)
+	}
+
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = managedTagsReadContextFunc(f)
+	}
+
+	if f := r.ReadWithoutTimeout; f != nil {
+		r.ReadWithoutTimeout = managedTagsReadContextFunc(f)
+	}
+
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = managedTagsApplyContextFunc(f)
+	}
+
+	if f := r.UpdateWithoutTimeout; f != nil {
+		r.UpdateWithoutTimeout = managedTagsApplyContextFunc(f)
+	}
+}
+
+// managedTagsApplyContextFunc wraps the specified context-aware Create or Update function
+// to record the configured tag keys and keep unmanaged tag keys out of state.
+func managedTagsApplyContextFunc(f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, ok := managedTagKeysOnly(meta)
+
+		if !ok {
+			return f(ctx, d, meta)
+		}
+
+		managedKeys := configuredTagKeys(d, client)
+		diags := f(ctx, d, meta)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if err := setManagedTags(d, managedKeys, true); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+// managedTagsReadContextFunc wraps the specified context-aware Read function to keep unmanaged tag keys out of state.
+func managedTagsReadContextFunc(f crudContextFunc) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if _, ok := managedTagKeysOnly(meta); !ok {
+			return f(ctx, d, meta)
+		}
+
+		managedKeys, ok := recordedTagKeys(d)
+		diags := f(ctx, d, meta)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if err := setManagedTags(d, managedKeys, ok); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// testManagedTagsResource returns a taggable resource whose remote tags are those specified.
+func testManagedTagsResource(remoteTags map[string]string) *schema.Resource {
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		if err := d.Set("tags", remoteTags); err != nil {
+			return err
+		}
+
+		return d.Set("tags_all", remoteTags)
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("test")
+
+			return read(d, meta)
+		},
+		Read:          read,
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+
+	wrapResourceForManagedTagKeys(r)
+
+	return r
+}
+
+// testManagedTagsResourceData returns the resource data for the specified resource's state.
+// Tags and managed tag keys are only in state if they are not nil.
+func testManagedTagsResourceData(t *testing.T, r *schema.Resource, tags map[string]string, managedKeys []string) *schema.ResourceData {
+	d := r.TestResourceData()
+	d.SetId("test")
+
+	if tags != nil {
+		for _, k := range []string{"tags", "tags_all"} {
+			if err := d.Set(k, tags); err != nil {
+				t.Fatalf("error setting %s: %s", k, err)
+			}
+		}
+	}
+
+	if managedKeys != nil {
+		if err := d.Set(tftags.ManagedKeysAttribute, managedKeys); err != nil {
+			t.Fatalf("error setting %s: %s", tftags.ManagedKeysAttribute, err)
+		}
+	}
+
+	return r.Data(d.State())
+}
+
+func TestManagedTagKeysRead(t *testing.T) {
+	remoteTags := map[string]string{"Owner": "team", "Foreign": "value"}
+
+	testCases := []struct {
+		Name                string
+		ManagedTagKeysOnly  bool
+		Tags                map[string]string
+		ManagedKeys         []string
+		ExpectedTags        map[string]string
+		ExpectedManagedKeys []string
+	}{
+		{
+			Name:                "disabled",
+			ManagedKeys:         []string{"Owner"},
+			ExpectedTags:        remoteTags,
+			ExpectedManagedKeys: []string{"Owner"},
+		},
+		{
+			Name:         "disabled not recorded",
+			Tags:         map[string]string{"Owner": "team"},
+			ExpectedTags: remoteTags,
+		},
+		{
+			Name:                "managed keys",
+			ManagedTagKeysOnly:  true,
+			ManagedKeys:         []string{"Owner", "Removed"},
+			ExpectedTags:        map[string]string{"Owner": "team"},
+			ExpectedManagedKeys: []string{"Owner", "Removed"},
+		},
+		{
+			Name:                "no managed keys",
+			ManagedTagKeysOnly:  true,
+			ManagedKeys:         []string{},
+			ExpectedTags:        map[string]string{},
+			ExpectedManagedKeys: []string{},
+		},
+		{
+			Name:                "not recorded",
+			ManagedTagKeysOnly:  true,
+			Tags:                map[string]string{"Owner": "team"},
+			ExpectedTags:        map[string]string{"Owner": "team"},
+			ExpectedManagedKeys: []string{"Owner"},
+		},
+		{
+			Name:                "not recorded no tags",
+			ManagedTagKeysOnly:  true,
+			Tags:                map[string]string{},
+			ExpectedTags:        map[string]string{},
+			ExpectedManagedKeys: []string{},
+		},
+		{
+			Name:                "import",
+			ManagedTagKeysOnly:  true,
+			ExpectedTags:        remoteTags,
+			ExpectedManagedKeys: []string{"Foreign", "Owner"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := testManagedTagsResource(remoteTags)
+			d := testManagedTagsResourceData(t, r, testCase.Tags, testCase.ManagedKeys)
+
+			if err := r.Read(d, &conns.AWSClient{ManagedTagKeysOnly: testCase.ManagedTagKeysOnly}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, k := range []string{"tags", "tags_all"} {
+				got := tftags.New(d.Get(k).(map[string]interface{}))
+
+				if expected := tftags.New(testCase.ExpectedTags); !got.Equal(expected) {
+					t.Errorf("got %s %s, expected %s", k, got, expected)
+				}
+			}
+
+			state := r.Data(d.State())
+
+			if _, ok := state.GetOkExists(tftags.ManagedKeysAttribute); ok != (testCase.ExpectedManagedKeys != nil) {
+				t.Fatalf("got %s recorded %t, expected %t", tftags.ManagedKeysAttribute, ok, testCase.ExpectedManagedKeys != nil)
+			}
+
+			got := tftags.New(state.Get(tftags.ManagedKeysAttribute).(*schema.Set).List())
+
+			if expected := tftags.New(testCase.ExpectedManagedKeys); !got.Equal(expected) {
+				t.Errorf("got %s %s, expected %s", tftags.ManagedKeysAttribute, got, expected)
+			}
+		})
+	}
+}
+
+func TestManagedTagKeysCreate(t *testing.T) {
+	r := testManagedTagsResource(map[string]string{"Owner": "team", "Foreign": "value"})
+	d := r.TestResourceData()
+
+	if err := d.Set("tags", map[string]string{"Owner": "team"}); err != nil {
+		t.Fatalf("error setting tags: %s", err)
+	}
+
+	meta := &conns.AWSClient{
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags: tftags.New(map[string]string{"Environment": "prod"}),
+		},
+		ManagedTagKeysOnly: true,
+	}
+
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := tftags.New(d.Get("tags_all").(map[string]interface{})), tftags.New(map[string]string{"Owner": "team"}); !got.Equal(expected) {
+		t.Errorf("got tags_all %s, expected %s", got, expected)
+	}
+
+	got := tftags.New(d.Get(tftags.ManagedKeysAttribute).(*schema.Set).List())
+
+	if expected := tftags.New([]string{"Environment", "Owner"}); !got.Equal(expected) {
+		t.Errorf("got %s %s, expected %s", tftags.ManagedKeysAttribute, got, expected)
+	}
+}
+
+func TestManagedTagKeysDiff(t *testing.T) {
+	meta := &conns.AWSClient{ManagedTagKeysOnly: true}
+	r := testManagedTagsResource(map[string]string{"Owner": "team", "Foreign": "value"})
+	d := r.TestResourceData()
+	d.SetId("test")
+
+	if err := d.Set(tftags.ManagedKeysAttribute, []string{"Owner"}); err != nil {
+		t.Fatalf("error setting %s: %s", tftags.ManagedKeysAttribute, err)
+	}
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": map[string]interface{}{"Owner": "team", "Environment": "prod"},
+	})
+
+	diff, err := r.Diff(context.Background(), d.State(), config, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, ok := diff.Attributes["tags_all.Foreign"]; ok {
+		t.Errorf("unexpected difference for unmanaged tag key: %v", diff.Attributes)
+	}
+
+	if v, ok := diff.Attributes["tags_all.Environment"]; !ok || v.New != "prod" {
+		t.Errorf("expected difference adding managed tag key: %v", diff.Attributes)
+	}
+
+	if v, ok := diff.Attributes[tftags.ManagedKeysAttribute+".#"]; !ok || v.New != "2" {
+		t.Errorf("expected difference recording managed tag keys: %v", diff.Attributes)
+	}
+}
+
+func TestManagedTagKeysResources(t *testing.T) {
+	p := Provider()
+
+	for _, typeName := range []string{"aws_instance", "aws_s3_bucket", "aws_vpc"} {
+		if _, ok := p.ResourcesMap[typeName].Schema[tftags.ManagedKeysAttribute]; !ok {
+			t.Errorf("resource type %s does not have %s", typeName, tftags.ManagedKeysAttribute)
+		}
+	}
+}
@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -186,6 +190,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -193,6 +199,20 @@ func Provider() *schema.Provider {
 				Description: descriptions["shared_credentials_file"],
 			},
 
+			"shared_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: descriptions["shared_config_file"],
+			},
+
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: descriptions["credential_process"],
+			},
+
 			"token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -218,6 +238,20 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"api_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_AWS_API_TRACE_FILE", ""),
+				Description: descriptions["api_trace_file"],
+			},
+
+			"read_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["read_cache"],
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -257,6 +291,41 @@ func Provider() *schema.Provider {
 				Description: descriptions["http_proxy"],
 			},
 
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"NO_PROXY", "no_proxy"}, ""),
+				Description: descriptions["no_proxy"],
+			},
+
+			"proxy_overrides": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  descriptions["proxy_overrides"],
+				ValidateFunc: validProxyOverrides,
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["client_certificate"],
+				RequiredWith: []string{"client_private_key"},
+			},
+
+			"client_private_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["client_private_key"],
+				RequiredWith: []string{"client_certificate"},
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -284,6 +353,19 @@ func Provider() *schema.Provider {
 				},
 			},
 
+			"managed_tag_keys_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["managed_tag_keys_only"],
+			},
+
+			"tag_policy": tagPolicySchema(),
+
+			"default_timeouts": defaultTimeoutsSchema(),
+
+			"rate_limits": rateLimitsSchema(),
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -529,6 +611,7 @@ func Provider() *schema.Provider {
 			"aws_iam_instance_profile":   iam.DataSourceInstanceProfile(),
 			"aws_iam_policy":             iam.DataSourcePolicy(),
 			"aws_iam_policy_document":    iam.DataSourcePolicyDocument(),
+			"aws_iam_policy_evaluation":  iam.DataSourcePolicyEvaluation(),
 			"aws_iam_role":               iam.DataSourceRole(),
 			"aws_iam_roles":              iam.DataSourceRoles(),
 			"aws_iam_server_certificate": iam.DataSourceServerCertificate(),
@@ -1664,14 +1747,55 @@ func Provider() *schema.Provider {
 		},
 	}
 
-	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+	for typeName, r := range provider.DataSourcesMap {
+		wrapResourceForAPITrace(typeName, r)
+	}
+
+	for typeName, r := range provider.ResourcesMap {
+		wrapResourceForAPITrace(typeName, r)
+		wrapResourceForContext(typeName, r)
+
+		if isTaggableResource(r) {
+			wrapResourceForManagedTagKeys(r)
+			wrapResourceForTagPolicy(provider, typeName, r)
+		}
+	}
+
+	// Resources are wrapped for their region after API call attribution so that
+	// attributed calls are made by the service clients for the resource's region.
+	for _, typeName := range regionalResourceTypes {
+		wrapResourceForRegion(provider.ResourcesMap[typeName])
+	}
+
+	// The timeouts in resource definitions are the defaults of the operations without
+	// timeouts in the default_timeouts block, which are applied when configuring the provider.
+	declaredTimeouts := newDeclaredTimeouts(provider.ResourcesMap)
+
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
 			// Terraform 0.12 introduced this field to the protocol
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(d, terraformVersion)
+
+		client, err := providerConfigure(ctx, d, terraformVersion)
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		timeoutsConfig, err := expandProviderDefaultTimeouts(d.Get("default_timeouts").([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if err := declaredTimeouts.apply(provider.ResourcesMap, timeoutsConfig); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		return client, nil
 	}
 
 	return provider
@@ -1697,6 +1821,12 @@ func init() {
 		"shared_credentials_file": "The path to the shared credentials file. If not set\n" +
 			"this defaults to ~/.aws/credentials.",
 
+		"shared_config_file": "The path to the shared config file. If not set\n" +
+			"this defaults to ~/.aws/config.",
+
+		"credential_process": "A command to run to retrieve credentials, in the format\n" +
+			"of the `credential_process` shared config file setting.",
+
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
@@ -1704,11 +1834,35 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"api_trace_file": "The path of a file to append a JSON line to for each AWS API call. " +
+			"Can also be configured using the `TF_AWS_API_TRACE_FILE` environment variable.",
+
+		"read_cache": "Cache the results of supported Describe and List API calls made\n" +
+			"while reading resources for the duration of the run.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
+		"no_proxy": "Comma-separated list of hosts, domains, IP addresses and CIDR blocks " +
+			"that are accessed directly rather than through a proxy. " +
+			"Can also be configured using the `NO_PROXY` environment variable.",
+
+		"proxy_overrides": "Map of service names, as used in the `endpoints` block, to the address of the HTTP proxy " +
+			"to use when accessing that service's API. An empty address accesses the service directly.",
+
+		"custom_ca_bundle": "The path of a PEM file containing CA certificates to trust in addition to the system's. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"client_certificate": "The path of a PEM file containing the client certificate to present to AWS API endpoints " +
+			"that require mutual TLS authentication.",
+
+		"client_private_key": "The path of a PEM file containing the private key of the client certificate.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
+		"managed_tag_keys_only": "Only add, update and remove the tag keys that each resource sets,\n" +
+			"leaving tags added outside of Terraform in place.",
+
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 			"default value is `false`",
 
@@ -1899,20 +2053,31 @@ func init() {
 	}
 }
 
-func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := conns.Config{
 		AccessKey:               d.Get("access_key").(string),
+		APITraceFile:            d.Get("api_trace_file").(string),
 		SecretKey:               d.Get("secret_key").(string),
 		Profile:                 d.Get("profile").(string),
 		Token:                   d.Get("token").(string),
 		Region:                  d.Get("region").(string),
 		CredsFilename:           d.Get("shared_credentials_file").(string),
+		CredentialProcess:       d.Get("credential_process").(string),
+		SharedConfigFile:        d.Get("shared_config_file").(string),
 		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		HTTPProxy:               d.Get("http_proxy").(string),
+		NoProxy:                 d.Get("no_proxy").(string),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
+		ClientCertificate:       d.Get("client_certificate").(string),
+		ClientPrivateKey:        d.Get("client_private_key").(string),
+		ProxyOverrides:          expandProviderProxyOverrides(d.Get("proxy_overrides").(map[string]interface{})),
+		RateLimits:              expandProviderRateLimits(d.Get("rate_limits").(*schema.Set).List()),
+		ManagedTagKeysOnly:      d.Get("managed_tag_keys_only").(bool),
+		ReadCache:               d.Get("read_cache").(bool),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -1922,68 +2087,37 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		TerraformVersion:        terraformVersion,
 	}
 
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
+	tagPolicyConfig, err := expandProviderTagPolicy(d.Get("tag_policy").([]interface{}))
 
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
-		}
-
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
-		}
-
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
-		}
-
-		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
-			for _, policyARNRaw := range policyARNSet.List() {
-				policyARN, ok := policyARNRaw.(string)
-
-				if !ok {
-					continue
-				}
+	if err != nil {
+		return nil, err
+	}
 
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
-			}
-		}
+	config.TagPolicyConfig = tagPolicyConfig
 
-		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
-		}
+	for _, tfMapRaw := range d.Get("assume_role").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
 
-		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
+		if !ok {
+			continue
 		}
 
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
-
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
+		assumeRole := expandProviderAssumeRole(tfMap)
 
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTags[k] = v
-			}
+		// An empty assume_role block is ignored.
+		if assumeRole.RoleARN == "" {
+			continue
 		}
 
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
 
-				if !ok {
-					continue
-				}
+		config.AssumeRole = append(config.AssumeRole, assumeRole)
+	}
 
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
-			}
-		}
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		config.AssumeRoleWithWebIdentity = expandProviderAssumeRoleWithWebIdentity(l[0].(map[string]interface{}))
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName)
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -2007,14 +2141,20 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	// CRUD function contexts are not canceled when Terraform is interrupted,
+	// only the stop context is.
+	if stopContext, ok := schema.StopContext(ctx); ok {
+		config.StopContext = stopContext
+	}
+
 	return config.Client()
 }
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Roles to assume, in order. Each role is assumed using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -2070,6 +2210,56 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Seconds to restrict the assume role session duration.",
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Description:   "OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path of a file containing an OAuth 2.0 access token or OpenID Connect ID token. The file is read each time the role is assumed.",
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -2091,6 +2281,94 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func tagPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with rules that the tags of all resources must follow.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enforcement": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      tftags.PolicyEnforcementError,
+					Description:  "Whether resources whose tags break the rules fail to plan (`error`) or only log a warning (`warn`).",
+					ValidateFunc: validation.StringInSlice(tftags.PolicyEnforcement_Values(), false),
+				},
+				"key_case": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Case that tag keys must be in.",
+					ValidateFunc: validation.StringInSlice(tftags.PolicyKeyCase_Values(), false),
+				},
+				"required_keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Tag keys that all resources must have.",
+				},
+				"value_pattern": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Regular expressions that the values of tags must match.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Tag key.",
+							},
+							"pattern": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Description:  "Regular expression that the whole tag value must match.",
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func rateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Configuration blocks with client-side request rate limits for individual services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Maximum number of requests that can be sent at once before `requests_per_second` applies.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_in_flight": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Maximum number of concurrent requests to the service.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Description:  "Sustained number of requests per second sent to the service.",
+					ValidateFunc: validation.FloatAtLeast(0),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Service to limit. Uses the same names as the `endpoints` block.",
+					ValidateFunc: validation.StringInSlice(EndpointServiceNames, false),
+				},
+			},
+		},
+	}
+}
+
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -2123,3 +2401,219 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 
 	return ignoreConfig
 }
+
+func expandProviderTagPolicy(l []interface{}) (*tftags.PolicyConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["enforcement"].(string); ok {
+		policyConfig.Enforcement = v
+	}
+
+	if v, ok := tfMap["key_case"].(string); ok {
+		policyConfig.KeyCase = v
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		for _, vRaw := range v.List() {
+			policyConfig.RequiredKeys = append(policyConfig.RequiredKeys, vRaw.(string))
+		}
+	}
+
+	if v, ok := tfMap["value_pattern"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.ValuePatterns = make(map[string]*regexp.Regexp)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			key := tfMap["key"].(string)
+			pattern, err := tftags.CompilePolicyValuePattern(tfMap["pattern"].(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("error compiling tag_policy value_pattern for key (%s): %w", key, err)
+			}
+
+			policyConfig.ValuePatterns[key] = pattern
+		}
+	}
+
+	return policyConfig, nil
+}
+
+func expandProviderRateLimits(l []interface{}) map[string]conns.RateLimit {
+	if len(l) == 0 {
+		return nil
+	}
+
+	rateLimits := make(map[string]conns.RateLimit)
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rateLimit := conns.RateLimit{}
+
+		if v, ok := tfMap["burst"].(int); ok {
+			rateLimit.Burst = v
+		}
+
+		if v, ok := tfMap["max_in_flight"].(int); ok {
+			rateLimit.MaxInFlight = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			rateLimit.RequestsPerSecond = v
+		}
+
+		rateLimits[tfMap["service"].(string)] = rateLimit
+	}
+
+	return rateLimits
+}
+
+func expandProviderAssumeRole(tfMap map[string]interface{}) conns.AssumeRole {
+	assumeRole := conns.AssumeRole{}
+
+	if v, ok := tfMap["duration_seconds"].(int); ok && v != 0 {
+		assumeRole.DurationSeconds = v
+	}
+
+	if v, ok := tfMap["external_id"].(string); ok && v != "" {
+		assumeRole.ExternalID = v
+	}
+
+	if v, ok := tfMap["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if v, ok := tfMap["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+		for _, vRaw := range v.List() {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, v)
+		}
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := tfMap["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		assumeRole.Tags = make(map[string]string)
+
+		for k, vRaw := range v {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.Tags[k] = v
+		}
+	}
+
+	if v, ok := tfMap["transitive_tag_keys"].(*schema.Set); ok && v.Len() > 0 {
+		for _, vRaw := range v.List() {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.TransitiveTagKeys = append(assumeRole.TransitiveTagKeys, v)
+		}
+	}
+
+	return assumeRole
+}
+
+func expandProviderAssumeRoleWithWebIdentity(tfMap map[string]interface{}) *conns.AssumeRoleWithWebIdentity {
+	assumeRole := &conns.AssumeRoleWithWebIdentity{}
+
+	if v, ok := tfMap["duration_seconds"].(int); ok && v != 0 {
+		assumeRole.DurationSeconds = v
+	}
+
+	if v, ok := tfMap["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+		for _, vRaw := range v.List() {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, v)
+		}
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := tfMap["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if v, ok := tfMap["web_identity_token"].(string); ok && v != "" {
+		assumeRole.WebIdentityToken = v
+	}
+
+	if v, ok := tfMap["web_identity_token_file"].(string); ok && v != "" {
+		assumeRole.WebIdentityTokenFile = v
+	}
+
+	return assumeRole
+}
+
+func expandProviderProxyOverrides(tfMap map[string]interface{}) map[string]string {
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	proxyOverrides := make(map[string]string, len(tfMap))
+
+	for k, v := range tfMap {
+		proxyOverrides[k] = v.(string)
+	}
+
+	return proxyOverrides
+}
+
+// validProxyOverrides validates that proxies are only overridden for known services.
+func validProxyOverrides(v interface{}, k string) (ws []string, errors []error) {
+	for serviceName := range v.(map[string]interface{}) {
+		found := false
+
+		for _, endpointServiceName := range EndpointServiceNames {
+			if serviceName == endpointServiceName {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			errors = append(errors, fmt.Errorf("%q contains an unknown service name: %q", k, serviceName))
+		}
+	}
+
+	return
+}
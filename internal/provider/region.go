@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// regionalImportIDSeparator separates a resource's import ID from its region,
+// e.g. "vpc-12345678@eu-west-1".
+const regionalImportIDSeparator = "@"
+
+// regionalResourceTypes are the resources that support the region argument.
+// Only resources whose API calls are all made to a single regional service endpoint can be listed.
+var regionalResourceTypes = []string{
+	"aws_cloudwatch_log_group",
+	"aws_ebs_volume",
+	"aws_eip",
+	"aws_instance",
+	"aws_internet_gateway",
+	"aws_key_pair",
+	"aws_kms_alias",
+	"aws_kms_key",
+	"aws_launch_template",
+	"aws_nat_gateway",
+	"aws_route",
+	"aws_route_table",
+	"aws_route_table_association",
+	"aws_s3_bucket",
+	"aws_s3_bucket_policy",
+	"aws_s3_bucket_public_access_block",
+	"aws_security_group",
+	"aws_security_group_rule",
+	"aws_sns_topic",
+	"aws_sqs_queue",
+	"aws_sqs_queue_policy",
+	"aws_subnet",
+	"aws_vpc",
+}
+
+// regionalMeta returns the provider meta to pass to a resource's CRUD
+// function so that its AWS API calls are made in the resource's region.
+func regionalMeta(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok {
+		return meta, nil
+	}
+
+	region := d.Get("region").(string)
+	client, err := client.ForRegion(region)
+
+	if err != nil {
+		return nil, fmt.Errorf("error configuring region (%s): %w", region, err)
+	}
+
+	return client, nil
+}
+
+// setRegion records the region of a resource whose region is not configured.
+func setRegion(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || d.Id() == "" || d.Get("region").(string) != "" {
+		return nil
+	}
+
+	return d.Set("region", client.Region)
+}
+
+// parseRegionalImportID splits an import ID of the form "<id>@<region>".
+// If the ID has no region suffix the region is empty. A suffix that is not a region name,
+// e.g. the host of a key pair named "deployer@laptop", is part of the ID.
+func parseRegionalImportID(id string) (string, string, error) {
+	i := strings.LastIndex(id, regionalImportIDSeparator)
+
+	if i < 0 {
+		return id, "", nil
+	}
+
+	region := id[i+1:]
+
+	if _, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); !ok {
+		return id, "", nil
+	}
+
+	if i == 0 {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ID or ID%[2]sREGION", id, regionalImportIDSeparator)
+	}
+
+	return id[:i], region, nil
+}
+
+// regionalImportMeta strips any region suffix from the ID of the resource being imported,
+// recording the region, and returns the provider meta for the resource's region.
+func regionalImportMeta(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	id, region, err := parseRegionalImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if region != "" {
+		d.SetId(id)
+
+		if err := d.Set("region", region); err != nil {
+			return nil, fmt.Errorf("error setting region: %w", err)
+		}
+	}
+
+	return regionalMeta(d, meta)
+}
+
+func setImportedRegions(results []*schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	for _, d := range results {
+		if err := setRegion(d, meta); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// wrapResourceForRegion adds the region argument to the specified resource
+// and wraps its CRUD and import functions to make AWS API calls in that region.
+func wrapResourceForRegion(r *schema.Resource) {
+	if v, ok := r.Schema["region"]; ok {
+		// The resource already reports the region it is in.
+		v.Optional = true
+		v.Computed = true
+		v.ForceNew = true
+	} else {
+		r.Schema["region"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+
+	if f := r.Create; f != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalMeta(d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			if err := f(d, meta); err != nil {
+				return err
+			}
+
+			return setRegion(d, meta)
+		}
+	}
+
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalMeta(d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			if err := f(d, meta); err != nil {
+				return err
+			}
+
+			return setRegion(d, meta)
+		}
+	}
+
+	if f := r.Update; f != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalMeta(d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			return f(d, meta)
+		}
+	}
+
+	if f := r.Delete; f != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			meta, err := regionalMeta(d, meta)
+
+			if err != nil {
+				return err
+			}
+
+			return f(d, meta)
+		}
+	}
+
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = regionalContextFunc(f, true)
+	}
+
+	if f := r.CreateWithoutTimeout; f != nil {
+		r.CreateWithoutTimeout = regionalContextFunc(f, true)
+	}
+
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = regionalContextFunc(f, true)
+	}
+
+	if f := r.ReadWithoutTimeout; f != nil {
+		r.ReadWithoutTimeout = regionalContextFunc(f, true)
+	}
+
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = regionalContextFunc(f, false)
+	}
+
+	if f := r.UpdateWithoutTimeout; f != nil {
+		r.UpdateWithoutTimeout = regionalContextFunc(f, false)
+	}
+
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = regionalContextFunc(f, false)
+	}
+
+	if f := r.DeleteWithoutTimeout; f != nil {
+		r.DeleteWithoutTimeout = regionalContextFunc(f, false)
+	}
+
+	if r.Importer == nil {
+		return
+	}
+
+	if f := r.Importer.State; f != nil {
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			meta, err := regionalImportMeta(d, meta)
+
+			if err != nil {
+				return nil, err
+			}
+
+			results, err := f(d, meta)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return setImportedRegions(results, meta)
+		}
+	}
+
+	if f := r.Importer.StateContext; f != nil {
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			meta, err := regionalImportMeta(d, meta)
+
+			if err != nil {
+				return nil, err
+			}
+
+			results, err := f(ctx, d, meta)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return setImportedRegions(results, meta)
+		}
+	}
+}
+
+// regionalContextFunc wraps the specified context-aware CRUD function so that its AWS API calls
+// are made in the resource's region, and, if setsRegion is true, so that it sets the region.
+func regionalContextFunc(f crudContextFunc, setsRegion bool) crudContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		meta, err := regionalMeta(d, meta)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags := f(ctx, d, meta)
+
+		if !setsRegion || diags.HasError() {
+			return diags
+		}
+
+		if err := setRegion(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
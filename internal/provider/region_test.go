@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseRegionalImportID(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		InputID        string
+		ExpectedID     string
+		ExpectedRegion string
+		ExpectError    bool
+	}{
+		{
+			TestName:   "no region",
+			InputID:    "vpc-12345678",
+			ExpectedID: "vpc-12345678",
+		},
+		{
+			TestName:       "region",
+			InputID:        "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			TestName:       "composite ID",
+			InputID:        "rtb-12345678_10.0.0.0/16@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "rtb-12345678_10.0.0.0/16",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			TestName:   "empty region",
+			InputID:    "vpc-12345678@",
+			ExpectedID: "vpc-12345678@",
+		},
+		{
+			TestName:   "at sign in ID",
+			InputID:    "deployer@laptop",
+			ExpectedID: "deployer@laptop",
+		},
+		{
+			TestName:       "at sign in ID and region",
+			InputID:        "deployer@laptop@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "deployer@laptop",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			TestName:    "empty ID",
+			InputID:     "@eu-west-1", //lintignore:AWSAT003
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			id, region, err := parseRegionalImportID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if id != testCase.ExpectedID {
+				t.Errorf("got ID %s, expected %s", id, testCase.ExpectedID)
+			}
+
+			if region != testCase.ExpectedRegion {
+				t.Errorf("got region %s, expected %s", region, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestRegionalResourceTypes(t *testing.T) {
+	p := Provider()
+
+	for _, typeName := range regionalResourceTypes {
+		r, ok := p.ResourcesMap[typeName]
+
+		if !ok {
+			t.Errorf("regional resource type %s not found", typeName)
+			continue
+		}
+
+		v, ok := r.Schema["region"]
+
+		if !ok {
+			t.Errorf("%s has no region argument", typeName)
+			continue
+		}
+
+		if !v.Optional || !v.Computed || !v.ForceNew {
+			t.Errorf("%s region argument must be Optional, Computed and ForceNew", typeName)
+		}
+	}
+}
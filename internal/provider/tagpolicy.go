@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// tagPolicyWarnings returns a warning naming the resource type and tag key for each violation
+// of a tag policy whose violations are only warnings.
+// Violations of other tag policies are errors returned by verify.SetTagsDiff, but errors are
+// all that a CustomizeDiff function can return, so warnings are returned when validating tags.
+func tagPolicyWarnings(typeName string, v interface{}, meta interface{}) []string {
+	client, ok := meta.(*conns.AWSClient)
+
+	// Configuration is validated both before and after the provider is configured.
+	if !ok || !client.TagPolicyConfig.IsWarning() {
+		return nil
+	}
+
+	tags, ok := v.(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	allTags := client.DefaultTagsConfig.MergeTags(tftags.New(tags)).IgnoreConfig(client.IgnoreTagsConfig)
+
+	var ws []string
+
+	for _, violation := range allTags.PolicyViolations(client.TagPolicyConfig) {
+		ws = append(ws, fmt.Sprintf("%s: tag_policy: %s", typeName, violation))
+	}
+
+	return ws
+}
+
+// wrapResourceForTagPolicy adds validation of the tags argument of the specified resource
+// that warns of violations of a tag policy whose violations are only warnings.
+func wrapResourceForTagPolicy(provider *schema.Provider, typeName string, r *schema.Resource) {
+	v := *r.Schema["tags"]
+
+	if !v.Optional || v.ValidateDiagFunc != nil {
+		return
+	}
+
+	// The tags schema may be shared with other resources.
+	r.Schema["tags"] = &v
+
+	f := v.ValidateFunc
+	v.ValidateFunc = func(i interface{}, k string) ([]string, []error) {
+		var ws []string
+		var es []error
+
+		if f != nil {
+			ws, es = f(i, k)
+		}
+
+		return append(ws, tagPolicyWarnings(typeName, i, provider.Meta())...), es
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestTagPolicyWarnings(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Enforcement      string
+		Configured       bool
+		ExpectedWarnings []string
+	}{
+		{
+			Name:        "not configured",
+			Enforcement: tftags.PolicyEnforcementWarn,
+		},
+		{
+			Name:        "error",
+			Enforcement: tftags.PolicyEnforcementError,
+			Configured:  true,
+		},
+		{
+			Name:        "warn",
+			Enforcement: tftags.PolicyEnforcementWarn,
+			Configured:  true,
+			ExpectedWarnings: []string{
+				`aws_example_thing: tag_policy: tag "CostCenter" is required`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			provider := &schema.Provider{}
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags":     tftags.TagsSchema(),
+					"tags_all": tftags.TagsSchemaComputed(),
+				},
+			}
+
+			wrapResourceForTagPolicy(provider, "aws_example_thing", r)
+
+			if testCase.Configured {
+				provider.SetMeta(&conns.AWSClient{
+					DefaultTagsConfig: &tftags.DefaultConfig{},
+					IgnoreTagsConfig:  &tftags.IgnoreConfig{},
+					TagPolicyConfig: &tftags.PolicyConfig{
+						Enforcement:  testCase.Enforcement,
+						RequiredKeys: []string{"CostCenter", "Owner"},
+					},
+				})
+			}
+
+			diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+				"tags": map[string]interface{}{
+					"Owner": "team",
+				},
+			}))
+
+			var warnings []string
+
+			for _, d := range diags {
+				if d.Severity != diag.Warning {
+					t.Fatalf("unexpected diagnostic: %s", d.Summary)
+				}
+
+				warnings = append(warnings, d.Summary)
+			}
+
+			if got, expected := len(warnings), len(testCase.ExpectedWarnings); got != expected {
+				t.Fatalf("got %d warnings (%v), expected %d", got, warnings, expected)
+			}
+
+			for i, expected := range testCase.ExpectedWarnings {
+				if got := warnings[i]; got != expected {
+					t.Errorf("got warning %q, expected %q", got, expected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// timeoutOperations are the operations whose timeouts can be configured in the default_timeouts block.
+var timeoutOperations = []string{
+	schema.TimeoutCreate,
+	schema.TimeoutRead,
+	schema.TimeoutUpdate,
+	schema.TimeoutDelete,
+}
+
+func defaultTimeoutsSchema() *schema.Schema {
+	timeoutSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  description,
+			ValidateFunc: validTimeout,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with the default timeouts of all resources, replacing the defaults of their definitions.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"create": timeoutSchema("Default timeout for creating resources, e.g. `60m`."),
+				"delete": timeoutSchema("Default timeout for deleting resources, e.g. `60m`."),
+				"override": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Configuration blocks with the default timeouts of individual resource types.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"create": timeoutSchema("Default timeout for creating resources of the type."),
+							"delete": timeoutSchema("Default timeout for deleting resources of the type."),
+							"read":   timeoutSchema("Default timeout for reading resources of the type."),
+							"resource_type": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Resource type, e.g. `aws_db_instance`.",
+							},
+							"update": timeoutSchema("Default timeout for updating resources of the type."),
+						},
+					},
+				},
+				"read":   timeoutSchema("Default timeout for reading resources, e.g. `10m`."),
+				"update": timeoutSchema("Default timeout for updating resources, e.g. `60m`."),
+			},
+		},
+	}
+}
+
+func validTimeout(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	duration, err := time.ParseDuration(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration, e.g. 60m or 1h30m: %w", k, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, got %s", k, value))
+	}
+
+	return
+}
+
+// timeoutsConfig is the configuration of the default_timeouts block.
+type timeoutsConfig struct {
+	// Defaults are the timeouts of all resources.
+	Defaults map[string]time.Duration
+	// Overrides are the timeouts of individual resource types, replacing Defaults.
+	Overrides map[string]map[string]time.Duration
+}
+
+func expandProviderDefaultTimeouts(l []interface{}) (*timeoutsConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+
+	defaults, err := expandProviderTimeouts(tfMap)
+
+	if err != nil {
+		return nil, err
+	}
+
+	config := &timeoutsConfig{
+		Defaults:  defaults,
+		Overrides: make(map[string]map[string]time.Duration),
+	}
+
+	if v, ok := tfMap["override"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			resourceType := tfMap["resource_type"].(string)
+
+			if _, ok := config.Overrides[resourceType]; ok {
+				return nil, fmt.Errorf("duplicate default_timeouts override for resource type (%s)", resourceType)
+			}
+
+			timeouts, err := expandProviderTimeouts(tfMap)
+
+			if err != nil {
+				return nil, fmt.Errorf("error expanding default_timeouts override for resource type (%s): %w", resourceType, err)
+			}
+
+			config.Overrides[resourceType] = timeouts
+		}
+	}
+
+	return config, nil
+}
+
+func expandProviderTimeouts(tfMap map[string]interface{}) (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration)
+
+	for _, operation := range timeoutOperations {
+		v, ok := tfMap[operation].(string)
+
+		if !ok || v == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s timeout: %w", operation, err)
+		}
+
+		timeouts[operation] = duration
+	}
+
+	return timeouts, nil
+}
+
+// declaredTimeouts are the timeouts in the definitions of resource types, by resource type.
+type declaredTimeouts map[string]schema.ResourceTimeout
+
+// newDeclaredTimeouts returns the timeouts in the definitions of the resources,
+// which remain the defaults of the operations without configured timeouts.
+func newDeclaredTimeouts(resources map[string]*schema.Resource) declaredTimeouts {
+	timeouts := make(declaredTimeouts)
+
+	for typeName, r := range resources {
+		if r.Timeouts == nil {
+			continue
+		}
+
+		timeouts[typeName] = copyResourceTimeout(*r.Timeouts)
+	}
+
+	return timeouts
+}
+
+// apply sets the default timeouts of the resources from the configuration, the timeouts of a resource type's
+// override replacing the provider's defaults, which replace the timeouts in the resource's definition.
+// Only the operations with a timeout in the resource's definition can be configured in its timeouts block,
+// so the timeouts of other operations are not set.
+// Timeouts configured in a resource's timeouts block take precedence over its default timeouts.
+func (declared declaredTimeouts) apply(resources map[string]*schema.Resource, config *timeoutsConfig) error {
+	if config == nil {
+		config = &timeoutsConfig{}
+	}
+
+	for resourceType, overrides := range config.Overrides {
+		if _, ok := resources[resourceType]; !ok {
+			return fmt.Errorf("default_timeouts override: unknown resource type (%s)", resourceType)
+		}
+
+		timeouts, ok := declared[resourceType]
+
+		if !ok {
+			return fmt.Errorf("default_timeouts override: resource type (%s) does not support timeouts", resourceType)
+		}
+
+		for _, operation := range timeoutOperations {
+			if _, ok := overrides[operation]; ok && resourceTimeoutOperation(&timeouts, operation) == nil {
+				return fmt.Errorf("default_timeouts override: resource type (%s) does not support %s timeout", resourceType, operation)
+			}
+		}
+	}
+
+	for resourceType, timeouts := range declared {
+		r, ok := resources[resourceType]
+
+		if !ok {
+			continue
+		}
+
+		resourceTimeouts := copyResourceTimeout(timeouts)
+
+		for _, operation := range timeoutOperations {
+			timeout := resourceTimeoutOperation(&resourceTimeouts, operation)
+
+			if timeout == nil {
+				continue
+			}
+
+			if v, ok := config.Defaults[operation]; ok {
+				*timeout = v
+			}
+
+			if v, ok := config.Overrides[resourceType][operation]; ok {
+				*timeout = v
+			}
+		}
+
+		r.Timeouts = &resourceTimeouts
+	}
+
+	return nil
+}
+
+// resourceTimeoutOperation returns the timeout of the operation, or nil if it is not set.
+func resourceTimeoutOperation(timeouts *schema.ResourceTimeout, operation string) *time.Duration {
+	switch operation {
+	case schema.TimeoutCreate:
+		return timeouts.Create
+	case schema.TimeoutRead:
+		return timeouts.Read
+	case schema.TimeoutUpdate:
+		return timeouts.Update
+	case schema.TimeoutDelete:
+		return timeouts.Delete
+	default:
+		return timeouts.Default
+	}
+}
+
+func copyResourceTimeout(timeouts schema.ResourceTimeout) schema.ResourceTimeout {
+	copyDuration := func(v *time.Duration) *time.Duration {
+		if v == nil {
+			return nil
+		}
+
+		duration := *v
+
+		return &duration
+	}
+
+	return schema.ResourceTimeout{
+		Create:  copyDuration(timeouts.Create),
+		Read:    copyDuration(timeouts.Read),
+		Update:  copyDuration(timeouts.Update),
+		Delete:  copyDuration(timeouts.Delete),
+		Default: copyDuration(timeouts.Default),
+	}
+}
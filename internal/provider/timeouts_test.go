@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeclaredTimeoutsApply(t *testing.T) {
+	newResources := func() map[string]*schema.Resource {
+		return map[string]*schema.Resource{
+			"aws_db_instance": {
+				Timeouts: &schema.ResourceTimeout{
+					Create: schema.DefaultTimeout(40 * time.Minute),
+					Update: schema.DefaultTimeout(80 * time.Minute),
+					Delete: schema.DefaultTimeout(60 * time.Minute),
+				},
+			},
+			"aws_route": {
+				Timeouts: &schema.ResourceTimeout{
+					Create: schema.DefaultTimeout(2 * time.Minute),
+					Delete: schema.DefaultTimeout(5 * time.Minute),
+				},
+			},
+			"aws_vpc_dhcp_options": {},
+		}
+	}
+
+	testCases := []struct {
+		Name          string
+		Config        *timeoutsConfig
+		Expected      map[string]schema.ResourceTimeout
+		ExpectedError string
+	}{
+		{
+			Name: "no configuration",
+			Expected: map[string]schema.ResourceTimeout{
+				"aws_db_instance": {
+					Create: schema.DefaultTimeout(40 * time.Minute),
+					Update: schema.DefaultTimeout(80 * time.Minute),
+					Delete: schema.DefaultTimeout(60 * time.Minute),
+				},
+				"aws_route": {
+					Create: schema.DefaultTimeout(2 * time.Minute),
+					Delete: schema.DefaultTimeout(5 * time.Minute),
+				},
+			},
+		},
+		{
+			Name: "defaults",
+			Config: &timeoutsConfig{
+				Defaults: map[string]time.Duration{
+					schema.TimeoutCreate: 90 * time.Minute,
+					schema.TimeoutRead:   10 * time.Minute,
+					schema.TimeoutUpdate: 120 * time.Minute,
+				},
+			},
+			Expected: map[string]schema.ResourceTimeout{
+				"aws_db_instance": {
+					Create: schema.DefaultTimeout(90 * time.Minute),
+					Update: schema.DefaultTimeout(120 * time.Minute),
+					Delete: schema.DefaultTimeout(60 * time.Minute),
+				},
+				"aws_route": {
+					Create: schema.DefaultTimeout(90 * time.Minute),
+					Delete: schema.DefaultTimeout(5 * time.Minute),
+				},
+			},
+		},
+		{
+			Name: "overrides",
+			Config: &timeoutsConfig{
+				Defaults: map[string]time.Duration{
+					schema.TimeoutCreate: 90 * time.Minute,
+					schema.TimeoutDelete: 30 * time.Minute,
+				},
+				Overrides: map[string]map[string]time.Duration{
+					"aws_db_instance": {
+						schema.TimeoutCreate: 3 * time.Hour,
+						schema.TimeoutUpdate: 4 * time.Hour,
+					},
+				},
+			},
+			Expected: map[string]schema.ResourceTimeout{
+				"aws_db_instance": {
+					Create: schema.DefaultTimeout(3 * time.Hour),
+					Update: schema.DefaultTimeout(4 * time.Hour),
+					Delete: schema.DefaultTimeout(30 * time.Minute),
+				},
+				"aws_route": {
+					Create: schema.DefaultTimeout(90 * time.Minute),
+					Delete: schema.DefaultTimeout(30 * time.Minute),
+				},
+			},
+		},
+		{
+			Name: "unknown resource type",
+			Config: &timeoutsConfig{
+				Overrides: map[string]map[string]time.Duration{
+					"aws_db_instances": {schema.TimeoutCreate: time.Hour},
+				},
+			},
+			ExpectedError: "default_timeouts override: unknown resource type (aws_db_instances)",
+		},
+		{
+			Name: "resource type without timeouts",
+			Config: &timeoutsConfig{
+				Overrides: map[string]map[string]time.Duration{
+					"aws_vpc_dhcp_options": {schema.TimeoutCreate: time.Hour},
+				},
+			},
+			ExpectedError: "default_timeouts override: resource type (aws_vpc_dhcp_options) does not support timeouts",
+		},
+		{
+			Name: "unsupported operation",
+			Config: &timeoutsConfig{
+				Overrides: map[string]map[string]time.Duration{
+					"aws_route": {schema.TimeoutUpdate: time.Hour},
+				},
+			},
+			ExpectedError: "default_timeouts override: resource type (aws_route) does not support update timeout",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			resources := newResources()
+			declared := newDeclaredTimeouts(resources)
+
+			// Configuring the provider again must start from the declared timeouts.
+			if err := declared.apply(resources, &timeoutsConfig{
+				Defaults: map[string]time.Duration{
+					schema.TimeoutCreate: time.Minute,
+					schema.TimeoutUpdate: time.Minute,
+					schema.TimeoutDelete: time.Minute,
+				},
+			}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err := declared.apply(resources, testCase.Config)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q", testCase.ExpectedError)
+				}
+
+				if err.Error() != testCase.ExpectedError {
+					t.Fatalf("got error %q, expected %q", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if resources["aws_vpc_dhcp_options"].Timeouts != nil {
+				t.Errorf("aws_vpc_dhcp_options: got timeouts, expected none")
+			}
+
+			for resourceType, expected := range testCase.Expected {
+				got := resources[resourceType].Timeouts
+
+				for _, operation := range append(timeoutOperations, schema.TimeoutDefault) {
+					gotTimeout := resourceTimeoutOperation(got, operation)
+					expectedTimeout := resourceTimeoutOperation(&expected, operation)
+
+					switch {
+					case gotTimeout == nil && expectedTimeout == nil:
+					case gotTimeout == nil || expectedTimeout == nil:
+						t.Errorf("%s: got %s timeout %v, expected %v", resourceType, operation, gotTimeout, expectedTimeout)
+					case *gotTimeout != *expectedTimeout:
+						t.Errorf("%s: got %s timeout %s, expected %s", resourceType, operation, *gotTimeout, *expectedTimeout)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestExpandProviderDefaultTimeouts(t *testing.T) {
+	config, err := expandProviderDefaultTimeouts([]interface{}{
+		map[string]interface{}{
+			"create": "1h",
+			"read":   "",
+			"update": "1h30m",
+			"delete": "",
+			"override": schema.NewSet(schema.HashResource(defaultTimeoutsSchema().Elem.(*schema.Resource).Schema["override"].Elem.(*schema.Resource)), []interface{}{
+				map[string]interface{}{
+					"resource_type": "aws_db_instance",
+					"create":        "3h",
+					"read":          "",
+					"update":        "",
+					"delete":        "2h",
+				},
+			}),
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(config.Defaults), 2; got != expected {
+		t.Errorf("got %d defaults, expected %d", got, expected)
+	}
+
+	if got, expected := config.Defaults[schema.TimeoutUpdate], 90*time.Minute; got != expected {
+		t.Errorf("got update timeout %s, expected %s", got, expected)
+	}
+
+	overrides := config.Overrides["aws_db_instance"]
+
+	if got, expected := len(overrides), 2; got != expected {
+		t.Errorf("got %d overrides, expected %d", got, expected)
+	}
+
+	if got, expected := overrides[schema.TimeoutDelete], 2*time.Hour; got != expected {
+		t.Errorf("got override delete timeout %s, expected %s", got, expected)
+	}
+}
+
+func TestValidTimeout(t *testing.T) {
+	for value, valid := range map[string]bool{
+		"60m":    true,
+		"1h30m":  true,
+		"45s":    true,
+		"0s":     false,
+		"-10m":   false,
+		"60":     false,
+		"1 hour": false,
+	} {
+		_, errors := validTimeout(value, "create")
+
+		if got := len(errors) == 0; got != valid {
+			t.Errorf("validTimeout(%q): got valid %t, expected %t", value, got, valid)
+		}
+	}
+}
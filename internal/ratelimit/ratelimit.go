@@ -0,0 +1,173 @@
+// Package ratelimit provides client-side request rate limiting and
+// concurrency caps for AWS API calls.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Semaphore can be used to limit concurrent executions.
+// A Semaphore with zero capacity imposes no limit.
+type Semaphore chan struct{}
+
+// NewSemaphore returns a Semaphore with the specified capacity.
+func NewSemaphore(limit int) Semaphore {
+	if limit <= 0 {
+		return nil
+	}
+
+	return make(Semaphore, limit)
+}
+
+// Acquire waits for a free slot in the semaphore or for the context to be done.
+func (s Semaphore) Acquire(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot previously acquired from the semaphore.
+func (s Semaphore) Release() {
+	if s == nil {
+		return
+	}
+
+	select {
+	case <-s:
+	default:
+	}
+}
+
+// TokenBucket is a token bucket rate limiter.
+// Tokens are added at a fixed rate up to a maximum burst size and each
+// request consumes one token.
+type TokenBucket struct {
+	burst float64
+	rate  float64 // tokens per second
+
+	mu     sync.Mutex
+	last   time.Time
+	tokens float64
+
+	now func() time.Time
+}
+
+// NewTokenBucket returns a TokenBucket that allows requestsPerSecond
+// requests per second with bursts of at most burst requests.
+// A burst value less than 1 is treated as 1.
+func NewTokenBucket(requestsPerSecond float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucket{
+		burst:  float64(burst),
+		rate:   requestsPerSecond,
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller
+// must wait before the token may be used.
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token previously taken by reserve to the bucket.
+func (b *TokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// Wait blocks until a token is available or the context is done.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if b == nil || b.rate <= 0 {
+		return nil
+	}
+
+	delay := b.reserve()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
+
+// Limiter combines a request rate limit with a cap on in-flight requests.
+type Limiter struct {
+	bucket   *TokenBucket
+	inFlight Semaphore
+}
+
+// NewLimiter returns a Limiter.
+// A requestsPerSecond or maxInFlight value of zero disables that limit.
+func NewLimiter(requestsPerSecond float64, burst, maxInFlight int) *Limiter {
+	l := &Limiter{
+		inFlight: NewSemaphore(maxInFlight),
+	}
+
+	if requestsPerSecond > 0 {
+		l.bucket = NewTokenBucket(requestsPerSecond, burst)
+	}
+
+	return l
+}
+
+// Acquire waits until a request may be sent.
+// Every successful Acquire must be paired with a call to Release.
+func (l *Limiter) Acquire(ctx context.Context) error {
+	if err := l.inFlight.Acquire(ctx); err != nil {
+		return err
+	}
+
+	if err := l.bucket.Wait(ctx); err != nil {
+		l.inFlight.Release()
+
+		return err
+	}
+
+	return nil
+}
+
+// Release marks a request acquired by Acquire as complete.
+func (l *Limiter) Release() {
+	l.inFlight.Release()
+}
@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	now := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	b := NewTokenBucket(2, 3)
+	b.now = func() time.Time { return now }
+
+	cases := []struct {
+		advance       time.Duration
+		expectedDelay time.Duration
+	}{
+		// Burst is available immediately.
+		{0, 0},
+		{0, 0},
+		{0, 0},
+		// Bucket is empty, each token takes 1/rate seconds to refill.
+		{0, 500 * time.Millisecond},
+		{0, 1 * time.Second},
+		// Refill is capped at the burst size.
+		{10 * time.Second, 0},
+		{0, 0},
+		{0, 0},
+		{0, 500 * time.Millisecond},
+	}
+
+	for i, tc := range cases {
+		now = now.Add(tc.advance)
+
+		if got := b.reserve(); got != tc.expectedDelay {
+			t.Errorf("reservation %d: got delay %s, expected %s", i, got, tc.expectedDelay)
+		}
+	}
+}
+
+func TestTokenBucketWaitCancelled(t *testing.T) {
+	b := NewTokenBucket(0.001, 1)
+
+	if err := b.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := b.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, expected %s", err, context.DeadlineExceeded)
+	}
+}
+
+func TestSemaphore(t *testing.T) {
+	s := NewSemaphore(2)
+
+	for i := 0; i < 2; i++ {
+		if err := s.Acquire(context.Background()); err != nil {
+			t.Fatalf("acquire %d: unexpected error: %s", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := s.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, expected %s", err, context.DeadlineExceeded)
+	}
+
+	s.Release()
+
+	if err := s.Acquire(context.Background()); err != nil {
+		t.Fatalf("acquire after release: unexpected error: %s", err)
+	}
+}
+
+func TestSemaphoreUnlimited(t *testing.T) {
+	s := NewSemaphore(0)
+
+	for i := 0; i < 100; i++ {
+		if err := s.Acquire(context.Background()); err != nil {
+			t.Fatalf("acquire %d: unexpected error: %s", i, err)
+		}
+	}
+
+	s.Release()
+}
@@ -0,0 +1,314 @@
+// Package readcache implements an optional, per-process read-through cache
+// for AWS Describe/List/Get API calls made by finders.
+//
+// The cache is intended to cut the number of API calls made while refreshing
+// many resources that read the same parent object, e.g. one
+// DescribeSecurityGroups call per aws_security_group_rule.
+// Cached entries are invalidated by mutating calls on the same parent object,
+// and no results are cached for a parent that was recently mutated so that
+// eventual consistency retries in Create and Update are not affected.
+package readcache
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// EntryTTL is the maximum age of a cached entry.
+	EntryTTL = 5 * time.Minute
+
+	// MutationSettleTime is the period after a mutating call on a parent
+	// during which reads of that parent are not cached.
+	MutationSettleTime = 2 * time.Minute
+)
+
+// readOnlyOperationPrefixes are the operation name prefixes of calls that do not mutate.
+var readOnlyOperationPrefixes = []string{
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+}
+
+// Parent identifies the object described by a cached read as an API input
+// field name and value, e.g. {Field: "GroupId", Value: "sg-12345678"}.
+type Parent struct {
+	Field string
+	Value string
+}
+
+// IsZero returns whether the parent is unset.
+func (p Parent) IsZero() bool {
+	return p.Field == "" || p.Value == ""
+}
+
+type entry struct {
+	done    chan struct{}
+	expires time.Time
+	output  interface{}
+	err     error
+}
+
+// scope holds the cache for a single set of credentials.
+type scope struct {
+	lock sync.Mutex
+
+	entries map[string]*entry
+	// parents maps a service's parent to the keys of its cached entries.
+	parents map[string]map[string]struct{}
+	// parentFields are the parent field names used by each service.
+	parentFields map[string]map[string]struct{}
+	// mutations records the time of the latest mutating call on each
+	// service's parent, or on the whole service for the empty parent.
+	mutations map[string]time.Time
+}
+
+var (
+	scopes     = make(map[*credentials.Credentials]*scope)
+	scopesLock sync.Mutex
+
+	now = time.Now
+)
+
+// Enable turns on caching for service clients using the specified credentials.
+func Enable(creds *credentials.Credentials) {
+	scopesLock.Lock()
+	defer scopesLock.Unlock()
+
+	if _, ok := scopes[creds]; !ok {
+		scopes[creds] = &scope{
+			entries:      make(map[string]*entry),
+			parents:      make(map[string]map[string]struct{}),
+			parentFields: make(map[string]map[string]struct{}),
+			mutations:    make(map[string]time.Time),
+		}
+	}
+}
+
+func scopeFor(creds *credentials.Credentials) *scope {
+	scopesLock.Lock()
+	defer scopesLock.Unlock()
+
+	return scopes[creds]
+}
+
+// AddInvalidationHandlers adds a handler that invalidates cached entries
+// when a mutating call is made.
+// The handler runs both before the call is sent and once it has completed
+// so that reads racing with the mutation are never cached.
+func AddInvalidationHandlers(handlers *request.Handlers) {
+	h := request.NamedHandler{
+		Name: "terraform-provider-aws.ReadCacheInvalidate",
+		Fn:   invalidate,
+	}
+
+	handlers.Send.PushFrontNamed(h)
+	handlers.Complete.PushBackNamed(h)
+}
+
+func invalidate(r *request.Request) {
+	if IsReadOnlyOperation(r.Operation.Name) {
+		return
+	}
+
+	s := scopeFor(r.Config.Credentials)
+
+	if s == nil {
+		return
+	}
+
+	s.invalidate(serviceKey(r.Config, r.ClientInfo), r.Params)
+}
+
+// Do returns a copy of the cached output of the operation for the input, or calls fn and caches its output.
+// Concurrent calls for the same operation and input share a single call to fn.
+// Errors are never cached.
+// The output is only cached if caching is enabled for the client's credentials and parent is set.
+func Do(c *client.Client, operation string, input interface{}, parent Parent, fn func() (interface{}, error)) (interface{}, error) {
+	s := scopeFor(c.Config.Credentials)
+
+	if s == nil || parent.IsZero() {
+		return fn()
+	}
+
+	key, err := entryKey(c, operation, input)
+
+	if err != nil {
+		return fn()
+	}
+
+	return s.do(serviceKey(c.Config, c.ClientInfo), key, parent, fn)
+}
+
+func (s *scope) do(service, key string, parent Parent, fn func() (interface{}, error)) (interface{}, error) {
+	parentKey := parentKey(service, parent)
+	start := now()
+
+	s.lock.Lock()
+	if e, ok := s.entries[key]; ok && (e.expires.IsZero() || start.Before(e.expires)) {
+		s.lock.Unlock()
+		<-e.done
+
+		if e.err != nil {
+			return nil, e.err
+		}
+
+		return awsutil.CopyOf(e.output), nil
+	}
+
+	if s.mutatedSince(service, parentKey, start.Add(-MutationSettleTime)) {
+		s.lock.Unlock()
+
+		return fn()
+	}
+
+	e := &entry{done: make(chan struct{})}
+	s.entries[key] = e
+	s.addParent(service, parent, parentKey, key)
+	s.lock.Unlock()
+
+	output, err := fn()
+
+	s.lock.Lock()
+	e.err = err
+	if err != nil || s.mutatedSince(service, parentKey, start) {
+		e.output = output
+
+		if s.entries[key] == e {
+			delete(s.entries, key)
+		}
+	} else {
+		e.expires = now().Add(EntryTTL)
+		e.output = awsutil.CopyOf(output)
+	}
+	close(e.done)
+	s.lock.Unlock()
+
+	return output, err
+}
+
+// mutatedSince returns whether the parent or its whole service was mutated after the specified time.
+// The caller must hold the scope lock.
+func (s *scope) mutatedSince(service, parentKey string, t time.Time) bool {
+	return s.mutations[service].After(t) || s.mutations[parentKey].After(t)
+}
+
+// addParent records the entry key against its parent.
+// The caller must hold the scope lock.
+func (s *scope) addParent(service string, parent Parent, parentKey, key string) {
+	if _, ok := s.parentFields[service]; !ok {
+		s.parentFields[service] = make(map[string]struct{})
+	}
+	s.parentFields[service][parent.Field] = struct{}{}
+
+	if _, ok := s.parents[parentKey]; !ok {
+		s.parents[parentKey] = make(map[string]struct{})
+	}
+	s.parents[parentKey][key] = struct{}{}
+}
+
+// invalidate removes cached entries affected by a mutating call with the specified input.
+// If the input references any known parent field only the entries for those parents are
+// removed, otherwise all of the service's entries are removed.
+func (s *scope) invalidate(service string, input interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	t := now()
+	var parentKeys []string
+
+	for field := range s.parentFields[service] {
+		if value := stringField(input, field); value != "" {
+			parentKeys = append(parentKeys, parentKey(service, Parent{Field: field, Value: value}))
+		}
+	}
+
+	if len(parentKeys) == 0 {
+		s.mutations[service] = t
+
+		for parentKey, keys := range s.parents {
+			if strings.HasPrefix(parentKey, service+"|") {
+				s.removeEntries(keys)
+				delete(s.parents, parentKey)
+			}
+		}
+
+		return
+	}
+
+	for _, parentKey := range parentKeys {
+		s.mutations[parentKey] = t
+		s.removeEntries(s.parents[parentKey])
+		delete(s.parents, parentKey)
+	}
+}
+
+// removeEntries removes the specified entries.
+// The caller must hold the scope lock.
+func (s *scope) removeEntries(keys map[string]struct{}) {
+	for key := range keys {
+		delete(s.entries, key)
+	}
+}
+
+// IsReadOnlyOperation returns whether the named API operation does not mutate.
+func IsReadOnlyOperation(operation string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serviceKey identifies a service endpoint in a region.
+func serviceKey(config aws.Config, info metadata.ClientInfo) string {
+	return strings.Join([]string{info.ServiceID, aws.StringValue(config.Region), info.Endpoint}, "|")
+}
+
+func parentKey(service string, parent Parent) string {
+	return strings.Join([]string{service, parent.Field, parent.Value}, "|")
+}
+
+func entryKey(c *client.Client, operation string, input interface{}) (string, error) {
+	b, err := json.Marshal(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{serviceKey(c.Config, c.ClientInfo), operation, string(b)}, "|"), nil
+}
+
+// stringField returns the value of the named top-level string field of the API input, if any.
+func stringField(input interface{}, field string) string {
+	v := reflect.Indirect(reflect.ValueOf(input))
+
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	f := v.FieldByName(field)
+
+	if !f.IsValid() {
+		return ""
+	}
+
+	if s, ok := f.Interface().(*string); ok {
+		return aws.StringValue(s)
+	}
+
+	return ""
+}
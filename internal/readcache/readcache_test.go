@@ -0,0 +1,219 @@
+package readcache
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+type testInput struct {
+	GroupId   *string
+	GroupName *string
+}
+
+type testOutput struct {
+	Value *string
+}
+
+func testClient(t *testing.T, enable bool) *client.Client {
+	t.Helper()
+
+	c := &client.Client{
+		Config: aws.Config{
+			Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+			Region:      aws.String("us-west-2"), //lintignore:AWSAT003
+		},
+		ClientInfo: metadata.ClientInfo{
+			Endpoint:  "https://ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			ServiceID: "EC2",
+		},
+	}
+
+	if enable {
+		Enable(c.Config.Credentials)
+	}
+
+	return c
+}
+
+func testMutation(c *client.Client, operation string, params interface{}) {
+	invalidate(&request.Request{
+		ClientInfo: c.ClientInfo,
+		Config:     c.Config,
+		Operation:  &request.Operation{Name: operation},
+		Params:     params,
+	})
+}
+
+// testRead calls Do for the specified group ID, returning the output value and whether the API was called.
+func testRead(t *testing.T, c *client.Client, groupID string, calls *int32) (string, bool) {
+	t.Helper()
+
+	before := atomic.LoadInt32(calls)
+	input := &testInput{GroupId: aws.String(groupID)}
+
+	output, err := Do(c, "DescribeSecurityGroups", input, Parent{Field: "GroupId", Value: groupID}, func() (interface{}, error) {
+		n := atomic.AddInt32(calls, 1)
+
+		return &testOutput{Value: aws.String(fmt.Sprintf("%s-%d", groupID, n))}, nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return aws.StringValue(output.(*testOutput).Value), atomic.LoadInt32(calls) != before
+}
+
+func TestDoDisabled(t *testing.T) {
+	c := testClient(t, false)
+	var calls int32
+
+	testRead(t, c, "sg-1", &calls)
+	testRead(t, c, "sg-1", &calls)
+
+	if calls != 2 {
+		t.Errorf("got %d API calls, expected 2", calls)
+	}
+}
+
+func TestDoCached(t *testing.T) {
+	c := testClient(t, true)
+	var calls int32
+
+	v1, _ := testRead(t, c, "sg-1", &calls)
+	v2, called := testRead(t, c, "sg-1", &calls)
+
+	if called {
+		t.Error("expected second read to be cached")
+	}
+
+	if v1 != v2 {
+		t.Errorf("got cached value %q, expected %q", v2, v1)
+	}
+
+	if _, called := testRead(t, c, "sg-2", &calls); !called {
+		t.Error("expected read of another parent not to be cached")
+	}
+}
+
+func TestDoReturnsCopy(t *testing.T) {
+	c := testClient(t, true)
+	input := &testInput{GroupId: aws.String("sg-1")}
+	fn := func() (interface{}, error) {
+		return &testOutput{Value: aws.String("original")}, nil
+	}
+
+	output, _ := Do(c, "DescribeSecurityGroups", input, Parent{Field: "GroupId", Value: "sg-1"}, fn)
+	output.(*testOutput).Value = aws.String("modified")
+
+	output, _ = Do(c, "DescribeSecurityGroups", input, Parent{Field: "GroupId", Value: "sg-1"}, fn)
+
+	if got, expected := aws.StringValue(output.(*testOutput).Value), "original"; got != expected {
+		t.Errorf("got cached value %q, expected %q", got, expected)
+	}
+}
+
+func TestDoParentInvalidation(t *testing.T) {
+	c := testClient(t, true)
+	var calls int32
+
+	testRead(t, c, "sg-1", &calls)
+	testRead(t, c, "sg-2", &calls)
+
+	testMutation(c, "AuthorizeSecurityGroupIngress", &testInput{GroupId: aws.String("sg-1")})
+
+	if _, called := testRead(t, c, "sg-1", &calls); !called {
+		t.Error("expected read of mutated parent not to be cached")
+	}
+
+	if _, called := testRead(t, c, "sg-1", &calls); !called {
+		t.Error("expected read of recently mutated parent not to be cached")
+	}
+
+	if _, called := testRead(t, c, "sg-2", &calls); called {
+		t.Error("expected read of other parent to be cached")
+	}
+
+	// Reads are cached again once the mutation has settled.
+	defer func(f func() time.Time) { now = f }(now)
+	later := time.Now().Add(MutationSettleTime + time.Second)
+	now = func() time.Time { return later }
+
+	testRead(t, c, "sg-1", &calls)
+
+	if _, called := testRead(t, c, "sg-1", &calls); called {
+		t.Error("expected read of settled parent to be cached")
+	}
+}
+
+func TestDoServiceInvalidation(t *testing.T) {
+	c := testClient(t, true)
+	var calls int32
+
+	testRead(t, c, "sg-1", &calls)
+	testRead(t, c, "sg-2", &calls)
+
+	// The mutation does not reference a known parent field.
+	testMutation(c, "RevokeSecurityGroupIngress", &testInput{GroupName: aws.String("default")})
+
+	if _, called := testRead(t, c, "sg-1", &calls); !called {
+		t.Error("expected read after service mutation not to be cached")
+	}
+
+	if _, called := testRead(t, c, "sg-2", &calls); !called {
+		t.Error("expected read after service mutation not to be cached")
+	}
+}
+
+func TestDoReadOnlyOperationDoesNotInvalidate(t *testing.T) {
+	c := testClient(t, true)
+	var calls int32
+
+	testRead(t, c, "sg-1", &calls)
+
+	testMutation(c, "DescribeSecurityGroupReferences", &testInput{GroupId: aws.String("sg-1")})
+
+	if _, called := testRead(t, c, "sg-1", &calls); called {
+		t.Error("expected read to be cached")
+	}
+}
+
+func TestDoConcurrent(t *testing.T) {
+	c := testClient(t, true)
+	var calls int32
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			input := &testInput{GroupId: aws.String("sg-1")}
+
+			_, _ = Do(c, "DescribeSecurityGroups", input, Parent{Field: "GroupId", Value: "sg-1"}, func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+
+				return &testOutput{}, nil
+			})
+		}()
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("got %d API calls, expected 1", calls)
+	}
+}
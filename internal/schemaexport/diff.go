@@ -0,0 +1,328 @@
+package schemaexport
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ChangeKind is the classification of a schema change.
+type ChangeKind string
+
+const (
+	// ChangeKindBreaking is a change that can break existing configurations or plans,
+	// e.g. an Optional attribute becoming Required or ForceNew being added.
+	ChangeKindBreaking ChangeKind = "breaking"
+	// ChangeKindDeprecation is a new or changed deprecation.
+	ChangeKindDeprecation ChangeKind = "deprecation"
+	// ChangeKindAdditive is a change that existing configurations are not affected by,
+	// e.g. a new Optional attribute.
+	ChangeKindAdditive ChangeKind = "additive"
+)
+
+// Change is a classified change of a resource, data source or attribute schema.
+type Change struct {
+	Kind ChangeKind
+	// Resource is the resource type name, e.g. aws_sqs_queue, or the data source type name
+	// prefixed with "data.", e.g. data.aws_sqs_queue.
+	Resource string
+	// Attribute is the attribute path, e.g. rule.filter.prefix. Empty for resource level changes.
+	Attribute string
+	Message   string
+}
+
+func (c Change) String() string {
+	if c.Attribute == "" {
+		return fmt.Sprintf("%s: %s: %s", c.Kind, c.Resource, c.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s: %s", c.Kind, c.Resource, c.Attribute, c.Message)
+}
+
+// Diff returns the classified changes from the old to the new schema, sorted by resource and attribute.
+func Diff(old, new *ProviderSchema) []Change {
+	d := &differ{}
+
+	d.resources("", old.Resources, new.Resources)
+	d.resources("data.", old.DataSources, new.DataSources)
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		if d.changes[i].Resource != d.changes[j].Resource {
+			return d.changes[i].Resource < d.changes[j].Resource
+		}
+
+		return d.changes[i].Attribute < d.changes[j].Attribute
+	})
+
+	return d.changes
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(kind ChangeKind, resource, attribute, format string, a ...interface{}) {
+	d.changes = append(d.changes, Change{
+		Kind:      kind,
+		Resource:  resource,
+		Attribute: attribute,
+		Message:   fmt.Sprintf(format, a...),
+	})
+}
+
+func (d *differ) resources(prefix string, old, new map[string]*Resource) {
+	for name, o := range old {
+		n, ok := new[name]
+
+		if !ok {
+			d.add(ChangeKindBreaking, prefix+name, "", "removed")
+			continue
+		}
+
+		d.resource(prefix+name, o, n)
+	}
+
+	for name := range new {
+		if _, ok := old[name]; !ok {
+			d.add(ChangeKindAdditive, prefix+name, "", "added")
+		}
+	}
+}
+
+func (d *differ) resource(name string, old, new *Resource) {
+	switch {
+	case new.SchemaVersion < old.SchemaVersion:
+		d.add(ChangeKindBreaking, name, "", "schema version decreased from %d to %d", old.SchemaVersion, new.SchemaVersion)
+	case new.SchemaVersion > old.SchemaVersion:
+		d.add(ChangeKindAdditive, name, "", "schema version increased from %d to %d", old.SchemaVersion, new.SchemaVersion)
+	}
+
+	if removed, _ := intsDiff(old.StateUpgraders, new.StateUpgraders); len(removed) > 0 {
+		d.add(ChangeKindBreaking, name, "", "state upgraders removed for versions %v", removed)
+	}
+
+	d.deprecation(name, "", old.DeprecationMessage, new.DeprecationMessage)
+	d.attributes(name, "", old.Attributes, new.Attributes)
+}
+
+func (d *differ) deprecation(name, path, old, new string) {
+	switch {
+	case old == "" && new != "":
+		d.add(ChangeKindDeprecation, name, path, "deprecated: %s", new)
+	case old != "" && new == "":
+		d.add(ChangeKindAdditive, name, path, "no longer deprecated")
+	case old != new:
+		d.add(ChangeKindDeprecation, name, path, "deprecation message changed: %s", new)
+	}
+}
+
+func (d *differ) attributes(name, prefix string, old, new map[string]*Attribute) {
+	for k, o := range old {
+		path := prefix + k
+		n, ok := new[k]
+
+		if !ok {
+			d.add(ChangeKindBreaking, name, path, "removed")
+			continue
+		}
+
+		d.attribute(name, path, o, n)
+	}
+
+	for k, n := range new {
+		if _, ok := old[k]; ok {
+			continue
+		}
+
+		if n.Required {
+			d.add(ChangeKindBreaking, name, prefix+k, "added as Required")
+		} else {
+			d.add(ChangeKindAdditive, name, prefix+k, "added")
+		}
+	}
+}
+
+func (d *differ) attribute(name, path string, old, new *Attribute) {
+	if old.Type != new.Type || old.ElemType != new.ElemType {
+		d.add(ChangeKindBreaking, name, path, "type changed from %s to %s", typeString(old), typeString(new))
+		return
+	}
+
+	switch {
+	case !old.Required && new.Required:
+		d.add(ChangeKindBreaking, name, path, "Required added")
+	case old.Required && !new.Required:
+		d.add(ChangeKindAdditive, name, path, "Required removed")
+	}
+
+	switch {
+	case !old.Optional && !old.Required && new.Optional:
+		d.add(ChangeKindAdditive, name, path, "Optional added")
+	case old.Optional && !new.Optional && !new.Required:
+		d.add(ChangeKindBreaking, name, path, "Optional removed")
+	}
+
+	switch {
+	case old.Computed && !new.Computed:
+		d.add(ChangeKindBreaking, name, path, "Computed removed")
+	case !old.Computed && new.Computed:
+		d.add(ChangeKindAdditive, name, path, "Computed added")
+	}
+
+	d.flag(name, path, "ForceNew", old.ForceNew, new.ForceNew)
+	d.flag(name, path, "Sensitive", old.Sensitive, new.Sensitive)
+
+	if !reflect.DeepEqual(old.Default, new.Default) {
+		d.add(ChangeKindBreaking, name, path, "Default changed from %s to %s", valueString(old.Default), valueString(new.Default))
+	}
+
+	switch {
+	case new.MaxItems != 0 && (old.MaxItems == 0 || new.MaxItems < old.MaxItems):
+		d.add(ChangeKindBreaking, name, path, "MaxItems tightened from %s to %d", limitString(old.MaxItems), new.MaxItems)
+	case new.MaxItems != old.MaxItems:
+		d.add(ChangeKindAdditive, name, path, "MaxItems relaxed from %d to %s", old.MaxItems, limitString(new.MaxItems))
+	}
+
+	switch {
+	case new.MinItems > old.MinItems:
+		d.add(ChangeKindBreaking, name, path, "MinItems tightened from %d to %d", old.MinItems, new.MinItems)
+	case new.MinItems < old.MinItems:
+		d.add(ChangeKindAdditive, name, path, "MinItems relaxed from %d to %d", old.MinItems, new.MinItems)
+	}
+
+	d.references(name, path, "ConflictsWith", old.ConflictsWith, new.ConflictsWith)
+	d.references(name, path, "ExactlyOneOf", old.ExactlyOneOf, new.ExactlyOneOf)
+	d.references(name, path, "AtLeastOneOf", old.AtLeastOneOf, new.AtLeastOneOf)
+	d.references(name, path, "RequiredWith", old.RequiredWith, new.RequiredWith)
+
+	// Validators are only known by name, so any new validator may reject existing values.
+	if removed, added := stringsDiff(old.Validators, new.Validators); len(added) > 0 {
+		d.add(ChangeKindBreaking, name, path, "validators added: %s", strings.Join(added, ", "))
+	} else if len(removed) > 0 {
+		d.add(ChangeKindAdditive, name, path, "validators removed: %s", strings.Join(removed, ", "))
+	}
+
+	d.deprecation(name, path, old.Deprecated, new.Deprecated)
+
+	switch {
+	case old.Elem != nil && new.Elem != nil:
+		d.attributes(name, path+".", old.Elem.Attributes, new.Elem.Attributes)
+	case old.Elem == nil && new.Elem != nil, old.Elem != nil && new.Elem == nil:
+		d.add(ChangeKindBreaking, name, path, "type changed from %s to %s", typeString(old), typeString(new))
+	}
+}
+
+// flag adds the change of a boolean schema field whose addition is breaking.
+func (d *differ) flag(name, path, field string, old, new bool) {
+	switch {
+	case !old && new:
+		d.add(ChangeKindBreaking, name, path, "%s added", field)
+	case old && !new:
+		d.add(ChangeKindAdditive, name, path, "%s removed", field)
+	}
+}
+
+// references adds the change of a schema field referencing other attributes whose additions are breaking.
+func (d *differ) references(name, path, field string, old, new []string) {
+	removed, added := stringsDiff(old, new)
+
+	if len(added) > 0 {
+		d.add(ChangeKindBreaking, name, path, "%s added: %s", field, strings.Join(added, ", "))
+	}
+
+	if len(removed) > 0 {
+		d.add(ChangeKindAdditive, name, path, "%s removed: %s", field, strings.Join(removed, ", "))
+	}
+}
+
+func typeString(a *Attribute) string {
+	switch {
+	case a.ElemType != "":
+		return fmt.Sprintf("%s of %s", a.Type, a.ElemType)
+	case a.Elem != nil:
+		return fmt.Sprintf("%s of blocks", a.Type)
+	}
+
+	return a.Type
+}
+
+func valueString(v interface{}) string {
+	if v == nil {
+		return "none"
+	}
+
+	return fmt.Sprintf("%#v", v)
+}
+
+func limitString(v int) string {
+	if v == 0 {
+		return "unlimited"
+	}
+
+	return fmt.Sprint(v)
+}
+
+// stringsDiff returns the sorted elements only in old and only in new.
+func stringsDiff(old, new []string) ([]string, []string) {
+	var removed, added []string
+
+	in := func(s []string, v string) bool {
+		for _, e := range s {
+			if e == v {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, v := range old {
+		if !in(new, v) {
+			removed = append(removed, v)
+		}
+	}
+
+	for _, v := range new {
+		if !in(old, v) {
+			added = append(added, v)
+		}
+	}
+
+	sort.Strings(removed)
+	sort.Strings(added)
+
+	return removed, added
+}
+
+// intsDiff returns the sorted elements only in old and only in new.
+func intsDiff(old, new []int) ([]int, []int) {
+	var removed, added []int
+
+	in := func(s []int, v int) bool {
+		for _, e := range s {
+			if e == v {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, v := range old {
+		if !in(new, v) {
+			removed = append(removed, v)
+		}
+	}
+
+	for _, v := range new {
+		if !in(old, v) {
+			added = append(added, v)
+		}
+	}
+
+	sort.Ints(removed)
+	sort.Ints(added)
+
+	return removed, added
+}
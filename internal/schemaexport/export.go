@@ -0,0 +1,225 @@
+// Package schemaexport serializes the provider's resource and data source schemas
+// and classifies the changes between two serialized schemas.
+package schemaexport
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderSchema is the serialized schema of all resources and data sources of the provider.
+type ProviderSchema struct {
+	Resources   map[string]*Resource `json:"resources"`
+	DataSources map[string]*Resource `json:"data_sources"`
+}
+
+// Resource is the serialized schema of a resource, data source or nested block.
+type Resource struct {
+	SchemaVersion      int                   `json:"schema_version,omitempty"`
+	StateUpgraders     []int                 `json:"state_upgraders,omitempty"`
+	DeprecationMessage string                `json:"deprecation_message,omitempty"`
+	Attributes         map[string]*Attribute `json:"attributes"`
+}
+
+// Attribute is the serialized schema of an attribute or nested block.
+type Attribute struct {
+	Type string `json:"type"`
+	// ElemType is the type of the elements of a list, set or map of primitive values.
+	ElemType string `json:"elem_type,omitempty"`
+	// Elem is the schema of the nested block of a list or set.
+	Elem *Resource `json:"elem,omitempty"`
+
+	Optional  bool `json:"optional,omitempty"`
+	Required  bool `json:"required,omitempty"`
+	Computed  bool `json:"computed,omitempty"`
+	ForceNew  bool `json:"force_new,omitempty"`
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	Default  interface{} `json:"default,omitempty"`
+	MaxItems int         `json:"max_items,omitempty"`
+	MinItems int         `json:"min_items,omitempty"`
+
+	ConflictsWith []string `json:"conflicts_with,omitempty"`
+	ExactlyOneOf  []string `json:"exactly_one_of,omitempty"`
+	AtLeastOneOf  []string `json:"at_least_one_of,omitempty"`
+	RequiredWith  []string `json:"required_with,omitempty"`
+
+	// Validators are the names of the validation functions, e.g. validation.StringInSlice.
+	Validators []string `json:"validators,omitempty"`
+
+	Deprecated string `json:"deprecated,omitempty"`
+}
+
+// Export returns the serialized schema of the provider.
+func Export(p *schema.Provider) *ProviderSchema {
+	return &ProviderSchema{
+		Resources:   exportResources(p.ResourcesMap),
+		DataSources: exportResources(p.DataSourcesMap),
+	}
+}
+
+// Write writes the schema as indented JSON. Object keys are sorted, so the output is stable.
+func (s *ProviderSchema) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(s)
+}
+
+// Read reads a schema written by Write.
+func Read(r io.Reader) (*ProviderSchema, error) {
+	var s ProviderSchema
+
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func exportResources(m map[string]*schema.Resource) map[string]*Resource {
+	resources := make(map[string]*Resource, len(m))
+
+	for name, r := range m {
+		resources[name] = exportResource(r)
+	}
+
+	return resources
+}
+
+func exportResource(r *schema.Resource) *Resource {
+	resource := &Resource{
+		SchemaVersion:      r.SchemaVersion,
+		DeprecationMessage: r.DeprecationMessage,
+		Attributes:         make(map[string]*Attribute, len(r.Schema)),
+	}
+
+	for _, v := range r.StateUpgraders {
+		resource.StateUpgraders = append(resource.StateUpgraders, v.Version)
+	}
+
+	sort.Ints(resource.StateUpgraders)
+
+	for k, v := range r.Schema {
+		resource.Attributes[k] = exportAttribute(v)
+	}
+
+	return resource
+}
+
+func exportAttribute(s *schema.Schema) *Attribute {
+	attribute := &Attribute{
+		Type:          typeName(s.Type),
+		Optional:      s.Optional,
+		Required:      s.Required,
+		Computed:      s.Computed,
+		ForceNew:      s.ForceNew,
+		Sensitive:     s.Sensitive,
+		Default:       s.Default,
+		MaxItems:      s.MaxItems,
+		MinItems:      s.MinItems,
+		ConflictsWith: sortedStrings(s.ConflictsWith),
+		ExactlyOneOf:  sortedStrings(s.ExactlyOneOf),
+		AtLeastOneOf:  sortedStrings(s.AtLeastOneOf),
+		RequiredWith:  sortedStrings(s.RequiredWith),
+		Deprecated:    s.Deprecated,
+	}
+
+	for _, f := range []interface{}{s.ValidateFunc, s.ValidateDiagFunc} {
+		if name := funcName(f); name != "" {
+			attribute.Validators = append(attribute.Validators, name)
+		}
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		attribute.Elem = exportResource(elem)
+	case *schema.Schema:
+		attribute.ElemType = typeName(elem.Type)
+	}
+
+	return attribute
+}
+
+func typeName(t schema.ValueType) string {
+	switch t {
+	case schema.TypeBool:
+		return "bool"
+	case schema.TypeInt:
+		return "int"
+	case schema.TypeFloat:
+		return "float"
+	case schema.TypeString:
+		return "string"
+	case schema.TypeList:
+		return "list"
+	case schema.TypeMap:
+		return "map"
+	case schema.TypeSet:
+		return "set"
+	}
+
+	return "invalid"
+}
+
+// funcName returns the short name of a function, e.g. validation.StringInSlice for the
+// closure returned by github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation.StringInSlice.
+func funcName(f interface{}) string {
+	v := reflect.ValueOf(f)
+
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	fn := runtime.FuncForPC(v.Pointer())
+
+	if fn == nil {
+		return ""
+	}
+
+	name := fn.Name()
+
+	// Strip the package path, e.g. github.com/hashicorp/terraform-plugin-sdk/v2/helper/.
+	name = name[strings.LastIndex(name, "/")+1:]
+
+	// Strip closure suffixes, e.g. .func1 or .func1.1.
+	parts := strings.Split(name, ".")
+
+	for len(parts) > 2 && (strings.HasPrefix(parts[len(parts)-1], "func") || isDigits(parts[len(parts)-1])) {
+		parts = parts[:len(parts)-1]
+	}
+
+	return strings.Join(parts, ".")
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedStrings(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	v := make([]string, len(s))
+	copy(v, s)
+	sort.Strings(v)
+
+	return v
+}
@@ -0,0 +1,106 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/schemaexport"
+)
+
+var (
+	diff   = flag.Bool("diff", false, "whether to diff the two schema files given as arguments instead of exporting the schema")
+	output = flag.String("output", "schema.json", "name of the exported schema file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [-output <schema-file>]\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go -diff <old-schema-file> <new-schema-file>\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *diff {
+		if flag.NArg() != 2 {
+			flag.Usage()
+			os.Exit(2)
+		}
+
+		os.Exit(diffSchemas(flag.Arg(0), flag.Arg(1)))
+	}
+
+	var buffer bytes.Buffer
+
+	if err := schemaexport.Export(provider.Provider()).Write(&buffer); err != nil {
+		log.Fatalf("error encoding schema: %s", err)
+	}
+
+	if err := os.WriteFile(*output, buffer.Bytes(), 0644); err != nil {
+		log.Fatalf("error writing %s: %s", *output, err)
+	}
+}
+
+// diffSchemas prints the changes between the schema files and returns the exit code,
+// which is 1 if there are breaking changes.
+func diffSchemas(oldFile, newFile string) int {
+	old, err := readSchema(oldFile)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	new, err := readSchema(newFile)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	changes := schemaexport.Diff(old, new)
+	exitCode := 0
+
+	for _, kind := range []schemaexport.ChangeKind{schemaexport.ChangeKindBreaking, schemaexport.ChangeKindDeprecation, schemaexport.ChangeKindAdditive} {
+		for _, change := range changes {
+			if change.Kind != kind {
+				continue
+			}
+
+			fmt.Println(change)
+
+			if kind == schemaexport.ChangeKindBreaking {
+				exitCode = 1
+			}
+		}
+	}
+
+	return exitCode
+}
+
+func readSchema(filename string) (*schemaexport.ProviderSchema, error) {
+	f, err := os.Open(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening %s: %w", filename, err)
+	}
+
+	defer f.Close()
+
+	s, err := schemaexport.Read(f)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", filename, err)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,299 @@
+package schemaexport
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func testProvider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_example": {
+				SchemaVersion: 1,
+				StateUpgraders: []schema.StateUpgrader{
+					{Version: 0},
+				},
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						ForceNew:      true,
+						ConflictsWith: []string{"name_prefix"},
+						ValidateFunc:  validation.StringLenBetween(1, 64),
+					},
+					"rule": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"enabled": {
+									Type:     schema.TypeBool,
+									Optional: true,
+									Default:  true,
+								},
+							},
+						},
+					},
+					"tags": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_example": {
+				DeprecationMessage: "use aws_examples",
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	var buffer bytes.Buffer
+
+	if err := Export(testProvider()).Write(&buffer); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{
+  "resources": {
+    "aws_example": {
+      "schema_version": 1,
+      "state_upgraders": [
+        0
+      ],
+      "attributes": {
+        "name": {
+          "type": "string",
+          "optional": true,
+          "force_new": true,
+          "conflicts_with": [
+            "name_prefix"
+          ],
+          "validators": [
+            "validation.StringLenBetween"
+          ]
+        },
+        "rule": {
+          "type": "list",
+          "elem": {
+            "attributes": {
+              "enabled": {
+                "type": "bool",
+                "optional": true,
+                "default": true
+              }
+            }
+          },
+          "optional": true,
+          "max_items": 1
+        },
+        "tags": {
+          "type": "map",
+          "elem_type": "string",
+          "optional": true
+        }
+      }
+    }
+  },
+  "data_sources": {
+    "aws_example": {
+      "deprecation_message": "use aws_examples",
+      "attributes": {
+        "name": {
+          "type": "string",
+          "required": true
+        }
+      }
+    }
+  }
+}
+`
+
+	if got := buffer.String(); got != expected {
+		t.Errorf("got:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	s, err := Read(&buffer)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if changes := Diff(s, s); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := testProvider()
+	new := testProvider()
+
+	r := new.ResourcesMap["aws_example"]
+	r.SchemaVersion = 2
+	r.Schema["name"].Optional = false
+	r.Schema["name"].Required = true
+	r.Schema["name"].ValidateFunc = validation.StringLenBetween(1, 32)
+	r.Schema["rule"].Elem.(*schema.Resource).Schema["enabled"].Default = false
+	r.Schema["rule"].Elem.(*schema.Resource).Schema["enabled"].ForceNew = true
+	r.Schema["rule"].MaxItems = 2
+	r.Schema["tags"].Deprecated = "use tags_all"
+	r.Schema["description"] = &schema.Schema{Type: schema.TypeString, Optional: true}
+	r.Schema["kind"] = &schema.Schema{Type: schema.TypeString, Required: true}
+
+	delete(new.DataSourcesMap, "aws_example")
+	new.DataSourcesMap["aws_examples"] = &schema.Resource{}
+
+	var got []string
+
+	for _, change := range Diff(roundTrip(t, old), roundTrip(t, new)) {
+		got = append(got, change.String())
+	}
+
+	expected := []string{
+		"additive: aws_example: schema version increased from 1 to 2",
+		"additive: aws_example: description: added",
+		"breaking: aws_example: kind: added as Required",
+		"breaking: aws_example: name: Required added",
+		"additive: aws_example: rule: MaxItems relaxed from 1 to 2",
+		"breaking: aws_example: rule.enabled: ForceNew added",
+		"breaking: aws_example: rule.enabled: Default changed from true to false",
+		"deprecation: aws_example: tags: deprecated: use tags_all",
+		"breaking: data.aws_example: removed",
+		"additive: data.aws_examples: added",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got:\n%s\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestDiffAttribute(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Old      *Attribute
+		New      *Attribute
+		Expected []string
+	}{
+		{
+			Name:     "type changed",
+			Old:      &Attribute{Type: "list", ElemType: "string", Optional: true},
+			New:      &Attribute{Type: "set", ElemType: "string", Optional: true},
+			Expected: []string{"breaking: aws_example: a: type changed from list of string to set of string"},
+		},
+		{
+			Name:     "optional to computed",
+			Old:      &Attribute{Type: "string", Optional: true},
+			New:      &Attribute{Type: "string", Computed: true},
+			Expected: []string{"breaking: aws_example: a: Optional removed", "additive: aws_example: a: Computed added"},
+		},
+		{
+			Name:     "computed removed",
+			Old:      &Attribute{Type: "string", Optional: true, Computed: true},
+			New:      &Attribute{Type: "string", Optional: true},
+			Expected: []string{"breaking: aws_example: a: Computed removed"},
+		},
+		{
+			Name:     "max items tightened",
+			Old:      &Attribute{Type: "list", Optional: true},
+			New:      &Attribute{Type: "list", Optional: true, MaxItems: 1},
+			Expected: []string{"breaking: aws_example: a: MaxItems tightened from unlimited to 1"},
+		},
+		{
+			Name:     "min items relaxed",
+			Old:      &Attribute{Type: "list", Optional: true, MinItems: 1},
+			New:      &Attribute{Type: "list", Optional: true},
+			Expected: []string{"additive: aws_example: a: MinItems relaxed from 1 to 0"},
+		},
+		{
+			Name:     "conflicts with changed",
+			Old:      &Attribute{Type: "string", Optional: true, ConflictsWith: []string{"b"}},
+			New:      &Attribute{Type: "string", Optional: true, ConflictsWith: []string{"c"}},
+			Expected: []string{"breaking: aws_example: a: ConflictsWith added: c", "additive: aws_example: a: ConflictsWith removed: b"},
+		},
+		{
+			Name:     "validator removed",
+			Old:      &Attribute{Type: "string", Optional: true, Validators: []string{"validation.StringLenBetween"}},
+			New:      &Attribute{Type: "string", Optional: true},
+			Expected: []string{"additive: aws_example: a: validators removed: validation.StringLenBetween"},
+		},
+		{
+			Name:     "sensitive added",
+			Old:      &Attribute{Type: "string", Optional: true},
+			New:      &Attribute{Type: "string", Optional: true, Sensitive: true},
+			Expected: []string{"breaking: aws_example: a: Sensitive added"},
+		},
+		{
+			Name:     "deprecation removed",
+			Old:      &Attribute{Type: "string", Optional: true, Deprecated: "use b"},
+			New:      &Attribute{Type: "string", Optional: true},
+			Expected: []string{"additive: aws_example: a: no longer deprecated"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			d := &differ{}
+			d.attribute("aws_example", "a", testCase.Old, testCase.New)
+
+			var got []string
+
+			for _, change := range d.changes {
+				got = append(got, change.String())
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFuncName(t *testing.T) {
+	for _, testCase := range []struct {
+		f        interface{}
+		expected string
+	}{
+		{validation.StringLenBetween(1, 2), "validation.StringLenBetween"},
+		{validation.IsCIDR, "validation.IsCIDR"},
+		{validation.ToDiagFunc(validation.IsCIDR), "validation.ToDiagFunc"},
+		{schema.SchemaValidateFunc(nil), ""},
+	} {
+		if got := funcName(testCase.f); got != testCase.expected {
+			t.Errorf("got %s, expected %s", got, testCase.expected)
+		}
+	}
+}
+
+// roundTrip returns the provider's exported schema after writing and reading it.
+func roundTrip(t *testing.T, p *schema.Provider) *ProviderSchema {
+	t.Helper()
+
+	var buffer bytes.Buffer
+
+	if err := Export(p).Write(&buffer); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	s, err := Read(&buffer)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return s
+}
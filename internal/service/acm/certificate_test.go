@@ -19,7 +19,7 @@ import (
 func TestAccACMCertificate_emailValidation(t *testing.T) {
 	resourceName := "aws_acm_certificate.cert"
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -51,7 +51,7 @@ func TestAccACMCertificate_emailValidation(t *testing.T) {
 func TestAccACMCertificate_dnsValidation(t *testing.T) {
 	resourceName := "aws_acm_certificate.cert"
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -123,8 +123,8 @@ func TestAccACMCertificate_privateCert(t *testing.T) {
 	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
 	resourceName := "aws_acm_certificate.cert"
 
-	commonName := acctest.RandomDomain()
-	certificateDomainName := commonName.RandomSubdomain().String()
+	commonName := acctest.RandomDomain(t)
+	certificateDomainName := commonName.RandomSubdomain(t).String()
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -217,7 +217,7 @@ func TestAccACMCertificate_rootAndWildcardSan(t *testing.T) {
 
 func TestAccACMCertificate_SubjectAlternativeNames_emptyString(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -236,8 +236,8 @@ func TestAccACMCertificate_SubjectAlternativeNames_emptyString(t *testing.T) {
 func TestAccACMCertificate_San_single(t *testing.T) {
 	resourceName := "aws_acm_certificate.cert"
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
-	sanDomain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
+	sanDomain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -278,9 +278,9 @@ func TestAccACMCertificate_San_single(t *testing.T) {
 func TestAccACMCertificate_San_multiple(t *testing.T) {
 	resourceName := "aws_acm_certificate.cert"
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
-	sanDomain1 := acctest.ACMCertificateRandomSubDomain(rootDomain)
-	sanDomain2 := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
+	sanDomain1 := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
+	sanDomain2 := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -325,8 +325,8 @@ func TestAccACMCertificate_San_multiple(t *testing.T) {
 
 func TestAccACMCertificate_San_trailingPeriod(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
-	sanDomain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
+	sanDomain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 	resourceName := "aws_acm_certificate.cert"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -482,7 +482,7 @@ func TestAccACMCertificate_disableCTLogging(t *testing.T) {
 func TestAccACMCertificate_tags(t *testing.T) {
 	resourceName := "aws_acm_certificate.cert"
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -528,7 +528,7 @@ func TestAccACMCertificate_tags(t *testing.T) {
 	})
 }
 
-//lintignore:AT002
+// lintignore:AT002
 func TestAccACMCertificate_Imported_domainName(t *testing.T) {
 	resourceName := "aws_acm_certificate.test"
 
@@ -542,7 +542,7 @@ func TestAccACMCertificate_Imported_domainName(t *testing.T) {
 	newCaCertificate := acctest.TLSRSAX509SelfSignedCACertificatePEM(newCaKey)
 	newCertificate := acctest.TLSRSAX509LocallySignedCertificatePEM(newCaKey, newCaCertificate, key, commonName)
 
-	withoutChainDomain := acctest.RandomDomainName()
+	withoutChainDomain := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -584,7 +584,7 @@ func TestAccACMCertificate_Imported_domainName(t *testing.T) {
 	})
 }
 
-//lintignore:AT002
+// lintignore:AT002
 func TestAccACMCertificate_Imported_ipAddress(t *testing.T) { // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/7103
 	resourceName := "aws_acm_certificate.test"
 
@@ -765,7 +765,7 @@ resource "aws_acm_certificate" "cert" {
 }
 
 func testAccCheckAcmCertificateDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acm_certificate" {
@@ -13,7 +13,7 @@ import (
 
 func TestAccACMCertificateValidation_basic(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 	certificateResourceName := "aws_acm_certificate.test"
 	resourceName := "aws_acm_certificate_validation.test"
 
@@ -36,7 +36,7 @@ func TestAccACMCertificateValidation_basic(t *testing.T) {
 
 func TestAccACMCertificateValidation_timeout(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -54,7 +54,7 @@ func TestAccACMCertificateValidation_timeout(t *testing.T) {
 
 func TestAccACMCertificateValidation_validationRecordFQDNS(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 	certificateResourceName := "aws_acm_certificate.test"
 	resourceName := "aws_acm_certificate_validation.test"
 
@@ -82,7 +82,7 @@ func TestAccACMCertificateValidation_validationRecordFQDNS(t *testing.T) {
 
 func TestAccACMCertificateValidation_validationRecordFQDNSEmail(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -143,8 +143,8 @@ func TestAccACMCertificateValidation_validationRecordFQDNSRootAndWildcard(t *tes
 
 func TestAccACMCertificateValidation_validationRecordFQDNSSan(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
-	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
-	sanDomain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	domain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
+	sanDomain := acctest.ACMCertificateRandomSubDomain(t, rootDomain)
 	certificateResourceName := "aws_acm_certificate.test"
 	resourceName := "aws_acm_certificate_validation.test"
 
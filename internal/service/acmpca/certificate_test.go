@@ -21,7 +21,7 @@ func TestAccACMPCACertificate_rootCertificate(t *testing.T) {
 	resourceName := "aws_acmpca_certificate.test"
 	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -64,7 +64,7 @@ func TestAccACMPCACertificate_subordinateCertificate(t *testing.T) {
 	rootCertificateAuthorityResourceName := "aws_acmpca_certificate_authority.root"
 	subordinateCertificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -105,9 +105,9 @@ func TestAccACMPCACertificate_subordinateCertificate(t *testing.T) {
 func TestAccACMPCACertificate_endEntityCertificate(t *testing.T) {
 	resourceName := "aws_acmpca_certificate.test"
 
-	csrDomain := acctest.RandomDomainName()
+	csrDomain := acctest.RandomDomainName(t)
 	csr, _ := acctest.TLSRSAX509CertificateRequestPEM(4096, csrDomain)
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -147,9 +147,9 @@ func TestAccACMPCACertificate_endEntityCertificate(t *testing.T) {
 func TestAccACMPCACertificate_Validity_endDate(t *testing.T) {
 	resourceName := "aws_acmpca_certificate.test"
 
-	csrDomain := acctest.RandomDomainName()
+	csrDomain := acctest.RandomDomainName(t)
 	csr, _ := acctest.TLSRSAX509CertificateRequestPEM(4096, csrDomain)
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 	later := time.Now().Add(time.Minute * 10).Format(time.RFC3339)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -190,9 +190,9 @@ func TestAccACMPCACertificate_Validity_endDate(t *testing.T) {
 func TestAccACMPCACertificate_Validity_absolute(t *testing.T) {
 	resourceName := "aws_acmpca_certificate.test"
 
-	csrDomain := acctest.RandomDomainName()
+	csrDomain := acctest.RandomDomainName(t)
 	csr, _ := acctest.TLSRSAX509CertificateRequestPEM(4096, csrDomain)
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 	later := time.Now().Add(time.Minute * 10).Unix()
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -231,7 +231,7 @@ func TestAccACMPCACertificate_Validity_absolute(t *testing.T) {
 }
 
 func testAccCheckCertificateDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMPCAConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ACMPCAConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acmpca_certificate" {
@@ -270,7 +270,7 @@ func testAccCheckCertificateExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ACMPCAConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ACMPCAConn()
 		input := &acmpca.GetCertificateInput{
 			CertificateArn:          aws.String(rs.Primary.ID),
 			CertificateAuthorityArn: aws.String(rs.Primary.Attributes["certificate_authority_arn"]),
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/acmpca"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // waitCertificateAuthorityCreated waits for a CertificateAuthority to return Active or PendingCertificate
@@ -16,7 +17,7 @@ func waitCertificateAuthorityCreated(conn *acmpca.ACMPCA, arn string, timeout ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*acmpca.CertificateAuthority); ok {
 		return v, err
@@ -23,7 +23,7 @@ func waitDomainAssociationCreated(conn *amplify.Amplify, appID, domainName strin
 		Timeout: domainAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*amplify.DomainAssociation); ok {
 		if status := aws.StringValue(v.DomainStatus); status == amplify.DomainStatusFailed {
@@ -44,7 +44,7 @@ func waitDomainAssociationVerified(conn *amplify.Amplify, appID, domainName stri
 		Timeout: domainAssociationVerifiedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*amplify.DomainAssociation); ok {
 		if v != nil && aws.StringValue(v.DomainStatus) == amplify.DomainStatusFailed {
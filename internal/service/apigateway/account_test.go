@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 func TestAccAPIGatewayAccount_basic(t *testing.T) {
 	var conf apigateway.Account
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	firstName := fmt.Sprintf("tf_acc_api_gateway_cloudwatch_%d", rInt)
 	secondName := fmt.Sprintf("tf_acc_api_gateway_cloudwatch_modified_%d", rInt)
 	resourceName := "aws_api_gateway_account.test"
@@ -94,7 +93,7 @@ func testAccCheckAccountExists(n string, res *apigateway.Account) resource.TestC
 			return fmt.Errorf("No API Gateway Account ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 		req := &apigateway.GetAccountInput{}
 		describe, err := conn.GetAccount(req)
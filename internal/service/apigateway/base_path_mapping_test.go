@@ -69,7 +69,7 @@ func TestDecodeApiGatewayBasePathMappingId(t *testing.T) {
 func TestAccAPIGatewayBasePathMapping_basic(t *testing.T) {
 	var conf apigateway.BasePathMapping
 
-	name := acctest.RandomSubdomain()
+	name := acctest.RandomSubdomain(t)
 
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
 	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, name)
@@ -99,7 +99,7 @@ func TestAccAPIGatewayBasePathMapping_basic(t *testing.T) {
 func TestAccAPIGatewayBasePathMapping_BasePath_empty(t *testing.T) {
 	var conf apigateway.BasePathMapping
 
-	name := acctest.RandomSubdomain()
+	name := acctest.RandomSubdomain(t)
 
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
 	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, name)
@@ -128,7 +128,7 @@ func TestAccAPIGatewayBasePathMapping_BasePath_empty(t *testing.T) {
 func TestAccAPIGatewayBasePathMapping_updates(t *testing.T) {
 	var confFirst, conf apigateway.BasePathMapping
 	resourceName := "aws_api_gateway_base_path_mapping.test"
-	name := acctest.RandomSubdomain()
+	name := acctest.RandomSubdomain(t)
 
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
 	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, name)
@@ -178,7 +178,7 @@ func TestAccAPIGatewayBasePathMapping_updates(t *testing.T) {
 func TestAccAPIGatewayBasePathMapping_disappears(t *testing.T) {
 	var conf apigateway.BasePathMapping
 
-	name := acctest.RandomSubdomain()
+	name := acctest.RandomSubdomain(t)
 	resourceName := "aws_api_gateway_base_path_mapping.test"
 
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
@@ -213,7 +213,7 @@ func testAccCheckBasePathExists(n string, res *apigateway.BasePathMapping) resou
 			return fmt.Errorf("No API Gateway ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 		domainName, basePath, err := tfapigateway.DecodeBasePathMappingID(rs.Primary.ID)
 		if err != nil {
@@ -237,7 +237,7 @@ func testAccCheckBasePathExists(n string, res *apigateway.BasePathMapping) resou
 
 func testAccCheckBasePathDestroy(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_api_gateway_base_path_mapping" {
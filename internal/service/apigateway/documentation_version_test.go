@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccAPIGatewayDocumentationVersion_basic(t *testing.T) {
 	var conf apigateway.DocumentationVersion
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	version := fmt.Sprintf("tf-acc-test_version_%s", rString)
 	apiName := fmt.Sprintf("tf-acc-test_api_doc_version_basic_%s", rString)
 
@@ -50,7 +49,7 @@ func TestAccAPIGatewayDocumentationVersion_basic(t *testing.T) {
 func TestAccAPIGatewayDocumentationVersion_allFields(t *testing.T) {
 	var conf apigateway.DocumentationVersion
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	version := fmt.Sprintf("tf-acc-test_version_%s", rString)
 	apiName := fmt.Sprintf("tf-acc-test_api_doc_version_method_%s", rString)
 	stageName := fmt.Sprintf("tf-acc-test_stage_%s", rString)
@@ -95,7 +94,7 @@ func TestAccAPIGatewayDocumentationVersion_allFields(t *testing.T) {
 func TestAccAPIGatewayDocumentationVersion_disappears(t *testing.T) {
 	var conf apigateway.DocumentationVersion
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	version := fmt.Sprintf("tf-acc-test_version_%s", rString)
 	apiName := fmt.Sprintf("tf-acc-test_api_doc_version_basic_%s", rString)
 
@@ -130,7 +129,7 @@ func testAccCheckDocumentationVersionExists(n string, res *apigateway.Documentat
 			return fmt.Errorf("No API Gateway Documentation Version ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 		apiId, version, err := tfapigateway.DecodeDocumentationVersionID(rs.Primary.ID)
 		if err != nil {
@@ -153,7 +152,7 @@ func testAccCheckDocumentationVersionExists(n string, res *apigateway.Documentat
 }
 
 func testAccCheckDocumentationVersionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_api_gateway_documentation_version" {
@@ -0,0 +1,17 @@
+package apigateway
+
+import (
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// errorClassifier classifies the errors returned by the API Gateway API.
+var errorClassifier = tfresource.RegisterServiceErrors("apigateway", tfresource.ServiceErrors{
+	NotFound: []tfresource.ErrorMatcher{
+		{Code: apigateway.ErrCodeNotFoundException},
+	},
+	Unsupported: []tfresource.ErrorMatcher{
+		// BadRequestException: vpc link not supported for region us-gov-west-1
+		{Code: "BadRequestException", Message: "not supported"},
+	},
+})
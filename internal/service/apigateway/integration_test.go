@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccAPIGatewayIntegration_basic(t *testing.T) {
 	var conf apigateway.Integration
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(7))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 7))
 	resourceName := "aws_api_gateway_integration.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -134,7 +133,7 @@ func TestAccAPIGatewayIntegration_basic(t *testing.T) {
 
 func TestAccAPIGatewayIntegration_contentHandling(t *testing.T) {
 	var conf apigateway.Integration
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(7))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 7))
 	resourceName := "aws_api_gateway_integration.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -210,7 +209,7 @@ func TestAccAPIGatewayIntegration_contentHandling(t *testing.T) {
 
 func TestAccAPIGatewayIntegration_CacheKey_parameters(t *testing.T) {
 	var conf apigateway.Integration
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(7))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 7))
 	resourceName := "aws_api_gateway_integration.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -253,7 +252,7 @@ func TestAccAPIGatewayIntegration_CacheKey_parameters(t *testing.T) {
 
 func TestAccAPIGatewayIntegration_integrationType(t *testing.T) {
 	var conf apigateway.Integration
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(7))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 7))
 	resourceName := "aws_api_gateway_integration.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -298,7 +297,7 @@ func TestAccAPIGatewayIntegration_integrationType(t *testing.T) {
 
 func TestAccAPIGatewayIntegration_TLS_insecureSkipVerification(t *testing.T) {
 	var conf apigateway.Integration
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(7))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 7))
 	resourceName := "aws_api_gateway_integration.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -335,7 +334,7 @@ func TestAccAPIGatewayIntegration_TLS_insecureSkipVerification(t *testing.T) {
 
 func TestAccAPIGatewayIntegration_disappears(t *testing.T) {
 	var conf apigateway.Integration
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(7))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 7))
 	resourceName := "aws_api_gateway_integration.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -367,7 +366,7 @@ func testAccCheckIntegrationExists(n string, res *apigateway.Integration) resour
 			return fmt.Errorf("No API Gateway Method ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 		req := &apigateway.GetIntegrationInput{
 			HttpMethod: aws.String("GET"),
@@ -386,7 +385,7 @@ func testAccCheckIntegrationExists(n string, res *apigateway.Integration) resour
 }
 
 func testAccCheckIntegrationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_api_gateway_integration" {
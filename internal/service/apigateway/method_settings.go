@@ -135,7 +135,7 @@ func flattenMethodSettings(settings *apigateway.MethodSetting) []interface{} {
 }
 
 func resourceMethodSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 
 	input := &apigateway.GetStageInput{
 		RestApiId: aws.String(d.Get("rest_api_id").(string)),
@@ -171,7 +171,7 @@ func resourceMethodSettingsRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceMethodSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 
 	methodPath := d.Get("method_path").(string)
 	prefix := fmt.Sprintf("/%s/", methodPath)
@@ -272,7 +272,7 @@ func resourceMethodSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceMethodSettingsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 
 	input := &apigateway.UpdateStageInput{
 		RestApiId: aws.String(d.Get("rest_api_id").(string)),
@@ -5,7 +5,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -14,7 +13,7 @@ func apiGatewayVpcLinkStatus(conn *apigateway.APIGateway, vpcLinkId string) reso
 		output, err := conn.GetVpcLink(&apigateway.GetVpcLinkInput{
 			VpcLinkId: aws.String(vpcLinkId),
 		})
-		if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+		if errorClassifier.IsNotFoundError(err, apigateway.ErrCodeNotFoundException) {
 			return nil, "", nil
 		}
 		if err != nil {
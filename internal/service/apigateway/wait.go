@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -24,7 +25,7 @@ func waitAPIGatewayVPCLinkAvailable(conn *apigateway.APIGateway, vpcLinkId strin
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -42,7 +43,7 @@ func waitAPIGatewayVPCLinkDeleted(conn *apigateway.APIGateway, vpcLinkId string)
 		Refresh:    apiGatewayVpcLinkStatus(conn, vpcLinkId),
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(&stateConf)
 
 	return err
 }
@@ -53,7 +53,7 @@ func ResourceRouteResponse() *schema.Resource {
 }
 
 func resourceRouteResponseCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 
 	req := &apigatewayv2.CreateRouteResponseInput{
 		ApiId:            aws.String(d.Get("api_id").(string)),
@@ -79,7 +79,7 @@ func resourceRouteResponseCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRouteResponseRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 
 	resp, err := conn.GetRouteResponse(&apigatewayv2.GetRouteResponseInput{
 		ApiId:           aws.String(d.Get("api_id").(string)),
@@ -105,7 +105,7 @@ func resourceRouteResponseRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRouteResponseUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 
 	req := &apigatewayv2.UpdateRouteResponseInput{
 		ApiId:           aws.String(d.Get("api_id").(string)),
@@ -132,7 +132,7 @@ func resourceRouteResponseUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRouteResponseDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 
 	log.Printf("[DEBUG] Deleting API Gateway v2 route response (%s)", d.Id())
 	_, err := conn.DeleteRouteResponse(&apigatewayv2.DeleteRouteResponseInput{
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -27,7 +28,7 @@ func WaitDeploymentDeployed(conn *apigatewayv2.ApiGatewayV2, apiId, deploymentId
 		Timeout: DeploymentDeployedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*apigatewayv2.GetDeploymentOutput); ok {
 		return v, err
@@ -44,7 +45,7 @@ func WaitDomainNameAvailable(conn *apigatewayv2.ApiGatewayV2, name string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*apigatewayv2.GetDomainNameOutput); ok {
 		return v, err
@@ -62,7 +63,7 @@ func WaitVPCLinkAvailable(conn *apigatewayv2.ApiGatewayV2, vpcLinkId string) (*a
 		Timeout: VPCLinkAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*apigatewayv2.GetVpcLinkOutput); ok {
 		return v, err
@@ -80,7 +81,7 @@ func WaitVPCLinkDeleted(conn *apigatewayv2.ApiGatewayV2, vpcLinkId string) (*api
 		Timeout: VPCLinkDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*apigatewayv2.GetVpcLinkOutput); ok {
 		return v, err
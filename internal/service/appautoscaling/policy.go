@@ -1,9 +1,9 @@
 package appautoscaling
 
 import (
+	"bytes"
 	"fmt"
 	"log"
-	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -88,12 +91,16 @@ func ResourcePolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"metric_interval_lower_bound": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:             nullable.TypeNullableFloat,
+										Optional:         true,
+										ValidateFunc:     nullable.ValidateTypeStringNullableFloat,
+										DiffSuppressFunc: nullable.DiffSuppressNullableFloat,
 									},
 									"metric_interval_upper_bound": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:             nullable.TypeNullableFloat,
+										Optional:         true,
+										ValidateFunc:     nullable.ValidateTypeStringNullableFloat,
+										DiffSuppressFunc: nullable.DiffSuppressNullableFloat,
 									},
 									"scaling_adjustment": {
 										Type:     schema.TypeInt,
@@ -101,6 +108,7 @@ func ResourcePolicy() *schema.Resource {
 									},
 								},
 							},
+							Set: StepAdjustmentHash,
 						},
 					},
 				},
@@ -204,7 +212,7 @@ func ResourcePolicy() *schema.Resource {
 }
 
 func resourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppAutoScalingConn
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn()
 
 	params, err := getPutScalingPolicyInput(d)
 	if err != nil {
@@ -297,7 +305,7 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppAutoScalingConn
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn()
 
 	params, inputErr := getPutScalingPolicyInput(d)
 	if inputErr != nil {
@@ -329,7 +337,7 @@ func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppAutoScalingConn
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn()
 	p, err := getPolicy(d, meta)
 	if err != nil {
 		return fmt.Errorf("Error getting policy: %s", err)
@@ -430,54 +438,21 @@ func ValidPolicyImportInput(id string) ([]string, error) {
 
 // Takes the result of flatmap.Expand for an array of step adjustments and
 // returns a []*applicationautoscaling.StepAdjustment.
-func expandAppautoscalingStepAdjustments(configured []interface{}) ([]*applicationautoscaling.StepAdjustment, error) {
+func expandAppautoscalingStepAdjustments(configured []interface{}) []*applicationautoscaling.StepAdjustment {
 	var adjustments []*applicationautoscaling.StepAdjustment
 
-	// Loop over our configured step adjustments and create an array
-	// of aws-sdk-go compatible objects. We're forced to convert strings
-	// to floats here because there's no way to detect whether or not
-	// an uninitialized, optional schema element is "0.0" deliberately.
-	// With strings, we can test for "", which is definitely an empty
-	// struct value.
+	// The bounds are nullable floats, as a bound of 0 differs from no bound.
 	for _, raw := range configured {
 		data := raw.(map[string]interface{})
 		a := &applicationautoscaling.StepAdjustment{
-			ScalingAdjustment: aws.Int64(int64(data["scaling_adjustment"].(int))),
-		}
-		if data["metric_interval_lower_bound"] != "" {
-			bound := data["metric_interval_lower_bound"]
-			switch bound := bound.(type) {
-			case string:
-				f, err := strconv.ParseFloat(bound, 64)
-				if err != nil {
-					return nil, fmt.Errorf(
-						"metric_interval_lower_bound must be a float value represented as a string")
-				}
-				a.MetricIntervalLowerBound = aws.Float64(f)
-			default:
-				return nil, fmt.Errorf(
-					"metric_interval_lower_bound isn't a string. This is a bug. Please file an issue.")
-			}
-		}
-		if data["metric_interval_upper_bound"] != "" {
-			bound := data["metric_interval_upper_bound"]
-			switch bound := bound.(type) {
-			case string:
-				f, err := strconv.ParseFloat(bound, 64)
-				if err != nil {
-					return nil, fmt.Errorf(
-						"metric_interval_upper_bound must be a float value represented as a string")
-				}
-				a.MetricIntervalUpperBound = aws.Float64(f)
-			default:
-				return nil, fmt.Errorf(
-					"metric_interval_upper_bound isn't a string. This is a bug. Please file an issue.")
-			}
+			MetricIntervalLowerBound: flex.ExpandNullableFloat64(nullable.Float(data["metric_interval_lower_bound"].(string))),
+			MetricIntervalUpperBound: flex.ExpandNullableFloat64(nullable.Float(data["metric_interval_upper_bound"].(string))),
+			ScalingAdjustment:        aws.Int64(int64(data["scaling_adjustment"].(int))),
 		}
 		adjustments = append(adjustments, a)
 	}
 
-	return adjustments, nil
+	return adjustments
 }
 
 func expandAppautoscalingCustomizedMetricSpecification(configured []interface{}) *applicationautoscaling.CustomizedMetricSpecification {
@@ -592,7 +567,7 @@ func getPutScalingPolicyInput(d *schema.ResourceData) (applicationautoscaling.Pu
 }
 
 func getPolicy(d *schema.ResourceData, meta interface{}) (*applicationautoscaling.ScalingPolicy, error) {
-	conn := meta.(*conns.AWSClient).AppAutoScalingConn
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn()
 
 	params := applicationautoscaling.DescribeScalingPoliciesInput{
 		PolicyNames:       []*string{aws.String(d.Get("name").(string))},
@@ -634,7 +609,7 @@ func expandStepScalingPolicyConfiguration(cfg []interface{}) *applicationautosca
 		out.MinAdjustmentMagnitude = aws.Int64(int64(v))
 	}
 	if v, ok := m["step_adjustment"].(*schema.Set); ok && v.Len() > 0 {
-		out.StepAdjustments, _ = expandAppautoscalingStepAdjustments(v.List())
+		out.StepAdjustments = expandAppautoscalingStepAdjustments(v.List())
 	}
 
 	return out
@@ -660,23 +635,7 @@ func flattenStepScalingPolicyConfiguration(cfg *applicationautoscaling.StepScali
 		m["min_adjustment_magnitude"] = aws.Int64Value(cfg.MinAdjustmentMagnitude)
 	}
 	if cfg.StepAdjustments != nil {
-		stepAdjustmentsResource := &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"metric_interval_lower_bound": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"metric_interval_upper_bound": {
-					Type:     schema.TypeString,
-					Optional: true,
-				},
-				"scaling_adjustment": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-			},
-		}
-		m["step_adjustment"] = schema.NewSet(schema.HashResource(stepAdjustmentsResource), flattenAppautoscalingStepAdjustments(cfg.StepAdjustments))
+		m["step_adjustment"] = schema.NewSet(StepAdjustmentHash, flattenAppautoscalingStepAdjustments(cfg.StepAdjustments))
 	}
 
 	return []interface{}{m}
@@ -690,12 +649,8 @@ func flattenAppautoscalingStepAdjustments(adjs []*applicationautoscaling.StepAdj
 
 		m["scaling_adjustment"] = int(aws.Int64Value(adj.ScalingAdjustment))
 
-		if adj.MetricIntervalLowerBound != nil {
-			m["metric_interval_lower_bound"] = fmt.Sprintf("%g", aws.Float64Value(adj.MetricIntervalLowerBound))
-		}
-		if adj.MetricIntervalUpperBound != nil {
-			m["metric_interval_upper_bound"] = fmt.Sprintf("%g", aws.Float64Value(adj.MetricIntervalUpperBound))
-		}
+		m["metric_interval_lower_bound"] = flex.FlattenNullableFloat64(adj.MetricIntervalLowerBound)
+		m["metric_interval_upper_bound"] = flex.FlattenNullableFloat64(adj.MetricIntervalUpperBound)
 
 		out[i] = m
 	}
@@ -703,6 +658,23 @@ func flattenAppautoscalingStepAdjustments(adjs []*applicationautoscaling.StepAdj
 	return out
 }
 
+// StepAdjustmentHash hashes a step adjustment with its bounds in canonical form,
+// so that equivalent bounds, e.g. 1 and 1.0, hash identically.
+func StepAdjustmentHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	for _, k := range []string{"metric_interval_lower_bound", "metric_interval_upper_bound"} {
+		bound, _ := m[k].(string)
+		if v, null, err := nullable.Float(bound).Value(); err == nil && !null {
+			bound = string(nullable.NewFloat(v))
+		}
+		buf.WriteString(fmt.Sprintf("%s-", bound))
+	}
+	buf.WriteString(fmt.Sprintf("%d-", m["scaling_adjustment"].(int)))
+
+	return create.StringHashcode(buf.String())
+}
+
 func flattenTargetTrackingScalingPolicyConfiguration(cfg *applicationautoscaling.TargetTrackingScalingPolicyConfiguration) []interface{} {
 	if cfg == nil {
 		return []interface{}{}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -80,11 +79,38 @@ func TestValidateAppAutoScalingPolicyImportInput(t *testing.T) {
 	}
 }
 
+func TestStepAdjustmentHash(t *testing.T) {
+	stepAdjustment := func(lower, upper string) map[string]interface{} {
+		return map[string]interface{}{
+			"metric_interval_lower_bound": lower,
+			"metric_interval_upper_bound": upper,
+			"scaling_adjustment":          1,
+		}
+	}
+
+	testCases := []struct {
+		a, b     map[string]interface{}
+		expected bool
+	}{
+		{stepAdjustment("1.0", ""), stepAdjustment("1", ""), true},
+		{stepAdjustment("", "1e1"), stepAdjustment("", "10"), true},
+		{stepAdjustment("0", ""), stepAdjustment("", ""), false},
+		{stepAdjustment("1", ""), stepAdjustment("", "1"), false},
+		{stepAdjustment("1.5", ""), stepAdjustment("1", ""), false},
+	}
+
+	for _, tc := range testCases {
+		if got := tfappautoscaling.StepAdjustmentHash(tc.a) == tfappautoscaling.StepAdjustmentHash(tc.b); got != tc.expected {
+			t.Errorf("tfappautoscaling.StepAdjustmentHash(%v) == tfappautoscaling.StepAdjustmentHash(%v): expected %t, got %t", tc.a, tc.b, tc.expected, got)
+		}
+	}
+}
+
 func TestAccAppAutoScalingPolicy_basic(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 	appAutoscalingTargetResourceName := "aws_appautoscaling_target.test"
 	resourceName := "aws_appautoscaling_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -124,7 +150,7 @@ func TestAccAppAutoScalingPolicy_basic(t *testing.T) {
 func TestAccAppAutoScalingPolicy_disappears(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 	resourceName := "aws_appautoscaling_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -147,8 +173,8 @@ func TestAccAppAutoScalingPolicy_disappears(t *testing.T) {
 func TestAccAppAutoScalingPolicy_scaleOutAndIn(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 
-	randClusterName := fmt.Sprintf("cluster%s", sdkacctest.RandString(10))
-	randPolicyNamePrefix := fmt.Sprintf("terraform-test-foobar-%s", sdkacctest.RandString(5))
+	randClusterName := fmt.Sprintf("cluster%s", acctest.RandString(t, 10))
+	randPolicyNamePrefix := fmt.Sprintf("terraform-test-foobar-%s", acctest.RandString(t, 5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -228,7 +254,7 @@ func TestAccAppAutoScalingPolicy_scaleOutAndIn(t *testing.T) {
 func TestAccAppAutoScalingPolicy_spotFleetRequest(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 
-	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", sdkacctest.RandString(5))
+	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", acctest.RandString(t, 5))
 	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -261,7 +287,7 @@ func TestAccAppAutoScalingPolicy_spotFleetRequest(t *testing.T) {
 func TestAccAppAutoScalingPolicy_DynamoDB_table(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 
-	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", sdkacctest.RandString(5))
+	randPolicyName := fmt.Sprintf("test-appautoscaling-policy-%s", acctest.RandString(t, 5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -291,7 +317,7 @@ func TestAccAppAutoScalingPolicy_DynamoDB_table(t *testing.T) {
 
 func TestAccAppAutoScalingPolicy_DynamoDB_index(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	appautoscalingTargetResourceName := "aws_appautoscaling_target.test"
 	resourceName := "aws_appautoscaling_policy.test"
 
@@ -325,9 +351,9 @@ func TestAccAppAutoScalingPolicy_multiplePoliciesSameName(t *testing.T) {
 	var readPolicy1 applicationautoscaling.ScalingPolicy
 	var readPolicy2 applicationautoscaling.ScalingPolicy
 
-	tableName1 := fmt.Sprintf("tf-autoscaled-table-%s", sdkacctest.RandString(5))
-	tableName2 := fmt.Sprintf("tf-autoscaled-table-%s", sdkacctest.RandString(5))
-	namePrefix := fmt.Sprintf("tf-appautoscaling-policy-%s", sdkacctest.RandString(5))
+	tableName1 := fmt.Sprintf("tf-autoscaled-table-%s", acctest.RandString(t, 5))
+	tableName2 := fmt.Sprintf("tf-autoscaled-table-%s", acctest.RandString(t, 5))
+	namePrefix := fmt.Sprintf("tf-appautoscaling-policy-%s", acctest.RandString(t, 5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -359,8 +385,8 @@ func TestAccAppAutoScalingPolicy_multiplePoliciesSameResource(t *testing.T) {
 	var readPolicy applicationautoscaling.ScalingPolicy
 	var writePolicy applicationautoscaling.ScalingPolicy
 
-	tableName := fmt.Sprintf("tf-autoscaled-table-%s", sdkacctest.RandString(5))
-	namePrefix := fmt.Sprintf("tf-appautoscaling-policy-%s", sdkacctest.RandString(5))
+	tableName := fmt.Sprintf("tf-autoscaled-table-%s", acctest.RandString(t, 5))
+	namePrefix := fmt.Sprintf("tf-appautoscaling-policy-%s", acctest.RandString(t, 5))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -405,7 +431,7 @@ func TestAccAppAutoScalingPolicy_ResourceID_forceNew(t *testing.T) {
 	var policy applicationautoscaling.ScalingPolicy
 	appAutoscalingTargetResourceName := "aws_appautoscaling_target.test"
 	resourceName := "aws_appautoscaling_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -442,7 +468,7 @@ func testAccCheckPolicyExists(n string, policy *applicationautoscaling.ScalingPo
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn()
 		params := &applicationautoscaling.DescribeScalingPoliciesInput{
 			PolicyNames:       []*string{aws.String(rs.Primary.ID)},
 			ResourceId:        aws.String(rs.Primary.Attributes["resource_id"]),
@@ -464,7 +490,7 @@ func testAccCheckPolicyExists(n string, policy *applicationautoscaling.ScalingPo
 }
 
 func testAccCheckPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		params := applicationautoscaling.DescribeScalingPoliciesInput{
@@ -487,7 +513,7 @@ func testAccCheckPolicyDestroy(s *terraform.State) error {
 
 func testAccCheckPolicyDisappears(policy *applicationautoscaling.ScalingPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn()
 
 		input := &applicationautoscaling.DeleteScalingPolicyInput{
 			PolicyName:        policy.PolicyName,
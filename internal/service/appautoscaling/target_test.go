@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 func TestAccAppAutoScalingTarget_basic(t *testing.T) {
 	var target applicationautoscaling.ScalableTarget
 
-	randClusterName := fmt.Sprintf("cluster-%s", sdkacctest.RandString(10))
+	randClusterName := fmt.Sprintf("cluster-%s", acctest.RandString(t, 10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -58,7 +57,7 @@ func TestAccAppAutoScalingTarget_basic(t *testing.T) {
 
 func TestAccAppAutoScalingTarget_disappears(t *testing.T) {
 	var target applicationautoscaling.ScalableTarget
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_appautoscaling_target.bar"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -109,7 +108,7 @@ func TestAccAppAutoScalingTarget_spotFleetRequest(t *testing.T) {
 
 func TestAccAppAutoScalingTarget_emrCluster(t *testing.T) {
 	var target applicationautoscaling.ScalableTarget
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -139,7 +138,7 @@ func TestAccAppAutoScalingTarget_multipleTargets(t *testing.T) {
 	var writeTarget applicationautoscaling.ScalableTarget
 	var readTarget applicationautoscaling.ScalableTarget
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	tableName := fmt.Sprintf("tf_acc_test_table_%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -173,7 +172,7 @@ func TestAccAppAutoScalingTarget_multipleTargets(t *testing.T) {
 func TestAccAppAutoScalingTarget_optionalRoleARN(t *testing.T) {
 	var readTarget applicationautoscaling.ScalableTarget
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	tableName := fmt.Sprintf("tf_acc_test_table_%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -195,7 +194,7 @@ func TestAccAppAutoScalingTarget_optionalRoleARN(t *testing.T) {
 }
 
 func testAccCheckTargetDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appautoscaling_target" {
@@ -241,7 +240,7 @@ func testAccCheckTargetExists(n string, target *applicationautoscaling.ScalableT
 			return fmt.Errorf("No Application AutoScaling Target ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn()
 
 		namespace := rs.Primary.Attributes["service_namespace"]
 		dimension := rs.Primary.Attributes["scalable_dimension"]
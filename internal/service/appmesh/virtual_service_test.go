@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,10 +16,10 @@ import (
 func testAccVirtualService_virtualNode(t *testing.T) {
 	var vs appmesh.VirtualServiceData
 	resourceName := "aws_appmesh_virtual_service.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+	meshName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vnName1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vnName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", acctest.RandInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -71,10 +70,10 @@ func testAccVirtualService_virtualNode(t *testing.T) {
 func testAccVirtualService_virtualRouter(t *testing.T) {
 	var vs appmesh.VirtualServiceData
 	resourceName := "aws_appmesh_virtual_service.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vrName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+	meshName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vrName1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vrName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", acctest.RandInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -118,10 +117,10 @@ func testAccVirtualService_virtualRouter(t *testing.T) {
 func testAccVirtualService_tags(t *testing.T) {
 	var vs appmesh.VirtualServiceData
 	resourceName := "aws_appmesh_virtual_service.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
+	meshName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vnName1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vnName2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", acctest.RandInt(t))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
@@ -172,7 +171,7 @@ func testAccVirtualService_tags(t *testing.T) {
 }
 
 func testAccCheckAppmeshVirtualServiceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppMeshConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppMeshConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appmesh_virtual_service" {
@@ -197,7 +196,7 @@ func testAccCheckAppmeshVirtualServiceDestroy(s *terraform.State) error {
 
 func testAccCheckAppmeshVirtualServiceExists(name string, v *appmesh.VirtualServiceData) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppMeshConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppMeshConn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
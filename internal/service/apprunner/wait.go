@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/apprunner"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -30,7 +31,7 @@ func WaitAutoScalingConfigurationActive(ctx context.Context, conn *apprunner.App
 		Timeout: AutoScalingConfigurationCreateTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -43,7 +44,7 @@ func WaitAutoScalingConfigurationInactive(ctx context.Context, conn *apprunner.A
 		Timeout: AutoScalingConfigurationDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -56,7 +57,7 @@ func WaitConnectionDeleted(ctx context.Context, conn *apprunner.AppRunner, name
 		Timeout: ConnectionDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -69,7 +70,7 @@ func WaitCustomDomainAssociationCreated(ctx context.Context, conn *apprunner.App
 		Timeout: CustomDomainAssociationCreateTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -82,7 +83,7 @@ func WaitCustomDomainAssociationDeleted(ctx context.Context, conn *apprunner.App
 		Timeout: CustomDomainAssociationDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -95,7 +96,7 @@ func WaitServiceCreated(ctx context.Context, conn *apprunner.AppRunner, serviceA
 		Timeout: ServiceCreateTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -108,7 +109,7 @@ func WaitServiceUpdated(ctx context.Context, conn *apprunner.AppRunner, serviceA
 		Timeout: ServiceUpdateTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -121,7 +122,7 @@ func WaitServiceDeleted(ctx context.Context, conn *apprunner.AppRunner, serviceA
 		Timeout: ServiceDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -33,7 +33,7 @@ func waitStackStateDeleted(ctx context.Context, conn *appstream.AppStream, name
 		Timeout: stackOperationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
 
 	if output, ok := outputRaw.(*appstream.Stack); ok {
 		if errors := output.StackErrors; len(errors) > 0 {
@@ -61,7 +61,7 @@ func waitFleetStateRunning(ctx context.Context, conn *appstream.AppStream, name
 		Timeout: fleetStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
 
 	if output, ok := outputRaw.(*appstream.Fleet); ok {
 		if errors := output.FleetErrors; len(errors) > 0 {
@@ -89,7 +89,7 @@ func waitFleetStateStopped(ctx context.Context, conn *appstream.AppStream, name
 		Timeout: fleetStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
 
 	if output, ok := outputRaw.(*appstream.Fleet); ok {
 		if errors := output.FleetErrors; len(errors) > 0 {
@@ -117,7 +117,7 @@ func waitImageBuilderStateRunning(ctx context.Context, conn *appstream.AppStream
 		Timeout: imageBuilderStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
 
 	if output, ok := outputRaw.(*appstream.ImageBuilder); ok {
 		if state, errors := aws.StringValue(output.State), output.ImageBuilderErrors; state == appstream.ImageBuilderStateFailed && len(errors) > 0 {
@@ -145,7 +145,7 @@ func waitImageBuilderStateDeleted(ctx context.Context, conn *appstream.AppStream
 		Timeout: imageBuilderStateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
 
 	if output, ok := outputRaw.(*appstream.ImageBuilder); ok {
 		if state, errors := aws.StringValue(output.State), output.ImageBuilderErrors; state == appstream.ImageBuilderStateFailed && len(errors) > 0 {
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 )
 
 func testAccAppSyncDataSource_basic(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.Test(t, resource.TestCase{
@@ -50,7 +49,7 @@ func testAccAppSyncDataSource_basic(t *testing.T) {
 }
 
 func testAccAppSyncDataSource_description(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.Test(t, resource.TestCase{
@@ -83,7 +82,7 @@ func testAccAppSyncDataSource_description(t *testing.T) {
 }
 
 func testAccAppSyncDataSource_DynamoDB_region(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.Test(t, resource.TestCase{
@@ -118,7 +117,7 @@ func testAccAppSyncDataSource_DynamoDB_region(t *testing.T) {
 }
 
 func testAccAppSyncDataSource_DynamoDB_useCallerCredentials(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.Test(t, resource.TestCase{
@@ -153,7 +152,7 @@ func testAccAppSyncDataSource_DynamoDB_useCallerCredentials(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_ElasticSearch_region(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_datasource.test"
 
 	// Keep this test Parallel as it takes considerably longer to run than any non-Elasticsearch tests.
@@ -189,7 +188,7 @@ func TestAccAppSyncDataSource_ElasticSearch_region(t *testing.T) {
 }
 
 func testAccAppSyncDataSource_HTTP_endpoint(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.Test(t, resource.TestCase{
@@ -226,7 +225,7 @@ func testAccAppSyncDataSource_HTTP_endpoint(t *testing.T) {
 }
 
 func testAccAppSyncDataSource_type(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.Test(t, resource.TestCase{
@@ -254,7 +253,7 @@ func testAccAppSyncDataSource_type(t *testing.T) {
 }
 
 func testAccAppSyncDataSource_Type_dynamoDB(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	dynamodbTableResourceName := "aws_dynamodb_table.test"
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_appsync_datasource.test"
@@ -286,7 +285,7 @@ func testAccAppSyncDataSource_Type_dynamoDB(t *testing.T) {
 }
 
 func TestAccAppSyncDataSource_Type_elasticSearch(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_appsync_datasource.test"
 
@@ -318,7 +317,7 @@ func TestAccAppSyncDataSource_Type_elasticSearch(t *testing.T) {
 }
 
 func testAccAppSyncDataSource_Type_http(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.Test(t, resource.TestCase{
@@ -346,7 +345,7 @@ func testAccAppSyncDataSource_Type_http(t *testing.T) {
 }
 
 func testAccAppSyncDataSource_Type_lambda(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	iamRoleResourceName := "aws_iam_role.test"
 	lambdaFunctionResourceName := "aws_lambda_function.test"
 	resourceName := "aws_appsync_datasource.test"
@@ -377,7 +376,7 @@ func testAccAppSyncDataSource_Type_lambda(t *testing.T) {
 }
 
 func testAccAppSyncDataSource_Type_none(t *testing.T) {
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_datasource.test"
 
 	resource.Test(t, resource.TestCase{
@@ -403,7 +402,7 @@ func testAccAppSyncDataSource_Type_none(t *testing.T) {
 }
 
 func testAccCheckDestroyDataSource(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_datasource" {
 			continue
@@ -447,7 +446,7 @@ func testAccCheckExistsDataSource(name string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 
 		input := &appsync.GetDataSourceInput{
 			ApiId: aws.String(apiID),
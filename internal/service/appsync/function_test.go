@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,9 +16,9 @@ import (
 )
 
 func testAccAppSyncFunction_basic(t *testing.T) {
-	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
-	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
-	rName3 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
+	rName1 := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
+	rName2 := fmt.Sprintf("tfexample%s", acctest.RandString(t, 8))
+	rName3 := fmt.Sprintf("tfexample%s", acctest.RandString(t, 8))
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
@@ -57,8 +56,8 @@ func testAccAppSyncFunction_basic(t *testing.T) {
 }
 
 func testAccAppSyncFunction_description(t *testing.T) {
-	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
-	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
+	rName1 := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
+	rName2 := fmt.Sprintf("tfexample%s", acctest.RandString(t, 8))
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
@@ -92,8 +91,8 @@ func testAccAppSyncFunction_description(t *testing.T) {
 }
 
 func testAccAppSyncFunction_responseMappingTemplate(t *testing.T) {
-	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
-	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
+	rName1 := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
+	rName2 := fmt.Sprintf("tfexample%s", acctest.RandString(t, 8))
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
@@ -119,8 +118,8 @@ func testAccAppSyncFunction_responseMappingTemplate(t *testing.T) {
 }
 
 func testAccAppSyncFunction_disappears(t *testing.T) {
-	rName1 := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
-	rName2 := fmt.Sprintf("tfexample%s", sdkacctest.RandString(8))
+	rName1 := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
+	rName2 := fmt.Sprintf("tfexample%s", acctest.RandString(t, 8))
 	resourceName := "aws_appsync_function.test"
 	var config appsync.FunctionConfiguration
 
@@ -143,7 +142,7 @@ func testAccAppSyncFunction_disappears(t *testing.T) {
 }
 
 func testAccCheckFunctionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_function" {
 			continue
@@ -177,7 +176,7 @@ func testAccCheckFunctionExists(name string, config *appsync.FunctionConfigurati
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 
 		apiID, functionID, err := tfappsync.DecodeFunctionID(rs.Primary.ID)
 		if err != nil {
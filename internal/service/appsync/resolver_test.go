@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func testAccAppSyncResolver_basic(t *testing.T) {
 	var resolver1 appsync.Resolver
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_resolver.test"
 
 	resource.Test(t, resource.TestCase{
@@ -48,7 +47,7 @@ func testAccAppSyncResolver_basic(t *testing.T) {
 func testAccAppSyncResolver_disappears(t *testing.T) {
 	var api1 appsync.GraphqlApi
 	var resolver1 appsync.Resolver
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	appsyncGraphqlApiResourceName := "aws_appsync_graphql_api.test"
 	resourceName := "aws_appsync_resolver.test"
 
@@ -73,7 +72,7 @@ func testAccAppSyncResolver_disappears(t *testing.T) {
 
 func testAccAppSyncResolver_dataSource(t *testing.T) {
 	var resolver1, resolver2 appsync.Resolver
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_resolver.test"
 
 	resource.Test(t, resource.TestCase{
@@ -107,7 +106,7 @@ func testAccAppSyncResolver_dataSource(t *testing.T) {
 
 func testAccAppSyncResolver_DataSource_lambda(t *testing.T) {
 	var resolver appsync.Resolver
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_resolver.test"
 
 	resource.Test(t, resource.TestCase{
@@ -134,7 +133,7 @@ func testAccAppSyncResolver_DataSource_lambda(t *testing.T) {
 
 func testAccAppSyncResolver_requestTemplate(t *testing.T) {
 	var resolver1, resolver2 appsync.Resolver
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_resolver.test"
 
 	resource.Test(t, resource.TestCase{
@@ -168,7 +167,7 @@ func testAccAppSyncResolver_requestTemplate(t *testing.T) {
 
 func testAccAppSyncResolver_responseTemplate(t *testing.T) {
 	var resolver1, resolver2 appsync.Resolver
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_resolver.test"
 
 	resource.Test(t, resource.TestCase{
@@ -202,7 +201,7 @@ func testAccAppSyncResolver_responseTemplate(t *testing.T) {
 
 func testAccAppSyncResolver_multipleResolvers(t *testing.T) {
 	var resolver appsync.Resolver
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_resolver.test"
 
 	resource.Test(t, resource.TestCase{
@@ -232,7 +231,7 @@ func testAccAppSyncResolver_multipleResolvers(t *testing.T) {
 
 func testAccAppSyncResolver_pipeline(t *testing.T) {
 	var resolver appsync.Resolver
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_resolver.test"
 
 	resource.Test(t, resource.TestCase{
@@ -260,7 +259,7 @@ func testAccAppSyncResolver_pipeline(t *testing.T) {
 
 func testAccAppSyncResolver_caching(t *testing.T) {
 	var resolver appsync.Resolver
-	rName := fmt.Sprintf("tfacctest%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tfacctest%d", acctest.RandInt(t))
 	resourceName := "aws_appsync_resolver.test"
 
 	resource.Test(t, resource.TestCase{
@@ -287,7 +286,7 @@ func testAccAppSyncResolver_caching(t *testing.T) {
 }
 
 func testAccCheckResolverDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_resolver" {
 			continue
@@ -334,7 +333,7 @@ func testAccCheckResolverExists(name string, resolver *appsync.Resolver) resourc
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 
 		input := &appsync.GetResolverInput{
 			ApiId:     aws.String(apiID),
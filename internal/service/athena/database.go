@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceDatabase() *schema.Resource {
@@ -91,7 +92,7 @@ func expandAthenaResultConfiguration(bucket string, encryptionConfigurationList
 }
 
 func resourceDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 
 	input := &athena.StartQueryExecutionInput{
 		QueryString:         aws.String(fmt.Sprintf("create database `%s`;", d.Get("name").(string))),
@@ -111,7 +112,7 @@ func resourceDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDatabaseRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 
 	input := &athena.GetDatabaseInput{
 		DatabaseName: aws.String(d.Get("name").(string)),
@@ -129,7 +130,7 @@ func resourceDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 
 	name := d.Get("name").(string)
 
@@ -186,7 +187,7 @@ func QueryExecutionResult(qeid string, conn *athena.Athena) (*athena.ResultSet,
 		Delay:      3 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	_, err := executionStateConf.WaitForState()
+	_, err := tfresource.WaitForState(executionStateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/s3"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,13 +16,13 @@ import (
 )
 
 func TestAccAthenaDatabase_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := sdkacctest.RandString(8)
+	rInt := acctest.RandInt(t)
+	dbName := acctest.RandString(t, 8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
 		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckDatabaseDestroy,
+		CheckDestroy: testAccCheckDatabaseDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAthenaDatabaseConfig(rInt, dbName, false),
@@ -36,13 +35,13 @@ func TestAccAthenaDatabase_basic(t *testing.T) {
 }
 
 func TestAccAthenaDatabase_encryption(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := sdkacctest.RandString(8)
+	rInt := acctest.RandInt(t)
+	dbName := acctest.RandString(t, 8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
 		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckDatabaseDestroy,
+		CheckDestroy: testAccCheckDatabaseDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAthenaDatabaseWithKMSConfig(rInt, dbName, false),
@@ -56,13 +55,13 @@ func TestAccAthenaDatabase_encryption(t *testing.T) {
 }
 
 func TestAccAthenaDatabase_nameStartsWithUnderscore(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := "_" + sdkacctest.RandString(8)
+	rInt := acctest.RandInt(t)
+	dbName := "_" + acctest.RandString(t, 8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
 		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckDatabaseDestroy,
+		CheckDestroy: testAccCheckDatabaseDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAthenaDatabaseConfig(rInt, dbName, false),
@@ -76,13 +75,13 @@ func TestAccAthenaDatabase_nameStartsWithUnderscore(t *testing.T) {
 }
 
 func TestAccAthenaDatabase_nameCantHaveUppercase(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := "A" + sdkacctest.RandString(8)
+	rInt := acctest.RandInt(t)
+	dbName := "A" + acctest.RandString(t, 8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
 		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckDatabaseDestroy,
+		CheckDestroy: testAccCheckDatabaseDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccAthenaDatabaseConfig(rInt, dbName, false),
@@ -93,13 +92,13 @@ func TestAccAthenaDatabase_nameCantHaveUppercase(t *testing.T) {
 }
 
 func TestAccAthenaDatabase_destroyFailsIfTablesExist(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := sdkacctest.RandString(8)
+	rInt := acctest.RandInt(t)
+	dbName := acctest.RandString(t, 8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
 		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckDatabaseDestroy,
+		CheckDestroy: testAccCheckDatabaseDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAthenaDatabaseConfig(rInt, dbName, false),
@@ -115,13 +114,13 @@ func TestAccAthenaDatabase_destroyFailsIfTablesExist(t *testing.T) {
 }
 
 func TestAccAthenaDatabase_forceDestroyAlwaysSucceeds(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	dbName := sdkacctest.RandString(8)
+	rInt := acctest.RandInt(t)
+	dbName := acctest.RandString(t, 8)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
 		Providers:    acctest.Providers,
-		CheckDestroy: testAccCheckDatabaseDestroy,
+		CheckDestroy: testAccCheckDatabaseDestroy(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAthenaDatabaseConfig(rInt, dbName, true),
@@ -136,15 +135,20 @@ func TestAccAthenaDatabase_forceDestroyAlwaysSucceeds(t *testing.T) {
 
 // StartQueryExecution requires OutputLocation but terraform destroy deleted S3 bucket as well.
 // So temporary S3 bucket as OutputLocation is created to confirm whether the database is actually deleted.
-func testAccCheckDatabaseDestroy(s *terraform.State) error {
-	athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
-	s3conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+func testAccCheckDatabaseDestroy(t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return testAccCheckDatabaseDestroyWithBucket(s, acctest.RandInt(t))
+	}
+}
+
+func testAccCheckDatabaseDestroyWithBucket(s *terraform.State, rInt int) error {
+	athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
+	s3conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_athena_database" {
 			continue
 		}
 
-		rInt := sdkacctest.RandInt()
 		bucketName := fmt.Sprintf("tf-test-athena-db-%d", rInt)
 		_, err := s3conn.CreateBucket(&s3.CreateBucketInput{
 			Bucket: aws.String(bucketName),
@@ -239,7 +243,7 @@ func testAccDatabaseCreateTables(dbName string) resource.TestCheckFunc {
 			return err
 		}
 
-		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -269,7 +273,7 @@ func testAccDatabaseDestroyTables(dbName string) resource.TestCheckFunc {
 			return err
 		}
 
-		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -298,7 +302,7 @@ func testAccCheckDatabaseDropFails(dbName string) resource.TestCheckFunc {
 			return err
 		}
 
-		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -24,7 +23,7 @@ func TestAccAthenaNamedQuery_basic(t *testing.T) {
 		CheckDestroy: testAccCheckNamedQueryDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAthenaNamedQueryConfig(sdkacctest.RandInt(), sdkacctest.RandString(5)),
+				Config: testAccAthenaNamedQueryConfig(acctest.RandInt(t), acctest.RandString(t, 5)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNamedQueryExists(resourceName),
 				),
@@ -48,7 +47,7 @@ func TestAccAthenaNamedQuery_withWorkGroup(t *testing.T) {
 		CheckDestroy: testAccCheckNamedQueryDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAthenaNamedWorkGroupQueryConfig(sdkacctest.RandInt(), sdkacctest.RandString(5)),
+				Config: testAccAthenaNamedWorkGroupQueryConfig(acctest.RandInt(t), acctest.RandString(t, 5)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNamedQueryExists(resourceName),
 				),
@@ -63,7 +62,7 @@ func TestAccAthenaNamedQuery_withWorkGroup(t *testing.T) {
 }
 
 func testAccCheckNamedQueryDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_athena_named_query" {
 			continue
@@ -94,7 +93,7 @@ func testAccCheckNamedQueryExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 
 		input := &athena.GetNamedQueryInput{
 			NamedQueryId: aws.String(rs.Primary.ID),
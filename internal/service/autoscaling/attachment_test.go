@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,7 +14,7 @@ import (
 
 func TestAccAutoScalingAttachment_elb(t *testing.T) {
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -59,7 +58,7 @@ func TestAccAutoScalingAttachment_elb(t *testing.T) {
 
 func TestAccAutoScalingAttachment_albTargetGroup(t *testing.T) {
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -102,7 +101,7 @@ func TestAccAutoScalingAttachment_albTargetGroup(t *testing.T) {
 }
 
 func testAccCheckAutocalingAttachmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_attachment" {
@@ -134,7 +133,7 @@ func testAccCheckAutocalingELBAttachmentExists(asgname string, loadBalancerCount
 			return fmt.Errorf("Not found: %s", asgname)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 		asg := rs.Primary.ID
 
 		actual, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
@@ -160,7 +159,7 @@ func testAccCheckAutocalingAlbAttachmentExists(asgname string, targetGroupCount
 			return fmt.Errorf("Not found: %s", asgname)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 		asg := rs.Primary.ID
 
 		actual, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
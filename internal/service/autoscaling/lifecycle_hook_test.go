@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -14,7 +13,7 @@ import (
 )
 
 func TestAccAutoScalingLifecycleHook_basic(t *testing.T) {
-	resourceName := fmt.Sprintf("tf-test-%s", sdkacctest.RandString(10))
+	resourceName := fmt.Sprintf("tf-test-%s", acctest.RandString(t, 10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -43,8 +42,8 @@ func TestAccAutoScalingLifecycleHook_basic(t *testing.T) {
 }
 
 func TestAccAutoScalingLifecycleHook_omitDefaultResult(t *testing.T) {
-	rName := sdkacctest.RandString(10)
-	rInt := sdkacctest.RandInt()
+	rName := acctest.RandString(t, 10)
+	rInt := acctest.RandInt(t)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
@@ -75,7 +74,7 @@ func testAccCheckLifecycleHookExists(n string) resource.TestCheckFunc {
 }
 
 func checkLifecycleHookExistsByName(asgName, hookName string) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 	params := &autoscaling.DescribeLifecycleHooksInput{
 		AutoScalingGroupName: aws.String(asgName),
 		LifecycleHookNames:   []*string{aws.String(hookName)},
@@ -92,7 +91,7 @@ func checkLifecycleHookExistsByName(asgName, hookName string) error {
 }
 
 func testAccCheckLifecycleHookDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_group" {
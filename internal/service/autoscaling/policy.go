@@ -304,7 +304,7 @@ func ResourcePolicy() *schema.Resource {
 }
 
 func resourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	params, err := getPutScalingPolicyInput(d)
 	log.Printf("[DEBUG] AutoScaling PutScalingPolicy on Create: %#v", params)
@@ -363,7 +363,7 @@ func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	params, inputErr := getPutScalingPolicyInput(d)
 	log.Printf("[DEBUG] AutoScaling PutScalingPolicy on Update: %#v", params)
@@ -380,7 +380,7 @@ func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	p, err := getPolicy(d, meta)
 	if err != nil {
 		return err
@@ -511,7 +511,7 @@ func getPutScalingPolicyInput(d *schema.ResourceData) (autoscaling.PutScalingPol
 }
 
 func getPolicy(d *schema.ResourceData, meta interface{}) (*autoscaling.ScalingPolicy, error) {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	params := autoscaling.DescribePoliciesInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
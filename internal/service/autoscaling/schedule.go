@@ -103,7 +103,7 @@ func resourceScheduleImport(d *schema.ResourceData, meta interface{}) ([]*schema
 }
 
 func resourceScheduleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	params := &autoscaling.PutScheduledUpdateGroupActionInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
 		ScheduledActionName:  aws.String(d.Get("scheduled_action_name").(string)),
@@ -212,7 +212,7 @@ func resourceScheduleRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceScheduleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	params := &autoscaling.DeleteScheduledActionInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
@@ -229,7 +229,7 @@ func resourceScheduleDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceASGScheduledActionRetrieve(d *schema.ResourceData, meta interface{}) (*autoscaling.ScheduledUpdateGroupAction, bool, error) {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	params := &autoscaling.DescribeScheduledActionsInput{
 		AutoScalingGroupName: aws.String(d.Get("autoscaling_group_name").(string)),
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccAutoScalingSchedule_basic(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
 	start := testAccScheduleValidStart(t)
 	end := testAccScheduleValidEnd(t)
 
@@ -57,7 +56,7 @@ func TestAccAutoScalingSchedule_basic(t *testing.T) {
 
 func TestAccAutoScalingSchedule_disappears(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
 	start := testAccScheduleValidStart(t)
 	end := testAccScheduleValidEnd(t)
 
@@ -81,7 +80,7 @@ func TestAccAutoScalingSchedule_disappears(t *testing.T) {
 
 func testAccCheckScalingScheduleDisappears(schedule *autoscaling.ScheduledUpdateGroupAction) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 		params := &autoscaling.DeleteScheduledActionInput{
 			AutoScalingGroupName: schedule.AutoScalingGroupName,
 			ScheduledActionName:  schedule.ScheduledActionName,
@@ -94,7 +93,7 @@ func testAccCheckScalingScheduleDisappears(schedule *autoscaling.ScheduledUpdate
 func TestAccAutoScalingSchedule_recurrence(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
 
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
 
 	scheduledActionName := "foobar"
 	resourceName := fmt.Sprintf("aws_autoscaling_schedule.%s", scheduledActionName)
@@ -126,7 +125,7 @@ func TestAccAutoScalingSchedule_recurrence(t *testing.T) {
 func TestAccAutoScalingSchedule_zeroValues(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
 
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
 	start := testAccScheduleValidStart(t)
 	end := testAccScheduleValidEnd(t)
 
@@ -159,7 +158,7 @@ func TestAccAutoScalingSchedule_zeroValues(t *testing.T) {
 func TestAccAutoScalingSchedule_negativeOne(t *testing.T) {
 	var schedule autoscaling.ScheduledUpdateGroupAction
 
-	rName := fmt.Sprintf("tf-test-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-%d", acctest.RandInt(t))
 	start := testAccScheduleValidStart(t)
 	end := testAccScheduleValidEnd(t)
 
@@ -216,7 +215,7 @@ func testAccCheckScalingScheduleExists(n string, policy *autoscaling.ScheduledUp
 		}
 
 		autoScalingGroup := rs.Primary.Attributes["autoscaling_group_name"]
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 		params := &autoscaling.DescribeScheduledActionsInput{
 			AutoScalingGroupName: aws.String(autoScalingGroup),
 			ScheduledActionNames: []*string{aws.String(rs.Primary.ID)},
@@ -237,7 +236,7 @@ func testAccCheckScalingScheduleExists(n string, policy *autoscaling.ScheduledUp
 }
 
 func testAccCheckScheduleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_schedule" {
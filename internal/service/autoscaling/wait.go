@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -34,7 +35,7 @@ func waitInstanceRefreshCancelled(conn *autoscaling.AutoScaling, asgName, instan
 		Timeout: instanceRefreshCancelledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
 		return v, err
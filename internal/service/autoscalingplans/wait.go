@@ -25,7 +25,7 @@ func waitScalingPlanCreated(conn *autoscalingplans.AutoScalingPlans, scalingPlan
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*autoscalingplans.ScalingPlan); ok {
 		if statusCode := aws.StringValue(output.StatusCode); statusCode == autoscalingplans.ScalingPlanStatusCodeCreationFailed {
@@ -47,7 +47,7 @@ func waitScalingPlanDeleted(conn *autoscalingplans.AutoScalingPlans, scalingPlan
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*autoscalingplans.ScalingPlan); ok {
 		if statusCode := aws.StringValue(output.StatusCode); statusCode == autoscalingplans.ScalingPlanStatusCodeDeletionFailed {
@@ -69,7 +69,7 @@ func waitScalingPlanUpdated(conn *autoscalingplans.AutoScalingPlans, scalingPlan
 		Delay:   10 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*autoscalingplans.ScalingPlan); ok {
 		if statusCode := aws.StringValue(output.StatusCode); statusCode == autoscalingplans.ScalingPlanStatusCodeUpdateFailed {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -65,7 +66,7 @@ func ResourceJobQueue() *schema.Resource {
 }
 
 func resourceJobQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	input := batch.CreateJobQueueInput{
@@ -94,7 +95,7 @@ func resourceJobQueueCreate(d *schema.ResourceData, meta interface{}) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for JobQueue state to be \"VALID\": %s", err)
 	}
@@ -107,7 +108,7 @@ func resourceJobQueueCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceJobQueueRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -156,7 +157,7 @@ func resourceJobQueueRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceJobQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 
 	if d.HasChanges("compute_environments", "priority", "state") {
 		name := d.Get("name").(string)
@@ -179,7 +180,7 @@ func resourceJobQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 			MinTimeout: 3 * time.Second,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = tfresource.WaitForState(stateConf)
 		if err != nil {
 			return err
 		}
@@ -197,7 +198,7 @@ func resourceJobQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceJobQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 	name := d.Get("name").(string)
 
 	log.Printf("[DEBUG] Disabling Batch Job Queue %s", name)
@@ -242,7 +243,7 @@ func DeleteJobQueue(jobQueue string, conn *batch.Batch) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateChangeConf.WaitForState()
+	_, err = tfresource.WaitForState(stateChangeConf)
 	return err
 }
 
@@ -263,7 +264,7 @@ func DisableJobQueue(jobQueue string, conn *batch.Batch) error {
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	_, err = stateChangeConf.WaitForState()
+	_, err = tfresource.WaitForState(stateChangeConf)
 	return err
 }
 
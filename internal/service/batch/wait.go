@@ -18,7 +18,7 @@ func waitComputeEnvironmentCreated(conn *batch.Batch, name string, timeout time.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -39,7 +39,7 @@ func waitComputeEnvironmentDeleted(conn *batch.Batch, name string, timeout time.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -60,7 +60,7 @@ func waitComputeEnvironmentDisabled(conn *batch.Batch, name string, timeout time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		if status := aws.StringValue(output.Status); status == batch.CEStatusInvalid {
@@ -81,7 +81,7 @@ func waitComputeEnvironmentUpdated(conn *batch.Batch, name string, timeout time.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*batch.ComputeEnvironmentDetail); ok {
 		return v, err
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/budgets"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -26,7 +27,7 @@ func waitActionAvailable(conn *budgets.Budgets, accountID, actionID, budgetName
 		Timeout: actionAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*budgets.Action); ok {
 		return v, err
@@ -76,7 +76,7 @@ func ResourceEnvironmentEC2() *schema.Resource {
 }
 
 func resourceEnvironmentEC2Create(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Cloud9Conn
+	conn := meta.(*conns.AWSClient).Cloud9Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -148,7 +148,7 @@ func resourceEnvironmentEC2Create(d *schema.ResourceData, meta interface{}) erro
 			return out, status, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(&stateConf)
 	if err != nil {
 		return err
 	}
@@ -157,7 +157,7 @@ func resourceEnvironmentEC2Create(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceEnvironmentEC2Read(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Cloud9Conn
+	conn := meta.(*conns.AWSClient).Cloud9Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -211,7 +211,7 @@ func resourceEnvironmentEC2Read(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceEnvironmentEC2Update(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Cloud9Conn
+	conn := meta.(*conns.AWSClient).Cloud9Conn()
 
 	input := cloud9.UpdateEnvironmentInput{
 		Description:   aws.String(d.Get("description").(string)),
@@ -241,7 +241,7 @@ func resourceEnvironmentEC2Update(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceEnvironmentEC2Delete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Cloud9Conn
+	conn := meta.(*conns.AWSClient).Cloud9Conn()
 
 	_, err := conn.DeleteEnvironment(&cloud9.DeleteEnvironmentInput{
 		EnvironmentId: aws.String(d.Id()),
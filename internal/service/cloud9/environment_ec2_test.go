@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloud9"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,8 +18,8 @@ import (
 func TestAccCloud9EnvironmentEC2_basic(t *testing.T) {
 	var conf cloud9.Environment
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-updated")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rNameUpdated := acctest.RandomWithPrefix(t, "tf-acc-test-updated")
 	resourceName := "aws_cloud9_environment_ec2.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -64,11 +63,11 @@ func TestAccCloud9EnvironmentEC2_basic(t *testing.T) {
 func TestAccCloud9EnvironmentEC2_allFields(t *testing.T) {
 	var conf cloud9.Environment
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-updated")
-	description := sdkacctest.RandomWithPrefix("Tf Acc Test")
-	uDescription := sdkacctest.RandomWithPrefix("Tf Acc Test Updated")
-	userName := sdkacctest.RandomWithPrefix("tf_acc_cloud9_env")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rNameUpdated := acctest.RandomWithPrefix(t, "tf-acc-test-updated")
+	description := acctest.RandomWithPrefix(t, "Tf Acc Test")
+	uDescription := acctest.RandomWithPrefix(t, "Tf Acc Test Updated")
+	userName := acctest.RandomWithPrefix(t, "tf_acc_cloud9_env")
 	resourceName := "aws_cloud9_environment_ec2.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -112,7 +111,7 @@ func TestAccCloud9EnvironmentEC2_allFields(t *testing.T) {
 func TestAccCloud9EnvironmentEC2_tags(t *testing.T) {
 	var conf cloud9.Environment
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloud9_environment_ec2.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -159,7 +158,7 @@ func TestAccCloud9EnvironmentEC2_tags(t *testing.T) {
 func TestAccCloud9EnvironmentEC2_disappears(t *testing.T) {
 	var conf cloud9.Environment
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_cloud9_environment_ec2.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -191,7 +190,7 @@ func testAccCheckEnvironmentEC2Exists(n string, res *cloud9.Environment) resourc
 			return fmt.Errorf("No Cloud9 Environment EC2 ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn()
 
 		out, err := conn.DescribeEnvironments(&cloud9.DescribeEnvironmentsInput{
 			EnvironmentIds: []*string{aws.String(rs.Primary.ID)},
@@ -215,7 +214,7 @@ func testAccCheckEnvironmentEC2Exists(n string, res *cloud9.Environment) resourc
 
 func testAccCheckEnvironmentEC2Disappears(res *cloud9.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn()
 
 		_, err := conn.DeleteEnvironment(&cloud9.DeleteEnvironmentInput{
 			EnvironmentId: res.Id,
@@ -251,7 +250,7 @@ func testAccCheckEnvironmentEC2Disappears(res *cloud9.Environment) resource.Test
 }
 
 func testAccCheckEnvironmentEC2Destroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Cloud9Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloud9_environment_ec2" {
@@ -19,7 +19,7 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
 
 	if output, ok := outputRaw.(*cloudcontrolapi.ProgressEvent); ok {
 		if operationStatus := aws.StringValue(output.OperationStatus); operationStatus == cloudcontrolapi.OperationStatusFailed {
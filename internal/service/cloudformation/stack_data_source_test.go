@@ -6,13 +6,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccCloudFormationStackDataSource_DataSource_basic(t *testing.T) {
-	stackName := sdkacctest.RandomWithPrefix("tf-acc-ds-basic")
+	stackName := acctest.RandomWithPrefix(t, "tf-acc-ds-basic")
 	resourceName := "data.aws_cloudformation_stack.network"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -98,7 +97,7 @@ data "aws_cloudformation_stack" "network" {
 }
 
 func TestAccCloudFormationStackDataSource_DataSource_yaml(t *testing.T) {
-	stackName := sdkacctest.RandomWithPrefix("tf-acc-ds-yaml")
+	stackName := acctest.RandomWithPrefix(t, "tf-acc-ds-yaml")
 	resourceName := "data.aws_cloudformation_stack.yaml"
 
 	resource.ParallelTest(t, resource.TestCase{
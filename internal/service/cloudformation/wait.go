@@ -25,7 +25,7 @@ func WaitChangeSetCreated(conn *cloudformation.CloudFormation, stackID, changeSe
 		Refresh: StatusChangeSet(conn, stackID, changeSetName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(&stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.DescribeChangeSetOutput); ok {
 		if status := aws.StringValue(output.Status); status == cloudformation.ChangeSetStatusFailed {
@@ -65,7 +65,7 @@ func WaitStackSetOperationSucceeded(conn *cloudformation.CloudFormation, stackSe
 		Delay:   stackSetOperationDelay,
 	}
 
-	outputRaw, waitErr := stateConf.WaitForState()
+	outputRaw, waitErr := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.StackSetOperation); ok {
 		if status := aws.StringValue(output.Status); status == cloudformation.StackSetOperationStatusFailed {
@@ -136,7 +136,7 @@ func WaitStackCreated(conn *cloudformation.CloudFormation, stackID, requestToken
 		Refresh:    StatusStack(conn, stackID),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(&stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +197,7 @@ func WaitStackUpdated(conn *cloudformation.CloudFormation, stackID, requestToken
 		Refresh:    StatusStack(conn, stackID),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(&stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +236,7 @@ func WaitStackDeleted(conn *cloudformation.CloudFormation, stackID, requestToken
 		Refresh:    StatusStack(conn, stackID),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(&stateConf)
 	if err != nil {
 		return nil, err
 	}
@@ -271,7 +271,7 @@ func WaitTypeRegistrationProgressStatusComplete(ctx context.Context, conn *cloud
 		Timeout: TypeRegistrationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*cloudformation.DescribeTypeRegistrationOutput); ok {
 		return output, err
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitClusterActive(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Cluster, error) {
@@ -20,7 +21,7 @@ func waitClusterActive(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Dura
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
 		return v, err
@@ -39,7 +40,7 @@ func waitClusterDeleted(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Dur
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
 		return v, err
@@ -61,7 +62,7 @@ func waitClusterUninitialized(conn *cloudhsmv2.CloudHSMV2, id string, timeout ti
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
 		return v, err
@@ -80,7 +81,7 @@ func waitHSMActive(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Hsm); ok {
 		return v, err
@@ -99,7 +100,7 @@ func waitHSMDeleted(conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*cloudhsmv2.Hsm); ok {
 		return v, err
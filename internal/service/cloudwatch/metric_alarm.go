@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -159,9 +160,11 @@ func ResourceMetricAlarm() *schema.Resource {
 				ValidateFunc:  validation.StringInSlice(cloudwatch.Statistic_Values(), false),
 			},
 			"threshold": {
-				Type:          schema.TypeFloat,
-				Optional:      true,
-				ConflictsWith: []string{"threshold_metric_id"},
+				Type:             nullable.TypeNullableFloat,
+				Optional:         true,
+				ConflictsWith:    []string{"threshold_metric_id"},
+				ValidateFunc:     nullable.ValidateTypeStringNullableFloat,
+				DiffSuppressFunc: nullable.DiffSuppressNullableFloat,
 			},
 			"threshold_metric_id": {
 				Type:          schema.TypeString,
@@ -284,7 +287,7 @@ func validMetricAlarm(d *schema.ResourceData) error {
 }
 
 func resourceMetricAlarmCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchConn
+	conn := meta.(*conns.AWSClient).CloudWatchConn()
 
 	err := validMetricAlarm(d)
 	if err != nil {
@@ -304,7 +307,7 @@ func resourceMetricAlarmCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMetricAlarmRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchConn
+	conn := meta.(*conns.AWSClient).CloudWatchConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -353,7 +356,7 @@ func resourceMetricAlarmRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("period", resp.Period)
 	d.Set("statistic", resp.Statistic)
-	d.Set("threshold", resp.Threshold)
+	d.Set("threshold", flex.FlattenNullableFloat64(resp.Threshold))
 	d.Set("threshold_metric_id", resp.ThresholdMetricId)
 	d.Set("unit", resp.Unit)
 	d.Set("extended_statistic", resp.ExtendedStatistic)
@@ -385,7 +388,7 @@ func resourceMetricAlarmRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMetricAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchConn
+	conn := meta.(*conns.AWSClient).CloudWatchConn()
 	params := getPutMetricAlarmInput(d, meta)
 
 	log.Printf("[DEBUG] Updating CloudWatch Metric Alarm: %#v", params)
@@ -408,7 +411,7 @@ func resourceMetricAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceMetricAlarmDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchConn
+	conn := meta.(*conns.AWSClient).CloudWatchConn()
 	params := cloudwatch.DeleteAlarmsInput{
 		AlarmNames: []*string{aws.String(d.Id())},
 	}
@@ -479,8 +482,8 @@ func getPutMetricAlarmInput(d *schema.ResourceData, meta interface{}) cloudwatch
 
 	if v, ok := d.GetOk("threshold_metric_id"); ok {
 		params.ThresholdMetricId = aws.String(v.(string))
-	} else {
-		params.Threshold = aws.Float64(d.Get("threshold").(float64))
+	} else if v := flex.ExpandNullableFloat64(nullable.Float(d.Get("threshold").(string))); v != nil {
+		params.Threshold = v
 	}
 
 	if v, ok := d.GetOk("alarm_actions"); ok {
@@ -0,0 +1,60 @@
+package cloudwatch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func TestGetPutMetricAlarmInputThreshold(t *testing.T) {
+	cases := []struct {
+		name      string
+		raw       map[string]interface{}
+		threshold *float64
+	}{
+		{
+			name:      "unset",
+			raw:       map[string]interface{}{},
+			threshold: nil,
+		},
+		{
+			name:      "zero",
+			raw:       map[string]interface{}{"threshold": "0"},
+			threshold: aws.Float64(0),
+		},
+		{
+			name:      "non-zero",
+			raw:       map[string]interface{}{"threshold": "80.5"},
+			threshold: aws.Float64(80.5),
+		},
+		{
+			name:      "threshold metric ID",
+			raw:       map[string]interface{}{"threshold_metric_id": "e1"},
+			threshold: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.raw["alarm_name"] = "test"
+			tc.raw["comparison_operator"] = "GreaterThanThreshold"
+			tc.raw["evaluation_periods"] = 1
+			d := schema.TestResourceDataRaw(t, ResourceMetricAlarm().Schema, tc.raw)
+
+			params := getPutMetricAlarmInput(d, &conns.AWSClient{})
+
+			if got, want := params.Threshold, tc.threshold; (got == nil) != (want == nil) || aws.Float64Value(got) != aws.Float64Value(want) {
+				t.Fatalf("expected Threshold %v, got %v", aws.Float64Value(want), aws.Float64Value(got))
+			}
+			if params.Threshold == nil {
+				return
+			}
+			if got, want := flex.FlattenNullableFloat64(params.Threshold), tc.raw["threshold"]; got != want {
+				t.Errorf("expected threshold to round-trip as %q, got %q", want, got)
+			}
+		})
+	}
+}
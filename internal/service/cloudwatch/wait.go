@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -27,7 +28,7 @@ func WaitMetricStreamDeleted(ctx context.Context, conn *cloudwatch.CloudWatch, n
 		Timeout: MetricStreamDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
 
 	if v, ok := outputRaw.(*cloudwatch.GetMetricStreamOutput); ok {
 		return v, err
@@ -48,7 +49,7 @@ func WaitMetricStreamReady(ctx context.Context, conn *cloudwatch.CloudWatch, nam
 		Timeout: MetricStreamReadyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
 
 	if v, ok := outputRaw.(*cloudwatch.GetMetricStreamOutput); ok {
 		return v, err
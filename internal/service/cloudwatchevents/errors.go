@@ -0,0 +1,17 @@
+package cloudwatchevents
+
+import (
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// errorClassifier classifies the errors returned by the CloudWatch Events API.
+var errorClassifier = tfresource.RegisterServiceErrors("cloudwatchevents", tfresource.ServiceErrors{
+	NotFound: []tfresource.ErrorMatcher{
+		{Code: events.ErrCodeResourceNotFoundException},
+	},
+	Unsupported: []tfresource.ErrorMatcher{
+		// For example from us-gov-west-1 CloudWatch Events archive.
+		{Code: "UnknownOperationException", Message: "Operation is disabled in this region"},
+	},
+})
@@ -5,7 +5,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -16,7 +15,7 @@ func FindConnectionByName(conn *events.CloudWatchEvents, name string) (*events.D
 
 	output, err := conn.DescribeConnection(input)
 
-	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+	if errorClassifier.IsNotFoundError(err, events.ErrCodeResourceNotFoundException) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -48,7 +47,7 @@ func FindRuleByEventBusAndRuleNames(conn *events.CloudWatchEvents, eventBusName,
 
 	output, err := conn.DescribeRule(&input)
 
-	if tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+	if errorClassifier.IsNotFoundError(err, events.ErrCodeResourceNotFoundException) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
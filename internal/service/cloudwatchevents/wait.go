@@ -5,6 +5,7 @@ import (
 
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -21,7 +22,7 @@ func waitConnectionCreated(conn *events.CloudWatchEvents, id string) (*events.De
 		Timeout: connectionCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*events.DescribeConnectionOutput); ok {
 		return v, err
@@ -38,7 +39,7 @@ func waitConnectionDeleted(conn *events.CloudWatchEvents, id string) (*events.De
 		Timeout: connectionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*events.DescribeConnectionOutput); ok {
 		return v, err
@@ -55,7 +56,7 @@ func waitConnectionUpdated(conn *events.CloudWatchEvents, id string) (*events.De
 		Timeout: connectionUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*events.DescribeConnectionOutput); ok {
 		return v, err
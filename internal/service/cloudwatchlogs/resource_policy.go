@@ -42,7 +42,7 @@ func ResourceResourcePolicy() *schema.Resource {
 }
 
 func resourceResourcePolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 
 	policyName := d.Get("policy_name").(string)
 
@@ -63,7 +63,7 @@ func resourceResourcePolicyPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 	policyName := d.Get("policy_name").(string)
 	resourcePolicy, exists, err := LookupResourcePolicy(conn, policyName, nil)
 	if err != nil {
@@ -81,7 +81,7 @@ func resourceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 	input := cloudwatchlogs.DeleteResourcePolicyInput{
 		PolicyName: aws.String(d.Id()),
 	}
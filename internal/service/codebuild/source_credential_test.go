@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccCodeBuildSourceCredential_basic(t *testing.T) {
 	var sourceCredentialsInfo codebuild.SourceCredentialsInfo
-	token := sdkacctest.RandomWithPrefix("token")
+	token := acctest.RandomWithPrefix(t, "token")
 	resourceName := "aws_codebuild_source_credential.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -55,7 +54,7 @@ func TestAccCodeBuildSourceCredential_basic(t *testing.T) {
 
 func TestAccCodeBuildSourceCredential_basicAuth(t *testing.T) {
 	var sourceCredentialsInfo codebuild.SourceCredentialsInfo
-	token := sdkacctest.RandomWithPrefix("token")
+	token := acctest.RandomWithPrefix(t, "token")
 	resourceName := "aws_codebuild_source_credential.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -92,7 +91,7 @@ func TestAccCodeBuildSourceCredential_basicAuth(t *testing.T) {
 }
 
 func testAccCheckSourceCredentialDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codebuild_source_credential" {
@@ -124,7 +123,7 @@ func testAccCheckSourceCredentialExists(name string, sourceCredential *codebuild
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildConn()
 
 		resp, err := conn.ListSourceCredentials(&codebuild.ListSourceCredentialsInput{})
 		if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -21,7 +22,7 @@ func waitReportGroupDeleted(conn *codebuild.CodeBuild, arn string) (*codebuild.R
 		Timeout: reportGroupDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*codebuild.ReportGroup); ok {
 		return output, err
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/codedeploy"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -23,7 +22,7 @@ func TestAccCodeDeployDeploymentGroup_basic(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -96,7 +95,7 @@ func TestAccCodeDeployDeploymentGroup_Basic_tagSet(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -167,7 +166,7 @@ func TestAccCodeDeployDeploymentGroup_Basic_tagSet(t *testing.T) {
 func TestAccCodeDeployDeploymentGroup_onPremiseTag(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -208,7 +207,7 @@ func TestAccCodeDeployDeploymentGroup_onPremiseTag(t *testing.T) {
 func TestAccCodeDeployDeploymentGroup_disappears(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -231,7 +230,7 @@ func TestAccCodeDeployDeploymentGroup_disappears(t *testing.T) {
 func TestAccCodeDeployDeploymentGroup_Disappears_app(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -255,7 +254,7 @@ func TestAccCodeDeployDeploymentGroup_tags(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -302,7 +301,7 @@ func TestAccCodeDeployDeploymentGroup_Trigger_basic(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -351,7 +350,7 @@ func TestAccCodeDeployDeploymentGroup_Trigger_multiple(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -412,7 +411,7 @@ func TestAccCodeDeployDeploymentGroup_AutoRollback_create(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -447,7 +446,7 @@ func TestAccCodeDeployDeploymentGroup_AutoRollback_update(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -496,7 +495,7 @@ func TestAccCodeDeployDeploymentGroup_AutoRollback_delete(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -539,7 +538,7 @@ func TestAccCodeDeployDeploymentGroup_AutoRollback_disable(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -587,7 +586,7 @@ func TestAccCodeDeployDeploymentGroup_Alarm_create(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -624,7 +623,7 @@ func TestAccCodeDeployDeploymentGroup_Alarm_update(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -677,7 +676,7 @@ func TestAccCodeDeployDeploymentGroup_Alarm_delete(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -722,7 +721,7 @@ func TestAccCodeDeployDeploymentGroup_Alarm_disable(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -775,7 +774,7 @@ func TestAccCodeDeployDeploymentGroup_DeploymentStyle_default(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -809,7 +808,7 @@ func TestAccCodeDeployDeploymentGroup_DeploymentStyle_create(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -851,7 +850,7 @@ func TestAccCodeDeployDeploymentGroup_DeploymentStyle_update(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -898,7 +897,7 @@ func TestAccCodeDeployDeploymentGroup_DeploymentStyle_delete(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -944,7 +943,7 @@ func TestAccCodeDeployDeploymentGroup_LoadBalancerInfo_create(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -979,7 +978,7 @@ func TestAccCodeDeployDeploymentGroup_LoadBalancerInfo_update(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1027,7 +1026,7 @@ func TestAccCodeDeployDeploymentGroup_LoadBalancerInfo_delete(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1070,7 +1069,7 @@ func TestAccCodeDeployDeploymentGroup_LoadBalancerInfoTargetGroupInfo_create(t *
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1106,7 +1105,7 @@ func TestAccCodeDeployDeploymentGroup_LoadBalancerInfoTargetGroupInfo_update(t *
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1154,7 +1153,7 @@ func TestAccCodeDeployDeploymentGroup_LoadBalancerInfoTargetGroupInfo_delete(t *
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1197,7 +1196,7 @@ func TestAccCodeDeployDeploymentGroup_InPlaceDeploymentWithTrafficControl_create
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1239,7 +1238,7 @@ func TestAccCodeDeployDeploymentGroup_InPlaceDeploymentWithTrafficControl_update
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1315,7 +1314,7 @@ func TestAccCodeDeployDeploymentGroup_BlueGreenDeployment_create(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1371,7 +1370,7 @@ func TestAccCodeDeployDeploymentGroup_BlueGreenDeployment_updateWithASG(t *testi
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1439,7 +1438,7 @@ func TestAccCodeDeployDeploymentGroup_BlueGreenDeployment_update(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1521,7 +1520,7 @@ func TestAccCodeDeployDeploymentGroup_BlueGreenDeployment_delete(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1589,7 +1588,7 @@ func TestAccCodeDeployDeploymentGroup_BlueGreenDeployment_complete(t *testing.T)
 	var group codedeploy.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -1691,7 +1690,7 @@ func TestAccCodeDeployDeploymentGroup_BlueGreenDeployment_complete(t *testing.T)
 
 func TestAccCodeDeployDeploymentGroup_ECS_blueGreen(t *testing.T) {
 	var group codedeploy.DeploymentGroupInfo
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 5))
 	ecsClusterResourceName := "aws_ecs_cluster.test"
 	ecsServiceResourceName := "aws_ecs_service.test"
 	lbTargetGroupBlueResourceName := "aws_lb_target_group.blue"
@@ -2277,7 +2276,7 @@ func testAccCheckCodeDeployDeploymentGroupTriggerTargetArn(group *codedeploy.Dep
 }
 
 func testAccCheckDeploymentGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeDeployConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeDeployConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codedeploy_deployment_group" {
@@ -2312,7 +2311,7 @@ func testAccCheckDeploymentGroupExists(name string, group *codedeploy.Deployment
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeDeployConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeDeployConn()
 
 		resp, err := conn.GetDeploymentGroup(&codedeploy.GetDeploymentGroupInput{
 			ApplicationName:     aws.String(rs.Primary.Attributes["app_name"]),
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/codestarconnections"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -24,7 +25,7 @@ func waitHostPendingOrAvailable(conn *codestarconnections.CodeStarConnections, h
 		Timeout: hostCreationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*codestarconnections.Host); ok {
 		return output, err
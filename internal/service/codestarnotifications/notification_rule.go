@@ -128,7 +128,7 @@ func expandCodeStarNotificationsNotificationRuleTargets(targetsData []interface{
 }
 
 func resourceNotificationRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeStarNotificationsConn
+	conn := meta.(*conns.AWSClient).CodeStarNotificationsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -156,7 +156,7 @@ func resourceNotificationRuleCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNotificationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeStarNotificationsConn
+	conn := meta.(*conns.AWSClient).CodeStarNotificationsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -266,7 +266,7 @@ func cleanupCodeStarNotificationsNotificationRuleTargets(conn *codestarnotificat
 }
 
 func resourceNotificationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeStarNotificationsConn
+	conn := meta.(*conns.AWSClient).CodeStarNotificationsConn()
 
 	params := &codestarnotifications.UpdateNotificationRuleInput{
 		Arn:          aws.String(d.Id()),
@@ -299,7 +299,7 @@ func resourceNotificationRuleUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNotificationRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodeStarNotificationsConn
+	conn := meta.(*conns.AWSClient).CodeStarNotificationsConn()
 
 	_, err := conn.DeleteNotificationRule(&codestarnotifications.DeleteNotificationRuleInput{
 		Arn: aws.String(d.Id()),
@@ -1,3 +1,4 @@
+//go:generate go run ../../generate/listpages/main.go -ListOps=ListUserPools -ListAll -Filter -PageSizeField=MaxResults -PageSize=60
 //go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
@@ -0,0 +1,52 @@
+// Code generated by "internal/generate/listpages/main.go -ListOps=ListUserPools -ListAll -Filter -PageSizeField=MaxResults -PageSize=60"; DO NOT EDIT.
+
+package cognitoidp
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+)
+
+func listAllUserPools(ctx context.Context, conn *cognitoidentityprovider.CognitoIdentityProvider, input *cognitoidentityprovider.ListUserPoolsInput) ([]*cognitoidentityprovider.UserPoolDescriptionType, error) {
+	return listAllUserPoolsWithFilter(ctx, conn, input, nil)
+}
+
+// listAllUserPoolsWithFilter returns the listed items for which filter returns true, or all items if filter is nil.
+func listAllUserPoolsWithFilter(ctx context.Context, conn *cognitoidentityprovider.CognitoIdentityProvider, input *cognitoidentityprovider.ListUserPoolsInput, filter func(*cognitoidentityprovider.UserPoolDescriptionType) bool) ([]*cognitoidentityprovider.UserPoolDescriptionType, error) {
+	if input.MaxResults == nil {
+		// Copy the input so that the caller's input keeps its page size.
+		copied := *input
+		copied.MaxResults = aws.Int64(60)
+		input = &copied
+	}
+
+	var output []*cognitoidentityprovider.UserPoolDescriptionType
+
+	err := conn.ListUserPoolsPagesWithContext(ctx, input, func(page *cognitoidentityprovider.ListUserPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.UserPools {
+			if v == nil {
+				continue
+			}
+
+			if filter != nil && !filter(v) {
+				continue
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
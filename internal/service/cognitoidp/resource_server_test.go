@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,10 +17,10 @@ import (
 
 func TestAccCognitoIDPResourceServer_basic(t *testing.T) {
 	var resourceServer cognitoidentityprovider.ResourceServerType
-	identifier := fmt.Sprintf("tf-acc-test-resource-server-id-%s", sdkacctest.RandString(10))
-	name1 := fmt.Sprintf("tf-acc-test-resource-server-name-%s", sdkacctest.RandString(10))
-	name2 := fmt.Sprintf("tf-acc-test-resource-server-name-%s", sdkacctest.RandString(10))
-	poolName := fmt.Sprintf("tf-acc-test-pool-%s", sdkacctest.RandString(10))
+	identifier := fmt.Sprintf("tf-acc-test-resource-server-id-%s", acctest.RandString(t, 10))
+	name1 := fmt.Sprintf("tf-acc-test-resource-server-name-%s", acctest.RandString(t, 10))
+	name2 := fmt.Sprintf("tf-acc-test-resource-server-name-%s", acctest.RandString(t, 10))
+	poolName := fmt.Sprintf("tf-acc-test-pool-%s", acctest.RandString(t, 10))
 	resourceName := "aws_cognito_resource_server.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -61,9 +60,9 @@ func TestAccCognitoIDPResourceServer_basic(t *testing.T) {
 
 func TestAccCognitoIDPResourceServer_scope(t *testing.T) {
 	var resourceServer cognitoidentityprovider.ResourceServerType
-	identifier := fmt.Sprintf("tf-acc-test-resource-server-id-%s", sdkacctest.RandString(10))
-	name := fmt.Sprintf("tf-acc-test-resource-server-name-%s", sdkacctest.RandString(10))
-	poolName := fmt.Sprintf("tf-acc-test-pool-%s", sdkacctest.RandString(10))
+	identifier := fmt.Sprintf("tf-acc-test-resource-server-id-%s", acctest.RandString(t, 10))
+	name := fmt.Sprintf("tf-acc-test-resource-server-name-%s", acctest.RandString(t, 10))
+	poolName := fmt.Sprintf("tf-acc-test-pool-%s", acctest.RandString(t, 10))
 	resourceName := "aws_cognito_resource_server.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -117,7 +116,7 @@ func testAccCheckResourceServerExists(n string, resourceServer *cognitoidentityp
 			return errors.New("No Cognito Resource Server ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
 
 		userPoolID, identifier, err := tfcognitoidp.DecodeResourceServerID(rs.Primary.ID)
 		if err != nil {
@@ -144,7 +143,7 @@ func testAccCheckResourceServerExists(n string, resourceServer *cognitoidentityp
 }
 
 func testAccCheckResourceServerDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_resource_server" {
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,9 +15,9 @@ import (
 )
 
 func TestAccCognitoIDPUserGroup_basic(t *testing.T) {
-	poolName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
-	groupName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
-	updatedGroupName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandString(t, 10))
+	groupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(t, 10))
+	updatedGroupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(t, 10))
 	resourceName := "aws_cognito_user_group.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -51,9 +50,9 @@ func TestAccCognitoIDPUserGroup_basic(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserGroup_complex(t *testing.T) {
-	poolName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
-	groupName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
-	updatedGroupName := fmt.Sprintf("tf-acc-%s", sdkacctest.RandString(10))
+	poolName := fmt.Sprintf("tf-acc-%s", acctest.RandString(t, 10))
+	groupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(t, 10))
+	updatedGroupName := fmt.Sprintf("tf-acc-%s", acctest.RandString(t, 10))
 	resourceName := "aws_cognito_user_group.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -92,7 +91,7 @@ func TestAccCognitoIDPUserGroup_complex(t *testing.T) {
 }
 
 func TestAccCognitoIDPUserGroup_roleARN(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_cognito_user_group.main"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -147,7 +146,7 @@ func testAccCheckUserGroupExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf(fmt.Sprintf("ID should be user_pool_id/name. ID was %s. name was %s, user_pool_id was %s", id, name, userPoolId))
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
 
 		params := &cognitoidentityprovider.GetGroupInput{
 			GroupName:  aws.String(rs.Primary.Attributes["name"]),
@@ -160,7 +159,7 @@ func testAccCheckUserGroupExists(name string) resource.TestCheckFunc {
 }
 
 func testAccCheckUserGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_user_group" {
@@ -1,18 +1,20 @@
 package cognitoidp
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func DataSourceUserPools() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceUserPoolsRead,
+		ReadWithoutTimeout: dataSourceUserPoolsRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -32,34 +34,34 @@ func DataSourceUserPools() *schema.Resource {
 	}
 }
 
-func dataSourceUserPoolsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+func dataSourceUserPoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 	name := d.Get("name").(string)
 	var ids []string
 	var arns []string
 
-	pools, err := getAllCognitoUserPools(conn)
+	pools, err := listAllUserPoolsWithFilter(ctx, conn, &cognitoidentityprovider.ListUserPoolsInput{}, func(pool *cognitoidentityprovider.UserPoolDescriptionType) bool {
+		return name == aws.StringValue(pool.Name)
+	})
 	if err != nil {
-		return fmt.Errorf("Error listing cognito user pools: %w", err)
+		return diag.FromErr(fmt.Errorf("Error listing cognito user pools: %w", err))
 	}
 	for _, pool := range pools {
-		if name == aws.StringValue(pool.Name) {
-			id := aws.StringValue(pool.Id)
-			arn := arn.ARN{
-				Partition: meta.(*conns.AWSClient).Partition,
-				Service:   "cognito-idp",
-				Region:    meta.(*conns.AWSClient).Region,
-				AccountID: meta.(*conns.AWSClient).AccountID,
-				Resource:  fmt.Sprintf("userpool/%s", id),
-			}.String()
+		id := aws.StringValue(pool.Id)
+		arn := arn.ARN{
+			Partition: meta.(*conns.AWSClient).Partition,
+			Service:   "cognito-idp",
+			Region:    meta.(*conns.AWSClient).Region,
+			AccountID: meta.(*conns.AWSClient).AccountID,
+			Resource:  fmt.Sprintf("userpool/%s", id),
+		}.String()
 
-			ids = append(ids, id)
-			arns = append(arns, arn)
-		}
+		ids = append(ids, id)
+		arns = append(arns, arn)
 	}
 
 	if len(ids) == 0 {
-		return fmt.Errorf("No cognito user pool found with name: %s", name)
+		return diag.Errorf("No cognito user pool found with name: %s", name)
 	}
 
 	d.SetId(name)
@@ -68,30 +70,3 @@ func dataSourceUserPoolsRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
-
-func getAllCognitoUserPools(conn *cognitoidentityprovider.CognitoIdentityProvider) ([]*cognitoidentityprovider.UserPoolDescriptionType, error) {
-	var pools []*cognitoidentityprovider.UserPoolDescriptionType
-	var nextToken string
-
-	for {
-		input := &cognitoidentityprovider.ListUserPoolsInput{
-			// MaxResults Valid Range: Minimum value of 1. Maximum value of 60
-			MaxResults: aws.Int64(60),
-		}
-		if nextToken != "" {
-			input.NextToken = aws.String(nextToken)
-		}
-		out, err := conn.ListUserPools(input)
-		if err != nil {
-			return pools, err
-		}
-		pools = append(pools, out.UserPools...)
-
-		if out.NextToken == nil {
-			break
-		}
-		nextToken = aws.StringValue(out.NextToken)
-	}
-
-	return pools, nil
-}
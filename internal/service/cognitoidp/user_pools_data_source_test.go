@@ -6,13 +6,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccCognitoIDPUserPoolsDataSource_basic(t *testing.T) {
-	rName := fmt.Sprintf("tf_acc_ds_cognito_user_pools_%s", sdkacctest.RandString(7))
+	rName := fmt.Sprintf("tf_acc_ds_cognito_user_pools_%s", acctest.RandString(t, 7))
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
 		ErrorCheck: acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
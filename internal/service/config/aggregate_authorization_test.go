@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccConfigAggregateAuthorization_basic(t *testing.T) {
-	rString := sdkacctest.RandStringFromCharSet(12, "0123456789")
+	rString := acctest.RandStringFromCharSet(t, 12, "0123456789")
 	resourceName := "aws_config_aggregate_authorization.example"
 	dataSourceName := "data.aws_region.current"
 
@@ -44,7 +43,7 @@ func TestAccConfigAggregateAuthorization_basic(t *testing.T) {
 }
 
 func TestAccConfigAggregateAuthorization_tags(t *testing.T) {
-	rString := sdkacctest.RandStringFromCharSet(12, "0123456789")
+	rString := acctest.RandStringFromCharSet(t, 12, "0123456789")
 	resourceName := "aws_config_aggregate_authorization.example"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -87,7 +86,7 @@ func TestAccConfigAggregateAuthorization_tags(t *testing.T) {
 }
 
 func testAccCheckAggregateAuthorizationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_aggregate_authorization" {
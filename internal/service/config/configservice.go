@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -386,7 +387,7 @@ func configWaitForConformancePackStateCreateComplete(conn *configservice.ConfigS
 		Refresh: configRefreshConformancePackStatus(conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForState()
+	_, err := tfresource.WaitForState(&stateChangeConf)
 
 	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
 		return nil
@@ -404,7 +405,7 @@ func configWaitForConformancePackStateDeleteComplete(conn *configservice.ConfigS
 		Refresh: configRefreshConformancePackStatus(conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForState()
+	_, err := tfresource.WaitForState(&stateChangeConf)
 
 	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
 		return nil
@@ -423,7 +424,7 @@ func configWaitForOrganizationConformancePackStatusCreateSuccessful(conn *config
 		Delay: 30 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForState()
+	_, err := tfresource.WaitForState(&stateChangeConf)
 
 	return err
 
@@ -437,7 +438,7 @@ func configWaitForOrganizationConformancePackStatusUpdateSuccessful(conn *config
 		Refresh: configRefreshOrganizationConformancePackStatus(conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForState()
+	_, err := tfresource.WaitForState(&stateChangeConf)
 
 	return err
 }
@@ -450,7 +451,7 @@ func configWaitForOrganizationConformancePackStatusDeleteSuccessful(conn *config
 		Refresh: configRefreshOrganizationConformancePackStatus(conn, name),
 	}
 
-	_, err := stateChangeConf.WaitForState()
+	_, err := tfresource.WaitForState(&stateChangeConf)
 
 	return err
 }
@@ -464,7 +465,7 @@ func configWaitForOrganizationRuleStatusCreateSuccessful(conn *configservice.Con
 		Delay:   10 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForState()
+	_, err := tfresource.WaitForState(stateChangeConf)
 
 	return err
 }
@@ -478,7 +479,7 @@ func configWaitForOrganizationRuleStatusDeleteSuccessful(conn *configservice.Con
 		Delay:   10 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForState()
+	_, err := tfresource.WaitForState(stateChangeConf)
 
 	if tfawserr.ErrMessageContains(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException, "") {
 		return nil
@@ -496,7 +497,7 @@ func configWaitForOrganizationRuleStatusUpdateSuccessful(conn *configservice.Con
 		Delay:   10 * time.Second,
 	}
 
-	_, err := stateChangeConf.WaitForState()
+	_, err := tfresource.WaitForState(stateChangeConf)
 
 	return err
 }
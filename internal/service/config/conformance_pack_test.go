@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func testAccConfigConformancePack_basic(t *testing.T) {
 	var pack configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -52,8 +51,8 @@ func testAccConfigConformancePack_basic(t *testing.T) {
 
 func testAccConfigConformancePack_forceNew(t *testing.T) {
 	var before, after configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-update")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rNameUpdated := acctest.RandomWithPrefix(t, "tf-acc-test-update")
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -94,7 +93,7 @@ func testAccConfigConformancePack_forceNew(t *testing.T) {
 
 func testAccConfigConformancePack_disappears(t *testing.T) {
 	var pack configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -117,7 +116,7 @@ func testAccConfigConformancePack_disappears(t *testing.T) {
 
 func testAccConfigConformancePack_inputParameters(t *testing.T) {
 	var pack configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -153,7 +152,7 @@ func testAccConfigConformancePack_inputParameters(t *testing.T) {
 
 func testAccConfigConformancePack_S3Delivery(t *testing.T) {
 	var pack configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -185,7 +184,7 @@ func testAccConfigConformancePack_S3Delivery(t *testing.T) {
 
 func testAccConfigConformancePack_S3Template(t *testing.T) {
 	var pack configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -217,7 +216,7 @@ func testAccConfigConformancePack_S3Template(t *testing.T) {
 
 func testAccConfigConformancePack_updateInputParameters(t *testing.T) {
 	var pack configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -266,8 +265,8 @@ func testAccConfigConformancePack_updateInputParameters(t *testing.T) {
 
 func testAccConfigConformancePack_updateS3Delivery(t *testing.T) {
 	var pack configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	bucketName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -305,8 +304,8 @@ func testAccConfigConformancePack_updateS3Delivery(t *testing.T) {
 
 func testAccConfigConformancePack_updateS3Template(t *testing.T) {
 	var pack configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	bucketName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -344,7 +343,7 @@ func testAccConfigConformancePack_updateS3Template(t *testing.T) {
 
 func testAccConfigConformancePack_updateTemplateBody(t *testing.T) {
 	var pack configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -384,7 +383,7 @@ func testAccConfigConformancePack_updateTemplateBody(t *testing.T) {
 
 func testAccConfigConformancePack_S3TemplateAndTemplateBody(t *testing.T) {
 	var pack configservice.ConformancePackDetail
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_config_conformance_pack.test"
 
 	resource.Test(t, resource.TestCase{
@@ -418,7 +417,7 @@ func testAccConfigConformancePack_S3TemplateAndTemplateBody(t *testing.T) {
 }
 
 func testAccCheckConfigConformancePackDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_conformance_pack" {
@@ -450,7 +449,7 @@ func testAccCheckConfigConformancePackExists(resourceName string, detail *config
 			return fmt.Errorf("Not Found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 		pack, err := tfconfig.DescribeConformancePack(conn, rs.Primary.ID)
 
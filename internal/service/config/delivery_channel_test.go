@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,7 +14,7 @@ import (
 
 func testAccConfigDeliveryChannel_basic(t *testing.T) {
 	var dc configservice.DeliveryChannel
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	expectedName := fmt.Sprintf("tf-acc-test-awsconfig-%d", rInt)
 	expectedBucketName := fmt.Sprintf("tf-acc-test-awsconfig-%d", rInt)
 
@@ -41,7 +40,7 @@ func testAccConfigDeliveryChannel_basic(t *testing.T) {
 func testAccConfigDeliveryChannel_allParams(t *testing.T) {
 	resourceName := "aws_config_delivery_channel.foo"
 	var dc configservice.DeliveryChannel
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	expectedName := fmt.Sprintf("tf-acc-test-awsconfig-%d", rInt)
 	expectedBucketName := fmt.Sprintf("tf-acc-test-awsconfig-%d", rInt)
 
@@ -70,7 +69,7 @@ func testAccConfigDeliveryChannel_allParams(t *testing.T) {
 
 func testAccConfigDeliveryChannel_importBasic(t *testing.T) {
 	resourceName := "aws_config_delivery_channel.foo"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -115,7 +114,7 @@ func testAccCheckConfigDeliveryChannelExists(n string, obj *configservice.Delive
 			return fmt.Errorf("No delivery channel ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 		out, err := conn.DescribeDeliveryChannels(&configservice.DescribeDeliveryChannelsInput{
 			DeliveryChannelNames: []*string{aws.String(rs.Primary.Attributes["name"])},
 		})
@@ -134,7 +133,7 @@ func testAccCheckConfigDeliveryChannelExists(n string, obj *configservice.Delive
 }
 
 func testAccCheckConfigDeliveryChannelDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_delivery_channel" {
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 func testAccConfigRemediationConfiguration_basic(t *testing.T) {
 	var rc configservice.RemediationConfiguration
 	resourceName := "aws_config_remediation_configuration.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	prefix := "Original"
 	sseAlgorithm := "AES256"
 	expectedName := fmt.Sprintf("%s-tf-acc-test-%d", prefix, rInt)
@@ -50,7 +49,7 @@ func testAccConfigRemediationConfiguration_basic(t *testing.T) {
 func testAccConfigRemediationConfiguration_disappears(t *testing.T) {
 	var rc configservice.RemediationConfiguration
 	resourceName := "aws_config_remediation_configuration.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	prefix := "original"
 	sseAlgorithm := "AES256"
 
@@ -76,7 +75,7 @@ func testAccConfigRemediationConfiguration_recreates(t *testing.T) {
 	var original configservice.RemediationConfiguration
 	var updated configservice.RemediationConfiguration
 	resourceName := "aws_config_remediation_configuration.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	originalName := "Original"
 	updatedName := "Updated"
@@ -111,7 +110,7 @@ func testAccConfigRemediationConfiguration_updates(t *testing.T) {
 	var original configservice.RemediationConfiguration
 	var updated configservice.RemediationConfiguration
 	resourceName := "aws_config_remediation_configuration.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	name := "Original"
 	originalSseAlgorithm := "AES256"
@@ -153,7 +152,7 @@ func testAccCheckConfigRemediationConfigurationExists(n string, obj *configservi
 			return fmt.Errorf("No config rule ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 		out, err := conn.DescribeRemediationConfigurations(&configservice.DescribeRemediationConfigurationsInput{
 			ConfigRuleNames: []*string{aws.String(rs.Primary.Attributes["config_rule_name"])},
 		})
@@ -172,7 +171,7 @@ func testAccCheckConfigRemediationConfigurationExists(n string, obj *configservi
 }
 
 func testAccCheckConfigRemediationConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_remediation_configuration" {
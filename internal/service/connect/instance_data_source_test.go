@@ -6,13 +6,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/connect"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccConnectInstanceDataSource_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix("datasource-test-terraform")
+	rName := acctest.RandomWithPrefix(t, "datasource-test-terraform")
 	dataSourceName := "data.aws_connect_instance.test"
 	resourceName := "aws_connect_instance.test"
 	resource.ParallelTest(t, resource.TestCase{
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/connect"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -25,7 +26,7 @@ func waitInstanceCreated(ctx context.Context, conn *connect.Connect, instanceId
 		Timeout: connectInstanceCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*connect.DescribeInstanceOutput); ok {
 		return v, err
@@ -45,7 +46,7 @@ func waitInstanceDeleted(ctx context.Context, conn *connect.Connect, instanceId
 		Timeout: connectInstanceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*connect.DescribeInstanceOutput); ok {
 		return v, err
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cur_report_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cur_report_definition", &resource.Sweeper{
 		Name: "aws_cur_report_definition",
 		F:    sweepReportDefinitions,
 	})
@@ -37,7 +37,7 @@ func sweepReportDefinitions(region string) error {
 		}
 	}
 
-	conn := client.CURConn
+	conn := client.CURConn()
 
 	input := &cur.DescribeReportDefinitionsInput{}
 	var sweeperErrs *multierror.Error
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitAgentReady(conn *datasync.DataSync, arn string, timeout time.Duration) (*datasync.DescribeAgentOutput, error) {
@@ -18,7 +19,7 @@ func waitAgentReady(conn *datasync.DataSync, arn string, timeout time.Duration)
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*datasync.DescribeAgentOutput); ok {
 		return output, err
@@ -35,7 +36,7 @@ func waitTaskAvailable(conn *datasync.DataSync, arn string, timeout time.Duratio
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*datasync.DescribeTaskOutput); ok {
 		if err != nil && output != nil && output.ErrorCode != nil && output.ErrorDetail != nil {
@@ -181,7 +181,7 @@ func ResourceCluster() *schema.Resource {
 }
 
 func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DAXConn
+	conn := meta.(*conns.AWSClient).DAXConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -270,7 +270,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Waiting for state to become available: %v", d.Id())
-	_, sterr := stateConf.WaitForState()
+	_, sterr := tfresource.WaitForState(stateConf)
 	if sterr != nil {
 		return fmt.Errorf("Error waiting for DAX cluster (%s) to be created: %s", d.Id(), sterr)
 	}
@@ -279,7 +279,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DAXConn
+	conn := meta.(*conns.AWSClient).DAXConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -362,7 +362,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DAXConn
+	conn := meta.(*conns.AWSClient).DAXConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -459,7 +459,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 			Delay:      30 * time.Second,
 		}
 
-		_, sterr := stateConf.WaitForState()
+		_, sterr := tfresource.WaitForState(stateConf)
 		if sterr != nil {
 			return fmt.Errorf("Error waiting for DAX (%s) to update: %s", d.Id(), sterr)
 		}
@@ -500,7 +500,7 @@ func (b byNodeId) Less(i, j int) bool {
 }
 
 func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DAXConn
+	conn := meta.(*conns.AWSClient).DAXConn()
 
 	req := &dax.DeleteClusterInput{
 		ClusterName: aws.String(d.Id()),
@@ -532,7 +532,7 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 		Delay:      30 * time.Second,
 	}
 
-	_, sterr := stateConf.WaitForState()
+	_, sterr := tfresource.WaitForState(stateConf)
 	if sterr != nil {
 		return fmt.Errorf("Error waiting for DAX (%s) to delete: %s", d.Id(), sterr)
 	}
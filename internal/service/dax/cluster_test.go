@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccDAXCluster_basic(t *testing.T) {
 	var dc dax.Cluster
-	rString := sdkacctest.RandString(10)
+	rString := acctest.RandString(t, 10)
 	iamRoleResourceName := "aws_iam_role.test"
 	resourceName := "aws_dax_cluster.test"
 
@@ -72,7 +71,7 @@ func TestAccDAXCluster_basic(t *testing.T) {
 
 func TestAccDAXCluster_resize(t *testing.T) {
 	var dc dax.Cluster
-	rString := sdkacctest.RandString(10)
+	rString := acctest.RandString(t, 10)
 	resourceName := "aws_dax_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -116,7 +115,7 @@ func TestAccDAXCluster_resize(t *testing.T) {
 
 func TestAccDAXCluster_Encryption_disabled(t *testing.T) {
 	var dc dax.Cluster
-	rString := sdkacctest.RandString(10)
+	rString := acctest.RandString(t, 10)
 	resourceName := "aws_dax_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -150,7 +149,7 @@ func TestAccDAXCluster_Encryption_disabled(t *testing.T) {
 
 func TestAccDAXCluster_Encryption_enabled(t *testing.T) {
 	var dc dax.Cluster
-	rString := sdkacctest.RandString(10)
+	rString := acctest.RandString(t, 10)
 	resourceName := "aws_dax_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -183,7 +182,7 @@ func TestAccDAXCluster_Encryption_enabled(t *testing.T) {
 }
 
 func testAccCheckClusterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DAXConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DAXConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dax_cluster" {
@@ -217,7 +216,7 @@ func testAccCheckClusterExists(n string, v *dax.Cluster) resource.TestCheckFunc
 			return fmt.Errorf("No DAX cluster ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DAXConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DAXConn()
 		resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{
 			ClusterNames: []*string{aws.String(rs.Primary.ID)},
 		})
@@ -236,7 +235,7 @@ func testAccCheckClusterExists(n string, v *dax.Cluster) resource.TestCheckFunc
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DAXConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DAXConn()
 
 	input := &dax.DescribeClustersInput{}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceBGPPeer() *schema.Resource {
@@ -77,7 +78,7 @@ func ResourceBGPPeer() *schema.Resource {
 }
 
 func resourceBGPPeerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectConnectConn
+	conn := meta.(*conns.AWSClient).DirectConnectConn()
 
 	vifId := d.Get("virtual_interface_id").(string)
 	addrFamily := d.Get("address_family").(string)
@@ -121,7 +122,7 @@ func resourceBGPPeerCreate(d *schema.ResourceData, meta interface{}) error {
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for Direct Connect BGP peer (%s) to be available: %s", d.Id(), err)
 	}
@@ -130,7 +131,7 @@ func resourceBGPPeerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBGPPeerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectConnectConn
+	conn := meta.(*conns.AWSClient).DirectConnectConn()
 
 	vifId := d.Get("virtual_interface_id").(string)
 	addrFamily := d.Get("address_family").(string)
@@ -158,7 +159,7 @@ func resourceBGPPeerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBGPPeerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectConnectConn
+	conn := meta.(*conns.AWSClient).DirectConnectConn()
 
 	vifId := d.Get("virtual_interface_id").(string)
 	addrFamily := d.Get("address_family").(string)
@@ -193,7 +194,7 @@ func resourceBGPPeerDelete(d *schema.ResourceData, meta interface{}) error {
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for Direct Connect BGP peer (%s) to be deleted: %s", d.Id(), err)
 	}
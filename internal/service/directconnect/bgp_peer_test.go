@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/directconnect"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ func TestAccDirectConnectBGPPeer_basic(t *testing.T) {
 	if vifId == "" {
 		t.Skipf("Environment variable %s is not set", key)
 	}
-	bgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	bgpAsn := acctest.RandIntRange(t, 64512, 65534)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -41,7 +40,7 @@ func TestAccDirectConnectBGPPeer_basic(t *testing.T) {
 }
 
 func testAccCheckBGPPeerDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_bgp_peer" {
@@ -26,7 +26,7 @@ func waitConnectionConfirmed(conn *directconnect.DirectConnect, id string) (*dir
 		Timeout: connectionConfirmedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Connection); ok {
 		return output, err
@@ -43,7 +43,7 @@ func waitConnectionDeleted(conn *directconnect.DirectConnect, id string) (*direc
 		Timeout: connectionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Connection); ok {
 		return output, err
@@ -60,7 +60,7 @@ func waitGatewayCreated(conn *directconnect.DirectConnect, id string, timeout ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Gateway); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -79,7 +79,7 @@ func waitGatewayDeleted(conn *directconnect.DirectConnect, id string, timeout ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Gateway); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -98,7 +98,7 @@ func waitGatewayAssociationCreated(conn *directconnect.DirectConnect, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directconnect.GatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -117,7 +117,7 @@ func waitGatewayAssociationUpdated(conn *directconnect.DirectConnect, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directconnect.GatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -136,7 +136,7 @@ func waitGatewayAssociationDeleted(conn *directconnect.DirectConnect, id string,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directconnect.GatewayAssociation); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateChangeError)))
@@ -155,7 +155,7 @@ func waitHostedConnectionDeleted(conn *directconnect.DirectConnect, id string) (
 		Timeout: hostedConnectionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Connection); ok {
 		return output, err
@@ -172,7 +172,7 @@ func waitLagDeleted(conn *directconnect.DirectConnect, id string) (*directconnec
 		Timeout: lagDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directconnect.Lag); ok {
 		return output, err
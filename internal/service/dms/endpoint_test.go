@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccDMSEndpoint_basic(t *testing.T) {
 	resourceName := "aws_dms_endpoint.dms_endpoint"
-	randId := sdkacctest.RandString(8) + "-basic"
+	randId := acctest.RandString(t, 8) + "-basic"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -57,7 +56,7 @@ func TestAccDMSEndpoint_basic(t *testing.T) {
 
 func TestAccDMSEndpoint_s3(t *testing.T) {
 	resourceName := "aws_dms_endpoint.dms_endpoint"
-	randId := sdkacctest.RandString(8) + "-s3"
+	randId := acctest.RandString(t, 8) + "-s3"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -110,7 +109,7 @@ func TestAccDMSEndpoint_s3(t *testing.T) {
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/8009
 func TestAccDMSEndpoint_S3_extraConnectionAttributes(t *testing.T) {
 	resourceName := "aws_dms_endpoint.dms_endpoint"
-	randId := sdkacctest.RandString(8) + "-s3"
+	randId := acctest.RandString(t, 8) + "-s3"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -137,7 +136,7 @@ func TestAccDMSEndpoint_S3_extraConnectionAttributes(t *testing.T) {
 
 func TestAccDMSEndpoint_dynamoDB(t *testing.T) {
 	resourceName := "aws_dms_endpoint.dms_endpoint"
-	randId := sdkacctest.RandString(8) + "-dynamodb"
+	randId := acctest.RandString(t, 8) + "-dynamodb"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -170,7 +169,7 @@ func TestAccDMSEndpoint_dynamoDB(t *testing.T) {
 
 func TestAccDMSEndpoint_elasticSearch(t *testing.T) {
 	resourceName := "aws_dms_endpoint.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -204,7 +203,7 @@ func TestAccDMSEndpoint_elasticSearch(t *testing.T) {
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/8009
 func TestAccDMSEndpoint_ElasticSearch_extraConnectionAttributes(t *testing.T) {
 	resourceName := "aws_dms_endpoint.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -231,7 +230,7 @@ func TestAccDMSEndpoint_ElasticSearch_extraConnectionAttributes(t *testing.T) {
 
 func TestAccDMSEndpoint_ElasticSearch_errorRetryDuration(t *testing.T) {
 	resourceName := "aws_dms_endpoint.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -269,7 +268,7 @@ func TestAccDMSEndpoint_ElasticSearch_errorRetryDuration(t *testing.T) {
 
 func TestAccDMSEndpoint_ElasticSearch_fullLoadErrorPercentage(t *testing.T) {
 	resourceName := "aws_dms_endpoint.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -306,8 +305,8 @@ func TestAccDMSEndpoint_ElasticSearch_fullLoadErrorPercentage(t *testing.T) {
 }
 
 func TestAccDMSEndpoint_kafka(t *testing.T) {
-	domainName := acctest.RandomSubdomain()
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomSubdomain(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_dms_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -379,7 +378,7 @@ func TestAccDMSEndpoint_kinesis(t *testing.T) {
 	iamRoleResourceName := "aws_iam_role.test"
 	stream1ResourceName := "aws_kinesis_stream.test1"
 	stream2ResourceName := "aws_kinesis_stream.test2"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -431,7 +430,7 @@ func TestAccDMSEndpoint_kinesis(t *testing.T) {
 
 func TestAccDMSEndpoint_mongoDB(t *testing.T) {
 	resourceName := "aws_dms_endpoint.dms_endpoint"
-	randId := sdkacctest.RandString(8) + "-mongodb"
+	randId := acctest.RandString(t, 8) + "-mongodb"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -461,7 +460,7 @@ func TestAccDMSEndpoint_mongoDB(t *testing.T) {
 // per https://github.com/hashicorp/terraform-provider-aws/issues/8009
 func TestAccDMSEndpoint_MongoDB_update(t *testing.T) {
 	resourceName := "aws_dms_endpoint.dms_endpoint"
-	randId := sdkacctest.RandString(8) + "-mongodb"
+	randId := acctest.RandString(t, 8) + "-mongodb"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -506,7 +505,7 @@ func TestAccDMSEndpoint_MongoDB_update(t *testing.T) {
 
 func TestAccDMSEndpoint_docDB(t *testing.T) {
 	resourceName := "aws_dms_endpoint.dms_endpoint"
-	randId := sdkacctest.RandString(8) + "-docdb"
+	randId := acctest.RandString(t, 8) + "-docdb"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -546,7 +545,7 @@ func TestAccDMSEndpoint_docDB(t *testing.T) {
 
 func TestAccDMSEndpoint_db2(t *testing.T) {
 	resourceName := "aws_dms_endpoint.dms_endpoint"
-	randId := sdkacctest.RandString(8) + "-db2"
+	randId := acctest.RandString(t, 8) + "-db2"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -585,7 +584,7 @@ func TestAccDMSEndpoint_db2(t *testing.T) {
 }
 
 func testAccCheckEndpointDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dms_endpoint" {
@@ -619,7 +618,7 @@ func testAccCheckEndpointExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No DMS Endpoint ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn()
 
 		_, err := tfdms.FindEndpointByID(conn, rs.Primary.ID)
 
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -87,7 +88,7 @@ func ResourceEventSubscription() *schema.Resource {
 }
 
 func resourceEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -124,7 +125,7 @@ func resourceEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) e
 		Delay:      10 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("error waiting for DMS Event Subscription (%s) creation: %w", d.Id(), err)
 	}
@@ -133,7 +134,7 @@ func resourceEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 
 	if d.HasChanges("enabled", "event_categories", "sns_topic_arn", "source_type") {
 		request := &dms.ModifyEventSubscriptionInput{
@@ -162,7 +163,7 @@ func resourceEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) e
 			Delay:      10 * time.Second,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = tfresource.WaitForState(stateConf)
 		if err != nil {
 			return fmt.Errorf("error waiting for DMS Event Subscription (%s) modification: %w", d.Id(), err)
 		}
@@ -180,7 +181,7 @@ func resourceEventSubscriptionUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -245,7 +246,7 @@ func resourceEventSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 
 	request := &dms.DeleteEventSubscriptionInput{
 		SubscriptionName: aws.String(d.Id()),
@@ -270,7 +271,7 @@ func resourceEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) e
 		Delay:      10 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("error waiting for DMS Event Subscription (%s) deletion: %w", d.Id(), err)
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -137,7 +138,7 @@ func ResourceReplicationInstance() *schema.Resource {
 }
 
 func resourceReplicationInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -195,7 +196,7 @@ func resourceReplicationInstanceCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("error waiting for DMS Replication Instance (%s) creation: %s", d.Id(), err)
 	}
@@ -204,7 +205,7 @@ func resourceReplicationInstanceCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceReplicationInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -287,7 +288,7 @@ func resourceReplicationInstanceRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceReplicationInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 
 	request := &dms.ModifyReplicationInstanceInput{
 		ApplyImmediately:       aws.Bool(d.Get("apply_immediately").(bool)),
@@ -369,7 +370,7 @@ func resourceReplicationInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = tfresource.WaitForState(stateConf)
 		if err != nil {
 			return fmt.Errorf("error waiting for DMS Replication Instance (%s) modification: %s", d.Id(), err)
 		}
@@ -379,7 +380,7 @@ func resourceReplicationInstanceUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceReplicationInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 
 	request := &dms.DeleteReplicationInstanceInput{
 		ReplicationInstanceArn: aws.String(d.Get("replication_instance_arn").(string)),
@@ -407,7 +408,7 @@ func resourceReplicationInstanceDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("error waiting for DMS Replication Instance (%s) deletion: %s", d.Id(), err)
 	}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -16,7 +15,7 @@ import (
 
 func TestAccDMSReplicationSubnetGroup_basic(t *testing.T) {
 	resourceName := "aws_dms_replication_subnet_group.dms_replication_subnet_group"
-	randId := sdkacctest.RandString(8)
+	randId := acctest.RandString(t, 8)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -67,7 +66,7 @@ func checkDmsReplicationSubnetGroupExistsWithProviders(n string, providers *[]*s
 				continue
 			}
 
-			conn := provider.Meta().(*conns.AWSClient).DMSConn
+			conn := provider.Meta().(*conns.AWSClient).DMSConn()
 			_, err := conn.DescribeReplicationSubnetGroups(&dms.DescribeReplicationSubnetGroupsInput{
 				Filters: []*dms.Filter{
 					{
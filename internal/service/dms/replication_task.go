@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -99,7 +100,7 @@ func ResourceReplicationTask() *schema.Resource {
 }
 
 func resourceReplicationTaskCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -149,7 +150,7 @@ func resourceReplicationTaskCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return err
 	}
@@ -158,7 +159,7 @@ func resourceReplicationTaskCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceReplicationTaskRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -205,7 +206,7 @@ func resourceReplicationTaskRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceReplicationTaskUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 
 	request := &dms.ModifyReplicationTaskInput{
 		ReplicationTaskArn: aws.String(d.Get("replication_task_arn").(string)),
@@ -268,7 +269,7 @@ func resourceReplicationTaskUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = tfresource.WaitForState(stateConf)
 		if err != nil {
 			return err
 		}
@@ -280,7 +281,7 @@ func resourceReplicationTaskUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceReplicationTaskDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DMSConn
+	conn := meta.(*conns.AWSClient).DMSConn()
 
 	request := &dms.DeleteReplicationTaskInput{
 		ReplicationTaskArn: aws.String(d.Get("replication_task_arn").(string)),
@@ -307,7 +308,7 @@ func resourceReplicationTaskDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// Wait, catching any errors
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -336,7 +337,7 @@ func resourceReplicationTaskSetState(d *schema.ResourceData, task *dms.Replicati
 func resourceReplicationTaskStateRefreshFunc(
 	d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*conns.AWSClient).DMSConn
+		conn := meta.(*conns.AWSClient).DMSConn()
 
 		v, err := conn.DescribeReplicationTasks(&dms.DescribeReplicationTasksInput{
 			Filters: []*dms.Filter{
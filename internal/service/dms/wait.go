@@ -5,6 +5,7 @@ import (
 
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -19,7 +20,7 @@ func waitEndpointDeleted(conn *dms.DatabaseMigrationService, id string) (*dms.En
 		Timeout: endpointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dms.Endpoint); ok {
 		return output, err
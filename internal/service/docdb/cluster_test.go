@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/docdb"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ import (
 
 func TestAccDocDBCluster_basic(t *testing.T) {
 	var dbCluster docdb.DBCluster
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_docdb_cluster.default"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -132,7 +131,7 @@ func TestAccDocDBCluster_generatedName(t *testing.T) {
 
 func TestAccDocDBCluster_takeFinalSnapshot(t *testing.T) {
 	var v docdb.DBCluster
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -172,7 +171,7 @@ func TestAccDocDBCluster_missingUserNameCausesError(t *testing.T) {
 		CheckDestroy: testAccCheckDocDBClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccDocDBClusterConfigWithoutUserNameAndPassword(sdkacctest.RandInt()),
+				Config:      testAccDocDBClusterConfigWithoutUserNameAndPassword(acctest.RandInt(t)),
 				ExpectError: regexp.MustCompile(`required field is not set`),
 			},
 		},
@@ -181,7 +180,7 @@ func TestAccDocDBCluster_missingUserNameCausesError(t *testing.T) {
 
 func TestAccDocDBCluster_updateTags(t *testing.T) {
 	var v docdb.DBCluster
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -223,7 +222,7 @@ func TestAccDocDBCluster_updateTags(t *testing.T) {
 
 func TestAccDocDBCluster_updateCloudWatchLogsExports(t *testing.T) {
 	var v docdb.DBCluster
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -271,7 +270,7 @@ func TestAccDocDBCluster_kmsKey(t *testing.T) {
 		CheckDestroy: testAccCheckDocDBClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDocDBClusterConfig_kmsKey(sdkacctest.RandInt()),
+				Config: testAccDocDBClusterConfig_kmsKey(acctest.RandInt(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDocDBClusterExists("aws_docdb_cluster.default", &v),
 					resource.TestCheckResourceAttrPair("aws_docdb_cluster.default", "kms_key_id", "aws_kms_key.foo", "arn"),
@@ -303,7 +302,7 @@ func TestAccDocDBCluster_encrypted(t *testing.T) {
 		CheckDestroy: testAccCheckDocDBClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDocDBClusterConfig_encrypted(sdkacctest.RandInt()),
+				Config: testAccDocDBClusterConfig_encrypted(acctest.RandInt(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDocDBClusterExists("aws_docdb_cluster.default", &v),
 					resource.TestCheckResourceAttr(
@@ -331,7 +330,7 @@ func TestAccDocDBCluster_encrypted(t *testing.T) {
 func TestAccDocDBCluster_backupsUpdate(t *testing.T) {
 	var v docdb.DBCluster
 
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, docdb.EndpointsID),
@@ -380,7 +379,7 @@ func TestAccDocDBCluster_backupsUpdate(t *testing.T) {
 
 func TestAccDocDBCluster_port(t *testing.T) {
 	var dbCluster1, dbCluster2 docdb.DBCluster
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_docdb_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -479,7 +478,7 @@ func testAccCheckDocDBClusterDestroy(s *terraform.State) error {
 }
 
 func testAccCheckDocDBClusterDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*conns.AWSClient).DocDBConn
+	conn := provider.Meta().(*conns.AWSClient).DocDBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_docdb_cluster" {
@@ -529,7 +528,7 @@ func testAccCheckDocDBClusterExistsWithProvider(n string, v *docdb.DBCluster, pr
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*conns.AWSClient).DocDBConn
+		conn := provider.Meta().(*conns.AWSClient).DocDBConn()
 		resp, err := conn.DescribeDBClusters(&docdb.DescribeDBClustersInput{
 			DBClusterIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -570,7 +569,7 @@ func testAccCheckDocDBClusterSnapshot(rInt int) resource.TestCheckFunc {
 			snapshot_identifier := fmt.Sprintf("tf-acctest-docdbcluster-snapshot-%d", rInt)
 
 			awsClient := acctest.Provider.Meta().(*conns.AWSClient)
-			conn := awsClient.DocDBConn
+			conn := awsClient.DocDBConn()
 
 			log.Printf("[INFO] Deleting the Snapshot %s", snapshot_identifier)
 			_, snapDeleteErr := conn.DeleteDBClusterSnapshot(
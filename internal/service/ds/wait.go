@@ -23,7 +23,7 @@ func waitDirectoryCreated(conn *directoryservice.DirectoryService, id string) (*
 		Timeout: directoryCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.DirectoryDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StageReason)))
@@ -42,7 +42,7 @@ func waitDirectoryDeleted(conn *directoryservice.DirectoryService, id string) (*
 		Timeout: directoryDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*directoryservice.DirectoryDescription); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StageReason)))
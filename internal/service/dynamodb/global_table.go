@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceGlobalTable() *schema.Resource {
@@ -59,7 +60,7 @@ func ResourceGlobalTable() *schema.Resource {
 }
 
 func resourceGlobalTableCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	globalTableName := d.Get("name").(string)
 
@@ -90,7 +91,7 @@ func resourceGlobalTableCreate(d *schema.ResourceData, meta interface{}) error {
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 	}
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func resourceGlobalTableRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	if d.HasChange("replica") {
 		o, n := d.GetChange("replica")
@@ -157,7 +158,7 @@ func resourceGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
 			Timeout:    d.Timeout(schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 		}
-		_, err := stateConf.WaitForState()
+		_, err := tfresource.WaitForState(stateConf)
 		if err != nil {
 			return err
 		}
@@ -168,7 +169,7 @@ func resourceGlobalTableUpdate(d *schema.ResourceData, meta interface{}) error {
 
 // Deleting a DynamoDB Global Table is represented by removing all replicas.
 func resourceGlobalTableDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	input := &dynamodb.UpdateGlobalTableInput{
 		GlobalTableName: aws.String(d.Id()),
@@ -192,12 +193,12 @@ func resourceGlobalTableDelete(d *schema.ResourceData, meta interface{}) error {
 		Timeout:    d.Timeout(schema.TimeoutDelete),
 		MinTimeout: 10 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 	return err
 }
 
 func resourceGlobalTableRetrieve(d *schema.ResourceData, meta interface{}) (*dynamodb.GlobalTableDescription, error) {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	input := &dynamodb.DescribeGlobalTableInput{
 		GlobalTableName: aws.String(d.Id()),
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccDynamoDBTableItem_basic(t *testing.T) {
 	var conf dynamodb.GetItemOutput
 
-	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 8))
 	hashKey := "hashKey"
 	itemContent := `{
 	"hashKey": {"S": "something"},
@@ -51,7 +50,7 @@ func TestAccDynamoDBTableItem_basic(t *testing.T) {
 func TestAccDynamoDBTableItem_rangeKey(t *testing.T) {
 	var conf dynamodb.GetItemOutput
 
-	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 8))
 	hashKey := "hashKey"
 	rangeKey := "rangeKey"
 	itemContent := `{
@@ -88,7 +87,7 @@ func TestAccDynamoDBTableItem_withMultipleItems(t *testing.T) {
 	var conf1 dynamodb.GetItemOutput
 	var conf2 dynamodb.GetItemOutput
 
-	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 8))
 	hashKey := "hashKey"
 	rangeKey := "rangeKey"
 	firstItem := `{
@@ -138,7 +137,7 @@ func TestAccDynamoDBTableItem_withMultipleItems(t *testing.T) {
 func TestAccDynamoDBTableItem_update(t *testing.T) {
 	var conf dynamodb.GetItemOutput
 
-	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 8))
 	hashKey := "hashKey"
 
 	itemBefore := `{
@@ -188,7 +187,7 @@ func TestAccDynamoDBTableItem_update(t *testing.T) {
 func TestAccDynamoDBTableItem_updateWithRangeKey(t *testing.T) {
 	var conf dynamodb.GetItemOutput
 
-	tableName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 8))
 	hashKey := "hashKey"
 	rangeKey := "rangeKey"
 
@@ -236,7 +235,7 @@ func TestAccDynamoDBTableItem_updateWithRangeKey(t *testing.T) {
 }
 
 func testAccCheckItemDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_table_item" {
@@ -283,7 +282,7 @@ func testAccCheckTableItemExists(n string, item *dynamodb.GetItemOutput) resourc
 			return fmt.Errorf("No DynamoDB table item ID specified!")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
 
 		attrs := rs.Primary.Attributes
 		attributes, err := tfdynamodb.ExpandTableItemAttributes(attrs["item"])
@@ -310,7 +309,7 @@ func testAccCheckTableItemExists(n string, item *dynamodb.GetItemOutput) resourc
 
 func testAccCheckTableItemCount(tableName string, count int64) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
 		out, err := conn.Scan(&dynamodb.ScanInput{
 			ConsistentRead: aws.Bool(true),
 			TableName:      aws.String(tableName),
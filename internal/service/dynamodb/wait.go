@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -29,7 +30,7 @@ func waitDynamoDBKinesisStreamingDestinationActive(ctx context.Context, conn *dy
 		Refresh: statusDynamoDBKinesisStreamingDestination(ctx, conn, streamArn, tableName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
 
 	return err
 }
@@ -42,7 +43,7 @@ func waitDynamoDBKinesisStreamingDestinationDisabled(ctx context.Context, conn *
 		Refresh: statusDynamoDBKinesisStreamingDestination(ctx, conn, streamArn, tableName),
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{})
 
 	return err
 }
@@ -60,7 +61,7 @@ func waitDynamoDBTableActive(conn *dynamodb.DynamoDB, tableName string) (*dynamo
 		Refresh: statusDynamoDBTable(conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -80,7 +81,7 @@ func waitDynamoDBTableDeleted(conn *dynamodb.DynamoDB, tableName string) (*dynam
 		Refresh: statusDynamoDBTable(conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
@@ -103,7 +104,7 @@ func waitDynamoDBReplicaActive(conn *dynamodb.DynamoDB, tableName, region string
 		Refresh: statusDynamoDBReplicaUpdate(conn, tableName, region),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.DescribeTableOutput); ok {
 		return output, err
@@ -125,7 +126,7 @@ func waitDynamoDBReplicaDeleted(conn *dynamodb.DynamoDB, tableName, region strin
 		Refresh: statusDynamoDBReplicaDelete(conn, tableName, region),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.DescribeTableOutput); ok {
 		return output, err
@@ -147,7 +148,7 @@ func waitDynamoDBGSIActive(conn *dynamodb.DynamoDB, tableName, indexName string)
 		Refresh: statusDynamoDBGSI(conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -168,7 +169,7 @@ func waitDynamoDBGSIDeleted(conn *dynamodb.DynamoDB, tableName, indexName string
 		Refresh: statusDynamoDBGSI(conn, tableName, indexName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.GlobalSecondaryIndexDescription); ok {
 		return output, err
@@ -195,7 +196,7 @@ func waitDynamoDBPITRUpdated(conn *dynamodb.DynamoDB, tableName string, toEnable
 		Refresh: statusDynamoDBPITR(conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.PointInTimeRecoveryDescription); ok {
 		return output, err
@@ -226,7 +227,7 @@ func waitDynamoDBTTLUpdated(conn *dynamodb.DynamoDB, tableName string, toEnable
 		Refresh: statusDynamoDBTTL(conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TimeToLiveDescription); ok {
 		return output, err
@@ -250,7 +251,7 @@ func waitDynamoDBSSEUpdated(conn *dynamodb.DynamoDB, tableName string) (*dynamod
 		Refresh: statusDynamoDBTableSES(conn, tableName),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*dynamodb.TableDescription); ok {
 		return output, err
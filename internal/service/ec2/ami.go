@@ -254,7 +254,7 @@ func ResourceAMI() *schema.Resource {
 }
 
 func resourceAMICreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).EC2Conn
+	client := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -331,7 +331,7 @@ func resourceAMICreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).EC2Conn
+	client := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -458,7 +458,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAMIUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).EC2Conn
+	client := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -484,7 +484,7 @@ func resourceAMIUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAMIDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).EC2Conn
+	client := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DeregisterImageInput{
 		ImageId: aws.String(d.Id()),
@@ -566,7 +566,7 @@ func AMIWaitForDestroy(timeout time.Duration, id string, client *ec2.EC2) error
 		MinTimeout: AMIRetryMinTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for AMI (%s) to be deleted: %v", id, err)
 	}
@@ -586,7 +586,7 @@ func resourceAMIWaitForAvailable(timeout time.Duration, id string, client *ec2.E
 		MinTimeout: AMIRetryMinTimeout,
 	}
 
-	info, err := stateConf.WaitForState()
+	info, err := tfresource.WaitForState(stateConf)
 	if err != nil {
 		return nil, fmt.Errorf("Error waiting for AMI (%s) to be ready: %v", id, err)
 	}
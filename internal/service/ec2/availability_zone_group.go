@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceAvailabilityZoneGroup() *schema.Resource {
@@ -46,7 +47,7 @@ func ResourceAvailabilityZoneGroup() *schema.Resource {
 }
 
 func resourceAvailabilityZoneGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	configurationOptInStatus := d.Get("opt_in_status").(string)
 
@@ -77,7 +78,7 @@ func resourceAvailabilityZoneGroupCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAvailabilityZoneGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	availabilityZone, err := ec2DescribeAvailabilityZoneGroup(conn, d.Id())
 
@@ -96,7 +97,7 @@ func resourceAvailabilityZoneGroupRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAvailabilityZoneGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	optInStatus := d.Get("opt_in_status").(string)
 
 	input := &ec2.ModifyAvailabilityZoneGroupInput{
@@ -183,7 +184,7 @@ func waitForEc2AvailabilityZoneGroupOptInStatus(conn *ec2.EC2, groupName string,
 	}
 
 	log.Printf("[DEBUG] Waiting for EC2 Availability Zone Group (%s) opt-in status update", groupName)
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func testAccClientVPNAuthorizationRule_basic(t *testing.T) {
 	var v ec2.AuthorizationRule
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
 	subnetResourceName := "aws_subnet.test.0"
 
@@ -47,7 +46,7 @@ func testAccClientVPNAuthorizationRule_basic(t *testing.T) {
 
 func testAccClientVPNAuthorizationRule_groups(t *testing.T) {
 	var v1, v2, v3, v4 ec2.AuthorizationRule
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resource1Name := "aws_ec2_client_vpn_authorization_rule.test1"
 	resource2Name := "aws_ec2_client_vpn_authorization_rule.test2"
 	subnetResourceName := "aws_subnet.test.0"
@@ -120,7 +119,7 @@ func testAccClientVPNAuthorizationRule_groups(t *testing.T) {
 
 func testAccClientVPNAuthorizationRule_Subnets(t *testing.T) {
 	var v1, v2, v3 ec2.AuthorizationRule
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resource1Name := "aws_ec2_client_vpn_authorization_rule.test1"
 	resource2Name := "aws_ec2_client_vpn_authorization_rule.test2"
 
@@ -177,7 +176,7 @@ func testAccClientVPNAuthorizationRule_Subnets(t *testing.T) {
 
 func testAccClientVPNAuthorizationRule_disappears(t *testing.T) {
 	var v ec2.AuthorizationRule
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -199,7 +198,7 @@ func testAccClientVPNAuthorizationRule_disappears(t *testing.T) {
 }
 
 func testAccCheckClientVPNAuthorizationRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_client_vpn_authorization_rule" {
@@ -230,7 +229,7 @@ func testAccCheckClientVPNAuthorizationRuleExists(name string, assoc *ec2.Author
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		result, err := tfec2.FindClientVPNAuthorizationRuleByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -1,28 +1,42 @@
 package ec2_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	"github.com/hashicorp/terraform-provider-aws/internal/ratelimit"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
 const clientVpnEndpointDefaultLimit = 5
 
-var testAccEc2ClientVpnEndpointSemaphore sync.Semaphore
+var (
+	testAccEc2ClientVpnEndpointLimit     int
+	testAccEc2ClientVpnEndpointSemaphore ratelimit.Semaphore
+)
 
 func init() {
-	testAccEc2ClientVpnEndpointSemaphore = sync.InitializeSemaphore("AWS_EC2_CLIENT_VPN_LIMIT", clientVpnEndpointDefaultLimit)
+	testAccEc2ClientVpnEndpointLimit = clientVpnEndpointDefaultLimit
+
+	if v := os.Getenv("AWS_EC2_CLIENT_VPN_LIMIT"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			panic(fmt.Errorf("could not parse %q: expected integer, got %q", "AWS_EC2_CLIENT_VPN_LIMIT", v))
+		}
+		testAccEc2ClientVpnEndpointLimit = limit
+	}
+
+	testAccEc2ClientVpnEndpointSemaphore = ratelimit.NewSemaphore(testAccEc2ClientVpnEndpointLimit)
 }
 
 // This is part of an experimental feature, do not use this as a starting point for tests
@@ -70,7 +84,7 @@ func TestAccEC2ClientVPNEndpoint_serial(t *testing.T) {
 			t.Run(fmt.Sprintf("%s_%s", group, name), func(t *testing.T) {
 				t.Cleanup(func() {
 					if os.Getenv(resource.TestEnvVar) != "" {
-						testAccEc2ClientVpnEndpointSemaphore.Notify()
+						testAccEc2ClientVpnEndpointSemaphore.Release()
 					}
 				})
 				tc(t)
@@ -81,7 +95,7 @@ func TestAccEC2ClientVPNEndpoint_serial(t *testing.T) {
 
 func testAccClientVPNEndpoint_basic(t *testing.T) {
 	var v ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -111,7 +125,7 @@ func testAccClientVPNEndpoint_basic(t *testing.T) {
 
 func testAccClientVPNEndpoint_disappears(t *testing.T) {
 	var v ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -134,7 +148,7 @@ func testAccClientVPNEndpoint_disappears(t *testing.T) {
 
 func testAccClientVPNEndpoint_msAD(t *testing.T) {
 	var v ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -162,7 +176,7 @@ func testAccClientVPNEndpoint_msAD(t *testing.T) {
 
 func testAccClientVPNEndpoint_mutualAuthAndMsAD(t *testing.T) {
 	var v ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -191,7 +205,7 @@ func testAccClientVPNEndpoint_mutualAuthAndMsAD(t *testing.T) {
 
 func testAccClientVPNEndpoint_federated(t *testing.T) {
 	var v ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -230,7 +244,7 @@ func testAccClientVPNEndpoint_federated(t *testing.T) {
 
 func testAccClientVPNEndpoint_withLogGroup(t *testing.T) {
 	var v1, v2 ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 	logGroupResourceName := "aws_cloudwatch_log_group.lg"
 	logStreamResourceName := "aws_cloudwatch_log_stream.ls"
@@ -268,7 +282,7 @@ func testAccClientVPNEndpoint_withLogGroup(t *testing.T) {
 
 func testAccClientVPNEndpoint_withDNSServers(t *testing.T) {
 	var v1, v2 ec2.ClientVpnEndpoint
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -296,7 +310,7 @@ func testAccClientVPNEndpoint_withDNSServers(t *testing.T) {
 func testAccClientVPNEndpoint_tags(t *testing.T) {
 	var v1, v2, v3 ec2.ClientVpnEndpoint
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheckClientVPNSyncronize(t); acctest.PreCheck(t) },
@@ -338,7 +352,7 @@ func testAccClientVPNEndpoint_tags(t *testing.T) {
 
 func testAccClientVPNEndpoint_splitTunnel(t *testing.T) {
 	var v1, v2 ec2.ClientVpnEndpoint
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -372,7 +386,7 @@ func testAccClientVPNEndpoint_splitTunnel(t *testing.T) {
 
 func testAccClientVPNEndpoint_selfServicePortal(t *testing.T) {
 	var v1, v2 ec2.ClientVpnEndpoint
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ec2_client_vpn_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -405,11 +419,17 @@ func testAccClientVPNEndpoint_selfServicePortal(t *testing.T) {
 }
 
 func testAccPreCheckClientVPNSyncronize(t *testing.T) {
-	sync.TestAccPreCheckSyncronize(t, testAccEc2ClientVpnEndpointSemaphore, "Client VPN")
+	if testAccEc2ClientVpnEndpointLimit <= 0 {
+		t.Skip("concurrency for Client VPN testing set to 0")
+	}
+
+	if err := testAccEc2ClientVpnEndpointSemaphore.Acquire(context.Background()); err != nil {
+		t.Fatalf("error waiting for Client VPN testing concurrency: %s", err)
+	}
 }
 
 func testAccCheckClientVPNEndpointDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_client_vpn_endpoint" {
@@ -437,7 +457,7 @@ func testAccCheckClientVPNEndpointExists(name string, endpoint *ec2.ClientVpnEnd
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		input := &ec2.DescribeClientVpnEndpointsInput{
 			ClientVpnEndpointIds: aws.StringSlice([]string{rs.Primary.ID}),
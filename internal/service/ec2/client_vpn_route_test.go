@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func testAccClientVPNRoute_basic(t *testing.T) {
 	var v ec2.ClientVpnRoute
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 
 	resourceName := "aws_ec2_client_vpn_route.test"
 	endpointResourceName := "aws_ec2_client_vpn_endpoint.test"
@@ -51,7 +50,7 @@ func testAccClientVPNRoute_basic(t *testing.T) {
 
 func testAccClientVPNRoute_description(t *testing.T) {
 	var v ec2.ClientVpnRoute
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 
 	resourceName := "aws_ec2_client_vpn_route.test"
 	endpointResourceName := "aws_ec2_client_vpn_endpoint.test"
@@ -83,7 +82,7 @@ func testAccClientVPNRoute_description(t *testing.T) {
 
 func testAccClientVPNRoute_disappears(t *testing.T) {
 	var v ec2.ClientVpnRoute
-	rStr := sdkacctest.RandString(5)
+	rStr := acctest.RandString(t, 5)
 
 	resourceName := "aws_ec2_client_vpn_route.test"
 
@@ -106,7 +105,7 @@ func testAccClientVPNRoute_disappears(t *testing.T) {
 }
 
 func testAccCheckClientVPNRouteDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_client_vpn_route" {
@@ -135,7 +134,7 @@ func testAccCheckClientVPNRouteExists(name string, route *ec2.ClientVpnRoute) re
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		resp, err := tfec2.FindClientVPNRouteByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -50,7 +50,7 @@ func DataSourceCustomerGateway() *schema.Resource {
 }
 
 func dataSourceCustomerGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	input := ec2.DescribeCustomerGatewaysInput{}
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -13,9 +12,10 @@ import (
 func TestAccEC2CustomerGatewayDataSource_filter(t *testing.T) {
 	dataSourceName := "data.aws_customer_gateway.test"
 	resourceName := "aws_customer_gateway.test"
+	rName := acctest.RandomWithPrefix(t, "test-filter")
 
-	asn := sdkacctest.RandIntRange(64512, 65534)
-	hostOctet := sdkacctest.RandIntRange(1, 254)
+	asn := acctest.RandIntRange(t, 64512, 65534)
+	hostOctet := acctest.RandIntRange(t, 1, 254)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -24,7 +24,7 @@ func TestAccEC2CustomerGatewayDataSource_filter(t *testing.T) {
 		CheckDestroy: testAccCheckCustomerGatewayDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCustomerGatewayFilterDataSourceConfig(asn, hostOctet),
+				Config: testAccCustomerGatewayFilterDataSourceConfig(rName, asn, hostOctet),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(resourceName, "bgp_asn", dataSourceName, "bgp_asn"),
 					resource.TestCheckResourceAttrPair(resourceName, "ip_address", dataSourceName, "ip_address"),
@@ -41,8 +41,8 @@ func TestAccEC2CustomerGatewayDataSource_id(t *testing.T) {
 	dataSourceName := "data.aws_customer_gateway.test"
 	resourceName := "aws_customer_gateway.test"
 
-	asn := sdkacctest.RandIntRange(64512, 65534)
-	hostOctet := sdkacctest.RandIntRange(1, 254)
+	asn := acctest.RandIntRange(t, 64512, 65534)
+	hostOctet := acctest.RandIntRange(t, 1, 254)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -64,8 +64,7 @@ func TestAccEC2CustomerGatewayDataSource_id(t *testing.T) {
 	})
 }
 
-func testAccCustomerGatewayFilterDataSourceConfig(asn, hostOctet int) string {
-	name := sdkacctest.RandomWithPrefix("test-filter")
+func testAccCustomerGatewayFilterDataSourceConfig(rName string, asn, hostOctet int) string {
 	return fmt.Sprintf(`
 resource "aws_customer_gateway" "test" {
   bgp_asn    = %d
@@ -83,7 +82,7 @@ data "aws_customer_gateway" "test" {
     values = [aws_customer_gateway.test.tags.Name]
   }
 }
-`, asn, hostOctet, name)
+`, asn, hostOctet, rName)
 }
 
 func testAccCustomerGatewayIDDataSourceConfig(asn, hostOctet int) string {
@@ -27,7 +27,7 @@ func ResourceEBSEncryptionByDefault() *schema.Resource {
 }
 
 func resourceEBSEncryptionByDefaultCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	enabled := d.Get("enabled").(bool)
 	if err := setEbsEncryptionByDefault(conn, enabled); err != nil {
@@ -41,7 +41,7 @@ func resourceEBSEncryptionByDefaultCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceEBSEncryptionByDefaultRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	resp, err := conn.GetEbsEncryptionByDefault(&ec2.GetEbsEncryptionByDefaultInput{})
 	if err != nil {
@@ -54,7 +54,7 @@ func resourceEBSEncryptionByDefaultRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceEBSEncryptionByDefaultUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	enabled := d.Get("enabled").(bool)
 	if err := setEbsEncryptionByDefault(conn, enabled); err != nil {
@@ -65,7 +65,7 @@ func resourceEBSEncryptionByDefaultUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceEBSEncryptionByDefaultDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	// Removing the resource disables default encryption.
 	if err := setEbsEncryptionByDefault(conn, false); err != nil {
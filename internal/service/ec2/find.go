@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/readcache"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -374,16 +375,23 @@ func FindMainRouteTableByVPCID(conn *ec2.EC2, vpcID string) (*ec2.RouteTable, er
 
 // FindRouteTableByID returns the route table corresponding to the specified identifier.
 // Returns NotFoundError if no route table is found.
+// The result may be served from the provider read cache.
 func FindRouteTableByID(conn *ec2.EC2, routeTableID string) (*ec2.RouteTable, error) {
 	input := &ec2.DescribeRouteTablesInput{
 		RouteTableIds: aws.StringSlice([]string{routeTableID}),
 	}
 
-	return FindRouteTable(conn, input)
+	return findRouteTable(conn, input, readcache.Parent{Field: "RouteTableId", Value: routeTableID})
 }
 
 func FindRouteTable(conn *ec2.EC2, input *ec2.DescribeRouteTablesInput) (*ec2.RouteTable, error) {
-	output, err := conn.DescribeRouteTables(input)
+	return findRouteTable(conn, input, readcache.Parent{})
+}
+
+func findRouteTable(conn *ec2.EC2, input *ec2.DescribeRouteTablesInput, parent readcache.Parent) (*ec2.RouteTable, error) {
+	outputRaw, err := readcache.Do(conn.Client, "DescribeRouteTables", input, parent, func() (interface{}, error) {
+		return conn.DescribeRouteTables(input)
+	})
 
 	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidRouteTableIDNotFound) {
 		return nil, &resource.NotFoundError{
@@ -396,6 +404,8 @@ func FindRouteTable(conn *ec2.EC2, input *ec2.DescribeRouteTablesInput) (*ec2.Ro
 		return nil, err
 	}
 
+	output, _ := outputRaw.(*ec2.DescribeRouteTablesOutput)
+
 	if output == nil || len(output.RouteTables) == 0 || output.RouteTables[0] == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
@@ -467,11 +477,12 @@ func FindRouteByPrefixListIDDestination(conn *ec2.EC2, routeTableID, prefixListI
 }
 
 // FindSecurityGroupByID looks up a security group by ID. Returns a resource.NotFoundError if not found.
+// The result may be served from the provider read cache.
 func FindSecurityGroupByID(conn *ec2.EC2, id string) (*ec2.SecurityGroup, error) {
 	input := &ec2.DescribeSecurityGroupsInput{
 		GroupIds: aws.StringSlice([]string{id}),
 	}
-	return FindSecurityGroup(conn, input)
+	return findSecurityGroup(conn, input, readcache.Parent{Field: "GroupId", Value: id})
 }
 
 // FindSecurityGroupByNameAndVPCID looks up a security group by name and VPC ID. Returns a resource.NotFoundError if not found.
@@ -489,7 +500,13 @@ func FindSecurityGroupByNameAndVPCID(conn *ec2.EC2, name, vpcID string) (*ec2.Se
 
 // FindSecurityGroup looks up a security group using an ec2.DescribeSecurityGroupsInput. Returns a resource.NotFoundError if not found.
 func FindSecurityGroup(conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) (*ec2.SecurityGroup, error) {
-	result, err := conn.DescribeSecurityGroups(input)
+	return findSecurityGroup(conn, input, readcache.Parent{})
+}
+
+func findSecurityGroup(conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput, parent readcache.Parent) (*ec2.SecurityGroup, error) {
+	resultRaw, err := readcache.Do(conn.Client, "DescribeSecurityGroups", input, parent, func() (interface{}, error) {
+		return conn.DescribeSecurityGroups(input)
+	})
 	if tfawserr.ErrCodeEquals(err, InvalidSecurityGroupIDNotFound) ||
 		tfawserr.ErrCodeEquals(err, InvalidGroupNotFound) {
 		return nil, &resource.NotFoundError{
@@ -501,6 +518,8 @@ func FindSecurityGroup(conn *ec2.EC2, input *ec2.DescribeSecurityGroupsInput) (*
 		return nil, err
 	}
 
+	result, _ := resultRaw.(*ec2.DescribeSecurityGroupsOutput)
+
 	if result == nil || len(result.SecurityGroups) == 0 || result.SecurityGroups[0] == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,10 +34,10 @@ import (
 func ResourceInstance() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
-		Create: resourceInstanceCreate,
-		Read:   resourceInstanceRead,
-		Update: resourceInstanceUpdate,
-		Delete: resourceInstanceDelete,
+		CreateWithoutTimeout: resourceInstanceCreate,
+		ReadWithoutTimeout:   resourceInstanceRead,
+		UpdateWithoutTimeout: resourceInstanceUpdate,
+		DeleteWithoutTimeout: resourceInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -648,7 +649,7 @@ func ResourceInstance() *schema.Resource {
 				_, ok := diff.GetOk("launch_template")
 
 				if diff.Id() != "" && diff.HasChange("launch_template.0.version") && ok {
-					conn := meta.(*conns.AWSClient).EC2Conn
+					conn := meta.(*conns.AWSClient).EC2Conn()
 
 					stateVersion := diff.Get("launch_template.0.version")
 
@@ -716,14 +717,14 @@ func throughputDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 	return strings.ToLower(v) != ec2.VolumeTypeGp3 && new == "0"
 }
 
-func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	instanceOpts, err := buildInstanceOpts(d, meta)
 	if err != nil {
-		return fmt.Errorf("error collecting instance settings: %w", err)
+		return diag.FromErr(fmt.Errorf("error collecting instance settings: %w", err))
 	}
 
 	tagSpecifications := ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeInstance)
@@ -765,14 +766,14 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	_, ipv6AddressOk := d.GetOk("ipv6_addresses")
 
 	if ipv6AddressOk && ipv6CountOk {
-		return fmt.Errorf("Only 1 of `ipv6_address_count` or `ipv6_addresses` can be specified")
+		return diag.FromErr(fmt.Errorf("Only 1 of `ipv6_address_count` or `ipv6_addresses` can be specified"))
 	}
 
 	// Create the instance
 	log.Printf("[DEBUG] Run configuration: %s", runOpts)
 
 	var runResp *ec2.Reservation
-	err = resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
 		var err error
 		runResp, err = conn.RunInstances(runOpts)
 		// IAM instance profiles can take ~10 seconds to propagate in AWS:
@@ -798,13 +799,13 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	// where a user uses group ids in security_groups for the Default VPC.
 	//   See https://github.com/hashicorp/terraform/issues/3798
 	if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "groupId is invalid") {
-		return fmt.Errorf("Error launching instance, possible mismatch of Security Group IDs and Names. See AWS Instance docs here: %s.\n\n\tAWS Error: %w", "https://terraform.io/docs/providers/aws/r/instance.html", err)
+		return diag.FromErr(fmt.Errorf("Error launching instance, possible mismatch of Security Group IDs and Names. See AWS Instance docs here: %s.\n\n\tAWS Error: %w", "https://terraform.io/docs/providers/aws/r/instance.html", err))
 	}
 	if err != nil {
-		return fmt.Errorf("Error launching source instance: %s", err)
+		return diag.FromErr(fmt.Errorf("Error launching source instance: %s", err))
 	}
 	if runResp == nil || len(runResp.Instances) == 0 {
-		return errors.New("Error launching source instance: no instances returned in response")
+		return diag.FromErr(errors.New("Error launching source instance: no instances returned in response"))
 	}
 
 	instance := runResp.Instances[0]
@@ -828,11 +829,14 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	instanceRaw, err := stateConf.WaitForState()
+	instanceRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EC2 Instance (%s) create", aws.StringValue(instance.InstanceId)),
+		Reason:      instanceStateReason,
+	})
 	if err != nil {
-		return fmt.Errorf(
+		return diag.FromErr(fmt.Errorf(
 			"Error waiting for instance (%s) to become ready: %s",
-			aws.StringValue(instance.InstanceId), err)
+			aws.StringValue(instance.InstanceId), err))
 	}
 
 	instance = instanceRaw.(*ec2.Instance)
@@ -884,11 +888,11 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Update if we need to
-	return resourceInstanceUpdate(d, meta)
+	return resourceInstanceUpdate(ctx, d, meta)
 }
 
-func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -903,7 +907,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		// Some other error, report it
-		return fmt.Errorf("error retrieving instance (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error retrieving instance (%s): %w", d.Id(), err))
 	}
 
 	// If nothing was found, then return no state
@@ -949,11 +953,11 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("metadata_options", flattenEc2InstanceMetadataOptions(instance.MetadataOptions)); err != nil {
-		return fmt.Errorf("error setting metadata_options: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting metadata_options: %s", err))
 	}
 
 	if err := d.Set("enclave_options", flattenEc2EnclaveOptions(instance.EnclaveOptions)); err != nil {
-		return fmt.Errorf("error setting enclave_options: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting enclave_options: %s", err))
 	}
 
 	d.Set("ami", instance.ImageId)
@@ -969,7 +973,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		name, err := tfiam.InstanceProfileARNToName(aws.StringValue(instance.IamInstanceProfile.Arn))
 
 		if err != nil {
-			return fmt.Errorf("error setting iam_instance_profile: %w", err)
+			return diag.FromErr(fmt.Errorf("error setting iam_instance_profile: %w", err))
 		}
 
 		d.Set("iam_instance_profile", name)
@@ -980,10 +984,10 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	{
 		launchTemplate, err := getInstanceLaunchTemplate(conn, d)
 		if err != nil {
-			return fmt.Errorf("error reading Instance (%s) Launch Template: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error reading Instance (%s) Launch Template: %w", d.Id(), err))
 		}
 		if err := d.Set("launch_template", launchTemplate); err != nil {
-			return fmt.Errorf("error setting launch_template: %w", err)
+			return diag.FromErr(fmt.Errorf("error setting launch_template: %w", err))
 		}
 	}
 
@@ -1026,7 +1030,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 			networkInterfaces = append(networkInterfaces, ni)
 		}
 		if err := d.Set("network_interface", networkInterfaces); err != nil {
-			return fmt.Errorf("Error setting network_interfaces: %v", err)
+			return diag.FromErr(fmt.Errorf("Error setting network_interfaces: %v", err))
 		}
 
 		// Set primary network interface details
@@ -1063,7 +1067,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("secondary_private_ips", secondaryPrivateIPs); err != nil {
-		return fmt.Errorf("Error setting private_ips for AWS Instance (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("Error setting private_ips for AWS Instance (%s): %w", d.Id(), err))
 	}
 
 	if err := d.Set("ipv6_addresses", ipv6Addresses); err != nil {
@@ -1084,35 +1088,35 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	if _, ok := d.GetOk("volume_tags"); ok && !blockDeviceTagsDefined(d) {
 		volumeTags, err := readVolumeTags(conn, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if err := d.Set("volume_tags", KeyValueTags(volumeTags).IgnoreAWS().Map()); err != nil {
-			return fmt.Errorf("error setting volume_tags: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting volume_tags: %s", err))
 		}
 	}
 
 	if err := readSecurityGroups(d, instance, conn); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Retrieve instance shutdown behavior
 	if err := readInstanceShutdownBehavior(d, conn); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if err := readBlockDevices(d, instance, conn); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if _, ok := d.GetOk("ephemeral_block_device"); !ok {
 		d.Set("ephemeral_block_device", []interface{}{})
@@ -1136,7 +1140,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 			InstanceId: aws.String(d.Id()),
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("disable_api_termination", attr.DisableApiTermination.Value)
 	}
@@ -1146,7 +1150,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 			InstanceId: aws.String(d.Id()),
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		if attr.UserData != nil && attr.UserData.Value != nil {
 			// Since user_data and user_data_base64 conflict with each other,
@@ -1170,18 +1174,18 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		// Ignore UnsupportedOperation errors for AWS China and GovCloud (US)
 		// Reference: https://github.com/hashicorp/terraform-provider-aws/pull/4362
 		if err != nil && !tfawserr.ErrMessageContains(err, "UnsupportedOperation", "") {
-			return fmt.Errorf("error getting EC2 Instance (%s) Credit Specifications: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error getting EC2 Instance (%s) Credit Specifications: %s", d.Id(), err))
 		}
 
 		if err := d.Set("credit_specification", creditSpecifications); err != nil {
-			return fmt.Errorf("error setting credit_specification: %s", err)
+			return diag.FromErr(fmt.Errorf("error setting credit_specification: %s", err))
 		}
 	}
 
 	if d.Get("get_password_data").(bool) {
 		passwordData, err := getInstancePasswordData(aws.StringValue(instance.InstanceId), conn)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		d.Set("password_data", passwordData)
 	} else {
@@ -1190,34 +1194,34 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("capacity_reservation_specification", flattenCapacityReservationSpecification(instance.CapacityReservationSpecification)); err != nil {
-		return fmt.Errorf("error setting capacity reservation specification: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting capacity reservation specification: %s", err))
 	}
 
 	return nil
 }
 
-func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") && !d.IsNewResource() {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %s", err))
 		}
 	}
 
 	if d.HasChange("volume_tags") && !d.IsNewResource() {
 		volumeIds, err := getInstanceVolumeIDs(conn, d.Id())
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		o, n := d.GetChange("volume_tags")
 
 		for _, volumeId := range volumeIds {
 			if err := UpdateTags(conn, volumeId, o, n); err != nil {
-				return fmt.Errorf("error updating volume_tags (%s): %s", volumeId, err)
+				return diag.FromErr(fmt.Errorf("error updating volume_tags (%s): %s", volumeId, err))
 			}
 		}
 	}
@@ -1234,7 +1238,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		resp, err := conn.DescribeIamInstanceProfileAssociations(request)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		// An Iam Instance Profile has been provided and is pending a change
@@ -1243,7 +1247,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			// Does not have an Iam Instance Profile associated with it, need to associate
 			if len(resp.IamInstanceProfileAssociations) == 0 {
 				if err := associateInstanceProfile(d, conn); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			} else {
 				// Has an Iam Instance Profile associated with it, need to replace the association
@@ -1262,13 +1266,13 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				if instanceState != "" {
 					if instanceState == ec2.InstanceStateNameStopped || instanceState == ec2.InstanceStateNameStopping || instanceState == ec2.InstanceStateNameShuttingDown {
 						if err := disassociateInstanceProfile(associationId, conn); err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 						if err := associateInstanceProfile(d, conn); err != nil {
-							return err
+							return diag.FromErr(err)
 						}
 					} else {
-						err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+						err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
 							_, err := conn.ReplaceIamInstanceProfileAssociation(input)
 							if err != nil {
 								if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Invalid IAM Instance Profile") {
@@ -1282,7 +1286,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 							_, err = conn.ReplaceIamInstanceProfileAssociation(input)
 						}
 						if err != nil {
-							return fmt.Errorf("Error replacing instance profile association: %s", err)
+							return diag.FromErr(fmt.Errorf("Error replacing instance profile association: %s", err))
 						}
 					}
 				}
@@ -1293,13 +1297,13 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				// Has an Iam Instance Profile associated with it, need to remove the association
 				associationId := resp.IamInstanceProfileAssociations[0].AssociationId
 				if err := disassociateInstanceProfile(associationId, conn); err != nil {
-					return err
+					return diag.FromErr(err)
 				}
 			}
 		}
 
 		if _, err := WaitInstanceIAMInstanceProfileUpdated(conn, d.Id(), d.Get("iam_instance_profile").(string)); err != nil {
-			return fmt.Errorf("error waiting for EC2 Instance (%s) IAM Instance Profile update: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for EC2 Instance (%s) IAM Instance Profile update: %w", d.Id(), err))
 		}
 	}
 
@@ -1328,7 +1332,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				// Tolerate InvalidParameterCombination error in Classic, otherwise
 				// return the error
 				if !tfawserr.ErrMessageContains(err, "InvalidParameterCombination", "") {
-					return err
+					return diag.FromErr(err)
 				}
 				log.Printf("[WARN] Attempted to modify SourceDestCheck on non VPC instance: %s", err)
 			}
@@ -1338,7 +1342,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChanges("secondary_private_ips", "vpc_security_group_ids") && !d.IsNewResource() {
 		instance, err := InstanceFindByID(conn, d.Id())
 		if err != nil {
-			return fmt.Errorf("error retrieving instance %q: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error retrieving instance %q: %w", d.Id(), err))
 		}
 		var primaryInterface ec2.InstanceNetworkInterface
 		for _, ni := range instance.NetworkInterfaces {
@@ -1349,8 +1353,8 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		if d.HasChange("secondary_private_ips") {
 			if primaryInterface.NetworkInterfaceId == nil {
-				return fmt.Errorf("Failed to update secondary_private_ips on %q, which does not contain a primary network interface",
-					d.Id())
+				return diag.FromErr(fmt.Errorf("Failed to update secondary_private_ips on %q, which does not contain a primary network interface",
+					d.Id()))
 			}
 			o, n := d.GetChange("secondary_private_ips")
 			if o == nil {
@@ -1373,7 +1377,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				log.Printf("[INFO] Unassigning secondary_private_ips on Instance %q", d.Id())
 				_, err := conn.UnassignPrivateIpAddresses(input)
 				if err != nil {
-					return fmt.Errorf("Failure to unassign Secondary Private IPs: %w", err)
+					return diag.FromErr(fmt.Errorf("Failure to unassign Secondary Private IPs: %w", err))
 				}
 			}
 
@@ -1387,15 +1391,15 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				log.Printf("[INFO] Assigning secondary_private_ips on Instance %q", d.Id())
 				_, err := conn.AssignPrivateIpAddresses(input)
 				if err != nil {
-					return fmt.Errorf("Failure to assign Secondary Private IPs: %w", err)
+					return diag.FromErr(fmt.Errorf("Failure to assign Secondary Private IPs: %w", err))
 				}
 			}
 		}
 
 		if d.HasChange("vpc_security_group_ids") {
 			if primaryInterface.NetworkInterfaceId == nil {
-				return fmt.Errorf("Failed to update vpc_security_group_ids on %q, which does not contain a primary network interface",
-					d.Id())
+				return diag.FromErr(fmt.Errorf("Failed to update vpc_security_group_ids on %q, which does not contain a primary network interface",
+					d.Id()))
 			}
 			var groups []*string
 			if v := d.Get("vpc_security_group_ids").(*schema.Set); v.Len() > 0 {
@@ -1405,7 +1409,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 
 			if len(groups) < 1 {
-				return fmt.Errorf("VPC-based instances require at least one security group to be attached.")
+				return diag.FromErr(fmt.Errorf("VPC-based instances require at least one security group to be attached."))
 			}
 			// If a user has multiple network interface attachments on the target EC2 instance, simply modifying the
 			// instance attributes via a `ModifyInstanceAttributes()` request would fail with the following error message:
@@ -1417,7 +1421,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				NetworkInterfaceId: primaryInterface.NetworkInterfaceId,
 				Groups:             groups,
 			}); err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1428,11 +1432,11 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			InstanceIds: []*string{aws.String(d.Id())},
 		})
 		if err != nil {
-			return fmt.Errorf("error stopping instance (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error stopping instance (%s): %s", d.Id(), err))
 		}
 
-		if err := WaitForInstanceStopping(conn, d.Id(), InstanceStopTimeout); err != nil {
-			return err
+		if err := WaitForInstanceStoppingContext(ctx, conn, d.Id(), InstanceStopTimeout); err != nil {
+			return diag.FromErr(err)
 		}
 
 		log.Printf("[INFO] Modifying instance type %s", d.Id())
@@ -1443,7 +1447,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			},
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		log.Printf("[INFO] Starting Instance %q after instance_type change", d.Id())
@@ -1453,7 +1457,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16433
-		err = resource.Retry(InstanceAttributePropagationTimeout, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, InstanceAttributePropagationTimeout, func() *resource.RetryError {
 			_, err := conn.StartInstances(input)
 
 			if tfawserr.ErrMessageContains(err, ErrCodeInvalidParameterValue, "LaunchPlan instance type does not match attribute value") {
@@ -1472,7 +1476,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("error starting EC2 Instance (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error starting EC2 Instance (%s): %w", d.Id(), err))
 		}
 
 		stateConf := &resource.StateChangeConf{
@@ -1484,11 +1488,14 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			MinTimeout: 3 * time.Second,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+			Description: fmt.Sprintf("EC2 Instance (%s) start", d.Id()),
+			Reason:      instanceStateReason,
+		})
 		if err != nil {
-			return fmt.Errorf(
+			return diag.FromErr(fmt.Errorf(
 				"Error waiting for instance (%s) to become ready: %s",
-				d.Id(), err)
+				d.Id(), err))
 		}
 	}
 
@@ -1496,7 +1503,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		err := resourceInstanceDisableAPITermination(conn, d.Id(), d.Get("disable_api_termination").(bool))
 
 		if err != nil {
-			return fmt.Errorf("error modifying instance (%s) attribute (%s): %w", d.Id(), ec2.InstanceAttributeNameDisableApiTermination, err)
+			return diag.FromErr(fmt.Errorf("error modifying instance (%s) attribute (%s): %w", d.Id(), ec2.InstanceAttributeNameDisableApiTermination, err))
 		}
 	}
 
@@ -1509,7 +1516,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			},
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -1527,7 +1534,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			})
 		}
 		if mErr != nil {
-			return fmt.Errorf("Error updating Instance monitoring: %s", mErr)
+			return diag.FromErr(fmt.Errorf("Error updating Instance monitoring: %s", mErr))
 		}
 	}
 
@@ -1544,7 +1551,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("Error updating Instance credit specification: %s", err)
+				return diag.FromErr(fmt.Errorf("Error updating Instance credit specification: %s", err))
 			}
 		}
 	}
@@ -1564,7 +1571,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				}
 				_, err := conn.ModifyInstanceMetadataOptions(input)
 				if err != nil {
-					return fmt.Errorf("Error updating metadata options: %s", err)
+					return diag.FromErr(fmt.Errorf("Error updating metadata options: %s", err))
 				}
 
 				stateConf := &resource.StateChangeConf{
@@ -1576,11 +1583,13 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					MinTimeout: 3 * time.Second,
 				}
 
-				_, err = stateConf.WaitForState()
+				_, err = tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+					Description: fmt.Sprintf("EC2 Instance (%s) metadata options update", d.Id()),
+				})
 				if err != nil {
-					return fmt.Errorf(
+					return diag.FromErr(fmt.Errorf(
 						"Error waiting for instance (%s) to apply metadata options update: %s",
-						d.Id(), err)
+						d.Id(), err))
 				}
 			}
 		}
@@ -1615,7 +1624,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 						// Volume defaults to gp2
 						t = ec2.VolumeTypeGp2
 					}
-					return fmt.Errorf("error updating instance: iops attribute not supported for type %s", t)
+					return diag.FromErr(fmt.Errorf("error updating instance: iops attribute not supported for type %s", t))
 				}
 				modifyVolume = true
 				input.Iops = aws.Int64(int64(v))
@@ -1625,7 +1634,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			if v, ok := d.Get("root_block_device.0.throughput").(int); ok && v != 0 {
 				// Enforce throughput usage with a valid volume type
 				if t, ok := d.Get("root_block_device.0.volume_type").(string); ok && t != ec2.VolumeTypeGp3 {
-					return fmt.Errorf("error updating instance: throughput attribute not supported for type %s", t)
+					return diag.FromErr(fmt.Errorf("error updating instance: throughput attribute not supported for type %s", t))
 				}
 				modifyVolume = true
 				input.Throughput = aws.Int64(int64(v))
@@ -1634,7 +1643,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		if modifyVolume {
 			_, err := conn.ModifyVolume(&input)
 			if err != nil {
-				return fmt.Errorf("error modifying EC2 Volume %q: %w", volumeID, err)
+				return diag.FromErr(fmt.Errorf("error modifying EC2 Volume %q: %w", volumeID, err))
 			}
 
 			// The volume is useable once the state is "optimizing", but will not be at full performance.
@@ -1649,9 +1658,12 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				MinTimeout: 30 * time.Second,
 			}
 
-			_, err = stateConf.WaitForState()
+			_, err = tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+				Description: fmt.Sprintf("EC2 Volume (%s) modification", volumeID),
+				Reason:      volumeModificationStatusMessage,
+			})
 			if err != nil {
-				return fmt.Errorf("error waiting for EC2 volume (%s) to be modified: %w", volumeID, err)
+				return diag.FromErr(fmt.Errorf("error waiting for EC2 volume (%s) to be modified: %w", volumeID, err))
 			}
 		}
 
@@ -1670,7 +1682,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					},
 				})
 				if err != nil {
-					return fmt.Errorf("error modifying delete on termination attribute for EC2 instance %q block device %q: %w", d.Id(), deviceName, err)
+					return diag.FromErr(fmt.Errorf("error modifying delete on termination attribute for EC2 instance %q block device %q: %w", d.Id(), deviceName, err))
 				}
 
 				stateConf := &resource.StateChangeConf{
@@ -1681,10 +1693,12 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 					MinTimeout: 3 * time.Second,
 				}
 
-				_, err = stateConf.WaitForState()
+				_, err = tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+					Description: fmt.Sprintf("EC2 Instance (%s) DeleteOnTermination attribute update", d.Id()),
+				})
 				if err != nil {
-					return fmt.Errorf("Error waiting for instance (%s) to apply DeleteOnTermination attribute update: %s",
-						d.Id(), err)
+					return diag.FromErr(fmt.Errorf("Error waiting for instance (%s) to apply DeleteOnTermination attribute update: %s",
+						d.Id(), err))
 				}
 			}
 		}
@@ -1693,7 +1707,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			o, n := d.GetChange("root_block_device.0.tags")
 
 			if err := UpdateTags(conn, volumeID, o, n); err != nil {
-				return fmt.Errorf("error updating tags for volume (%s): %s", volumeID, err)
+				return diag.FromErr(fmt.Errorf("error updating tags for volume (%s): %s", volumeID, err))
 			}
 		}
 	}
@@ -1711,7 +1725,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				})
 
 				if err != nil {
-					return fmt.Errorf("Error updating instance capacity specification: %s", err)
+					return diag.FromErr(fmt.Errorf("Error updating instance capacity specification: %s", err))
 				}
 			}
 		}
@@ -1720,11 +1734,11 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	// TODO(mitchellh): wait for the attributes we modified to
 	// persist the change...
 
-	return resourceInstanceRead(d, meta)
+	return resourceInstanceRead(ctx, d, meta)
 }
 
-func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	err := resourceInstanceDisableAPITermination(conn, d.Id(), d.Get("disable_api_termination").(bool))
 
@@ -1732,10 +1746,10 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[WARN] attempting to terminate EC2 instance (%s) despite error modifying attribute (%s): %s", d.Id(), ec2.InstanceAttributeNameDisableApiTermination, err)
 	}
 
-	err = terminateInstance(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+	err = terminateInstanceContext(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete))
 
 	if err != nil {
-		return fmt.Errorf("error terminating EC2 Instance (%s): %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error terminating EC2 Instance (%s): %s", d.Id(), err))
 	}
 
 	return nil
@@ -1795,6 +1809,21 @@ func InstanceStateRefreshFunc(conn *ec2.EC2, instanceID string, failStates []str
 	}
 }
 
+// instanceStateReason returns the reason for the state of the EC2 instance returned by InstanceStateRefreshFunc.
+func instanceStateReason(v interface{}) string {
+	instance, ok := v.(*ec2.Instance)
+
+	if !ok || instance == nil {
+		return ""
+	}
+
+	if instance.StateReason != nil {
+		return aws.StringValue(instance.StateReason.Message)
+	}
+
+	return aws.StringValue(instance.StateTransitionReason)
+}
+
 // MetadataOptionsRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // changes in an EC2 instance's metadata options.
 func MetadataOptionsRefreshFunc(conn *ec2.EC2, instanceID string) resource.StateRefreshFunc {
@@ -1849,6 +1878,17 @@ func RootBlockDeviceDeleteOnTerminationRefreshFunc(conn *ec2.EC2, instanceID str
 	}
 }
 
+// volumeModificationStatusMessage returns the status message of the EC2 volume modification returned by VolumeStateRefreshFunc.
+func volumeModificationStatusMessage(v interface{}) string {
+	modification, ok := v.(*ec2.VolumeModification)
+
+	if !ok || modification == nil {
+		return ""
+	}
+
+	return aws.StringValue(modification.StatusMessage)
+}
+
 // VolumeStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // an EC2 root device volume.
 func VolumeStateRefreshFunc(conn *ec2.EC2, volumeID, failState string) resource.StateRefreshFunc {
@@ -2570,7 +2610,7 @@ type awsInstanceOpts struct {
 }
 
 func buildInstanceOpts(d *schema.ResourceData, meta interface{}) (*awsInstanceOpts, error) {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	opts := &awsInstanceOpts{
 		DisableAPITermination: aws.Bool(d.Get("disable_api_termination").(bool)),
@@ -2759,6 +2799,10 @@ func buildInstanceOpts(d *schema.ResourceData, meta interface{}) (*awsInstanceOp
 }
 
 func terminateInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
+	return terminateInstanceContext(context.Background(), conn, id, timeout)
+}
+
+func terminateInstanceContext(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) error {
 	log.Printf("[INFO] Terminating instance: %s", id)
 	req := &ec2.TerminateInstancesInput{
 		InstanceIds: []*string{aws.String(id)},
@@ -2770,10 +2814,14 @@ func terminateInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
 		return err
 	}
 
-	return waitForInstanceDeletion(conn, id, timeout)
+	return waitForInstanceDeletion(ctx, conn, id, timeout)
 }
 
 func WaitForInstanceStopping(conn *ec2.EC2, id string, timeout time.Duration) error {
+	return WaitForInstanceStoppingContext(context.Background(), conn, id, timeout)
+}
+
+func WaitForInstanceStoppingContext(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for instance (%s) to become stopped", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -2786,7 +2834,10 @@ func WaitForInstanceStopping(conn *ec2.EC2, id string, timeout time.Duration) er
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EC2 Instance (%s) stop", id),
+		Reason:      instanceStateReason,
+	})
 	if err != nil {
 		return fmt.Errorf(
 			"error waiting for instance (%s) to stop: %s", id, err)
@@ -2795,7 +2846,7 @@ func WaitForInstanceStopping(conn *ec2.EC2, id string, timeout time.Duration) er
 	return nil
 }
 
-func waitForInstanceDeletion(conn *ec2.EC2, id string, timeout time.Duration) error {
+func waitForInstanceDeletion(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for instance (%s) to become terminated", id)
 
 	stateConf := &resource.StateChangeConf{
@@ -2808,7 +2859,10 @@ func waitForInstanceDeletion(conn *ec2.EC2, id string, timeout time.Duration) er
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EC2 Instance (%s) termination", id),
+		Reason:      instanceStateReason,
+	})
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for instance (%s) to terminate: %s", id, err)
@@ -2959,7 +3013,7 @@ func expandEc2EnclaveOptions(l []interface{}) *ec2.EnclaveOptionsRequest {
 	return opts
 }
 
-//Expands an array of secondary Private IPs into a ec2 Private IP Address Spec
+// Expands an array of secondary Private IPs into a ec2 Private IP Address Spec
 func expandSecondaryPrivateIPAddresses(ips []interface{}) []*ec2.PrivateIpAddressSpecification {
 	specs := make([]*ec2.PrivateIpAddressSpecification, 0, len(ips))
 	for _, v := range ips {
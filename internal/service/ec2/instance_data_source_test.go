@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -35,7 +34,7 @@ func TestAccEC2InstanceDataSource_basic(t *testing.T) {
 }
 
 func TestAccEC2InstanceDataSource_tags(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
 
@@ -210,7 +209,7 @@ func TestAccEC2InstanceDataSource_rootInstanceStore(t *testing.T) {
 func TestAccEC2InstanceDataSource_privateIP(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(t, 12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -232,7 +231,7 @@ func TestAccEC2InstanceDataSource_privateIP(t *testing.T) {
 func TestAccEC2InstanceDataSource_secondaryPrivateIPs(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(t, 12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -254,7 +253,7 @@ func TestAccEC2InstanceDataSource_secondaryPrivateIPs(t *testing.T) {
 func TestAccEC2InstanceDataSource_ipv6Addresses(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -276,9 +275,9 @@ func TestAccEC2InstanceDataSource_ipv6Addresses(t *testing.T) {
 func TestAccEC2InstanceDataSource_keyPair(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-test-key-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-key-%d", acctest.RandInt(t))
 
-	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	publicKey, _, err := acctest.RandSSHKeyPair(t, acctest.DefaultEmailAddress)
 	if err != nil {
 		t.Fatalf("error generating random SSH key: %s", err)
 	}
@@ -304,7 +303,7 @@ func TestAccEC2InstanceDataSource_keyPair(t *testing.T) {
 func TestAccEC2InstanceDataSource_vpc(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(t, 12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -328,7 +327,7 @@ func TestAccEC2InstanceDataSource_vpc(t *testing.T) {
 func TestAccEC2InstanceDataSource_placementGroup(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(t, 12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -346,7 +345,7 @@ func TestAccEC2InstanceDataSource_placementGroup(t *testing.T) {
 }
 
 func TestAccEC2InstanceDataSource_securityGroups(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
 
@@ -372,7 +371,7 @@ func TestAccEC2InstanceDataSource_securityGroups(t *testing.T) {
 func TestAccEC2InstanceDataSource_vpcSecurityGroups(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(t, 12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -395,8 +394,8 @@ func TestAccEC2InstanceDataSource_vpcSecurityGroups(t *testing.T) {
 func TestAccEC2InstanceDataSource_GetPasswordData_trueToFalse(t *testing.T) {
 	datasourceName := "data.aws_instance.test"
 
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
-	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(t, 12))
+	publicKey, _, err := acctest.RandSSHKeyPair(t, acctest.DefaultEmailAddress)
 	if err != nil {
 		t.Fatalf("error generating random SSH key: %s", err)
 	}
@@ -427,8 +426,8 @@ func TestAccEC2InstanceDataSource_GetPasswordData_trueToFalse(t *testing.T) {
 func TestAccEC2InstanceDataSource_GetPasswordData_falseToTrue(t *testing.T) {
 	datasourceName := "data.aws_instance.test"
 
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
-	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(t, 12))
+	publicKey, _, err := acctest.RandSSHKeyPair(t, acctest.DefaultEmailAddress)
 	if err != nil {
 		t.Fatalf("error generating random SSH key: %s", err)
 	}
@@ -458,7 +457,7 @@ func TestAccEC2InstanceDataSource_GetPasswordData_falseToTrue(t *testing.T) {
 
 func TestAccEC2InstanceDataSource_getUserData(t *testing.T) {
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(t, 12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -493,7 +492,7 @@ func TestAccEC2InstanceDataSource_getUserData(t *testing.T) {
 func TestAccEC2InstanceDataSource_GetUserData_noUserData(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(t, 12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -531,7 +530,7 @@ func TestAccEC2InstanceDataSource_GetUserData_noUserData(t *testing.T) {
 func TestAccEC2InstanceDataSource_creditSpecification(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := fmt.Sprintf("tf-testacc-instance-%s", sdkacctest.RandString(12))
+	rName := fmt.Sprintf("tf-testacc-instance-%s", acctest.RandString(t, 12))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -554,7 +553,7 @@ func TestAccEC2InstanceDataSource_creditSpecification(t *testing.T) {
 func TestAccEC2InstanceDataSource_metadataOptions(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -577,7 +576,7 @@ func TestAccEC2InstanceDataSource_metadataOptions(t *testing.T) {
 func TestAccEC2InstanceDataSource_enclaveOptions(t *testing.T) {
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -596,7 +595,7 @@ func TestAccEC2InstanceDataSource_enclaveOptions(t *testing.T) {
 }
 
 func TestAccEC2InstanceDataSource_blockDeviceTags(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_instance.test"
 	datasourceName := "data.aws_instance.test"
 
@@ -6,13 +6,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccEC2LaunchTemplateDataSource_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	dataSourceName := "data.aws_launch_template.test"
 	resourceName := "aws_launch_template.test"
 
@@ -37,7 +36,7 @@ func TestAccEC2LaunchTemplateDataSource_basic(t *testing.T) {
 }
 
 func TestAccEC2LaunchTemplateDataSource_ID_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	dataSourceName := "data.aws_launch_template.test"
 	resourceName := "aws_launch_template.test"
 
@@ -62,7 +61,7 @@ func TestAccEC2LaunchTemplateDataSource_ID_basic(t *testing.T) {
 }
 
 func TestAccEC2LaunchTemplateDataSource_Filter_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	dataSourceName := "data.aws_launch_template.test"
 	resourceName := "aws_launch_template.test"
 
@@ -107,8 +106,8 @@ func TestAccEC2LaunchTemplateDataSource_Filter_basic(t *testing.T) {
 }
 
 func TestAccEC2LaunchTemplateDataSource_Filter_tags(t *testing.T) {
-	rInt := sdkacctest.RandInt()
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rInt := acctest.RandInt(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	dataSourceName := "data.aws_launch_template.test"
 	resourceName := "aws_launch_template.test"
 
@@ -133,7 +132,7 @@ func TestAccEC2LaunchTemplateDataSource_Filter_tags(t *testing.T) {
 }
 
 func TestAccEC2LaunchTemplateDataSource_metadataOptions(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	dataSourceName := "data.aws_launch_template.test"
 	resourceName := "aws_launch_template.test"
 
@@ -158,7 +157,7 @@ func TestAccEC2LaunchTemplateDataSource_metadataOptions(t *testing.T) {
 }
 
 func TestAccEC2LaunchTemplateDataSource_enclaveOptions(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	dataSourceName := "data.aws_launch_template.test"
 	resourceName := "aws_launch_template.test"
 
@@ -180,7 +179,7 @@ func TestAccEC2LaunchTemplateDataSource_enclaveOptions(t *testing.T) {
 }
 
 func TestAccEC2LaunchTemplateDataSource_associatePublicIPAddress(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	dataSourceName := "data.aws_launch_template.test"
 	resourceName := "aws_launch_template.test"
 
@@ -216,7 +215,7 @@ func TestAccEC2LaunchTemplateDataSource_associatePublicIPAddress(t *testing.T) {
 }
 
 func TestAccEC2LaunchTemplateDataSource_associateCarrierIPAddress(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	dataSourceName := "data.aws_launch_template.test"
 	resourceName := "aws_launch_template.test"
 
@@ -252,7 +251,7 @@ func TestAccEC2LaunchTemplateDataSource_associateCarrierIPAddress(t *testing.T)
 }
 
 func TestAccEC2LaunchTemplateDataSource_NetworkInterfaces_deleteOnTermination(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	dataSourceName := "data.aws_launch_template.test"
 	resourceName := "aws_launch_template.test"
 
@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,7 +14,7 @@ import (
 )
 
 func TestAccEC2LocalGatewayRoute_basic(t *testing.T) {
-	rInt := sdkacctest.RandIntRange(0, 255)
+	rInt := acctest.RandIntRange(t, 0, 255)
 	destinationCidrBlock := fmt.Sprintf("172.16.%d.0/24", rInt)
 	localGatewayRouteTableDataSourceName := "data.aws_ec2_local_gateway_route_table.test"
 	localGatewayVirtualInterfaceGroupDataSourceName := "data.aws_ec2_local_gateway_virtual_interface_group.test"
@@ -46,7 +45,7 @@ func TestAccEC2LocalGatewayRoute_basic(t *testing.T) {
 }
 
 func TestAccEC2LocalGatewayRoute_disappears(t *testing.T) {
-	rInt := sdkacctest.RandIntRange(0, 255)
+	rInt := acctest.RandIntRange(t, 0, 255)
 	destinationCidrBlock := fmt.Sprintf("172.16.%d.0/24", rInt)
 	resourceName := "aws_ec2_local_gateway_route.test"
 
@@ -85,7 +84,7 @@ func testAccCheckLocalGatewayRouteExists(resourceName string) resource.TestCheck
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		route, err := tfec2.GetLocalGatewayRoute(conn, localGatewayRouteTableID, destination)
 
@@ -102,7 +101,7 @@ func testAccCheckLocalGatewayRouteExists(resourceName string) resource.TestCheck
 }
 
 func testAccCheckLocalGatewayRouteDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_local_gateway_route" {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -73,7 +74,7 @@ func ResourceNatGateway() *schema.Resource {
 }
 
 func resourceNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -114,7 +115,7 @@ func resourceNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 		Timeout: 10 * time.Minute,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := tfresource.WaitForState(stateConf); err != nil {
 		return fmt.Errorf("Error waiting for NAT Gateway (%s) to become available: %s", d.Id(), err)
 	}
 
@@ -122,7 +123,7 @@ func resourceNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -171,7 +172,7 @@ func resourceNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -185,7 +186,7 @@ func resourceNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	deleteOpts := &ec2.DeleteNatGatewayInput{
 		NatGatewayId: aws.String(d.Id()),
 	}
@@ -214,7 +215,7 @@ func resourceNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 		MinTimeout: 10 * time.Second,
 	}
 
-	_, stateErr := stateConf.WaitForState()
+	_, stateErr := tfresource.WaitForState(stateConf)
 	if stateErr != nil {
 		return fmt.Errorf("Error waiting for NAT Gateway (%s) to delete: %s", d.Id(), err)
 	}
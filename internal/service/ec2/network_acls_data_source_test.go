@@ -6,13 +6,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccEC2NetworkACLsDataSource_basic(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 	dataSourceName := "data.aws_network_acls.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -37,7 +36,7 @@ func TestAccEC2NetworkACLsDataSource_basic(t *testing.T) {
 }
 
 func TestAccEC2NetworkACLsDataSource_filter(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 	dataSourceName := "data.aws_network_acls.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -57,7 +56,7 @@ func TestAccEC2NetworkACLsDataSource_filter(t *testing.T) {
 }
 
 func TestAccEC2NetworkACLsDataSource_tags(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 	dataSourceName := "data.aws_network_acls.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -77,7 +76,7 @@ func TestAccEC2NetworkACLsDataSource_tags(t *testing.T) {
 }
 
 func TestAccEC2NetworkACLsDataSource_vpcID(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 	dataSourceName := "data.aws_network_acls.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -149,7 +149,7 @@ func ResourceNetworkInterface() *schema.Resource {
 
 func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -229,7 +229,7 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -331,7 +331,7 @@ func resourceNetworkInterfaceDetach(oa *schema.Set, meta interface{}, eniId stri
 			AttachmentId: aws.String(old_attachment["attachment_id"].(string)),
 			Force:        aws.Bool(true),
 		}
-		conn := meta.(*conns.AWSClient).EC2Conn
+		conn := meta.(*conns.AWSClient).EC2Conn()
 		_, detach_err := conn.DetachNetworkInterface(detach_request)
 		if detach_err != nil {
 			if !tfawserr.ErrMessageContains(detach_err, "InvalidAttachmentID.NotFound", "") {
@@ -346,7 +346,7 @@ func resourceNetworkInterfaceDetach(oa *schema.Set, meta interface{}, eniId stri
 			Refresh: networkInterfaceAttachmentRefreshFunc(conn, eniId),
 			Timeout: 10 * time.Minute,
 		}
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := tfresource.WaitForState(stateConf); err != nil {
 			return fmt.Errorf(
 				"Error waiting for ENI (%s) to become detached: %s", eniId, err)
 		}
@@ -356,7 +356,7 @@ func resourceNetworkInterfaceDetach(oa *schema.Set, meta interface{}, eniId stri
 }
 
 func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("attachment") {
 		oa, na := d.GetChange("attachment")
@@ -582,7 +582,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting ENI: %s", d.Id())
 
@@ -665,7 +665,7 @@ func detachNetworkInterface(conn *ec2.EC2, eni *ec2.NetworkInterface, timeout ti
 	}
 
 	log.Printf("[DEBUG] Waiting for ENI (%s) to become detached", eniId)
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 
 	if tfresource.NotFound(err) {
 		return nil
@@ -748,7 +748,7 @@ func waitForNetworkInterfaceCreation(conn *ec2.EC2, id string, timeout time.Dura
 		Delay:   30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
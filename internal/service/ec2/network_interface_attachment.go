@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceNetworkInterfaceAttachment() *schema.Resource {
@@ -52,7 +53,7 @@ func ResourceNetworkInterfaceAttachment() *schema.Resource {
 }
 
 func resourceNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	device_index := d.Get("device_index").(int)
 	instance_id := d.Get("instance_id").(string)
@@ -83,7 +84,7 @@ func resourceNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta inter
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for Volume (%s) to attach to Instance: %s, error: %s", network_interface_id, instance_id, err)
@@ -94,7 +95,7 @@ func resourceNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	interfaceId := d.Get("network_interface_id").(string)
 
@@ -134,7 +135,7 @@ func resourceNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	interfaceId := d.Get("network_interface_id").(string)
 
@@ -158,7 +159,7 @@ func resourceNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta inter
 		Timeout: 10 * time.Minute,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := tfresource.WaitForState(stateConf); err != nil {
 		return fmt.Errorf(
 			"Error waiting for ENI (%s) to become detached: %s", interfaceId, err)
 	}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -115,7 +114,7 @@ func TestIpPermissionIDHash(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_Ingress_vpc(t *testing.T) {
 	var group ec2.SecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	testRuleCount := func(*terraform.State) error {
 		if len(group.IpPermissions) != 1 {
@@ -161,7 +160,7 @@ func TestAccEC2SecurityGroupRule_Ingress_vpc(t *testing.T) {
 func TestAccEC2SecurityGroupRule_IngressSourceWithAccount_id(t *testing.T) {
 	var group ec2.SecurityGroup
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	ruleName := "aws_security_group_rule.allow_self"
 
@@ -286,7 +285,7 @@ func TestAccEC2SecurityGroupRule_Ingress_ipv6(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_Ingress_classic(t *testing.T) {
 	var group ec2.SecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	testRuleCount := func(*terraform.State) error {
 		if len(group.IpPermissions) != 1 {
@@ -378,7 +377,7 @@ func TestAccEC2SecurityGroupRule_multiIngress(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_egress(t *testing.T) {
 	var group ec2.SecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -429,7 +428,7 @@ func TestAccEC2SecurityGroupRule_selfReference(t *testing.T) {
 }
 
 func TestAccEC2SecurityGroupRule_expectInvalidTypeError(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
@@ -445,7 +444,7 @@ func TestAccEC2SecurityGroupRule_expectInvalidTypeError(t *testing.T) {
 }
 
 func TestAccEC2SecurityGroupRule_expectInvalidCIDR(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
@@ -467,7 +466,7 @@ func TestAccEC2SecurityGroupRule_expectInvalidCIDR(t *testing.T) {
 // testing partial match implementation
 func TestAccEC2SecurityGroupRule_PartialMatching_basic(t *testing.T) {
 	var group ec2.SecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	p := ec2.IpPermission{
 		FromPort:   aws.Int64(80),
@@ -530,7 +529,7 @@ func TestAccEC2SecurityGroupRule_PartialMatching_source(t *testing.T) {
 	var group ec2.SecurityGroup
 	var nat ec2.SecurityGroup
 	var p ec2.IpPermission
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	// This function creates the expected IPPermission with the group id from an
 	// external security group, needed because Security Group IDs are generated on
@@ -612,7 +611,7 @@ func TestAccEC2SecurityGroupRule_race(t *testing.T) {
 		CheckDestroy: testAccCheckSecurityGroupRuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSecurityGroupRuleRace,
+				Config: testAccSecurityGroupRuleRaceConfig(acctest.RandInt(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckSecurityGroupRuleExists("aws_security_group.race", &group),
 				),
@@ -623,7 +622,7 @@ func TestAccEC2SecurityGroupRule_race(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_selfSource(t *testing.T) {
 	var group ec2.SecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -655,7 +654,7 @@ func TestAccEC2SecurityGroupRule_prefixListEgress(t *testing.T) {
 	// This function creates the expected IPPermission with the prefix list ID from
 	// the VPC Endpoint created in the test
 	setupSG := func(*terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		prefixListInput := &ec2.DescribePrefixListsInput{
 			Filters: []*ec2.Filter{
 				{Name: aws.String("prefix-list-name"), Values: []*string{endpoint.ServiceName}},
@@ -712,7 +711,7 @@ func TestAccEC2SecurityGroupRule_prefixListEgress(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_ingressDescription(t *testing.T) {
 	var group ec2.SecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -740,7 +739,7 @@ func TestAccEC2SecurityGroupRule_ingressDescription(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_egressDescription(t *testing.T) {
 	var group ec2.SecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -768,7 +767,7 @@ func TestAccEC2SecurityGroupRule_egressDescription(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_IngressDescription_updates(t *testing.T) {
 	var group ec2.SecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -805,7 +804,7 @@ func TestAccEC2SecurityGroupRule_IngressDescription_updates(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_EgressDescription_updates(t *testing.T) {
 	var group ec2.SecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -842,7 +841,7 @@ func TestAccEC2SecurityGroupRule_EgressDescription_updates(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_Description_allPorts(t *testing.T) {
 	var group ec2.SecurityGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	securityGroupResourceName := "aws_security_group.test"
 	resourceName := "aws_security_group_rule.test"
 
@@ -900,7 +899,7 @@ func TestAccEC2SecurityGroupRule_Description_allPorts(t *testing.T) {
 
 func TestAccEC2SecurityGroupRule_DescriptionAllPorts_nonZeroPorts(t *testing.T) {
 	var group ec2.SecurityGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	securityGroupResourceName := "aws_security_group.test"
 	resourceName := "aws_security_group_rule.test"
 
@@ -959,7 +958,7 @@ func TestAccEC2SecurityGroupRule_DescriptionAllPorts_nonZeroPorts(t *testing.T)
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/6416
 func TestAccEC2SecurityGroupRule_MultipleRuleSearching_allProtocolCrash(t *testing.T) {
 	var group ec2.SecurityGroup
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	securityGroupResourceName := "aws_security_group.test"
 	resourceName1 := "aws_security_group_rule.test1"
 	resourceName2 := "aws_security_group_rule.test2"
@@ -1007,7 +1006,7 @@ func TestAccEC2SecurityGroupRule_MultipleRuleSearching_allProtocolCrash(t *testi
 func TestAccEC2SecurityGroupRule_multiDescription(t *testing.T) {
 	var group ec2.SecurityGroup
 	var nat ec2.SecurityGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	rule1 := ec2.IpPermission{
 		FromPort:   aws.Int64(22),
@@ -1055,7 +1054,7 @@ func TestAccEC2SecurityGroupRule_multiDescription(t *testing.T) {
 	// This function creates the expected IPPermission with the prefix list ID from
 	// the VPC Endpoint created in the test
 	setupPL := func(*terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		prefixListInput := &ec2.DescribePrefixListsInput{
 			Filters: []*ec2.Filter{
 				{Name: aws.String("prefix-list-name"), Values: []*string{endpoint.ServiceName}},
@@ -1178,7 +1177,7 @@ func TestAccEC2SecurityGroupRule_multiDescription(t *testing.T) {
 }
 
 func testAccCheckSecurityGroupRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_security_group" {
@@ -1210,7 +1209,7 @@ func testAccCheckSecurityGroupRuleExists(n string, group *ec2.SecurityGroup) res
 			return fmt.Errorf("No Security Group is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		sg, err := tfec2.FindSecurityGroupByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -2025,7 +2024,7 @@ resource "aws_security_group_rule" "test2" {
 `, rName)
 }
 
-var testAccSecurityGroupRuleRace = func() string {
+func testAccSecurityGroupRuleRaceConfig(rInt int) string {
 	var b bytes.Buffer
 	iterations := 50
 	b.WriteString(fmt.Sprintf(`
@@ -2041,7 +2040,7 @@ resource "aws_security_group" "race" {
   name   = "tf-sg-rule-race-group-%d"
   vpc_id = aws_vpc.default.id
 }
-`, sdkacctest.RandInt()))
+`, rInt))
 	for i := 1; i < iterations; i++ {
 		b.WriteString(fmt.Sprintf(`
 resource "aws_security_group_rule" "ingress%d" {
@@ -2064,7 +2063,7 @@ resource "aws_security_group_rule" "egress%d" {
 `, i, i, i, i, i, i, i, i))
 	}
 	return b.String()
-}()
+}
 
 func testAccSecurityGroupRuleSelfInSource(rInt int) string {
 	return fmt.Sprintf(`
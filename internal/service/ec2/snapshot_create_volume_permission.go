@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceSnapshotCreateVolumePermission() *schema.Resource {
@@ -35,7 +36,7 @@ func ResourceSnapshotCreateVolumePermission() *schema.Resource {
 }
 
 func resourceSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	snapshot_id := d.Get("snapshot_id").(string)
 	account_id := d.Get("account_id").(string)
@@ -64,7 +65,7 @@ func resourceSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, meta i
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := tfresource.WaitForState(stateConf); err != nil {
 		return fmt.Errorf(
 			"Error waiting for snapshot createVolumePermission (%s) to be added: %s",
 			d.Id(), err)
@@ -74,7 +75,7 @@ func resourceSnapshotCreateVolumePermissionCreate(d *schema.ResourceData, meta i
 }
 
 func resourceSnapshotCreateVolumePermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	snapshotID, accountID, err := SnapshotCreateVolumePermissionParseID(d.Id())
 	if err != nil {
@@ -95,7 +96,7 @@ func resourceSnapshotCreateVolumePermissionRead(d *schema.ResourceData, meta int
 }
 
 func resourceSnapshotCreateVolumePermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	snapshotID, accountID, err := SnapshotCreateVolumePermissionParseID(d.Id())
 	if err != nil {
@@ -124,7 +125,7 @@ func resourceSnapshotCreateVolumePermissionDelete(d *schema.ResourceData, meta i
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := tfresource.WaitForState(stateConf); err != nil {
 		return fmt.Errorf(
 			"Error waiting for snapshot createVolumePermission (%s) to be removed: %s",
 			d.Id(), err)
@@ -127,7 +127,7 @@ func ResourceSubnet() *schema.Resource {
 }
 
 func resourceSubnetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -169,7 +169,7 @@ func resourceSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 
 	if err != nil {
 		return fmt.Errorf("error waiting for subnet (%s) to become ready: %w", d.Id(), err)
@@ -231,7 +231,7 @@ func resourceSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSubnetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -324,7 +324,7 @@ func resourceSubnetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -423,7 +423,7 @@ func resourceSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 				Refresh: SubnetIpv6CidrStateRefreshFunc(conn, d.Id(), d.Get("ipv6_cidr_block_association_id").(string)),
 				Timeout: 3 * time.Minute,
 			}
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := tfresource.WaitForState(stateConf); err != nil {
 				return fmt.Errorf("Error waiting for IPv6 CIDR (%s) to become disassociated: %w", d.Id(), err)
 			}
 		}
@@ -452,7 +452,7 @@ func resourceSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 				Refresh: SubnetIpv6CidrStateRefreshFunc(conn, d.Id(), aws.StringValue(resp.Ipv6CidrBlockAssociation.AssociationId)),
 				Timeout: 3 * time.Minute,
 			}
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := tfresource.WaitForState(stateConf); err != nil {
 				return fmt.Errorf(
 					"Error waiting for IPv6 CIDR (%s) to become associated: %w",
 					d.Id(), err)
@@ -482,7 +482,7 @@ func resourceSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSubnetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting subnet: %s", d.Id())
 
@@ -518,7 +518,7 @@ func resourceSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 		},
 	}
 
-	if _, err := wait.WaitForState(); err != nil {
+	if _, err := tfresource.WaitForState(&wait); err != nil {
 		return fmt.Errorf("error deleting subnet (%s): %w", d.Id(), err)
 	}
 
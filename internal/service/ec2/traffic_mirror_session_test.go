@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,10 +20,10 @@ func TestAccEC2TrafficMirrorSession_basic(t *testing.T) {
 	var v ec2.TrafficMirrorSession
 	resourceName := "aws_ec2_traffic_mirror_session.test"
 	description := "test session"
-	session := sdkacctest.RandIntRange(1, 32766)
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
-	pLen := sdkacctest.RandIntRange(1, 255)
-	vni := sdkacctest.RandIntRange(1, 16777216)
+	session := acctest.RandIntRange(t, 1, 32766)
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 10))
+	pLen := acctest.RandIntRange(t, 1, 255)
+	vni := acctest.RandIntRange(t, 1, 16777216)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -84,8 +83,8 @@ func TestAccEC2TrafficMirrorSession_basic(t *testing.T) {
 func TestAccEC2TrafficMirrorSession_tags(t *testing.T) {
 	var v ec2.TrafficMirrorSession
 	resourceName := "aws_ec2_traffic_mirror_session.test"
-	session := sdkacctest.RandIntRange(1, 32766)
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+	session := acctest.RandIntRange(t, 1, 32766)
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -133,8 +132,8 @@ func TestAccEC2TrafficMirrorSession_tags(t *testing.T) {
 func TestAccEC2TrafficMirrorSession_disappears(t *testing.T) {
 	var v ec2.TrafficMirrorSession
 	resourceName := "aws_ec2_traffic_mirror_session.test"
-	session := sdkacctest.RandIntRange(1, 32766)
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+	session := acctest.RandIntRange(t, 1, 32766)
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -167,7 +166,7 @@ func testAccCheckTrafficMirrorSessionExists(name string, session *ec2.TrafficMir
 			return fmt.Errorf("No ID set for %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		out, err := conn.DescribeTrafficMirrorSessions(&ec2.DescribeTrafficMirrorSessionsInput{
 			TrafficMirrorSessionIds: []*string{
 				aws.String(rs.Primary.ID),
@@ -317,7 +316,7 @@ resource "aws_ec2_traffic_mirror_session" "test" {
 }
 
 func testAccPreCheckTrafficMirrorSession(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	_, err := conn.DescribeTrafficMirrorSessions(&ec2.DescribeTrafficMirrorSessionsInput{})
 
@@ -331,7 +330,7 @@ func testAccPreCheckTrafficMirrorSession(t *testing.T) {
 }
 
 func testAccCheckTrafficMirrorSessionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_traffic_mirror_session" {
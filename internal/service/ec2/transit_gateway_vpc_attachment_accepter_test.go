@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ func testAccTransitGatewayVPCAttachmentAccepter_basic(t *testing.T) {
 	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
 	vpcResourceName := "aws_vpc.test"
 	callerIdentityDatasourceName := "data.aws_caller_identity.creator"
-	rName := fmt.Sprintf("tf-testacc-tgwvpcattach-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-testacc-tgwvpcattach-%s", acctest.RandString(t, 8))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -66,7 +65,7 @@ func testAccTransitGatewayVPCAttachmentAccepter_Tags(t *testing.T) {
 	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
 	vpcResourceName := "aws_vpc.test"
 	callerIdentityDatasourceName := "data.aws_caller_identity.creator"
-	rName := fmt.Sprintf("tf-testacc-tgwvpcattach-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-testacc-tgwvpcattach-%s", acctest.RandString(t, 8))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -136,7 +135,7 @@ func testAccTransitGatewayVPCAttachmentAccepter_TransitGatewayDefaultRouteTableA
 	var transitGatewayVpcAttachment ec2.TransitGatewayVpcAttachment
 	resourceName := "aws_ec2_transit_gateway_vpc_attachment_accepter.test"
 	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
-	rName := fmt.Sprintf("tf-testacc-tgwvpcattach-%s", sdkacctest.RandString(8))
+	rName := fmt.Sprintf("tf-testacc-tgwvpcattach-%s", acctest.RandString(t, 8))
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceVolumeAttachment() *schema.Resource {
@@ -76,7 +77,7 @@ func ResourceVolumeAttachment() *schema.Resource {
 }
 
 func resourceVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	name := d.Get("device_name").(string)
 	iID := d.Get("instance_id").(string)
 	vID := d.Get("volume_id").(string)
@@ -111,7 +112,7 @@ func resourceVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 			MinTimeout: 3 * time.Second,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = tfresource.WaitForState(stateConf)
 		if err != nil {
 			return fmt.Errorf(
 				"Error waiting for instance (%s) to become ready: %s",
@@ -144,7 +145,7 @@ func resourceVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for Volume (%s) to attach to Instance: %s, error: %s",
@@ -156,7 +157,7 @@ func resourceVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVolumeAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	request := &ec2.DescribeVolumesInput{
 		VolumeIds: []*string{aws.String(d.Get("volume_id").(string))},
@@ -195,7 +196,7 @@ func resourceVolumeAttachmentUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if _, ok := d.GetOk("skip_destroy"); ok {
 		return nil
@@ -240,7 +241,7 @@ func resourceVolumeAttachmentDelete(d *schema.ResourceData, meta interface{}) er
 	}
 
 	log.Printf("[DEBUG] Detaching Volume (%s) from Instance (%s)", vID, iID)
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for Volume (%s) to detach from Instance (%s): %s",
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,7 +18,7 @@ import (
 
 func TestAccEC2VPCPeeringConnection_basic(t *testing.T) {
 	var connection ec2.VpcPeeringConnection
-	rName := fmt.Sprintf("tf-testacc-pcx-%s", sdkacctest.RandString(17))
+	rName := fmt.Sprintf("tf-testacc-pcx-%s", acctest.RandString(t, 17))
 	resourceName := "aws_vpc_peering_connection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -49,12 +48,12 @@ func TestAccEC2VPCPeeringConnection_basic(t *testing.T) {
 
 func TestAccEC2VPCPeeringConnection_plan(t *testing.T) {
 	var connection ec2.VpcPeeringConnection
-	rName := fmt.Sprintf("tf-testacc-pcx-%s", sdkacctest.RandString(17))
+	rName := fmt.Sprintf("tf-testacc-pcx-%s", acctest.RandString(t, 17))
 	resourceName := "aws_vpc_peering_connection.test"
 
 	// reach out and DELETE the VPC Peering connection outside of Terraform
 	testDestroy := func(*terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		log.Printf("[DEBUG] Test deleting the VPC Peering Connection.")
 		_, err := conn.DeleteVpcPeeringConnection(
 			&ec2.DeleteVpcPeeringConnectionInput{
@@ -87,7 +86,7 @@ func TestAccEC2VPCPeeringConnection_plan(t *testing.T) {
 
 func TestAccEC2VPCPeeringConnection_tags(t *testing.T) {
 	var connection ec2.VpcPeeringConnection
-	rName := fmt.Sprintf("tf-testacc-pcx-%s", sdkacctest.RandString(17))
+	rName := fmt.Sprintf("tf-testacc-pcx-%s", acctest.RandString(t, 17))
 	resourceName := "aws_vpc_peering_connection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -135,11 +134,11 @@ func TestAccEC2VPCPeeringConnection_tags(t *testing.T) {
 
 func TestAccEC2VPCPeeringConnection_options(t *testing.T) {
 	var connection ec2.VpcPeeringConnection
-	rName := fmt.Sprintf("tf-testacc-pcx-%s", sdkacctest.RandString(17))
+	rName := fmt.Sprintf("tf-testacc-pcx-%s", acctest.RandString(t, 17))
 	resourceName := "aws_vpc_peering_connection.test"
 
 	testAccepterChange := func(*terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		log.Printf("[DEBUG] Test change to the VPC Peering Connection Options.")
 
 		_, err := conn.ModifyVpcPeeringConnectionOptions(
@@ -308,7 +307,7 @@ func TestAccEC2VPCPeeringConnection_options(t *testing.T) {
 }
 
 func TestAccEC2VPCPeeringConnection_failedState(t *testing.T) {
-	rName := fmt.Sprintf("tf-testacc-pcx-%s", sdkacctest.RandString(17))
+	rName := fmt.Sprintf("tf-testacc-pcx-%s", acctest.RandString(t, 17))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -325,7 +324,7 @@ func TestAccEC2VPCPeeringConnection_failedState(t *testing.T) {
 }
 
 func testAccCheckVPCPeeringConnectionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_peering_connection" {
@@ -383,7 +382,7 @@ func testAccCheckVPCPeeringConnectionExistsWithProvider(n string, connection *ec
 			return fmt.Errorf("No VPC Peering Connection ID is set.")
 		}
 
-		conn := providerF().Meta().(*conns.AWSClient).EC2Conn
+		conn := providerF().Meta().(*conns.AWSClient).EC2Conn()
 		resp, err := conn.DescribeVpcPeeringConnections(
 			&ec2.DescribeVpcPeeringConnectionsInput{
 				VpcPeeringConnectionIds: []*string{aws.String(rs.Primary.ID)},
@@ -416,7 +415,7 @@ func testAccCheckVPCPeeringConnectionOptionsWithProvider(n, block string, option
 			return fmt.Errorf("No VPC Peering Connection ID is set.")
 		}
 
-		conn := providerF().Meta().(*conns.AWSClient).EC2Conn
+		conn := providerF().Meta().(*conns.AWSClient).EC2Conn()
 		resp, err := conn.DescribeVpcPeeringConnections(
 			&ec2.DescribeVpcPeeringConnectionsInput{
 				VpcPeeringConnectionIds: []*string{aws.String(rs.Primary.ID)},
@@ -443,7 +442,7 @@ func testAccCheckVPCPeeringConnectionOptionsWithProvider(n, block string, option
 
 func TestAccEC2VPCPeeringConnection_peerRegionAutoAccept(t *testing.T) {
 	var providers []*schema.Provider
-	rName := fmt.Sprintf("tf-testacc-pcx-%s", sdkacctest.RandString(17))
+	rName := fmt.Sprintf("tf-testacc-pcx-%s", acctest.RandString(t, 17))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -465,7 +464,7 @@ func TestAccEC2VPCPeeringConnection_peerRegionAutoAccept(t *testing.T) {
 func TestAccEC2VPCPeeringConnection_region(t *testing.T) {
 	var connection ec2.VpcPeeringConnection
 	var providers []*schema.Provider
-	rName := fmt.Sprintf("tf-testacc-pcx-%s", sdkacctest.RandString(17))
+	rName := fmt.Sprintf("tf-testacc-pcx-%s", acctest.RandString(t, 17))
 	resourceName := "aws_vpc_peering_connection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -498,7 +497,7 @@ func TestAccEC2VPCPeeringConnection_region(t *testing.T) {
 // Tests the peering connection acceptance functionality for same region, same account.
 func TestAccEC2VPCPeeringConnection_accept(t *testing.T) {
 	var connection ec2.VpcPeeringConnection
-	rName := fmt.Sprintf("tf-testacc-pcx-%s", sdkacctest.RandString(17))
+	rName := fmt.Sprintf("tf-testacc-pcx-%s", acctest.RandString(t, 17))
 	resourceName := "aws_vpc_peering_connection.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -564,7 +563,7 @@ func TestAccEC2VPCPeeringConnection_accept(t *testing.T) {
 
 // Tests that VPC peering connection options can't be set on non-active connection.
 func TestAccEC2VPCPeeringConnection_optionsNoAutoAccept(t *testing.T) {
-	rName := fmt.Sprintf("tf-testacc-pcx-%s", sdkacctest.RandString(17))
+	rName := fmt.Sprintf("tf-testacc-pcx-%s", acctest.RandString(t, 17))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceVPNConnectionRoute() *schema.Resource {
@@ -39,7 +40,7 @@ func ResourceVPNConnectionRoute() *schema.Resource {
 }
 
 func resourceVPNConnectionRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	cidrBlock := d.Get("destination_cidr_block").(string)
 	vpnConnectionId := d.Get("vpn_connection_id").(string)
@@ -70,7 +71,7 @@ func resourceVPNConnectionRouteCreate(d *schema.ResourceData, meta interface{})
 			return route, *route.State, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(&stateConf)
 	if err != nil {
 		return err
 	}
@@ -79,7 +80,7 @@ func resourceVPNConnectionRouteCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVPNConnectionRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	cidrBlock, vpnConnectionId := VPNConnectionRouteParseID(d.Id())
 
@@ -96,7 +97,7 @@ func resourceVPNConnectionRouteRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVPNConnectionRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	cidrBlock := d.Get("destination_cidr_block").(string)
 	vpnConnectionId := d.Get("vpn_connection_id").(string)
@@ -127,7 +128,7 @@ func resourceVPNConnectionRouteDelete(d *schema.ResourceData, meta interface{})
 			return route, *route.State, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(&stateConf)
 	return err
 }
 
@@ -40,7 +40,7 @@ func WaitCarrierGatewayAvailable(conn *ec2.EC2, carrierGatewayID string) (*ec2.C
 		Timeout: CarrierGatewayAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.CarrierGateway); ok {
 		return output, err
@@ -57,7 +57,7 @@ func WaitCarrierGatewayDeleted(conn *ec2.EC2, carrierGatewayID string) (*ec2.Car
 		Timeout: CarrierGatewayDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.CarrierGateway); ok {
 		return output, err
@@ -83,7 +83,7 @@ func WaitLocalGatewayRouteTableVPCAssociationAssociated(conn *ec2.EC2, localGate
 		Timeout: LocalGatewayRouteTableVPCAssociationAssociatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVpcAssociation); ok {
 		return output, err
@@ -101,7 +101,7 @@ func WaitLocalGatewayRouteTableVPCAssociationDisassociated(conn *ec2.EC2, localG
 		Timeout: LocalGatewayRouteTableVPCAssociationAssociatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.LocalGatewayRouteTableVpcAssociation); ok {
 		return output, err
@@ -122,7 +122,7 @@ func WaitClientVPNEndpointDeleted(conn *ec2.EC2, id string) (*ec2.ClientVpnEndpo
 		Timeout: ClientVPNEndpointDeletedTimout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnEndpoint); ok {
 		return output, err
@@ -145,7 +145,7 @@ func WaitClientVPNAuthorizationRuleAuthorized(conn *ec2.EC2, authorizationRuleID
 		Timeout: ClientVPNAuthorizationRuleActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.AuthorizationRule); ok {
 		return output, err
@@ -162,7 +162,7 @@ func WaitClientVPNAuthorizationRuleRevoked(conn *ec2.EC2, authorizationRuleID st
 		Timeout: ClientVPNAuthorizationRuleRevokedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.AuthorizationRule); ok {
 		return output, err
@@ -193,7 +193,7 @@ func WaitClientVPNNetworkAssociationAssociated(conn *ec2.EC2, networkAssociation
 		PollInterval: ClientVPNNetworkAssociationStatusPollInterval,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.TargetNetwork); ok {
 		return output, err
@@ -212,7 +212,7 @@ func WaitClientVPNNetworkAssociationDisassociated(conn *ec2.EC2, networkAssociat
 		PollInterval: ClientVPNNetworkAssociationStatusPollInterval,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.TargetNetwork); ok {
 		return output, err
@@ -233,7 +233,7 @@ func WaitClientVPNRouteDeleted(conn *ec2.EC2, routeID string) (*ec2.ClientVpnRou
 		Timeout: ClientVPNRouteDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.ClientVpnRoute); ok {
 		return output, err
@@ -251,7 +251,7 @@ func WaitInstanceIAMInstanceProfileUpdated(conn *ec2.EC2, instanceID string, exp
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.Instance); ok {
 		return output, err
@@ -276,7 +276,7 @@ func WaitRouteDeleted(conn *ec2.EC2, routeFinder RouteFinder, routeTableID, dest
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.Route); ok {
 		return output, err
@@ -294,7 +294,7 @@ func WaitRouteReady(conn *ec2.EC2, routeFinder RouteFinder, routeTableID, destin
 		ContinuousTargetOccurence: 2,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.Route); ok {
 		return output, err
@@ -320,7 +320,7 @@ func WaitRouteTableReady(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.
 		NotFoundChecks: RouteTableNotFoundChecks,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTable); ok {
 		return output, err
@@ -337,7 +337,7 @@ func WaitRouteTableDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTable); ok {
 		return output, err
@@ -355,7 +355,7 @@ func WaitRouteTableAssociationCreated(conn *ec2.EC2, id string) (*ec2.RouteTable
 		NotFoundChecks: RouteTableAssociationCreatedNotFoundChecks,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -376,7 +376,7 @@ func WaitRouteTableAssociationDeleted(conn *ec2.EC2, id string) (*ec2.RouteTable
 		Timeout: RouteTableAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -397,7 +397,7 @@ func WaitRouteTableAssociationUpdated(conn *ec2.EC2, id string) (*ec2.RouteTable
 		Timeout: RouteTableAssociationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.RouteTableAssociationState); ok {
 		if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeFailed {
@@ -418,7 +418,7 @@ func WaitSecurityGroupCreated(conn *ec2.EC2, id string, timeout time.Duration) (
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.SecurityGroup); ok {
 		return output, err
@@ -441,7 +441,7 @@ func WaitSubnetMapCustomerOwnedIPOnLaunchUpdated(conn *ec2.EC2, subnetID string,
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -459,7 +459,7 @@ func WaitSubnetMapPublicIPOnLaunchUpdated(conn *ec2.EC2, subnetID string, expect
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.Subnet); ok {
 		return output, err
@@ -480,7 +480,7 @@ func WaitTransitGatewayPrefixListReferenceStateCreated(conn *ec2.EC2, transitGat
 		Refresh: StatusTransitGatewayPrefixListReferenceState(conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -497,7 +497,7 @@ func WaitTransitGatewayPrefixListReferenceStateDeleted(conn *ec2.EC2, transitGat
 		Refresh: StatusTransitGatewayPrefixListReferenceState(conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidRouteTableIDNotFound) {
 		return nil, nil
@@ -518,7 +518,7 @@ func WaitTransitGatewayPrefixListReferenceStateUpdated(conn *ec2.EC2, transitGat
 		Refresh: StatusTransitGatewayPrefixListReferenceState(conn, transitGatewayRouteTableID, prefixListID),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayPrefixListReference); ok {
 		return output, err
@@ -539,7 +539,7 @@ func WaitTransitGatewayRouteTablePropagationStateEnabled(conn *ec2.EC2, transitG
 		Refresh: StatusTransitGatewayRouteTablePropagationState(conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTablePropagation); ok {
 		return output, err
@@ -556,7 +556,7 @@ func WaitTransitGatewayRouteTablePropagationStateDisabled(conn *ec2.EC2, transit
 		Refresh: StatusTransitGatewayRouteTablePropagationState(conn, transitGatewayRouteTableID, transitGatewayAttachmentID),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidRouteTableIDNotFound) {
 		return nil, nil
@@ -583,7 +583,7 @@ func WaitVPCAttributeUpdated(conn *ec2.EC2, vpcID string, attribute string, expe
 		MinTimeout: 3 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.Vpc); ok {
 		return output, err
@@ -606,7 +606,7 @@ func WaitVPNGatewayVPCAttachmentAttached(conn *ec2.EC2, vpnGatewayID, vpcID stri
 		Timeout: VPNGatewayVPCAttachmentAttachedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcAttachment); ok {
 		return output, err
@@ -623,7 +623,7 @@ func WaitVPNGatewayVPCAttachmentDetached(conn *ec2.EC2, vpnGatewayID, vpcID stri
 		Timeout: VPNGatewayVPCAttachmentDetachedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcAttachment); ok {
 		return output, err
@@ -646,7 +646,7 @@ func WaitHostCreated(conn *ec2.EC2, id string) (*ec2.Host, error) {
 		Refresh: StatusHostState(conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -663,7 +663,7 @@ func WaitHostUpdated(conn *ec2.EC2, id string) (*ec2.Host, error) {
 		Refresh: StatusHostState(conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -680,7 +680,7 @@ func WaitHostDeleted(conn *ec2.EC2, id string) (*ec2.Host, error) {
 		Refresh: StatusHostState(conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.Host); ok {
 		return output, err
@@ -701,7 +701,7 @@ func WaitManagedPrefixListCreated(conn *ec2.EC2, id string) (*ec2.ManagedPrefixL
 		Refresh: StatusManagedPrefixListState(conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateCreateFailed {
@@ -722,7 +722,7 @@ func WaitManagedPrefixListModified(conn *ec2.EC2, id string) (*ec2.ManagedPrefix
 		Refresh: StatusManagedPrefixListState(conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateModifyFailed {
@@ -743,7 +743,7 @@ func WaitManagedPrefixListDeleted(conn *ec2.EC2, id string) (*ec2.ManagedPrefixL
 		Refresh: StatusManagedPrefixListState(conn, id),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.ManagedPrefixList); ok {
 		if state := aws.StringValue(output.State); state == ec2.PrefixListStateDeleteFailed {
@@ -769,7 +769,7 @@ func WaitPlacementGroupCreated(conn *ec2.EC2, name string) (*ec2.PlacementGroup,
 		Refresh: StatusPlacementGroupState(conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.PlacementGroup); ok {
 		return output, err
@@ -786,7 +786,7 @@ func WaitPlacementGroupDeleted(conn *ec2.EC2, name string) (*ec2.PlacementGroup,
 		Refresh: StatusPlacementGroupState(conn, name),
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.PlacementGroup); ok {
 		return output, err
@@ -805,7 +805,7 @@ func WaitVPCEndpointAccepted(conn *ec2.EC2, vpcEndpointID string, timeout time.D
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		if state, lastError := aws.StringValue(output.State), output.LastError; state == VPCEndpointStateFailed && lastError != nil {
@@ -828,7 +828,7 @@ func WaitVPCEndpointAvailable(conn *ec2.EC2, vpcEndpointID string, timeout time.
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		if state, lastError := aws.StringValue(output.State), output.LastError; state == VPCEndpointStateFailed && lastError != nil {
@@ -851,7 +851,7 @@ func WaitVPCEndpointDeleted(conn *ec2.EC2, vpcEndpointID string, timeout time.Du
 		MinTimeout: 5 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*ec2.VpcEndpoint); ok {
 		return output, err
@@ -869,7 +869,7 @@ func WaitVPCEndpointRouteTableAssociationDeleted(conn *ec2.EC2, vpcEndpointID, r
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -883,7 +883,7 @@ func WaitVPCEndpointRouteTableAssociationReady(conn *ec2.EC2, vpcEndpointID, rou
 		ContinuousTargetOccurence: 2,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -902,7 +902,7 @@ func WaitEBSSnapshotImportComplete(conn *ec2.EC2, importTaskID string) (*ec2.Sna
 		Delay:   10 * time.Second,
 	}
 
-	detail, err := stateConf.WaitForState()
+	detail, err := tfresource.WaitForState(stateConf)
 	if err != nil {
 		return nil, err
 	} else {
@@ -39,7 +39,7 @@ func ResourceRegistryPolicy() *schema.Resource {
 }
 
 func resourceRegistryPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	input := ecr.PutRegistryPolicyInput{
 		PolicyText: aws.String(d.Get("policy").(string)),
@@ -58,7 +58,7 @@ func resourceRegistryPolicyPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRegistryPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	log.Printf("[DEBUG] Reading registry policy %s", d.Id())
 	out, err := conn.GetRegistryPolicy(&ecr.GetRegistryPolicyInput{})
@@ -72,13 +72,13 @@ func resourceRegistryPolicyRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("registry_id", out.RegistryId)
-	d.Set("policy", out.PolicyText)
+	d.Set("policy", verify.PolicyToSet(aws.StringValue(out.PolicyText)))
 
 	return nil
 }
 
 func resourceRegistryPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	_, err := conn.DeleteRegistryPolicy(&ecr.DeleteRegistryPolicyInput{})
 	if err != nil {
@@ -66,7 +66,7 @@ func ResourceReplicationConfiguration() *schema.Resource {
 }
 
 func resourceReplicationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	input := ecr.PutReplicationConfigurationInput{
 		ReplicationConfiguration: expandEcrReplicationConfigurationReplicationConfiguration(d.Get("replication_configuration").([]interface{})),
@@ -83,7 +83,7 @@ func resourceReplicationConfigurationPut(d *schema.ResourceData, meta interface{
 }
 
 func resourceReplicationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	log.Printf("[DEBUG] Reading ECR Replication Configuration %s", d.Id())
 	out, err := conn.DescribeRegistry(&ecr.DescribeRegistryInput{})
@@ -101,7 +101,7 @@ func resourceReplicationConfigurationRead(d *schema.ResourceData, meta interface
 }
 
 func resourceReplicationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	input := ecr.PutReplicationConfigurationInput{
 		ReplicationConfiguration: &ecr.ReplicationConfiguration{
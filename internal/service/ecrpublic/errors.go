@@ -0,0 +1,17 @@
+package ecrpublic
+
+import (
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// errorClassifier classifies the errors returned by the ECR Public API.
+var errorClassifier = tfresource.RegisterServiceErrors("ecrpublic", tfresource.ServiceErrors{
+	NotFound: []tfresource.ErrorMatcher{
+		{Code: ecrpublic.ErrCodeRepositoryNotFoundException},
+	},
+	Unsupported: []tfresource.ErrorMatcher{
+		// For example from us-west-2 ECR Public repository.
+		{Code: ecrpublic.ErrCodeUnsupportedCommandException, Message: "command is only supported in"},
+	},
+})
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ecrpublic"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccECRPublicRepository_basic(t *testing.T) {
 	var v ecrpublic.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecrpublic_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -47,7 +46,7 @@ func TestAccECRPublicRepository_basic(t *testing.T) {
 
 func TestAccECRPublicRepository_CatalogData_aboutText(t *testing.T) {
 	var v ecrpublic.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecrpublic_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -83,7 +82,7 @@ func TestAccECRPublicRepository_CatalogData_aboutText(t *testing.T) {
 
 func TestAccECRPublicRepository_CatalogData_architectures(t *testing.T) {
 	var v ecrpublic.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecrpublic_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -119,7 +118,7 @@ func TestAccECRPublicRepository_CatalogData_architectures(t *testing.T) {
 
 func TestAccECRPublicRepository_CatalogData_description(t *testing.T) {
 	var v ecrpublic.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecrpublic_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -155,7 +154,7 @@ func TestAccECRPublicRepository_CatalogData_description(t *testing.T) {
 
 func TestAccECRPublicRepository_CatalogData_operatingSystems(t *testing.T) {
 	var v ecrpublic.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecrpublic_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -191,7 +190,7 @@ func TestAccECRPublicRepository_CatalogData_operatingSystems(t *testing.T) {
 
 func TestAccECRPublicRepository_CatalogData_usageText(t *testing.T) {
 	var v ecrpublic.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecrpublic_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -227,7 +226,7 @@ func TestAccECRPublicRepository_CatalogData_usageText(t *testing.T) {
 
 func TestAccECRPublicRepository_CatalogData_logoImageBlob(t *testing.T) {
 	var v ecrpublic.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecrpublic_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -256,7 +255,7 @@ func TestAccECRPublicRepository_CatalogData_logoImageBlob(t *testing.T) {
 
 func TestAccECRPublicRepository_Basic_forceDestroy(t *testing.T) {
 	var v ecrpublic.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecrpublic_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -286,7 +285,7 @@ func TestAccECRPublicRepository_Basic_forceDestroy(t *testing.T) {
 
 func TestAccECRPublicRepository_disappears(t *testing.T) {
 	var v ecrpublic.Repository
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecrpublic_repository.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -318,7 +317,7 @@ func testAccCheckRepositoryExists(name string, res *ecrpublic.Repository) resour
 			return fmt.Errorf("No ECR Public repository ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn()
 
 		output, err := conn.DescribeRepositories(&ecrpublic.DescribeRepositoriesInput{
 			RepositoryNames: aws.StringSlice([]string{rs.Primary.ID}),
@@ -337,7 +336,7 @@ func testAccCheckRepositoryExists(name string, res *ecrpublic.Repository) resour
 }
 
 func testAccCheckRepositoryDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecrpublic_repository" {
@@ -460,7 +459,7 @@ func testAccPreCheck(t *testing.T) {
 		t.Skipf("skipping acceptance testing: region (%s) does not support ECR Public repositories", region)
 	}
 
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRPublicConn()
 	input := &ecrpublic.DescribeRepositoriesInput{}
 	_, err := conn.DescribeRepositories(input)
 	if acctest.PreCheckSkipError(err) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -31,7 +32,7 @@ func waitAccessPointCreated(conn *efs.EFS, accessPointId string) (*efs.AccessPoi
 		Timeout: accessPointCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*efs.AccessPointDescription); ok {
 		return output, err
@@ -49,7 +50,7 @@ func waitAccessPointDeleted(conn *efs.EFS, accessPointId string) (*efs.AccessPoi
 		Timeout: accessPointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*efs.AccessPointDescription); ok {
 		return output, err
@@ -68,7 +69,7 @@ func waitFileSystemAvailable(conn *efs.EFS, fileSystemID string) (*efs.FileSyste
 		MinTimeout: fileSystemAvailableMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*efs.FileSystemDescription); ok {
 		return output, err
@@ -87,7 +88,7 @@ func waitFileSystemDeleted(conn *efs.EFS, fileSystemID string) (*efs.FileSystemD
 		MinTimeout: fileSystemDeletedMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*efs.FileSystemDescription); ok {
 		return output, err
@@ -104,7 +105,7 @@ func waitBackupPolicyDisabled(conn *efs.EFS, id string) (*efs.BackupPolicy, erro
 		Timeout: backupPolicyDisabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*efs.BackupPolicy); ok {
 		return output, err
@@ -121,7 +122,7 @@ func waitBackupPolicyEnabled(conn *efs.EFS, id string) (*efs.BackupPolicy, error
 		Timeout: backupPolicyEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*efs.BackupPolicy); ok {
 		return output, err
@@ -2,6 +2,8 @@ package eks
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eks"
@@ -105,6 +107,27 @@ func statusNodegroup(conn *eks.EKS, clusterName, nodeGroupName string) resource.
 	}
 }
 
+// nodegroupHealthIssues returns the health issues of the EKS Node Group returned by statusNodegroup.
+func nodegroupHealthIssues(v interface{}) string {
+	nodeGroup, ok := v.(*eks.Nodegroup)
+
+	if !ok || nodeGroup == nil || nodeGroup.Health == nil {
+		return ""
+	}
+
+	var messages []string
+
+	for _, issue := range nodeGroup.Health.Issues {
+		if issue == nil {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%s: %s", aws.StringValue(issue.Code), aws.StringValue(issue.Message)))
+	}
+
+	return strings.Join(messages, "; ")
+}
+
 func statusNodegroupUpdate(conn *eks.EKS, clusterName, nodeGroupName, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindNodegroupUpdateByClusterNameNodegroupNameAndID(conn, clusterName, nodeGroupName, id)
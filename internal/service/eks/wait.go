@@ -2,6 +2,7 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,7 +25,9 @@ func waitAddonCreated(ctx context.Context, conn *eks.EKS, clusterName, addonName
 		Timeout: addonCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Add-On (%s/%s) create", clusterName, addonName),
+	})
 
 	if output, ok := outputRaw.(*eks.Addon); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.AddonStatusCreateFailed && health != nil {
@@ -45,7 +48,9 @@ func waitAddonDeleted(ctx context.Context, conn *eks.EKS, clusterName, addonName
 		Timeout: addonDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Add-On (%s/%s) delete", clusterName, addonName),
+	})
 
 	if output, ok := outputRaw.(*eks.Addon); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.AddonStatusDeleteFailed && health != nil {
@@ -66,7 +71,9 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName,
 		Timeout: addonUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Add-On (%s/%s) update (%s)", clusterName, addonName, id),
+	})
 
 	if output, ok := outputRaw.(*eks.Update); ok {
 		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
@@ -79,7 +86,7 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName,
 	return nil, err
 }
 
-func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+func waitClusterCreated(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.ClusterStatusCreating},
 		Target:  []string{eks.ClusterStatusActive},
@@ -87,7 +94,9 @@ func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Cluster (%s) create", name),
+	})
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -96,7 +105,7 @@ func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks
 	return nil, err
 }
 
-func waitClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
+func waitClusterDeleted(ctx context.Context, conn *eks.EKS, name string, timeout time.Duration) (*eks.Cluster, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
 		Target:  []string{},
@@ -104,7 +113,9 @@ func waitClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Cluster (%s) delete", name),
+	})
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -113,7 +124,7 @@ func waitClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks
 	return nil, err
 }
 
-func waitClusterUpdateSuccessful(conn *eks.EKS, name, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
+func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.EKS, name, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.UpdateStatusInProgress},
 		Target:  []string{eks.UpdateStatusSuccessful},
@@ -121,7 +132,9 @@ func waitClusterUpdateSuccessful(conn *eks.EKS, name, id string, timeout time.Du
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Cluster (%s) update (%s)", name, id),
+	})
 
 	if output, ok := outputRaw.(*eks.Update); ok {
 		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
@@ -142,7 +155,7 @@ func waitFargateProfileCreated(conn *eks.EKS, clusterName, fargateProfileName st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*eks.FargateProfile); ok {
 		return output, err
@@ -159,7 +172,7 @@ func waitFargateProfileDeleted(conn *eks.EKS, clusterName, fargateProfileName st
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*eks.FargateProfile); ok {
 		return output, err
@@ -176,7 +189,10 @@ func waitNodegroupCreated(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Node Group (%s/%s) create", clusterName, nodeGroupName),
+		Reason:      nodegroupHealthIssues,
+	})
 
 	if output, ok := outputRaw.(*eks.Nodegroup); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.NodegroupStatusCreateFailed && health != nil {
@@ -197,7 +213,10 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Node Group (%s/%s) delete", clusterName, nodeGroupName),
+		Reason:      nodegroupHealthIssues,
+	})
 
 	if output, ok := outputRaw.(*eks.Nodegroup); ok {
 		if status, health := aws.StringValue(output.Status), output.Health; status == eks.NodegroupStatusDeleteFailed && health != nil {
@@ -218,7 +237,9 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterNa
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Node Group (%s/%s) update (%s)", clusterName, nodeGroupName, id),
+	})
 
 	if output, ok := outputRaw.(*eks.Update); ok {
 		if status := aws.StringValue(output.Status); status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
@@ -239,7 +260,9 @@ func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.EKS, c
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Identity Provider Config (%s/%s) create", clusterName, configName),
+	})
 
 	if output, ok := outputRaw.(*eks.OidcIdentityProviderConfig); ok {
 		return output, err
@@ -256,7 +279,9 @@ func waitOIDCIdentityProviderConfigDeleted(ctx context.Context, conn *eks.EKS, c
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	outputRaw, err := tfresource.WaitForStateContext(ctx, &stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("EKS Identity Provider Config (%s/%s) delete", clusterName, configName),
+	})
 
 	if output, ok := outputRaw.(*eks.OidcIdentityProviderConfig); ok {
 		return output, err
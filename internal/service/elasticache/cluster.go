@@ -1,6 +1,7 @@
 package elasticache
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	gversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,10 +37,10 @@ const (
 
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterCreate,
-		Read:   resourceClusterRead,
-		Update: resourceClusterUpdate,
-		Delete: resourceClusterDelete,
+		CreateWithoutTimeout: resourceClusterCreate,
+		ReadWithoutTimeout:   resourceClusterRead,
+		UpdateWithoutTimeout: resourceClusterUpdate,
+		DeleteWithoutTimeout: resourceClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -277,8 +279,8 @@ func ResourceCluster() *schema.Resource {
 	}
 }
 
-func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -365,21 +367,21 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 
 	id, err := createElasticacheCacheCluster(conn, req)
 	if err != nil {
-		return fmt.Errorf("error creating ElastiCache Cache Cluster: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating ElastiCache Cache Cluster: %w", err))
 	}
 
 	d.SetId(id)
 
-	_, err = waitCacheClusterAvailable(conn, d.Id(), cacheClusterCreatedTimeout)
+	_, err = waitCacheClusterAvailable(ctx, conn, d.Id(), cacheClusterCreatedTimeout)
 	if err != nil {
-		return fmt.Errorf("error waiting for ElastiCache Cache Cluster (%s) to be created: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for ElastiCache Cache Cluster (%s) to be created: %w", d.Id(), err))
 	}
 
-	return resourceClusterRead(d, meta)
+	return resourceClusterRead(ctx, d, meta)
 }
 
-func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -390,13 +392,13 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("error reading ElastiCache Cache Cluster (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading ElastiCache Cache Cluster (%s): %w", d.Id(), err))
 	}
 
 	d.Set("cluster_id", c.CacheClusterId)
 
 	if err := elasticacheSetResourceDataFromCacheCluster(d, c); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("snapshot_window", c.SnapshotWindow)
@@ -429,7 +431,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := setCacheNodeData(d, c); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("arn", c.ARN)
@@ -437,18 +439,18 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	tags, err := ListTags(conn, aws.StringValue(c.ARN))
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for ElastiCache Cluster (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error listing tags for ElastiCache Cluster (%s): %w", d.Id(), err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	return nil
@@ -494,14 +496,14 @@ func elasticacheSetResourceDataEngineVersionFromCacheCluster(d *schema.ResourceD
 	return nil
 }
 
-func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating ElastiCache Cluster (%s) tags: %w", d.Get("arn").(string), err)
+			return diag.FromErr(fmt.Errorf("error updating ElastiCache Cluster (%s) tags: %w", d.Get("arn").(string), err))
 		}
 	}
 
@@ -583,7 +585,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 			if v, ok := d.GetOk("preferred_availability_zones"); ok && len(v.([]interface{})) > 0 {
 				// Here we check the list length to prevent a potential panic :)
 				if len(v.([]interface{})) != n {
-					return fmt.Errorf("length of preferred_availability_zones (%d) must match num_cache_nodes (%d)", len(v.([]interface{})), n)
+					return diag.FromErr(fmt.Errorf("length of preferred_availability_zones (%d) must match num_cache_nodes (%d)", len(v.([]interface{})), n))
 				}
 				req.NewAvailabilityZones = flex.ExpandStringList(v.([]interface{})[o:])
 			}
@@ -598,16 +600,16 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Modifying ElastiCache Cluster (%s), opts:\n%s", d.Id(), req)
 		_, err := conn.ModifyCacheCluster(req)
 		if err != nil {
-			return fmt.Errorf("Error updating ElastiCache cluster (%s), error: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("Error updating ElastiCache cluster (%s), error: %w", d.Id(), err))
 		}
 
-		_, err = waitCacheClusterAvailable(conn, d.Id(), CacheClusterUpdatedTimeout)
+		_, err = waitCacheClusterAvailable(ctx, conn, d.Id(), CacheClusterUpdatedTimeout)
 		if err != nil {
-			return fmt.Errorf("error waiting for ElastiCache Cache Cluster (%s) to update: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for ElastiCache Cache Cluster (%s) to update: %w", d.Id(), err))
 		}
 	}
 
-	return resourceClusterRead(d, meta)
+	return resourceClusterRead(ctx, d, meta)
 }
 
 func getCacheNodesToRemove(oldNumberOfNodes int, cacheNodesToRemove int) []*string {
@@ -651,8 +653,8 @@ func (b byCacheNodeId) Less(i, j int) bool {
 		*b[i].CacheNodeId < *b[j].CacheNodeId
 }
 
-func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	var finalSnapshotID = d.Get("final_snapshot_identifier").(string)
 	err := DeleteCacheCluster(conn, d.Id(), finalSnapshotID)
@@ -660,11 +662,11 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 		if tfawserr.ErrMessageContains(err, elasticache.ErrCodeCacheClusterNotFoundFault, "") {
 			return nil
 		}
-		return fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): %w", d.Id(), err))
 	}
-	_, err = WaitCacheClusterDeleted(conn, d.Id(), CacheClusterDeletedTimeout)
+	_, err = WaitCacheClusterDeleted(ctx, conn, d.Id(), CacheClusterDeletedTimeout)
 	if err != nil {
-		return fmt.Errorf("error waiting for ElastiCache Cache Cluster (%s) to be deleted: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for ElastiCache Cache Cluster (%s) to be deleted: %w", d.Id(), err))
 	}
 
 	return nil
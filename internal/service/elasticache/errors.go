@@ -0,0 +1,19 @@
+package elasticache
+
+import (
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// errorClassifier classifies the errors returned by the ElastiCache API.
+var errorClassifier = tfresource.RegisterServiceErrors("elasticache", tfresource.ServiceErrors{
+	NotFound: []tfresource.ErrorMatcher{
+		{Code: elasticache.ErrCodeCacheClusterNotFoundFault},
+		{Code: elasticache.ErrCodeGlobalReplicationGroupNotFoundFault},
+		{Code: elasticache.ErrCodeReplicationGroupNotFoundFault},
+	},
+	Unsupported: []tfresource.ErrorMatcher{
+		// InvalidParameterValue: Use of cache security groups is not permitted in this API version for your account.
+		{Code: "InvalidParameterValue", Message: "not permitted in this API version for your account"},
+	},
+})
@@ -5,7 +5,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -15,7 +14,7 @@ func FindReplicationGroupByID(conn *elasticache.ElastiCache, id string) (*elasti
 		ReplicationGroupId: aws.String(id),
 	}
 	output, err := conn.DescribeReplicationGroups(input)
-	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeReplicationGroupNotFoundFault) {
+	if errorClassifier.IsNotFoundError(err, elasticache.ErrCodeReplicationGroupNotFoundFault) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -75,7 +74,7 @@ func FindCacheClusterWithNodeInfoByID(conn *elasticache.ElastiCache, id string)
 // FindCacheCluster retrieves an ElastiCache Cache Cluster using DescribeCacheClustersInput.
 func FindCacheCluster(conn *elasticache.ElastiCache, input *elasticache.DescribeCacheClustersInput) (*elasticache.CacheCluster, error) {
 	result, err := conn.DescribeCacheClusters(input)
-	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeCacheClusterNotFoundFault) {
+	if errorClassifier.IsNotFoundError(err, elasticache.ErrCodeCacheClusterNotFoundFault) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -133,7 +132,7 @@ func FindGlobalReplicationGroupByID(conn *elasticache.ElastiCache, id string) (*
 		ShowMemberInfo:           aws.Bool(true),
 	}
 	output, err := conn.DescribeGlobalReplicationGroups(input)
-	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+	if errorClassifier.IsNotFoundError(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,10 +28,10 @@ import (
 func ResourceReplicationGroup() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
-		Create: resourceReplicationGroupCreate,
-		Read:   resourceReplicationGroupRead,
-		Update: resourceReplicationGroupUpdate,
-		Delete: resourceReplicationGroupDelete,
+		CreateWithoutTimeout: resourceReplicationGroupCreate,
+		ReadWithoutTimeout:   resourceReplicationGroupRead,
+		UpdateWithoutTimeout: resourceReplicationGroupUpdate,
+		DeleteWithoutTimeout: resourceReplicationGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -313,8 +314,8 @@ func ResourceReplicationGroup() *schema.Resource {
 	}
 }
 
-func resourceReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+func resourceReplicationGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -331,7 +332,7 @@ func resourceReplicationGroupCreate(d *schema.ResourceData, meta interface{}) er
 		// This cannot be handled at plan-time
 		nodeType := d.Get("node_type").(string)
 		if nodeType == "" {
-			return errors.New(`"node_type" is required unless "global_replication_group_id" is set.`)
+			return diag.FromErr(errors.New(`"node_type" is required unless "global_replication_group_id" is set.`))
 		}
 		params.AutomaticFailoverEnabled = aws.Bool(d.Get("automatic_failover_enabled").(bool))
 		params.CacheNodeType = aws.String(nodeType)
@@ -428,14 +429,14 @@ func resourceReplicationGroupCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	resp, err := conn.CreateReplicationGroup(params)
 	if err != nil {
-		return fmt.Errorf("error creating ElastiCache Replication Group (%s): %w", d.Get("replication_group_id").(string), err)
+		return diag.FromErr(fmt.Errorf("error creating ElastiCache Replication Group (%s): %w", d.Get("replication_group_id").(string), err))
 	}
 
 	d.SetId(aws.StringValue(resp.ReplicationGroup.ReplicationGroupId))
 
-	_, err = WaitReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = WaitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return fmt.Errorf("error creating ElastiCache Replication Group (%s): waiting for completion: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error creating ElastiCache Replication Group (%s): waiting for completion: %w", d.Id(), err))
 	}
 
 	if v, ok := d.GetOk("global_replication_group_id"); ok {
@@ -444,15 +445,15 @@ func resourceReplicationGroupCreate(d *schema.ResourceData, meta interface{}) er
 		// to be fully added to the global replication group.
 		// API calls to the global replication group can be made in any region.
 		if _, err := WaitGlobalReplicationGroupAvailable(conn, v.(string), GlobalReplicationGroupDefaultCreatedTimeout); err != nil {
-			return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) availability: %w", v, err)
+			return diag.FromErr(fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) availability: %w", v, err))
 		}
 	}
 
-	return resourceReplicationGroupRead(d, meta)
+	return resourceReplicationGroupRead(ctx, d, meta)
 }
 
-func resourceReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+func resourceReplicationGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -463,7 +464,7 @@ func resourceReplicationGroupRead(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if aws.StringValue(rgp.Status) == ReplicationGroupStatusDeleting {
@@ -502,35 +503,35 @@ func resourceReplicationGroupRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("replication_group_description", rgp.Description)
 	d.Set("number_cache_clusters", len(rgp.MemberClusters))
 	if err := d.Set("member_clusters", flex.FlattenStringSet(rgp.MemberClusters)); err != nil {
-		return fmt.Errorf("error setting member_clusters: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting member_clusters: %w", err))
 	}
 	if err := d.Set("cluster_mode", flattenElasticacheNodeGroupsToClusterMode(rgp.NodeGroups)); err != nil {
-		return fmt.Errorf("error setting cluster_mode attribute: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting cluster_mode attribute: %w", err))
 	}
 	d.Set("cluster_enabled", rgp.ClusterEnabled)
 	d.Set("replication_group_id", rgp.ReplicationGroupId)
 	d.Set("arn", rgp.ARN)
 
 	// Tags cannot be read when the replication group is not Available
-	_, err = WaitReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	_, err = WaitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return fmt.Errorf("error listing tags for resource (%s): %w", aws.StringValue(rgp.ARN), err)
+		return diag.FromErr(fmt.Errorf("error listing tags for resource (%s): %w", aws.StringValue(rgp.ARN), err))
 	}
 	tags, err := ListTags(conn, aws.StringValue(rgp.ARN))
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for resource (%s): %w", aws.StringValue(rgp.ARN), err)
+		return diag.FromErr(fmt.Errorf("error listing tags for resource (%s): %w", aws.StringValue(rgp.ARN), err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	if rgp.NodeGroups != nil {
@@ -545,7 +546,7 @@ func resourceReplicationGroupRead(d *schema.ResourceData, meta interface{}) erro
 			ShowCacheNodeInfo: aws.Bool(true),
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		if len(res.CacheClusters) == 0 {
@@ -555,7 +556,7 @@ func resourceReplicationGroupRead(d *schema.ResourceData, meta interface{}) erro
 		c := res.CacheClusters[0]
 
 		if err := elasticacheSetResourceDataFromCacheCluster(d, c); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		d.Set("snapshot_window", rgp.SnapshotWindow)
@@ -582,18 +583,18 @@ func resourceReplicationGroupRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	if d.HasChanges("cluster_mode.0.num_node_groups", "cluster_mode.0.replicas_per_node_group") {
-		err := elasticacheReplicationGroupModifyShardConfiguration(conn, d)
+		err := elasticacheReplicationGroupModifyShardConfiguration(ctx, conn, d)
 		if err != nil {
-			return fmt.Errorf("error modifying ElastiCache Replication Group (%s) shard configuration: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error modifying ElastiCache Replication Group (%s) shard configuration: %w", d.Id(), err))
 		}
 	} else if d.HasChange("number_cache_clusters") {
-		err := elasticacheReplicationGroupModifyNumCacheClusters(conn, d)
+		err := elasticacheReplicationGroupModifyNumCacheClusters(ctx, conn, d)
 		if err != nil {
-			return fmt.Errorf("error modifying ElastiCache Replication Group (%s) clusters: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error modifying ElastiCache Replication Group (%s) clusters: %w", d.Id(), err))
 		}
 	}
 
@@ -681,39 +682,39 @@ func resourceReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) er
 	if requestUpdate {
 		_, err := conn.ModifyReplicationGroup(params)
 		if err != nil {
-			return fmt.Errorf("error updating ElastiCache Replication Group (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating ElastiCache Replication Group (%s): %w", d.Id(), err))
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %w", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
 		}
 	}
 
-	_, err := WaitReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	_, err := WaitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return fmt.Errorf("error waiting for modification: %w", err)
+		return diag.FromErr(fmt.Errorf("error waiting for modification: %w", err))
 	}
 
-	return resourceReplicationGroupRead(d, meta)
+	return resourceReplicationGroupRead(ctx, d, meta)
 }
 
-func resourceReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ElastiCacheConn
+func resourceReplicationGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
 	if globalReplicationGroupID, ok := d.GetOk("global_replication_group_id"); ok {
 		err := DisassociateReplicationGroup(conn, globalReplicationGroupID.(string), d.Id(), meta.(*conns.AWSClient).Region, GlobalReplicationGroupDisassociationReadyTimeout)
 		if err != nil {
-			return fmt.Errorf("error disassociating ElastiCache Replication Group (%s) from Global Replication Group (%s): %w", d.Id(), globalReplicationGroupID, err)
+			return diag.FromErr(fmt.Errorf("error disassociating ElastiCache Replication Group (%s) from Global Replication Group (%s): %w", d.Id(), globalReplicationGroupID, err))
 		}
 	}
 
 	var finalSnapshotID = d.Get("final_snapshot_identifier").(string)
-	err := deleteElasticacheReplicationGroup(d.Id(), conn, finalSnapshotID, d.Timeout(schema.TimeoutDelete))
+	err := deleteElasticacheReplicationGroup(ctx, d.Id(), conn, finalSnapshotID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return fmt.Errorf("error deleting ElastiCache Replication Group (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting ElastiCache Replication Group (%s): %w", d.Id(), err))
 	}
 
 	return nil
@@ -762,7 +763,7 @@ func DisassociateReplicationGroup(conn *elasticache.ElastiCache, globalReplicati
 
 }
 
-func deleteElasticacheReplicationGroup(replicationGroupID string, conn *elasticache.ElastiCache, finalSnapshotID string, timeout time.Duration) error {
+func deleteElasticacheReplicationGroup(ctx context.Context, replicationGroupID string, conn *elasticache.ElastiCache, finalSnapshotID string, timeout time.Duration) error {
 	input := &elasticache.DeleteReplicationGroupInput{
 		ReplicationGroupId: aws.String(replicationGroupID),
 	}
@@ -771,7 +772,7 @@ func deleteElasticacheReplicationGroup(replicationGroupID string, conn *elastica
 	}
 
 	// 10 minutes should give any creating/deleting cache clusters or snapshots time to complete
-	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		_, err := conn.DeleteReplicationGroup(input)
 		if tfawserr.ErrMessageContains(err, elasticache.ErrCodeReplicationGroupNotFoundFault, "") {
 			return nil
@@ -797,7 +798,7 @@ func deleteElasticacheReplicationGroup(replicationGroupID string, conn *elastica
 		return err
 	}
 
-	_, err = WaitReplicationGroupDeleted(conn, replicationGroupID, timeout)
+	_, err = WaitReplicationGroupDeleted(ctx, conn, replicationGroupID, timeout)
 	if err != nil {
 		return err
 	}
@@ -817,16 +818,16 @@ func flattenElasticacheNodeGroupsToClusterMode(nodeGroups []*elasticache.NodeGro
 	return []map[string]interface{}{m}
 }
 
-func elasticacheReplicationGroupModifyShardConfiguration(conn *elasticache.ElastiCache, d *schema.ResourceData) error {
+func elasticacheReplicationGroupModifyShardConfiguration(ctx context.Context, conn *elasticache.ElastiCache, d *schema.ResourceData) error {
 	if d.HasChange("cluster_mode.0.num_node_groups") {
-		err := elasticacheReplicationGroupModifyShardConfigurationNumNodeGroups(conn, d)
+		err := elasticacheReplicationGroupModifyShardConfigurationNumNodeGroups(ctx, conn, d)
 		if err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("cluster_mode.0.replicas_per_node_group") {
-		err := elasticacheReplicationGroupModifyShardConfigurationReplicasPerNodeGroup(conn, d)
+		err := elasticacheReplicationGroupModifyShardConfigurationReplicasPerNodeGroup(ctx, conn, d)
 		if err != nil {
 			return err
 		}
@@ -835,7 +836,7 @@ func elasticacheReplicationGroupModifyShardConfiguration(conn *elasticache.Elast
 	return nil
 }
 
-func elasticacheReplicationGroupModifyShardConfigurationNumNodeGroups(conn *elasticache.ElastiCache, d *schema.ResourceData) error {
+func elasticacheReplicationGroupModifyShardConfigurationNumNodeGroups(ctx context.Context, conn *elasticache.ElastiCache, d *schema.ResourceData) error {
 	o, n := d.GetChange("cluster_mode.0.num_node_groups")
 	oldNumNodeGroups := o.(int)
 	newNumNodeGroups := n.(int)
@@ -863,7 +864,7 @@ func elasticacheReplicationGroupModifyShardConfigurationNumNodeGroups(conn *elas
 		return fmt.Errorf("error modifying ElastiCache Replication Group shard configuration: %w", err)
 	}
 
-	_, err = WaitReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	_, err = WaitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("error waiting for ElastiCache Replication Group (%s) shard reconfiguration completion: %w", d.Id(), err)
 	}
@@ -871,7 +872,7 @@ func elasticacheReplicationGroupModifyShardConfigurationNumNodeGroups(conn *elas
 	return nil
 }
 
-func elasticacheReplicationGroupModifyShardConfigurationReplicasPerNodeGroup(conn *elasticache.ElastiCache, d *schema.ResourceData) error {
+func elasticacheReplicationGroupModifyShardConfigurationReplicasPerNodeGroup(ctx context.Context, conn *elasticache.ElastiCache, d *schema.ResourceData) error {
 	o, n := d.GetChange("cluster_mode.0.replicas_per_node_group")
 	oldReplicas := o.(int)
 	newReplicas := n.(int)
@@ -886,7 +887,7 @@ func elasticacheReplicationGroupModifyShardConfigurationReplicasPerNodeGroup(con
 		if err != nil {
 			return fmt.Errorf("error adding ElastiCache Replication Group (%s) replicas: %w", d.Id(), err)
 		}
-		_, err = WaitReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		_, err = WaitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("error waiting for ElastiCache Replication Group (%s) replica addition: %w", d.Id(), err)
 		}
@@ -900,7 +901,7 @@ func elasticacheReplicationGroupModifyShardConfigurationReplicasPerNodeGroup(con
 		if err != nil {
 			return fmt.Errorf("error removing ElastiCache Replication Group (%s) replicas: %w", d.Id(), err)
 		}
-		_, err = WaitReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		_, err = WaitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("error waiting for ElastiCache Replication Group (%s) replica removal: %w", d.Id(), err)
 		}
@@ -909,21 +910,21 @@ func elasticacheReplicationGroupModifyShardConfigurationReplicasPerNodeGroup(con
 	return nil
 }
 
-func elasticacheReplicationGroupModifyNumCacheClusters(conn *elasticache.ElastiCache, d *schema.ResourceData) error {
+func elasticacheReplicationGroupModifyNumCacheClusters(ctx context.Context, conn *elasticache.ElastiCache, d *schema.ResourceData) error {
 	o, n := d.GetChange("number_cache_clusters")
 	oldNumberCacheClusters := o.(int)
 	newNumberCacheClusters := n.(int)
 
 	var err error
 	if newNumberCacheClusters > oldNumberCacheClusters {
-		err = elasticacheReplicationGroupIncreaseNumCacheClusters(conn, d.Id(), newNumberCacheClusters, d.Timeout(schema.TimeoutUpdate))
+		err = elasticacheReplicationGroupIncreaseNumCacheClusters(ctx, conn, d.Id(), newNumberCacheClusters, d.Timeout(schema.TimeoutUpdate))
 	} else if newNumberCacheClusters < oldNumberCacheClusters {
-		err = elasticacheReplicationGroupDecreaseNumCacheClusters(conn, d.Id(), newNumberCacheClusters, d.Timeout(schema.TimeoutUpdate))
+		err = elasticacheReplicationGroupDecreaseNumCacheClusters(ctx, conn, d.Id(), newNumberCacheClusters, d.Timeout(schema.TimeoutUpdate))
 	}
 	return err
 }
 
-func elasticacheReplicationGroupIncreaseNumCacheClusters(conn *elasticache.ElastiCache, replicationGroupID string, newNumberCacheClusters int, timeout time.Duration) error {
+func elasticacheReplicationGroupIncreaseNumCacheClusters(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string, newNumberCacheClusters int, timeout time.Duration) error {
 	input := &elasticache.IncreaseReplicaCountInput{
 		ApplyImmediately:   aws.Bool(true),
 		NewReplicaCount:    aws.Int64(int64(newNumberCacheClusters - 1)),
@@ -934,7 +935,7 @@ func elasticacheReplicationGroupIncreaseNumCacheClusters(conn *elasticache.Elast
 		return fmt.Errorf("error adding ElastiCache Replication Group (%s) replicas: %w", replicationGroupID, err)
 	}
 
-	_, err = WaitReplicationGroupMemberClustersAvailable(conn, replicationGroupID, timeout)
+	_, err = WaitReplicationGroupMemberClustersAvailable(ctx, conn, replicationGroupID, timeout)
 	if err != nil {
 		return fmt.Errorf("error waiting for ElastiCache Replication Group (%s) replica addition: %w", replicationGroupID, err)
 	}
@@ -942,7 +943,7 @@ func elasticacheReplicationGroupIncreaseNumCacheClusters(conn *elasticache.Elast
 	return nil
 }
 
-func elasticacheReplicationGroupDecreaseNumCacheClusters(conn *elasticache.ElastiCache, replicationGroupID string, newNumberCacheClusters int, timeout time.Duration) error {
+func elasticacheReplicationGroupDecreaseNumCacheClusters(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string, newNumberCacheClusters int, timeout time.Duration) error {
 	input := &elasticache.DecreaseReplicaCountInput{
 		ApplyImmediately:   aws.Bool(true),
 		NewReplicaCount:    aws.Int64(int64(newNumberCacheClusters - 1)),
@@ -953,7 +954,7 @@ func elasticacheReplicationGroupDecreaseNumCacheClusters(conn *elasticache.Elast
 		return fmt.Errorf("error removing ElastiCache Replication Group (%s) replicas: %w", replicationGroupID, err)
 	}
 
-	_, err = WaitReplicationGroupMemberClustersAvailable(conn, replicationGroupID, timeout)
+	_, err = WaitReplicationGroupMemberClustersAvailable(ctx, conn, replicationGroupID, timeout)
 	if err != nil {
 		return fmt.Errorf("error waiting for ElastiCache Replication Group (%s) replica removal: %w", replicationGroupID, err)
 	}
@@ -1,10 +1,13 @@
 package elasticache
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -23,7 +26,7 @@ const (
 )
 
 // WaitReplicationGroupAvailable waits for a ReplicationGroup to return Available
-func WaitReplicationGroupAvailable(conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
+func WaitReplicationGroupAvailable(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			ReplicationGroupStatusCreating,
@@ -37,7 +40,9 @@ func WaitReplicationGroupAvailable(conn *elasticache.ElastiCache, replicationGro
 		Delay:      replicationGroupAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("ElastiCache Replication Group (%s) to become available", replicationGroupID),
+	})
 	if v, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
 		return v, err
 	}
@@ -45,7 +50,7 @@ func WaitReplicationGroupAvailable(conn *elasticache.ElastiCache, replicationGro
 }
 
 // WaitReplicationGroupDeleted waits for a ReplicationGroup to be deleted
-func WaitReplicationGroupDeleted(conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
+func WaitReplicationGroupDeleted(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) (*elasticache.ReplicationGroup, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			ReplicationGroupStatusCreating,
@@ -59,7 +64,9 @@ func WaitReplicationGroupDeleted(conn *elasticache.ElastiCache, replicationGroup
 		Delay:      replicationGroupDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("ElastiCache Replication Group (%s) delete", replicationGroupID),
+	})
 	if v, ok := outputRaw.(*elasticache.ReplicationGroup); ok {
 		return v, err
 	}
@@ -67,7 +74,7 @@ func WaitReplicationGroupDeleted(conn *elasticache.ElastiCache, replicationGroup
 }
 
 // WaitReplicationGroupMemberClustersAvailable waits for all of a ReplicationGroup's Member Clusters to return Available
-func WaitReplicationGroupMemberClustersAvailable(conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) ([]*elasticache.CacheCluster, error) {
+func WaitReplicationGroupMemberClustersAvailable(ctx context.Context, conn *elasticache.ElastiCache, replicationGroupID string, timeout time.Duration) ([]*elasticache.CacheCluster, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			CacheClusterStatusCreating,
@@ -81,7 +88,9 @@ func WaitReplicationGroupMemberClustersAvailable(conn *elasticache.ElastiCache,
 		Delay:      cacheClusterAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("ElastiCache Replication Group (%s) member clusters to become available", replicationGroupID),
+	})
 	if v, ok := outputRaw.([]*elasticache.CacheCluster); ok {
 		return v, err
 	}
@@ -101,7 +110,7 @@ const (
 )
 
 // waitCacheClusterAvailable waits for a Cache Cluster to return Available
-func waitCacheClusterAvailable(conn *elasticache.ElastiCache, cacheClusterID string, timeout time.Duration) (*elasticache.CacheCluster, error) { //nolint:unparam
+func waitCacheClusterAvailable(ctx context.Context, conn *elasticache.ElastiCache, cacheClusterID string, timeout time.Duration) (*elasticache.CacheCluster, error) { //nolint:unparam
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			CacheClusterStatusCreating,
@@ -116,7 +125,9 @@ func waitCacheClusterAvailable(conn *elasticache.ElastiCache, cacheClusterID str
 		Delay:      cacheClusterAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("ElastiCache Cache Cluster (%s) to become available", cacheClusterID),
+	})
 	if v, ok := outputRaw.(*elasticache.CacheCluster); ok {
 		return v, err
 	}
@@ -124,7 +135,7 @@ func waitCacheClusterAvailable(conn *elasticache.ElastiCache, cacheClusterID str
 }
 
 // WaitCacheClusterDeleted waits for a Cache Cluster to be deleted
-func WaitCacheClusterDeleted(conn *elasticache.ElastiCache, cacheClusterID string, timeout time.Duration) (*elasticache.CacheCluster, error) {
+func WaitCacheClusterDeleted(ctx context.Context, conn *elasticache.ElastiCache, cacheClusterID string, timeout time.Duration) (*elasticache.CacheCluster, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			CacheClusterStatusCreating,
@@ -142,7 +153,9 @@ func WaitCacheClusterDeleted(conn *elasticache.ElastiCache, cacheClusterID strin
 		Delay:      cacheClusterDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("ElastiCache Cache Cluster (%s) delete", cacheClusterID),
+	})
 	if v, ok := outputRaw.(*elasticache.CacheCluster); ok {
 		return v, err
 	}
@@ -173,7 +186,7 @@ func WaitGlobalReplicationGroupAvailable(conn *elasticache.ElastiCache, globalRe
 		Delay:      globalReplicationGroupAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
 		return v, err
 	}
@@ -196,7 +209,7 @@ func WaitGlobalReplicationGroupDeleted(conn *elasticache.ElastiCache, globalRepl
 		Delay:      globalReplicationGroupDeletedDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
 		return v, err
 	}
@@ -227,7 +240,7 @@ func WaitGlobalReplicationGroupMemberDetached(conn *elasticache.ElastiCache, glo
 		Delay:      globalReplicationGroupDisassociationDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
 		return v, err
 	}
@@ -243,7 +256,7 @@ func WaitUserActive(conn *elasticache.ElastiCache, userId string) error {
 		Timeout: UserActiveTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -257,7 +270,7 @@ func WaitUserDeleted(conn *elasticache.ElastiCache, userId string) error {
 		Timeout: UserDeletedTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -12,7 +12,6 @@ import (
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -22,7 +21,7 @@ import (
 
 func TestAccElasticSearchDomain_basic(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceName := "aws_elasticsearch_domain.test"
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
@@ -53,7 +52,7 @@ func TestAccElasticSearchDomain_basic(t *testing.T) {
 
 func TestAccElasticSearchDomain_requireHTTPS(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -88,7 +87,7 @@ func TestAccElasticSearchDomain_requireHTTPS(t *testing.T) {
 
 func TestAccElasticSearchDomain_customEndpoint(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.example"
 	customEndpoint := fmt.Sprintf("%s.example.com", resourceId)
@@ -140,7 +139,7 @@ func TestAccElasticSearchDomain_customEndpoint(t *testing.T) {
 
 func TestAccElasticSearchDomain_Cluster_zoneAwareness(t *testing.T) {
 	var domain1, domain2, domain3, domain4 elasticsearch.ElasticsearchDomainStatus
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(16)) // len = 28
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 16)) // len = 28
 	resourceName := "aws_elasticsearch_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -199,7 +198,7 @@ func TestAccElasticSearchDomain_Cluster_zoneAwareness(t *testing.T) {
 
 func TestAccElasticSearchDomain_warm(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(16)) // len = 28
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 16)) // len = 28
 	resourceName := "aws_elasticsearch_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -256,7 +255,7 @@ func TestAccElasticSearchDomain_warm(t *testing.T) {
 
 func TestAccElasticSearchDomain_withDedicatedMaster(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceName := "aws_elasticsearch_domain.test"
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
@@ -296,7 +295,7 @@ func TestAccElasticSearchDomain_withDedicatedMaster(t *testing.T) {
 
 func TestAccElasticSearchDomain_duplicate(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -305,7 +304,7 @@ func TestAccElasticSearchDomain_duplicate(t *testing.T) {
 		ErrorCheck: acctest.ErrorCheck(t, elasticsearch.EndpointsID),
 		Providers:  acctest.Providers,
 		CheckDestroy: func(s *terraform.State) error {
-			conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 			_, err := conn.DeleteElasticsearchDomain(&elasticsearch.DeleteElasticsearchDomainInput{
 				DomainName: aws.String(resourceId),
 			})
@@ -315,7 +314,7 @@ func TestAccElasticSearchDomain_duplicate(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Create duplicate
-					conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+					conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 					_, err := conn.CreateElasticsearchDomain(&elasticsearch.CreateElasticsearchDomainInput{
 						DomainName: aws.String(resourceId),
 						EBSOptions: &elasticsearch.EBSOptions{
@@ -346,7 +345,7 @@ func TestAccElasticSearchDomain_duplicate(t *testing.T) {
 
 func TestAccElasticSearchDomain_v23(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -376,7 +375,7 @@ func TestAccElasticSearchDomain_v23(t *testing.T) {
 
 func TestAccElasticSearchDomain_complex(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -404,7 +403,7 @@ func TestAccElasticSearchDomain_complex(t *testing.T) {
 
 func TestAccElasticSearchDomain_vpc(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -432,7 +431,7 @@ func TestAccElasticSearchDomain_vpc(t *testing.T) {
 
 func TestAccElasticSearchDomain_VPC_update(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -468,7 +467,7 @@ func TestAccElasticSearchDomain_VPC_update(t *testing.T) {
 
 func TestAccElasticSearchDomain_internetToVPCEndpoint(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -502,7 +501,7 @@ func TestAccElasticSearchDomain_internetToVPCEndpoint(t *testing.T) {
 
 func TestAccElasticSearchDomain_AdvancedSecurityOptions_userDB(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	domainName := sdkacctest.RandomWithPrefix("tf-test")
+	domainName := acctest.RandomWithPrefix(t, "tf-test")
 	resourceName := "aws_elasticsearch_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -535,7 +534,8 @@ func TestAccElasticSearchDomain_AdvancedSecurityOptions_userDB(t *testing.T) {
 
 func TestAccElasticSearchDomain_AdvancedSecurityOptions_iam(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	domainName := sdkacctest.RandomWithPrefix("tf-test")
+	domainName := acctest.RandomWithPrefix(t, "tf-test")
+	rUserName := acctest.RandomWithPrefix(t, "es-master-user")
 	resourceName := "aws_elasticsearch_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -545,7 +545,7 @@ func TestAccElasticSearchDomain_AdvancedSecurityOptions_iam(t *testing.T) {
 		CheckDestroy: testAccCheckESDomainDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccESDomainConfig_AdvancedSecurityOptionsIAM(domainName),
+				Config: testAccESDomainConfig_AdvancedSecurityOptionsIAM(rUserName, domainName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckESDomainExists(resourceName, &domain),
 					testAccCheckAdvancedSecurityOptions(true, false, &domain),
@@ -568,7 +568,7 @@ func TestAccElasticSearchDomain_AdvancedSecurityOptions_iam(t *testing.T) {
 
 func TestAccElasticSearchDomain_AdvancedSecurityOptions_disabled(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	domainName := sdkacctest.RandomWithPrefix("tf-test")
+	domainName := acctest.RandomWithPrefix(t, "tf-test")
 	resourceName := "aws_elasticsearch_domain.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -601,7 +601,7 @@ func TestAccElasticSearchDomain_AdvancedSecurityOptions_disabled(t *testing.T) {
 
 func TestAccElasticSearchDomain_LogPublishingOptions_indexSlowLogs(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -633,7 +633,7 @@ func TestAccElasticSearchDomain_LogPublishingOptions_indexSlowLogs(t *testing.T)
 
 func TestAccElasticSearchDomain_LogPublishingOptions_searchSlowLogs(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -665,7 +665,7 @@ func TestAccElasticSearchDomain_LogPublishingOptions_searchSlowLogs(t *testing.T
 
 func TestAccElasticSearchDomain_LogPublishingOptions_esApplicationLogs(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -697,7 +697,7 @@ func TestAccElasticSearchDomain_LogPublishingOptions_esApplicationLogs(t *testin
 
 func TestAccElasticSearchDomain_LogPublishingOptions_auditLogs(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -731,7 +731,7 @@ func TestAccElasticSearchDomain_LogPublishingOptions_auditLogs(t *testing.T) {
 
 func TestAccElasticSearchDomain_cognitoOptionsCreateAndRemove(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceName := "aws_elasticsearch_domain.test"
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
@@ -771,7 +771,7 @@ func TestAccElasticSearchDomain_cognitoOptionsCreateAndRemove(t *testing.T) {
 
 func TestAccElasticSearchDomain_cognitoOptionsUpdate(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -812,7 +812,7 @@ func TestAccElasticSearchDomain_cognitoOptionsUpdate(t *testing.T) {
 func TestAccElasticSearchDomain_policy(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	resourceName := "aws_elasticsearch_domain.test"
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -840,7 +840,7 @@ func TestAccElasticSearchDomain_policy(t *testing.T) {
 func TestAccElasticSearchDomain_EncryptAtRestDefault_key(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	resourceName := "aws_elasticsearch_domain.test"
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -869,7 +869,7 @@ func TestAccElasticSearchDomain_EncryptAtRestDefault_key(t *testing.T) {
 func TestAccElasticSearchDomain_EncryptAtRestSpecify_key(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	resourceName := "aws_elasticsearch_domain.test"
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -898,7 +898,7 @@ func TestAccElasticSearchDomain_EncryptAtRestSpecify_key(t *testing.T) {
 func TestAccElasticSearchDomain_nodeToNodeEncryption(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	resourceName := "aws_elasticsearch_domain.test"
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -926,7 +926,7 @@ func TestAccElasticSearchDomain_nodeToNodeEncryption(t *testing.T) {
 
 func TestAccElasticSearchDomain_tags(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -964,7 +964,7 @@ func TestAccElasticSearchDomain_tags(t *testing.T) {
 
 func TestAccElasticSearchDomain_update(t *testing.T) {
 	var input elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -1001,7 +1001,7 @@ func TestAccElasticSearchDomain_update(t *testing.T) {
 
 func TestAccElasticSearchDomain_UpdateVolume_type(t *testing.T) {
 	var input elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -1047,7 +1047,7 @@ func TestAccElasticSearchDomain_UpdateVolume_type(t *testing.T) {
 func TestAccElasticSearchDomain_WithVolumeType_missing(t *testing.T) {
 	var domain elasticsearch.ElasticsearchDomainStatus
 	resourceName := "aws_elasticsearch_domain.test"
-	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(16))
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(t, 16))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
@@ -1080,7 +1080,7 @@ func TestAccElasticSearchDomain_WithVolumeType_missing(t *testing.T) {
 
 func TestAccElasticSearchDomain_Update_version(t *testing.T) {
 	var domain1, domain2, domain3 elasticsearch.ElasticsearchDomainStatus
-	ri := sdkacctest.RandInt()
+	ri := acctest.RandInt(t)
 	resourceId := fmt.Sprintf("tf-test-%d", ri)
 	resourceName := "aws_elasticsearch_domain.test"
 
@@ -1275,7 +1275,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 			return fmt.Errorf("No ES Domain ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -1293,7 +1293,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 
 func testAccCheckESDomainNotRecreated(i, j *elasticsearch.ElasticsearchDomainStatus) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 
 		iConfig, err := conn.DescribeElasticsearchDomainConfig(&elasticsearch.DescribeElasticsearchDomainConfigInput{
 			DomainName: i.DomainName,
@@ -1322,7 +1322,7 @@ func testAccCheckESDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -1340,7 +1340,7 @@ func testAccCheckESDomainDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckIamServiceLinkedRoleEs(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 	dnsSuffix := acctest.Provider.Meta().(*conns.AWSClient).DNSSuffix
 
 	input := &iam.ListRolesInput{
@@ -2181,7 +2181,7 @@ resource "aws_elasticsearch_domain" "test" {
 `, domainName)
 }
 
-func testAccESDomainConfig_AdvancedSecurityOptionsIAM(domainName string) string {
+func testAccESDomainConfig_AdvancedSecurityOptionsIAM(rUserName, domainName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_user" "es_master_user" {
   name = "%s"
@@ -2221,7 +2221,7 @@ resource "aws_elasticsearch_domain" "test" {
     volume_size = 10
   }
 }
-`, sdkacctest.RandomWithPrefix("es-master-user"), domainName)
+`, rUserName, domainName)
 }
 
 func testAccESDomainConfig_AdvancedSecurityOptionsDisabled(domainName string) string {
@@ -2427,7 +2427,7 @@ resource "aws_elasticsearch_domain" "test" {
 }
 
 func testAccPreCheckCognitoIdentityProvider(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(1),
@@ -2445,7 +2445,7 @@ func testAccPreCheckCognitoIdentityProvider(t *testing.T) {
 }
 
 func testAccCheckELBDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elb" {
@@ -17,7 +17,7 @@ import (
 )
 
 func TestAccELBListenerPolicy_basic(t *testing.T) {
-	rChar := sdkacctest.RandStringFromCharSet(6, sdkacctest.CharSetAlpha)
+	rChar := acctest.RandStringFromCharSet(t, 6, sdkacctest.CharSetAlpha)
 	lbName := rChar
 	mcName := rChar
 	resource.ParallelTest(t, resource.TestCase{
@@ -60,7 +60,7 @@ func policyInListenerPolicies(str string, list []string) bool {
 }
 
 func testAccCheckListenerPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch {
@@ -119,7 +119,7 @@ func testAccCheckListenerPolicyDestroy(s *terraform.State) error {
 
 func testAccCheckListenerPolicyState(loadBalancerName string, loadBalancerListenerPort int64, loadBalancerListenerPolicyName string, assigned bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
 
 		loadBalancerDescription, err := conn.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
 			LoadBalancerNames: []*string{aws.String(loadBalancerName)},
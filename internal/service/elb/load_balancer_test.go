@@ -81,7 +81,7 @@ func TestAccELBLoadBalancer_disappears(t *testing.T) {
 func TestAccELBLoadBalancer_fullCharacterRange(t *testing.T) {
 	var conf elb.LoadBalancerDescription
 	resourceName := "aws_elb.test"
-	lbName := fmt.Sprintf("Tf-%d", sdkacctest.RandInt())
+	lbName := fmt.Sprintf("Tf-%d", acctest.RandInt(t))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -103,7 +103,7 @@ func TestAccELBLoadBalancer_fullCharacterRange(t *testing.T) {
 func TestAccELBLoadBalancer_AccessLogs_enabled(t *testing.T) {
 	var conf elb.LoadBalancerDescription
 	resourceName := "aws_elb.test"
-	rName := fmt.Sprintf("tf-test-access-logs-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-access-logs-%d", acctest.RandInt(t))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -143,7 +143,7 @@ func TestAccELBLoadBalancer_AccessLogs_enabled(t *testing.T) {
 func TestAccELBLoadBalancer_AccessLogs_disabled(t *testing.T) {
 	var conf elb.LoadBalancerDescription
 	resourceName := "aws_elb.test"
-	rName := fmt.Sprintf("tf-test-access-logs-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("tf-test-access-logs-%d", acctest.RandInt(t))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -325,7 +325,7 @@ func TestAccELBLoadBalancer_ListenerSSLCertificateID_iamServerCertificate(t *tes
 	var conf elb.LoadBalancerDescription
 	key := acctest.TLSRSAPrivateKeyPEM(2048)
 	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(key, "example.com")
-	rName := fmt.Sprintf("tf-acctest-%s", sdkacctest.RandString(10))
+	rName := fmt.Sprintf("tf-acctest-%s", acctest.RandString(t, 10))
 	resourceName := "aws_elb.test"
 
 	testCheck := func(*terraform.State) error {
@@ -500,7 +500,7 @@ func TestAccELBLoadBalancer_listener(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Simulate out of band listener removal
-					conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn
+					conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
 					input := &elb.DeleteLoadBalancerListenersInput{
 						LoadBalancerName:  conf.LoadBalancerName,
 						LoadBalancerPorts: []*int64{aws.Int64(80)},
@@ -524,7 +524,7 @@ func TestAccELBLoadBalancer_listener(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Simulate out of band listener addition
-					conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn
+					conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
 					input := &elb.CreateLoadBalancerListenersInput{
 						LoadBalancerName: conf.LoadBalancerName,
 						Listeners: []*elb.Listener{
@@ -859,7 +859,7 @@ func TestValidLoadBalancerHealthCheckTarget(t *testing.T) {
 		},
 		{
 			Value: fmt.Sprintf("HTTP:8080/%s%s",
-				sdkacctest.RandStringFromCharSet(512, sdkacctest.CharSetAlpha), randomRunes(512)),
+				acctest.RandStringFromCharSet(t, 512, sdkacctest.CharSetAlpha), randomRunes(512)),
 			ErrCount: 1,
 		},
 	}
@@ -873,7 +873,7 @@ func TestValidLoadBalancerHealthCheckTarget(t *testing.T) {
 }
 
 func testAccCheckLoadBalancerDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elb" {
@@ -907,7 +907,7 @@ func testAccCheckLoadBalancerDestroy(s *terraform.State) error {
 
 func testAccCheckLoadBalancerDisappears(loadBalancer *elb.LoadBalancerDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
 
 		input := elb.DeleteLoadBalancerInput{
 			LoadBalancerName: loadBalancer.LoadBalancerName,
@@ -953,7 +953,7 @@ func testAccCheckLoadBalancerExists(n string, res *elb.LoadBalancerDescription)
 			return fmt.Errorf("No ELB ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
 
 		describe, err := conn.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
 			LoadBalancerNames: []*string{aws.String(rs.Primary.ID)},
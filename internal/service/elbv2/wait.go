@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -43,7 +44,7 @@ func waitLoadBalancerActive(conn *elbv2.ELBV2, arn string, timeout time.Duration
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
-	output, err := stateConf.WaitForState()
+	output, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := output.(*elbv2.LoadBalancer); ok {
 		return v, err
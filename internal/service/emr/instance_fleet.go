@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceInstanceFleet() *schema.Resource {
@@ -212,7 +213,7 @@ func ResourceInstanceFleet() *schema.Resource {
 }
 
 func resourceInstanceFleetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EMRConn
+	conn := meta.(*conns.AWSClient).EMRConn()
 
 	addInstanceFleetInput := &emr.AddInstanceFleetInput{
 		ClusterId: aws.String(d.Get("cluster_id").(string)),
@@ -243,7 +244,7 @@ func resourceInstanceFleetCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceInstanceFleetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EMRConn
+	conn := meta.(*conns.AWSClient).EMRConn()
 	instanceFleets, err := FetchAllInstanceFleets(conn, d.Get("cluster_id").(string))
 
 	if err != nil {
@@ -286,7 +287,7 @@ func FindInstanceFleetByID(instanceFleets []*emr.InstanceFleet, fleetId string)
 }
 
 func resourceInstanceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EMRConn
+	conn := meta.(*conns.AWSClient).EMRConn()
 
 	log.Printf("[DEBUG] Modify EMR task fleet")
 
@@ -315,7 +316,7 @@ func resourceInstanceFleetUpdate(d *schema.ResourceData, meta interface{}) error
 		MinTimeout: 30 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("error waiting for instance (%s) to terminate: %s", d.Id(), err)
 	}
@@ -347,7 +348,7 @@ func instanceFleetStateRefresh(conn *emr.EMR, clusterID, ifID string) resource.S
 
 func resourceInstanceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[WARN] AWS EMR Instance Fleet does not support DELETE; resizing cluster to zero before removing from state")
-	conn := meta.(*conns.AWSClient).EMRConn
+	conn := meta.(*conns.AWSClient).EMRConn()
 
 	clusterId := d.Get("cluster_id").(string)
 
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/emr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccEMRInstanceGroup_basic(t *testing.T) {
 	var ig emr.InstanceGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_emr_instance_group.task"
 	resource.ParallelTest(t, resource.TestCase{
@@ -49,7 +48,7 @@ func TestAccEMRInstanceGroup_basic(t *testing.T) {
 
 func TestAccEMRInstanceGroup_bidPrice(t *testing.T) {
 	var ig1, ig2 emr.InstanceGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_emr_instance_group.task"
 	resource.ParallelTest(t, resource.TestCase{
@@ -101,7 +100,7 @@ func TestAccEMRInstanceGroup_bidPrice(t *testing.T) {
 
 func TestAccEMRInstanceGroup_sJSON(t *testing.T) {
 	var ig emr.InstanceGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_emr_instance_group.task"
 	resource.ParallelTest(t, resource.TestCase{
@@ -144,7 +143,7 @@ func TestAccEMRInstanceGroup_sJSON(t *testing.T) {
 
 func TestAccEMRInstanceGroup_autoScalingPolicy(t *testing.T) {
 	var ig emr.InstanceGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_emr_instance_group.task"
 	resource.ParallelTest(t, resource.TestCase{
@@ -189,7 +188,7 @@ func TestAccEMRInstanceGroup_autoScalingPolicy(t *testing.T) {
 // Regression test for https://github.com/hashicorp/terraform-provider-aws/issues/1264
 func TestAccEMRInstanceGroup_instanceCount(t *testing.T) {
 	var ig emr.InstanceGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_emr_instance_group.task"
 	resource.ParallelTest(t, resource.TestCase{
@@ -221,7 +220,7 @@ func TestAccEMRInstanceGroup_instanceCount(t *testing.T) {
 func TestAccEMRInstanceGroup_Disappears_emrCluster(t *testing.T) {
 	var cluster emr.Cluster
 	var ig emr.InstanceGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	emrClusterResourceName := "aws_emr_cluster.tf-test-cluster"
 	resourceName := "aws_emr_instance_group.task"
 
@@ -246,7 +245,7 @@ func TestAccEMRInstanceGroup_Disappears_emrCluster(t *testing.T) {
 
 func TestAccEMRInstanceGroup_EBS_ebsOptimized(t *testing.T) {
 	var ig emr.InstanceGroup
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_emr_instance_group.task"
 	resource.ParallelTest(t, resource.TestCase{
@@ -283,7 +282,7 @@ func TestAccEMRInstanceGroup_EBS_ebsOptimized(t *testing.T) {
 }
 
 func testAccCheckInstanceGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_emr_cluster" {
@@ -324,7 +323,7 @@ func testAccCheckInstanceGroupExists(name string, ig *emr.InstanceGroup) resourc
 		}
 
 		meta := acctest.Provider.Meta()
-		conn := meta.(*conns.AWSClient).EMRConn
+		conn := meta.(*conns.AWSClient).EMRConn()
 		group, err := tfemr.FetchInstanceGroup(conn, rs.Primary.Attributes["cluster_id"], rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("EMR error: %v", err)
@@ -26,7 +26,7 @@ func waitDeliveryStreamCreated(conn *firehose.Firehose, name string) (*firehose.
 		Timeout: deliveryStreamCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*firehose.DeliveryStreamDescription); ok {
 		if status, failureDescription := aws.StringValue(output.DeliveryStreamStatus), output.FailureDescription; status == firehose.DeliveryStreamStatusCreatingFailed && failureDescription != nil {
@@ -47,7 +47,7 @@ func waitDeliveryStreamDeleted(conn *firehose.Firehose, name string) (*firehose.
 		Timeout: deliveryStreamDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*firehose.DeliveryStreamDescription); ok {
 		if status, failureDescription := aws.StringValue(output.DeliveryStreamStatus), output.FailureDescription; status == firehose.DeliveryStreamStatusDeletingFailed && failureDescription != nil {
@@ -68,7 +68,7 @@ func waitDeliveryStreamEncryptionEnabled(conn *firehose.Firehose, name string) (
 		Timeout: deliveryStreamEncryptionEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*firehose.DeliveryStreamEncryptionConfiguration); ok {
 		if status, failureDescription := aws.StringValue(output.Status), output.FailureDescription; status == firehose.DeliveryStreamEncryptionStatusEnablingFailed && failureDescription != nil {
@@ -89,7 +89,7 @@ func waitDeliveryStreamEncryptionDisabled(conn *firehose.Firehose, name string)
 		Timeout: deliveryStreamEncryptionDisabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*firehose.DeliveryStreamEncryptionConfiguration); ok {
 		if status, failureDescription := aws.StringValue(output.Status), output.FailureDescription; status == firehose.DeliveryStreamEncryptionStatusDisablingFailed && failureDescription != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -37,7 +38,7 @@ func ResourceAdminAccount() *schema.Resource {
 }
 
 func resourceAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 
 	// Ensure there is not an existing FMS Admin Account
 	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
@@ -67,7 +68,7 @@ func resourceAdminAccountCreate(d *schema.ResourceData, meta interface{}) error
 		Delay:   10 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := tfresource.WaitForState(stateConf); err != nil {
 		return fmt.Errorf("error waiting for FMS Admin Account (%s) association: %w", accountID, err)
 	}
 
@@ -111,7 +112,7 @@ func associateFmsAdminAccountRefreshFunc(conn *fms.FMS, accountId string) resour
 }
 
 func resourceAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 
 	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
 
@@ -141,7 +142,7 @@ func resourceAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 
 	_, err := conn.DisassociateAdminAccount(&fms.DisassociateAdminAccountInput{})
 
@@ -181,7 +182,7 @@ func waitForFmsAdminAccountDeletion(conn *fms.FMS) error {
 		Delay:   10 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -24,7 +24,7 @@ func waitAdministrativeActionCompleted(conn *fsx.FSx, fsID, actionType string, t
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*fsx.AdministrativeAction); ok {
 		if status, details := aws.StringValue(output.Status), output.FailureDetails; status == fsx.StatusFailed && details != nil {
@@ -45,7 +45,7 @@ func waitBackupAvailable(conn *fsx.FSx, id string) (*fsx.Backup, error) {
 		Timeout: backupAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*fsx.Backup); ok {
 		return output, err
@@ -62,7 +62,7 @@ func waitBackupDeleted(conn *fsx.FSx, id string) (*fsx.Backup, error) {
 		Timeout: backupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*fsx.Backup); ok {
 		return output, err
@@ -80,7 +80,7 @@ func waitFileSystemCreated(conn *fsx.FSx, id string, timeout time.Duration) (*fs
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileSystem); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileSystemLifecycleFailed && details != nil {
@@ -102,7 +102,7 @@ func waitFileSystemUpdated(conn *fsx.FSx, id string, timeout time.Duration) (*fs
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileSystem); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileSystemLifecycleFailed && details != nil {
@@ -124,7 +124,7 @@ func waitFileSystemDeleted(conn *fsx.FSx, id string, timeout time.Duration) (*fs
 		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*fsx.FileSystem); ok {
 		if status, details := aws.StringValue(output.Lifecycle), output.FailureDetails; status == fsx.FileSystemLifecycleFailed && details != nil {
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 func TestAccGameLiftAlias_basic(t *testing.T) {
 	var conf gamelift.Alias
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	resourceName := "aws_gamelift_alias.test"
 
 	aliasName := fmt.Sprintf("tf_acc_alias_%s", rString)
@@ -79,7 +78,7 @@ func TestAccGameLiftAlias_tags(t *testing.T) {
 	var conf gamelift.Alias
 
 	resourceName := "aws_gamelift_alias.test"
-	aliasName := sdkacctest.RandomWithPrefix("tf-acc-alias")
+	aliasName := acctest.RandomWithPrefix(t, "tf-acc-alias")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -128,7 +127,7 @@ func TestAccGameLiftAlias_tags(t *testing.T) {
 func TestAccGameLiftAlias_fleetRouting(t *testing.T) {
 	var conf gamelift.Alias
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 
 	aliasName := fmt.Sprintf("tf_acc_alias_%s", rString)
 	description := fmt.Sprintf("tf test description %s", rString)
@@ -190,7 +189,7 @@ func TestAccGameLiftAlias_fleetRouting(t *testing.T) {
 func TestAccGameLiftAlias_disappears(t *testing.T) {
 	var conf gamelift.Alias
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	resourceName := "aws_gamelift_alias.test"
 
 	aliasName := fmt.Sprintf("tf_acc_alias_%s", rString)
@@ -221,7 +220,7 @@ func TestAccGameLiftAlias_disappears(t *testing.T) {
 
 func testAccCheckAliasDisappears(res *gamelift.Alias) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		input := &gamelift.DeleteAliasInput{AliasId: res.AliasId}
 
@@ -242,7 +241,7 @@ func testAccCheckAliasExists(n string, res *gamelift.Alias) resource.TestCheckFu
 			return fmt.Errorf("No Gamelift Alias ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		out, err := conn.DescribeAlias(&gamelift.DescribeAliasInput{
 			AliasId: aws.String(rs.Primary.ID),
@@ -263,7 +262,7 @@ func testAccCheckAliasExists(n string, res *gamelift.Alias) resource.TestCheckFu
 }
 
 func testAccCheckAliasDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_alias" {
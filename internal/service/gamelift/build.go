@@ -81,7 +81,7 @@ func ResourceBuild() *schema.Resource {
 }
 
 func resourceBuildCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GameLiftConn
+	conn := meta.(*conns.AWSClient).GameLiftConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -132,7 +132,7 @@ func resourceBuildCreate(d *schema.ResourceData, meta interface{}) error {
 			return out, *out.Build.Status, nil
 		},
 	}
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(&stateConf)
 	if err != nil {
 		return err
 	}
@@ -141,7 +141,7 @@ func resourceBuildCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBuildRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GameLiftConn
+	conn := meta.(*conns.AWSClient).GameLiftConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -186,7 +186,7 @@ func resourceBuildRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBuildUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GameLiftConn
+	conn := meta.(*conns.AWSClient).GameLiftConn()
 
 	log.Printf("[INFO] Updating Gamelift Build: %s", d.Id())
 	input := gamelift.UpdateBuildInput{
@@ -215,7 +215,7 @@ func resourceBuildUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBuildDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GameLiftConn
+	conn := meta.(*conns.AWSClient).GameLiftConn()
 
 	log.Printf("[INFO] Deleting Gamelift Build: %s", d.Id())
 	_, err := conn.DeleteBuild(&gamelift.DeleteBuildInput{
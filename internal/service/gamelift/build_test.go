@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +20,7 @@ const testAccGameliftBuildPrefix = "tf_acc_build_"
 func TestAccGameLiftBuild_basic(t *testing.T) {
 	var conf gamelift.Build
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	resourceName := "aws_gamelift_build.test"
 
 	buildName := fmt.Sprintf("%s_%s", testAccGameliftBuildPrefix, rString)
@@ -88,7 +87,7 @@ func TestAccGameLiftBuild_basic(t *testing.T) {
 func TestAccGameLiftBuild_tags(t *testing.T) {
 	var conf gamelift.Build
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	resourceName := "aws_gamelift_build.test"
 
 	buildName := fmt.Sprintf("%s_%s", testAccGameliftBuildPrefix, rString)
@@ -150,7 +149,7 @@ func TestAccGameLiftBuild_tags(t *testing.T) {
 func TestAccGameLiftBuild_disappears(t *testing.T) {
 	var conf gamelift.Build
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	resourceName := "aws_gamelift_build.test"
 
 	buildName := fmt.Sprintf("%s_%s", testAccGameliftBuildPrefix, rString)
@@ -204,7 +203,7 @@ func testAccCheckBuildExists(n string, res *gamelift.Build) resource.TestCheckFu
 			return fmt.Errorf("No Gamelift Build ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		req := &gamelift.DescribeBuildInput{
 			BuildId: aws.String(rs.Primary.ID),
@@ -228,7 +227,7 @@ func testAccCheckBuildExists(n string, res *gamelift.Build) resource.TestCheckFu
 
 func testAccCheckBuildDisappears(res *gamelift.Build) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		input := &gamelift.DeleteBuildInput{BuildId: res.BuildId}
 
@@ -238,7 +237,7 @@ func testAccCheckBuildDisappears(res *gamelift.Build) resource.TestCheckFunc {
 }
 
 func testAccCheckBuildDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_build" {
@@ -265,7 +264,7 @@ func testAccCheckBuildDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 	input := &gamelift.ListBuildsInput{}
 
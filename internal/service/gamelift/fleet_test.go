@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -154,11 +153,11 @@ func TestDiffGameliftPortSettings(t *testing.T) {
 func TestAccGameLiftFleet_basic(t *testing.T) {
 	var conf gamelift.FleetAttributes
 
-	fleetName := sdkacctest.RandomWithPrefix("tf-acc-fleet")
-	uFleetName := sdkacctest.RandomWithPrefix("tf-acc-fleet-upd")
-	buildName := sdkacctest.RandomWithPrefix("tf-acc-build")
+	fleetName := acctest.RandomWithPrefix(t, "tf-acc-fleet")
+	uFleetName := acctest.RandomWithPrefix(t, "tf-acc-fleet-upd")
+	buildName := acctest.RandomWithPrefix(t, "tf-acc-build")
 
-	desc := fmt.Sprintf("Updated description %s", sdkacctest.RandString(8))
+	desc := fmt.Sprintf("Updated description %s", acctest.RandString(t, 8))
 
 	region := acctest.Region()
 	g, err := testAccSampleGame(region)
@@ -239,8 +238,8 @@ func TestAccGameLiftFleet_basic(t *testing.T) {
 func TestAccGameLiftFleet_tags(t *testing.T) {
 	var conf gamelift.FleetAttributes
 
-	fleetName := sdkacctest.RandomWithPrefix("tf-acc-fleet")
-	buildName := sdkacctest.RandomWithPrefix("tf-acc-build")
+	fleetName := acctest.RandomWithPrefix(t, "tf-acc-fleet")
+	buildName := acctest.RandomWithPrefix(t, "tf-acc-build")
 
 	region := acctest.Region()
 	g, err := testAccSampleGame(region)
@@ -304,10 +303,10 @@ func TestAccGameLiftFleet_tags(t *testing.T) {
 func TestAccGameLiftFleet_allFields(t *testing.T) {
 	var conf gamelift.FleetAttributes
 
-	fleetName := sdkacctest.RandomWithPrefix("tf-acc-fleet")
-	buildName := sdkacctest.RandomWithPrefix("tf-acc-build")
+	fleetName := acctest.RandomWithPrefix(t, "tf-acc-fleet")
+	buildName := acctest.RandomWithPrefix(t, "tf-acc-build")
 
-	desc := fmt.Sprintf("Terraform Acceptance Test %s", sdkacctest.RandString(8))
+	desc := fmt.Sprintf("Terraform Acceptance Test %s", acctest.RandString(t, 8))
 
 	region := acctest.Region()
 	g, err := testAccSampleGame(region)
@@ -427,8 +426,8 @@ func TestAccGameLiftFleet_allFields(t *testing.T) {
 func TestAccGameLiftFleet_disappears(t *testing.T) {
 	var conf gamelift.FleetAttributes
 
-	fleetName := sdkacctest.RandomWithPrefix("tf-acc-fleet")
-	buildName := sdkacctest.RandomWithPrefix("tf-acc-build")
+	fleetName := acctest.RandomWithPrefix(t, "tf-acc-fleet")
+	buildName := acctest.RandomWithPrefix(t, "tf-acc-build")
 
 	region := acctest.Region()
 	g, err := testAccSampleGame(region)
@@ -483,7 +482,7 @@ func testAccCheckFleetExists(n string, res *gamelift.FleetAttributes) resource.T
 			return fmt.Errorf("No Gamelift Fleet ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		out, err := conn.DescribeFleetAttributes(&gamelift.DescribeFleetAttributesInput{
 			FleetIds: aws.StringSlice([]string{rs.Primary.ID}),
@@ -513,7 +512,7 @@ func testAccCheckFleetExists(n string, res *gamelift.FleetAttributes) resource.T
 
 func testAccCheckFleetDisappears(res *gamelift.FleetAttributes) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 		input := &gamelift.DeleteFleetInput{FleetId: res.FleetId}
 		err := resource.Retry(60*time.Minute, func() *resource.RetryError {
@@ -539,7 +538,7 @@ func testAccCheckFleetDisappears(res *gamelift.FleetAttributes) resource.TestChe
 }
 
 func testAccCheckFleetDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_gamelift_fleet" {
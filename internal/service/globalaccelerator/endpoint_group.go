@@ -147,7 +147,7 @@ func ResourceEndpointGroup() *schema.Resource {
 }
 
 func resourceEndpointGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
 	region := meta.(*conns.AWSClient).Region
 
 	opts := &globalaccelerator.CreateEndpointGroupInput{
@@ -215,7 +215,7 @@ func resourceEndpointGroupCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceEndpointGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
 
 	endpointGroup, err := FindEndpointGroupByARN(conn, d.Id())
 
@@ -255,7 +255,7 @@ func resourceEndpointGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEndpointGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
 
 	opts := &globalaccelerator.UpdateEndpointGroupInput{
 		EndpointGroupArn: aws.String(d.Id()),
@@ -319,7 +319,7 @@ func resourceEndpointGroupUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceEndpointGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
 
 	input := &globalaccelerator.DeleteEndpointGroupInput{
 		EndpointGroupArn: aws.String(d.Id()),
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // waitAcceleratorDeployed waits for an Accelerator to return Deployed
@@ -16,7 +17,7 @@ func waitAcceleratorDeployed(conn *globalaccelerator.GlobalAccelerator, arn stri
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*globalaccelerator.Accelerator); ok {
 		return v, err
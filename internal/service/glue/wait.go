@@ -30,7 +30,7 @@ func waitMLTransformDeleted(conn *glue.Glue, transformId string) (*glue.GetMLTra
 		Timeout: mlTransformDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.GetMLTransformOutput); ok {
 		return output, err
@@ -48,7 +48,7 @@ func waitRegistryDeleted(conn *glue.Glue, registryID string) (*glue.GetRegistryO
 		Timeout: registryDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.GetRegistryOutput); ok {
 		return output, err
@@ -66,7 +66,7 @@ func waitSchemaAvailable(conn *glue.Glue, registryID string) (*glue.GetSchemaOut
 		Timeout: schemaAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaOutput); ok {
 		return output, err
@@ -84,7 +84,7 @@ func waitSchemaDeleted(conn *glue.Glue, registryID string) (*glue.GetSchemaOutpu
 		Timeout: schemaDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaOutput); ok {
 		return output, err
@@ -102,7 +102,7 @@ func waitSchemaVersionAvailable(conn *glue.Glue, registryID string) (*glue.GetSc
 		Timeout: schemaVersionAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.GetSchemaVersionOutput); ok {
 		return output, err
@@ -127,7 +127,7 @@ func waitTriggerCreated(conn *glue.Glue, triggerName string) (*glue.GetTriggerOu
 		Timeout: triggerCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
 		return output, err
@@ -145,7 +145,7 @@ func waitTriggerDeleted(conn *glue.Glue, triggerName string) (*glue.GetTriggerOu
 		Timeout: triggerDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.GetTriggerOutput); ok {
 		return output, err
@@ -162,7 +162,7 @@ func waitGlueDevEndpointCreated(conn *glue.Glue, name string) (*glue.DevEndpoint
 		Timeout: 15 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.DevEndpoint); ok {
 		if status := aws.StringValue(output.Status); status == devEndpointStatusFailed {
@@ -183,7 +183,7 @@ func waitGlueDevEndpointDeleted(conn *glue.Glue, name string) (*glue.DevEndpoint
 		Timeout: 15 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.DevEndpoint); ok {
 		if status := aws.StringValue(output.Status); status == devEndpointStatusFailed {
@@ -204,7 +204,7 @@ func waitGluePartitionIndexCreated(conn *glue.Glue, id string) (*glue.PartitionI
 		Timeout: 2 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.PartitionIndexDescriptor); ok {
 		return output, err
@@ -221,7 +221,7 @@ func waitGluePartitionIndexDeleted(conn *glue.Glue, id string) (*glue.PartitionI
 		Timeout: 2 * time.Minute,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*glue.PartitionIndexDescriptor); ok {
 		return output, err
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,11 +16,11 @@ import (
 )
 
 func testAccIPSet_basic(t *testing.T) {
-	bucketName := fmt.Sprintf("tf-test-%s", sdkacctest.RandString(5))
-	keyName1 := fmt.Sprintf("tf-%s", sdkacctest.RandString(5))
-	keyName2 := fmt.Sprintf("tf-%s", sdkacctest.RandString(5))
-	ipsetName1 := fmt.Sprintf("tf-%s", sdkacctest.RandString(5))
-	ipsetName2 := fmt.Sprintf("tf-%s", sdkacctest.RandString(5))
+	bucketName := fmt.Sprintf("tf-test-%s", acctest.RandString(t, 5))
+	keyName1 := fmt.Sprintf("tf-%s", acctest.RandString(t, 5))
+	keyName2 := fmt.Sprintf("tf-%s", acctest.RandString(t, 5))
+	ipsetName1 := fmt.Sprintf("tf-%s", acctest.RandString(t, 5))
+	ipsetName2 := fmt.Sprintf("tf-%s", acctest.RandString(t, 5))
 	resourceName := "aws_guardduty_ipset.test"
 
 	resource.Test(t, resource.TestCase{
@@ -60,7 +59,7 @@ func testAccIPSet_basic(t *testing.T) {
 }
 
 func testAccIPSet_tags(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_guardduty_ipset.test"
 
 	resource.Test(t, resource.TestCase{
@@ -104,7 +103,7 @@ func testAccIPSet_tags(t *testing.T) {
 }
 
 func testAccCheckIPSetDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_ipset" {
@@ -155,7 +154,7 @@ func testAccCheckIPSetExists(name string) resource.TestCheckFunc {
 			IpSetId:    aws.String(ipSetId),
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn()
 		_, err = conn.GetIPSet(input)
 		return err
 	}
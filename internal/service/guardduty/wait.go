@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -34,7 +35,7 @@ func waitAdminAccountEnabled(conn *guardduty.GuardDuty, adminAccountID string) (
 		Timeout: adminAccountEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*guardduty.AdminAccount); ok {
 		return output, err
@@ -52,7 +53,7 @@ func waitAdminAccountNotFound(conn *guardduty.GuardDuty, adminAccountID string)
 		Timeout: adminAccountNotFoundTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*guardduty.AdminAccount); ok {
 		return output, err
@@ -70,7 +71,7 @@ func waitPublishingDestinationCreated(conn *guardduty.GuardDuty, destinationID,
 		Timeout: publishingDestinationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*guardduty.CreatePublishingDestinationOutput); ok {
 		return v, err
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/readcache"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -44,6 +45,36 @@ func FindGroupAttachedPolicy(conn *iam.IAM, groupName string, policyARN string)
 	return result, nil
 }
 
+// FindRoleAttachedPolicies returns the AttachedPolicies of the specified role.
+// The result may be served from the provider read cache.
+func FindRoleAttachedPolicies(conn *iam.IAM, roleName string) ([]*iam.AttachedPolicy, error) {
+	input := &iam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(roleName),
+	}
+
+	outputRaw, err := readcache.Do(conn.Client, "ListAttachedRolePolicies", input, readcache.Parent{Field: "RoleName", Value: roleName}, func() (interface{}, error) {
+		output := &iam.ListAttachedRolePoliciesOutput{}
+
+		err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			output.AttachedPolicies = append(output.AttachedPolicies, page.AttachedPolicies...)
+
+			return !lastPage
+		})
+
+		return output, err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*iam.ListAttachedRolePoliciesOutput).AttachedPolicies, nil
+}
+
 // FindUserAttachedPolicy returns the AttachedPolicy corresponding to the specified user and policy ARN.
 func FindUserAttachedPolicy(conn *iam.IAM, userName string, policyARN string) (*iam.AttachedPolicy, error) {
 	input := &iam.ListAttachedUserPoliciesInput{
@@ -5,13 +5,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccIAMGroupDataSource_basic(t *testing.T) {
-	groupName := fmt.Sprintf("test-datasource-user-%d", sdkacctest.RandInt())
+	groupName := fmt.Sprintf("test-datasource-user-%d", acctest.RandInt(t))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -32,9 +31,9 @@ func TestAccIAMGroupDataSource_basic(t *testing.T) {
 }
 
 func TestAccIAMGroupDataSource_users(t *testing.T) {
-	groupName := fmt.Sprintf("test-datasource-group-%d", sdkacctest.RandInt())
-	userName := fmt.Sprintf("test-datasource-user-%d", sdkacctest.RandInt())
-	groupMemberShipName := fmt.Sprintf("test-datasource-group-membership-%d", sdkacctest.RandInt())
+	groupName := fmt.Sprintf("test-datasource-group-%d", acctest.RandInt(t))
+	userName := fmt.Sprintf("test-datasource-user-%d", acctest.RandInt(t))
+	groupMemberShipName := fmt.Sprintf("test-datasource-group-membership-%d", acctest.RandInt(t))
 	userCount := 101
 
 	resource.ParallelTest(t, resource.TestCase{
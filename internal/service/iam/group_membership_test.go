@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func TestAccIAMGroupMembership_basic(t *testing.T) {
 	var group iam.GetGroupOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	groupName := fmt.Sprintf("tf-acc-group-gm-basic-%s", rString)
 	userName := fmt.Sprintf("tf-acc-user-gm-basic-%s", rString)
 	userName2 := fmt.Sprintf("tf-acc-user-gm-basic-two-%s", rString)
@@ -61,7 +60,7 @@ func TestAccIAMGroupMembership_basic(t *testing.T) {
 func TestAccIAMGroupMembership_paginatedUserList(t *testing.T) {
 	var group iam.GetGroupOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	groupName := fmt.Sprintf("tf-acc-group-gm-pul-%s", rString)
 	membershipName := fmt.Sprintf("tf-acc-membership-gm-pul-%s", rString)
 	userNamePrefix := fmt.Sprintf("tf-acc-user-gm-pul-%s-", rString)
@@ -85,7 +84,7 @@ func TestAccIAMGroupMembership_paginatedUserList(t *testing.T) {
 }
 
 func testAccCheckGroupMembershipDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_group_membership" {
@@ -122,7 +121,7 @@ func testAccCheckGroupMembershipExists(n string, g *iam.GetGroupOutput) resource
 			return fmt.Errorf("No User name is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 		gn := rs.Primary.Attributes["group"]
 
 		resp, err := conn.GetGroup(&iam.GetGroupInput{
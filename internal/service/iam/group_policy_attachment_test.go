@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 func TestAccIAMGroupPolicyAttachment_basic(t *testing.T) {
 	var out iam.ListAttachedGroupPoliciesOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	groupName := fmt.Sprintf("tf-acc-group-gpa-basic-%s", rString)
 	policyName := fmt.Sprintf("tf-acc-policy-gpa-basic-%s", rString)
 	policyName2 := fmt.Sprintf("tf-acc-policy-gpa-basic-2-%s", rString)
@@ -80,7 +79,7 @@ func testAccCheckGroupPolicyAttachmentExists(n string, c int, out *iam.ListAttac
 			return fmt.Errorf("No policy name is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 		group := rs.Primary.Attributes["group"]
 
 		attachedPolicies, err := conn.ListAttachedGroupPolicies(&iam.ListAttachedGroupPoliciesInput{
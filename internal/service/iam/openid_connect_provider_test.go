@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 )
 
 func TestAccIAMOpenidConnectProvider_OpenID_basic(t *testing.T) {
-	rString := sdkacctest.RandString(5)
+	rString := acctest.RandString(t, 5)
 	url := "accounts.testle.com/" + rString
 	resourceName := "aws_iam_openid_connect_provider.test"
 
@@ -63,7 +62,7 @@ func TestAccIAMOpenidConnectProvider_OpenID_basic(t *testing.T) {
 }
 
 func TestAccIAMOpenidConnectProvider_OpenID_tags(t *testing.T) {
-	rString := sdkacctest.RandString(5)
+	rString := acctest.RandString(t, 5)
 	resourceName := "aws_iam_openid_connect_provider.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -108,7 +107,7 @@ func TestAccIAMOpenidConnectProvider_OpenID_tags(t *testing.T) {
 }
 
 func TestAccIAMOpenidConnectProvider_OpenID_disappears(t *testing.T) {
-	rString := sdkacctest.RandString(5)
+	rString := acctest.RandString(t, 5)
 	resourceName := "aws_iam_openid_connect_provider.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -130,7 +129,7 @@ func TestAccIAMOpenidConnectProvider_OpenID_disappears(t *testing.T) {
 }
 
 func testAccCheckIAMOpenIDConnectProviderDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_openid_connect_provider" {
@@ -168,7 +167,7 @@ func testAccCheckIAMOpenIDConnectProvider(id string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 		_, err := conn.GetOpenIDConnectProvider(&iam.GetOpenIDConnectProviderInput{
 			OpenIDConnectProviderArn: aws.String(rs.Primary.ID),
 		})
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
@@ -83,7 +82,7 @@ func TestPolicySearchDetails(t *testing.T) {
 func TestAccIAMPolicyDataSource_arn(t *testing.T) {
 	datasourceName := "data.aws_iam_policy.test"
 	resourceName := "aws_iam_policy.test"
-	policyName := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
+	policyName := fmt.Sprintf("test-policy-%s", acctest.RandString(t, 10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -109,7 +108,7 @@ func TestAccIAMPolicyDataSource_arn(t *testing.T) {
 func TestAccIAMPolicyDataSource_name(t *testing.T) {
 	datasourceName := "data.aws_iam_policy.test"
 	resourceName := "aws_iam_policy.test"
-	policyName := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
+	policyName := fmt.Sprintf("test-policy-%s", acctest.RandString(t, 10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -136,7 +135,7 @@ func TestAccIAMPolicyDataSource_nameAndPathPrefix(t *testing.T) {
 	datasourceName := "data.aws_iam_policy.test"
 	resourceName := "aws_iam_policy.test"
 
-	policyName := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
+	policyName := fmt.Sprintf("test-policy-%s", acctest.RandString(t, 10))
 	policyPath := "/test-path/"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -161,7 +160,7 @@ func TestAccIAMPolicyDataSource_nameAndPathPrefix(t *testing.T) {
 }
 
 func TestAccIAMPolicyDataSource_nonExistent(t *testing.T) {
-	policyName := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
+	policyName := fmt.Sprintf("test-policy-%s", acctest.RandString(t, 10))
 	policyPath := "/test-path/"
 
 	resource.ParallelTest(t, resource.TestCase{
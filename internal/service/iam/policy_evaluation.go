@@ -0,0 +1,844 @@
+package iam
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// Policy evaluation decisions, named as by the IAM policy simulator.
+const (
+	PolicyEvaluationDecisionAllowed      = "allowed"
+	PolicyEvaluationDecisionExplicitDeny = "explicitDeny"
+	PolicyEvaluationDecisionImplicitDeny = "implicitDeny"
+)
+
+const (
+	policyEffectAllow = "Allow"
+	policyEffectDeny  = "Deny"
+
+	policyVersion2012 = "2012-10-17"
+)
+
+// PolicyEvaluationPolicies are the policies in effect for a request.
+type PolicyEvaluationPolicies struct {
+	IdentityPolicies    []*IAMPolicyDoc
+	ResourcePolicy      *IAMPolicyDoc
+	PermissionsBoundary *IAMPolicyDoc
+	// ServiceControlPolicies are the SCPs in effect at each level of the organization hierarchy,
+	// each of which must allow the request.
+	ServiceControlPolicies []*IAMPolicyDoc
+}
+
+// PolicyEvaluationRequest is a request to evaluate against policies.
+type PolicyEvaluationRequest struct {
+	Action   string
+	Resource string
+	// PrincipalType and Principal identify the requester to the Principal and NotPrincipal elements
+	// of the resource-based policy, e.g. "AWS" and an IAM role ARN.
+	PrincipalType string
+	Principal     string
+	// Context maps the condition keys of the request to their values.
+	Context map[string][]string
+}
+
+// PolicyEvaluationResult is the outcome of evaluating a request.
+type PolicyEvaluationResult struct {
+	Decision string
+	// MatchedStatements identifies the statements matching the request, e.g. "identity_policies[0].AllowRead",
+	// or "identity_policies[0].Statement[1]" for statements without Sid.
+	MatchedStatements []string
+	// Reason explains the decision.
+	Reason string
+}
+
+// EvaluatePolicies evaluates the request against the policies following the IAM policy evaluation logic
+// for requests within a single account:
+//
+//   - An explicit Deny in any policy denies the request.
+//   - Each service control policy must allow the request.
+//   - An Allow in the resource-based policy allows the request.
+//   - Otherwise, an identity-based policy and the permissions boundary, if any, must allow the request.
+//
+// Requests not allowed by these rules are implicitly denied.
+func EvaluatePolicies(policies *PolicyEvaluationPolicies, request *PolicyEvaluationRequest) (*PolicyEvaluationResult, error) {
+	var identity, scps []*policyEvaluationSource
+	var resource, boundary *policyEvaluationSource
+
+	for i, doc := range policies.ServiceControlPolicies {
+		scps = append(scps, &policyEvaluationSource{Name: fmt.Sprintf("service_control_policies[%d]", i), Doc: doc})
+	}
+	if policies.ResourcePolicy != nil {
+		resource = &policyEvaluationSource{Name: "resource_policy", Doc: policies.ResourcePolicy, ResourceBased: true}
+	}
+	for i, doc := range policies.IdentityPolicies {
+		identity = append(identity, &policyEvaluationSource{Name: fmt.Sprintf("identity_policies[%d]", i), Doc: doc})
+	}
+	if policies.PermissionsBoundary != nil {
+		boundary = &policyEvaluationSource{Name: "permissions_boundary", Doc: policies.PermissionsBoundary}
+	}
+
+	var sources []*policyEvaluationSource
+	sources = append(sources, scps...)
+	if resource != nil {
+		sources = append(sources, resource)
+	}
+	sources = append(sources, identity...)
+	if boundary != nil {
+		sources = append(sources, boundary)
+	}
+
+	e := newPolicyEvaluationContext(request)
+	result := &PolicyEvaluationResult{}
+
+	for _, source := range sources {
+		if err := source.evaluate(e); err != nil {
+			return nil, err
+		}
+
+		result.MatchedStatements = append(result.MatchedStatements, source.Matches...)
+	}
+
+	for _, source := range sources {
+		if len(source.Denies) > 0 {
+			result.Decision = PolicyEvaluationDecisionExplicitDeny
+			result.Reason = fmt.Sprintf("explicitly denied by %s", strings.Join(source.Denies, ", "))
+
+			return result, nil
+		}
+	}
+
+	result.Decision = PolicyEvaluationDecisionImplicitDeny
+
+	for _, source := range scps {
+		if len(source.Allows) == 0 {
+			result.Reason = fmt.Sprintf("%s does not allow the request", source.Name)
+
+			return result, nil
+		}
+	}
+
+	if resource != nil && len(resource.Allows) > 0 {
+		result.Decision = PolicyEvaluationDecisionAllowed
+		result.Reason = "allowed by the resource-based policy"
+
+		return result, nil
+	}
+
+	allowed := false
+
+	for _, source := range identity {
+		if len(source.Allows) > 0 {
+			allowed = true
+			break
+		}
+	}
+
+	if !allowed {
+		result.Reason = "no identity-based or resource-based policy allows the request"
+
+		return result, nil
+	}
+
+	if boundary != nil && len(boundary.Allows) == 0 {
+		result.Reason = "the permissions boundary does not allow the request"
+
+		return result, nil
+	}
+
+	result.Decision = PolicyEvaluationDecisionAllowed
+	result.Reason = "allowed by an identity-based policy"
+
+	return result, nil
+}
+
+// policyEvaluationSource is a policy evaluated for a request and its statements matching the request.
+type policyEvaluationSource struct {
+	Name          string
+	Doc           *IAMPolicyDoc
+	ResourceBased bool
+
+	Matches []string
+	Allows  []string
+	Denies  []string
+}
+
+func (s *policyEvaluationSource) evaluate(e *policyEvaluationContext) error {
+	if s.Doc == nil {
+		return nil
+	}
+
+	for i, statement := range s.Doc.Statements {
+		id := statement.Sid
+
+		if id == "" {
+			id = fmt.Sprintf("Statement[%d]", i)
+		}
+
+		id = fmt.Sprintf("%s.%s", s.Name, id)
+
+		matches, err := e.statementMatches(statement, s.Doc.Version, s.ResourceBased)
+
+		if err != nil {
+			return fmt.Errorf("error evaluating %s: %w", id, err)
+		}
+
+		if !matches {
+			continue
+		}
+
+		s.Matches = append(s.Matches, id)
+
+		switch statement.Effect {
+		case policyEffectAllow:
+			s.Allows = append(s.Allows, id)
+		case policyEffectDeny:
+			s.Denies = append(s.Denies, id)
+		default:
+			return fmt.Errorf("error evaluating %s: unsupported Effect (%s)", id, statement.Effect)
+		}
+	}
+
+	return nil
+}
+
+// policyEvaluationContext is the request being evaluated, with its condition keys in lower case.
+type policyEvaluationContext struct {
+	request *PolicyEvaluationRequest
+	keys    map[string][]string
+}
+
+func newPolicyEvaluationContext(request *PolicyEvaluationRequest) *policyEvaluationContext {
+	keys := make(map[string][]string, len(request.Context))
+
+	for k, v := range request.Context {
+		keys[strings.ToLower(k)] = append(keys[strings.ToLower(k)], v...)
+	}
+
+	return &policyEvaluationContext{
+		request: request,
+		keys:    keys,
+	}
+}
+
+func (e *policyEvaluationContext) statementMatches(statement *IAMPolicyStatement, version string, resourceBased bool) (bool, error) {
+	if statement.Effect != policyEffectAllow && statement.Effect != policyEffectDeny {
+		return false, fmt.Errorf("unsupported Effect (%s)", statement.Effect)
+	}
+
+	// Action and NotAction.
+	switch {
+	case statement.Actions != nil:
+		matches, err := e.anyActionMatches(statement.Actions)
+
+		if err != nil || !matches {
+			return false, err
+		}
+	case statement.NotActions != nil:
+		matches, err := e.anyActionMatches(statement.NotActions)
+
+		if err != nil || matches {
+			return false, err
+		}
+	default:
+		return false, fmt.Errorf("statement has neither Action nor NotAction")
+	}
+
+	// Resource and NotResource. Either may be omitted, e.g. in role trust policies.
+	switch {
+	case statement.Resources != nil:
+		matches, err := e.anyResourceMatches(statement.Resources, version)
+
+		if err != nil || !matches {
+			return false, err
+		}
+	case statement.NotResources != nil:
+		matches, err := e.anyResourceMatches(statement.NotResources, version)
+
+		if err != nil || matches {
+			return false, err
+		}
+	}
+
+	// Principal and NotPrincipal only apply to resource-based policies.
+	if resourceBased {
+		switch {
+		case statement.Principals != nil:
+			matches, err := e.anyPrincipalMatches(statement.Principals)
+
+			if err != nil || !matches {
+				return false, err
+			}
+		case statement.NotPrincipals != nil:
+			matches, err := e.anyPrincipalMatches(statement.NotPrincipals)
+
+			if err != nil || matches {
+				return false, err
+			}
+		default:
+			return false, fmt.Errorf("resource-based policy statement has neither Principal nor NotPrincipal")
+		}
+	}
+
+	// All conditions must be met.
+	for _, condition := range statement.Conditions {
+		matches, err := e.conditionMatches(condition, version)
+
+		if err != nil {
+			return false, fmt.Errorf("error evaluating condition %s %s: %w", condition.Test, condition.Variable, err)
+		}
+
+		if !matches {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (e *policyEvaluationContext) anyActionMatches(v interface{}) (bool, error) {
+	patterns, err := policyStringList(v)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, pattern := range patterns {
+		// Service prefixes and action names are case insensitive.
+		if policyWildcardMatch(pattern, e.request.Action, true) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (e *policyEvaluationContext) anyResourceMatches(v interface{}, version string) (bool, error) {
+	patterns, err := policyStringList(v)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, pattern := range patterns {
+		if re, ok := e.wildcardRegexp(pattern, version, false); ok && re.MatchString(e.request.Resource) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (e *policyEvaluationContext) anyPrincipalMatches(principals IAMPolicyStatementPrincipalSet) (bool, error) {
+	for _, principal := range principals {
+		identifiers, err := policyStringList(principal.Identifiers)
+
+		if err != nil {
+			return false, err
+		}
+
+		if principal.Type == "*" {
+			return true, nil
+		}
+
+		if principal.Type != e.request.PrincipalType {
+			continue
+		}
+
+		for _, identifier := range identifiers {
+			if identifier == "*" || identifier == e.request.Principal {
+				return true, nil
+			}
+
+			// An account ID or account root ARN matches all principals of the account.
+			if principal.Type == "AWS" && policyPrincipalAccountID(identifier) != "" && policyPrincipalAccountID(identifier) == policyPrincipalAccountID(e.request.Principal) {
+				if !arn.IsARN(identifier) || strings.HasSuffix(identifier, ":root") {
+					return true, nil
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// conditionMatches returns whether a condition is met by the request.
+// Multiple values of the condition are ORed.
+func (e *policyEvaluationContext) conditionMatches(condition IAMPolicyStatementCondition, version string) (bool, error) {
+	policyValues, err := policyStringList(condition.Values)
+
+	if err != nil {
+		return false, err
+	}
+
+	requestValues, ok := e.keys[strings.ToLower(condition.Variable)]
+	ok = ok && len(requestValues) > 0
+
+	operator := condition.Test
+	setOperator := ""
+
+	for _, prefix := range []string{"ForAllValues:", "ForAnyValue:"} {
+		if strings.HasPrefix(operator, prefix) {
+			setOperator = prefix
+			operator = strings.TrimPrefix(operator, prefix)
+		}
+	}
+
+	if operator == "Null" {
+		for _, policyValue := range policyValues {
+			switch strings.ToLower(policyValue) {
+			case "true":
+				if !ok {
+					return true, nil
+				}
+			case "false":
+				if ok {
+					return true, nil
+				}
+			default:
+				return false, fmt.Errorf("invalid Null value (%s)", policyValue)
+			}
+		}
+
+		return false, nil
+	}
+
+	ifExists := strings.HasSuffix(operator, "IfExists")
+	operator = strings.TrimSuffix(operator, "IfExists")
+
+	conditionOperator, found := policyConditionOperators[operator]
+
+	if !found {
+		return false, fmt.Errorf("unsupported condition operator (%s)", condition.Test)
+	}
+
+	if !ok {
+		switch {
+		case ifExists:
+			return true, nil
+		case setOperator == "ForAllValues:":
+			return true, nil
+		case setOperator == "ForAnyValue:":
+			return false, nil
+		default:
+			// Negated operators are met by a missing key.
+			return conditionOperator.negated, nil
+		}
+	}
+
+	// valueMatches returns whether the request value matches any of the policy values.
+	valueMatches := func(requestValue string) (bool, error) {
+		for _, policyValue := range policyValues {
+			matches, err := conditionOperator.compare(e, policyValue, requestValue, version)
+
+			if err != nil || matches {
+				return matches, err
+			}
+		}
+
+		return false, nil
+	}
+
+	switch setOperator {
+	case "ForAllValues:":
+		for _, requestValue := range requestValues {
+			matches, err := valueMatches(requestValue)
+
+			if err != nil {
+				return false, err
+			}
+
+			if matches == conditionOperator.negated {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	case "ForAnyValue:":
+		for _, requestValue := range requestValues {
+			matches, err := valueMatches(requestValue)
+
+			if err != nil {
+				return false, err
+			}
+
+			if matches != conditionOperator.negated {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	default:
+		for _, requestValue := range requestValues {
+			matches, err := valueMatches(requestValue)
+
+			if err != nil {
+				return false, err
+			}
+
+			if matches {
+				return !conditionOperator.negated, nil
+			}
+		}
+
+		return conditionOperator.negated, nil
+	}
+}
+
+// policyConditionOperator compares a policy value with a request value.
+// Negated operators are met when the request value does not compare with any policy value.
+type policyConditionOperator struct {
+	compare func(e *policyEvaluationContext, policyValue, requestValue, version string) (bool, error)
+	negated bool
+}
+
+var policyConditionOperators = map[string]policyConditionOperator{
+	"StringEquals":              {compare: policyStringEquals(false)},
+	"StringNotEquals":           {compare: policyStringEquals(false), negated: true},
+	"StringEqualsIgnoreCase":    {compare: policyStringEquals(true)},
+	"StringNotEqualsIgnoreCase": {compare: policyStringEquals(true), negated: true},
+	"StringLike":                {compare: policyStringLike},
+	"StringNotLike":             {compare: policyStringLike, negated: true},
+	"NumericEquals":             {compare: policyNumericCompare(func(c int) bool { return c == 0 })},
+	"NumericNotEquals":          {compare: policyNumericCompare(func(c int) bool { return c == 0 }), negated: true},
+	"NumericLessThan":           {compare: policyNumericCompare(func(c int) bool { return c < 0 })},
+	"NumericLessThanEquals":     {compare: policyNumericCompare(func(c int) bool { return c <= 0 })},
+	"NumericGreaterThan":        {compare: policyNumericCompare(func(c int) bool { return c > 0 })},
+	"NumericGreaterThanEquals":  {compare: policyNumericCompare(func(c int) bool { return c >= 0 })},
+	"DateEquals":                {compare: policyDateCompare(func(c int) bool { return c == 0 })},
+	"DateNotEquals":             {compare: policyDateCompare(func(c int) bool { return c == 0 }), negated: true},
+	"DateLessThan":              {compare: policyDateCompare(func(c int) bool { return c < 0 })},
+	"DateLessThanEquals":        {compare: policyDateCompare(func(c int) bool { return c <= 0 })},
+	"DateGreaterThan":           {compare: policyDateCompare(func(c int) bool { return c > 0 })},
+	"DateGreaterThanEquals":     {compare: policyDateCompare(func(c int) bool { return c >= 0 })},
+	"Bool":                      {compare: policyBool},
+	"BinaryEquals":              {compare: policyStringEquals(false)},
+	"IpAddress":                 {compare: policyIPAddress},
+	"NotIpAddress":              {compare: policyIPAddress, negated: true},
+	"ArnEquals":                 {compare: policyARNLike},
+	"ArnNotEquals":              {compare: policyARNLike, negated: true},
+	"ArnLike":                   {compare: policyARNLike},
+	"ArnNotLike":                {compare: policyARNLike, negated: true},
+}
+
+func policyStringEquals(ignoreCase bool) func(*policyEvaluationContext, string, string, string) (bool, error) {
+	return func(e *policyEvaluationContext, policyValue, requestValue, version string) (bool, error) {
+		policyValue, ok := e.substitute(policyValue, version)
+
+		if !ok {
+			return false, nil
+		}
+
+		if ignoreCase {
+			return strings.EqualFold(policyValue, requestValue), nil
+		}
+
+		return policyValue == requestValue, nil
+	}
+}
+
+func policyStringLike(e *policyEvaluationContext, policyValue, requestValue, version string) (bool, error) {
+	re, ok := e.wildcardRegexp(policyValue, version, false)
+
+	return ok && re.MatchString(requestValue), nil
+}
+
+func policyNumericCompare(f func(int) bool) func(*policyEvaluationContext, string, string, string) (bool, error) {
+	return func(e *policyEvaluationContext, policyValue, requestValue, version string) (bool, error) {
+		p, err := strconv.ParseFloat(policyValue, 64)
+
+		if err != nil {
+			return false, fmt.Errorf("invalid numeric value (%s)", policyValue)
+		}
+
+		r, err := strconv.ParseFloat(requestValue, 64)
+
+		if err != nil {
+			return false, nil
+		}
+
+		switch {
+		case r < p:
+			return f(-1), nil
+		case r > p:
+			return f(1), nil
+		default:
+			return f(0), nil
+		}
+	}
+}
+
+func policyDateCompare(f func(int) bool) func(*policyEvaluationContext, string, string, string) (bool, error) {
+	return func(e *policyEvaluationContext, policyValue, requestValue, version string) (bool, error) {
+		p, ok := parsePolicyDate(policyValue)
+
+		if !ok {
+			return false, fmt.Errorf("invalid date value (%s)", policyValue)
+		}
+
+		r, ok := parsePolicyDate(requestValue)
+
+		if !ok {
+			return false, nil
+		}
+
+		switch {
+		case r.Before(p):
+			return f(-1), nil
+		case r.After(p):
+			return f(1), nil
+		default:
+			return f(0), nil
+		}
+	}
+}
+
+func policyBool(e *policyEvaluationContext, policyValue, requestValue, version string) (bool, error) {
+	if _, err := strconv.ParseBool(policyValue); err != nil {
+		return false, fmt.Errorf("invalid Bool value (%s)", policyValue)
+	}
+
+	return strings.EqualFold(policyValue, requestValue), nil
+}
+
+func policyIPAddress(e *policyEvaluationContext, policyValue, requestValue, version string) (bool, error) {
+	cidr := policyValue
+
+	if !strings.Contains(cidr, "/") {
+		if strings.Contains(cidr, ":") {
+			cidr += "/128"
+		} else {
+			cidr += "/32"
+		}
+	}
+
+	_, ipNet, err := net.ParseCIDR(cidr)
+
+	if err != nil {
+		return false, fmt.Errorf("invalid IP address value (%s)", policyValue)
+	}
+
+	ip := net.ParseIP(requestValue)
+
+	return ip != nil && ipNet.Contains(ip), nil
+}
+
+func policyARNLike(e *policyEvaluationContext, policyValue, requestValue, version string) (bool, error) {
+	return e.arnLike(policyValue, requestValue, version), nil
+}
+
+// arnLike returns whether the ARN matches the pattern segment by segment.
+func (e *policyEvaluationContext) arnLike(pattern, value, version string) bool {
+	patternSegments := strings.SplitN(pattern, ":", 6)
+	valueSegments := strings.SplitN(value, ":", 6)
+
+	if len(patternSegments) != len(valueSegments) {
+		return false
+	}
+
+	for i := range patternSegments {
+		re, ok := e.wildcardRegexp(patternSegments[i], version, false)
+
+		if !ok || !re.MatchString(valueSegments[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var policyVariableRegexp = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// substitute replaces the policy variables, e.g. ${aws:username}, in the value with their values in the request.
+// It returns false if a variable has neither a value nor a default.
+// Policy variables are only supported in policies of version 2012-10-17.
+func (e *policyEvaluationContext) substitute(s, version string) (string, bool) {
+	if version != policyVersion2012 {
+		return s, true
+	}
+
+	ok := true
+
+	s = policyVariableRegexp.ReplaceAllStringFunc(s, func(variable string) string {
+		v, found := e.variable(variable)
+
+		if !found {
+			ok = false
+		}
+
+		return v
+	})
+
+	return s, ok
+}
+
+// variable returns the value of a policy variable, e.g. ${aws:PrincipalTag/team, 'default'}.
+func (e *policyEvaluationContext) variable(variable string) (string, bool) {
+	name := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(variable, "${"), "}"))
+
+	switch name {
+	case "*", "?", "$":
+		return name, true
+	}
+
+	defaultValue, hasDefault := "", false
+
+	if i := strings.Index(name, ","); i >= 0 {
+		defaultValue = strings.Trim(strings.TrimSpace(name[i+1:]), "'")
+		hasDefault = true
+		name = strings.TrimSpace(name[:i])
+	}
+
+	if values := e.keys[strings.ToLower(name)]; len(values) == 1 {
+		return values[0], true
+	}
+
+	return defaultValue, hasDefault
+}
+
+// wildcardRegexp returns a regular expression matching the pattern, in which
+// * matches any sequence of characters, ? matches any single character and policy variables match their values.
+func (e *policyEvaluationContext) wildcardRegexp(pattern, version string, ignoreCase bool) (*regexp.Regexp, bool) {
+	var sb strings.Builder
+
+	if ignoreCase {
+		sb.WriteString("(?is)^")
+	} else {
+		sb.WriteString("(?s)^")
+	}
+
+	for pattern != "" {
+		if loc := policyVariableRegexp.FindStringIndex(pattern); version == policyVersion2012 && loc != nil && loc[0] == 0 {
+			v, ok := e.variable(pattern[:loc[1]])
+
+			if !ok {
+				return nil, false
+			}
+
+			sb.WriteString(regexp.QuoteMeta(v))
+			pattern = pattern[loc[1]:]
+
+			continue
+		}
+
+		switch pattern[0] {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(pattern[:1]))
+		}
+
+		pattern = pattern[1:]
+	}
+
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+
+	if err != nil {
+		return nil, false
+	}
+
+	return re, true
+}
+
+// policyWildcardMatch returns whether the value matches the pattern, in which
+// * matches any sequence of characters and ? matches any single character.
+func policyWildcardMatch(pattern, value string, ignoreCase bool) bool {
+	if ignoreCase {
+		pattern = strings.ToLower(pattern)
+		value = strings.ToLower(value)
+	}
+
+	p, v := 0, 0
+	star, match := -1, 0
+
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star = p
+			match = v
+			p++
+		case star >= 0:
+			p = star + 1
+			match++
+			v = match
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+// policyPrincipalAccountID returns the account ID of an AWS principal, which is either an account ID or an ARN.
+func policyPrincipalAccountID(principal string) string {
+	if policyAccountIDRegexp.MatchString(principal) {
+		return principal
+	}
+
+	if v, err := arn.Parse(principal); err == nil {
+		return v.AccountID
+	}
+
+	return ""
+}
+
+var policyAccountIDRegexp = regexp.MustCompile(`^\d{12}$`)
+
+// parsePolicyDate parses a date condition value, either in ISO 8601 format or in epoch seconds.
+func parsePolicyDate(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05Z0700", "2006-01-02T15:04Z07:00", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), true
+	}
+
+	return time.Time{}, false
+}
+
+// policyStringList returns the values of a policy element, which is either a string or a list of strings.
+func policyStringList(v interface{}) ([]string, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []string:
+		return v, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+
+		for _, v := range v {
+			s, ok := v.(string)
+
+			if !ok {
+				return nil, fmt.Errorf("unsupported data type %T in list of strings", v)
+			}
+
+			values = append(values, s)
+		}
+
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %T for list of strings", v)
+	}
+}
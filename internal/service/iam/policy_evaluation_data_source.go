@@ -0,0 +1,261 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"identity_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+			"permissions_boundary": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+			"request": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"context": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"principal": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"principal_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "AWS",
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "*",
+						},
+					},
+				},
+			},
+			"resource_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidIAMPolicyJSON,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_control_policies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIAMPolicyJSON,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(d *schema.ResourceData, meta interface{}) error {
+	policies := &PolicyEvaluationPolicies{}
+	var err error
+
+	if policies.IdentityPolicies, err = expandPolicyEvaluationDocs("identity_policies", d.Get("identity_policies").([]interface{})); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("permissions_boundary"); ok {
+		if policies.PermissionsBoundary, err = expandPolicyEvaluationDoc("permissions_boundary", v.(string)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("resource_policy"); ok {
+		if policies.ResourcePolicy, err = expandPolicyEvaluationDoc("resource_policy", v.(string)); err != nil {
+			return err
+		}
+	}
+
+	if policies.ServiceControlPolicies, err = expandPolicyEvaluationDocs("service_control_policies", d.Get("service_control_policies").([]interface{})); err != nil {
+		return err
+	}
+
+	allAllowed := true
+	var results []interface{}
+
+	for i, tfMapRaw := range d.Get("request").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		request := expandPolicyEvaluationRequest(tfMap)
+
+		result, err := EvaluatePolicies(policies, request)
+
+		if err != nil {
+			return fmt.Errorf("error evaluating IAM policies for request %d (%s): %w", i, request.Action, err)
+		}
+
+		if result.Decision != PolicyEvaluationDecisionAllowed {
+			allAllowed = false
+		}
+
+		results = append(results, map[string]interface{}{
+			"action":             request.Action,
+			"decision":           result.Decision,
+			"matched_statements": result.MatchedStatements,
+			"reason":             result.Reason,
+			"resource":           request.Resource,
+		})
+	}
+
+	if err := d.Set("results", results); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	d.Set("all_allowed", allAllowed)
+
+	id, err := json.Marshal([]interface{}{
+		d.Get("identity_policies"),
+		d.Get("permissions_boundary"),
+		d.Get("resource_policy"),
+		d.Get("service_control_policies"),
+		results,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(string(id))))
+
+	return nil
+}
+
+func expandPolicyEvaluationDoc(name, s string) (*IAMPolicyDoc, error) {
+	doc := &IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(s), doc); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", name, err)
+	}
+
+	return doc, nil
+}
+
+func expandPolicyEvaluationDocs(name string, tfList []interface{}) ([]*IAMPolicyDoc, error) {
+	var docs []*IAMPolicyDoc
+
+	for i, v := range tfList {
+		s, ok := v.(string)
+
+		if !ok || strings.TrimSpace(s) == "" {
+			return nil, fmt.Errorf("%s[%d] must not be empty", name, i)
+		}
+
+		doc, err := expandPolicyEvaluationDoc(fmt.Sprintf("%s[%d]", name, i), s)
+
+		if err != nil {
+			return nil, err
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+func expandPolicyEvaluationRequest(tfMap map[string]interface{}) *PolicyEvaluationRequest {
+	request := &PolicyEvaluationRequest{
+		Action:        tfMap["action"].(string),
+		Principal:     tfMap["principal"].(string),
+		PrincipalType: tfMap["principal_type"].(string),
+		Resource:      tfMap["resource"].(string),
+		Context:       make(map[string][]string),
+	}
+
+	for _, tfMapRaw := range tfMap["context"].(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := tfMap["key"].(string)
+
+		for _, v := range tfMap["values"].([]interface{}) {
+			s, _ := v.(string)
+			request.Context[key] = append(request.Context[key], s)
+		}
+	}
+
+	return request
+}
@@ -0,0 +1,135 @@
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "identity_policies.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "permissions_boundary"),
+					resource.TestCheckResourceAttr(dataSourceName, "request.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "request.0.principal_type", "AWS"),
+					resource.TestCheckResourceAttr(dataSourceName, "request.2.principal", "arn:aws:iam::123456789012:role/example"),
+					resource.TestCheckResourceAttr(dataSourceName, "request.1.context.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "request.1.context.*", map[string]string{
+						"key":      "aws:SecureTransport",
+						"values.#": "1",
+						"values.0": "false",
+					}),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resource_policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_control_policies.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.resource", "arn:aws:s3:::example/data.csv"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0", "service_control_policies[0].AllowAll"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.1", "identity_policies[0].AllowRead"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.2", "permissions_boundary.Statement[0]"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.reason", "allowed by an identity-based policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.2", "identity_policies[0].DenyInsecureTransport"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.action", "s3:PutObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.2.reason", "allowed by the resource-based policy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyEvaluationDataSourceConfig() string {
+	return `
+data "aws_iam_policy_evaluation" "test" {
+  identity_policies = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "AllowRead"
+        Effect   = "Allow"
+        Action   = "s3:Get*"
+        Resource = "arn:aws:s3:::example/*"
+      },
+      {
+        Sid      = "DenyInsecureTransport"
+        Effect   = "Deny"
+        Action   = "s3:*"
+        Resource = "*"
+        Condition = {
+          Bool = {
+            "aws:SecureTransport" = false
+          }
+        }
+      },
+    ]
+  })]
+
+  permissions_boundary = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:*"
+      Resource = "*"
+    }]
+  })
+
+  resource_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AllowWrite"
+      Effect    = "Allow"
+      Principal = { AWS = "arn:aws:iam::123456789012:role/example" }
+      Action    = "s3:PutObject"
+      Resource  = "arn:aws:s3:::example/*"
+    }]
+  })
+
+  service_control_policies = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid      = "AllowAll"
+      Effect   = "Allow"
+      Action   = "*"
+      Resource = "*"
+    }]
+  })]
+
+  request {
+    action   = "s3:GetObject"
+    resource = "arn:aws:s3:::example/data.csv"
+  }
+
+  request {
+    action   = "s3:GetObject"
+    resource = "arn:aws:s3:::example/data.csv"
+
+    context {
+      key    = "aws:SecureTransport"
+      values = ["false"]
+    }
+  }
+
+  request {
+    action    = "s3:PutObject"
+    resource  = "arn:aws:s3:::example/data.csv"
+    principal = "arn:aws:iam::123456789012:role/example"
+  }
+}
+`
+}
@@ -0,0 +1,740 @@
+package iam
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEvaluatePolicies(t *testing.T) {
+	const (
+		allowS3Read = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowRead",
+    "Effect": "Allow",
+    "Action": ["s3:Get*", "s3:List*"],
+    "Resource": ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+  }]
+}`
+		allowS3ReadStatementObject = `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "AllowRead",
+    "Effect": "Allow",
+    "Action": ["s3:Get*", "s3:List*"],
+    "Resource": ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+  }
+}`
+		allowAll = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowAll",
+    "Effect": "Allow",
+    "Action": "*",
+    "Resource": "*"
+  }]
+}`
+		denyDelete = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "DenyDelete",
+    "Effect": "Deny",
+    "Action": "s3:Delete*",
+    "Resource": "*"
+  }]
+}`
+		allowEC2 = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "ec2:*",
+    "Resource": "*"
+  }]
+}`
+	)
+
+	testCases := []struct {
+		Name                   string
+		IdentityPolicies       []string
+		ResourcePolicy         string
+		PermissionsBoundary    string
+		ServiceControlPolicies []string
+		Request                PolicyEvaluationRequest
+		ExpectedDecision       string
+		ExpectedStatements     []string
+		ExpectedReason         string
+		ExpectedError          string
+	}{
+		{
+			Name:             "no policies",
+			Request:          PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			ExpectedDecision: PolicyEvaluationDecisionImplicitDeny,
+			ExpectedReason:   "no identity-based or resource-based policy allows the request",
+		},
+		{
+			Name:               "identity allow",
+			IdentityPolicies:   []string{allowS3Read},
+			Request:            PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"identity_policies[0].AllowRead"},
+			ExpectedReason:     "allowed by an identity-based policy",
+		},
+		{
+			Name:               "identity allow single statement object",
+			IdentityPolicies:   []string{allowS3ReadStatementObject},
+			Request:            PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"identity_policies[0].AllowRead"},
+			ExpectedReason:     "allowed by an identity-based policy",
+		},
+		{
+			Name:               "action case insensitive",
+			IdentityPolicies:   []string{allowS3Read},
+			Request:            PolicyEvaluationRequest{Action: "S3:getobject", Resource: "arn:aws:s3:::example/key"},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"identity_policies[0].AllowRead"},
+			ExpectedReason:     "allowed by an identity-based policy",
+		},
+		{
+			Name:             "action not matched",
+			IdentityPolicies: []string{allowS3Read},
+			Request:          PolicyEvaluationRequest{Action: "s3:PutObject", Resource: "arn:aws:s3:::example/key"},
+			ExpectedDecision: PolicyEvaluationDecisionImplicitDeny,
+			ExpectedReason:   "no identity-based or resource-based policy allows the request",
+		},
+		{
+			Name:             "resource case sensitive",
+			IdentityPolicies: []string{allowS3Read},
+			Request:          PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::EXAMPLE/key"},
+			ExpectedDecision: PolicyEvaluationDecisionImplicitDeny,
+			ExpectedReason:   "no identity-based or resource-based policy allows the request",
+		},
+		{
+			Name:               "second identity policy",
+			IdentityPolicies:   []string{allowS3Read, allowEC2},
+			Request:            PolicyEvaluationRequest{Action: "ec2:RunInstances", Resource: "arn:aws:ec2:us-west-2:123456789012:instance/*"},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"identity_policies[1].Statement[0]"},
+			ExpectedReason:     "allowed by an identity-based policy",
+		},
+		{
+			Name:               "explicit deny",
+			IdentityPolicies:   []string{allowAll, denyDelete},
+			Request:            PolicyEvaluationRequest{Action: "s3:DeleteObject", Resource: "arn:aws:s3:::example/key"},
+			ExpectedDecision:   PolicyEvaluationDecisionExplicitDeny,
+			ExpectedStatements: []string{"identity_policies[0].AllowAll", "identity_policies[1].DenyDelete"},
+			ExpectedReason:     "explicitly denied by identity_policies[1].DenyDelete",
+		},
+		{
+			Name:             "explicit deny in permissions boundary",
+			IdentityPolicies: []string{allowAll},
+			PermissionsBoundary: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Action": "*", "Resource": "*"},
+    {"Effect": "Deny", "Action": "iam:*", "Resource": "*"}
+  ]
+}`,
+			Request:            PolicyEvaluationRequest{Action: "iam:CreateUser", Resource: "arn:aws:iam::123456789012:user/example"},
+			ExpectedDecision:   PolicyEvaluationDecisionExplicitDeny,
+			ExpectedStatements: []string{"identity_policies[0].AllowAll", "permissions_boundary.Statement[0]", "permissions_boundary.Statement[1]"},
+			ExpectedReason:     "explicitly denied by permissions_boundary.Statement[1]",
+		},
+		{
+			Name:                "permissions boundary does not allow",
+			IdentityPolicies:    []string{allowAll},
+			PermissionsBoundary: allowS3Read,
+			Request:             PolicyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			ExpectedDecision:    PolicyEvaluationDecisionImplicitDeny,
+			ExpectedStatements:  []string{"identity_policies[0].AllowAll"},
+			ExpectedReason:      "the permissions boundary does not allow the request",
+		},
+		{
+			Name:                "permissions boundary allows",
+			IdentityPolicies:    []string{allowAll},
+			PermissionsBoundary: allowS3Read,
+			Request:             PolicyEvaluationRequest{Action: "s3:ListBucket", Resource: "arn:aws:s3:::example"},
+			ExpectedDecision:    PolicyEvaluationDecisionAllowed,
+			ExpectedStatements:  []string{"identity_policies[0].AllowAll", "permissions_boundary.AllowRead"},
+			ExpectedReason:      "allowed by an identity-based policy",
+		},
+		{
+			Name:                   "service control policy does not allow",
+			IdentityPolicies:       []string{allowAll},
+			ServiceControlPolicies: []string{allowAll, allowS3Read},
+			Request:                PolicyEvaluationRequest{Action: "ec2:RunInstances", Resource: "*"},
+			ExpectedDecision:       PolicyEvaluationDecisionImplicitDeny,
+			ExpectedStatements:     []string{"service_control_policies[0].AllowAll", "identity_policies[0].AllowAll"},
+			ExpectedReason:         "service_control_policies[1] does not allow the request",
+		},
+		{
+			Name:                   "service control policy denies",
+			IdentityPolicies:       []string{allowAll},
+			ServiceControlPolicies: []string{allowAll, denyDelete},
+			Request:                PolicyEvaluationRequest{Action: "s3:DeleteBucket", Resource: "arn:aws:s3:::example"},
+			ExpectedDecision:       PolicyEvaluationDecisionExplicitDeny,
+			ExpectedStatements:     []string{"service_control_policies[0].AllowAll", "service_control_policies[1].DenyDelete", "identity_policies[0].AllowAll"},
+			ExpectedReason:         "explicitly denied by service_control_policies[1].DenyDelete",
+		},
+		{
+			Name:                   "service control policy does not grant",
+			ServiceControlPolicies: []string{allowAll},
+			Request:                PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key"},
+			ExpectedDecision:       PolicyEvaluationDecisionImplicitDeny,
+			ExpectedStatements:     []string{"service_control_policies[0].AllowAll"},
+			ExpectedReason:         "no identity-based or resource-based policy allows the request",
+		},
+		{
+			Name: "resource policy allows",
+			ResourcePolicy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowRole",
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:aws:iam::123456789012:role/example"},
+    "Action": "s3:PutObject",
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`,
+			PermissionsBoundary: allowS3Read,
+			Request:             PolicyEvaluationRequest{Action: "s3:PutObject", Resource: "arn:aws:s3:::example/key", PrincipalType: "AWS", Principal: "arn:aws:iam::123456789012:role/example"},
+			ExpectedDecision:    PolicyEvaluationDecisionAllowed,
+			ExpectedStatements:  []string{"resource_policy.AllowRole"},
+			ExpectedReason:      "allowed by the resource-based policy",
+		},
+		{
+			Name: "resource policy other principal",
+			ResourcePolicy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": ["arn:aws:iam::123456789012:role/other"]},
+    "Action": "s3:PutObject",
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`,
+			Request:          PolicyEvaluationRequest{Action: "s3:PutObject", Resource: "arn:aws:s3:::example/key", PrincipalType: "AWS", Principal: "arn:aws:iam::123456789012:role/example"},
+			ExpectedDecision: PolicyEvaluationDecisionImplicitDeny,
+			ExpectedReason:   "no identity-based or resource-based policy allows the request",
+		},
+		{
+			Name: "resource policy account principal",
+			ResourcePolicy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": ["arn:aws:iam::123456789012:root"]},
+    "Action": "sqs:SendMessage",
+    "Resource": "*"
+  }]
+}`,
+			Request:            PolicyEvaluationRequest{Action: "sqs:SendMessage", Resource: "arn:aws:sqs:us-west-2:123456789012:example", PrincipalType: "AWS", Principal: "arn:aws:iam::123456789012:role/example"},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"resource_policy.Statement[0]"},
+			ExpectedReason:     "allowed by the resource-based policy",
+		},
+		{
+			Name: "resource policy account ID principal",
+			ResourcePolicy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": "123456789012"},
+    "Action": "sqs:SendMessage",
+    "Resource": "*"
+  }]
+}`,
+			Request:          PolicyEvaluationRequest{Action: "sqs:SendMessage", Resource: "arn:aws:sqs:us-west-2:123456789012:example", PrincipalType: "AWS", Principal: "arn:aws:iam::210987654321:role/example"},
+			ExpectedDecision: PolicyEvaluationDecisionImplicitDeny,
+			ExpectedReason:   "no identity-based or resource-based policy allows the request",
+		},
+		{
+			Name: "resource policy service principal",
+			ResourcePolicy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "sns.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "*"
+  }]
+}`,
+			Request:            PolicyEvaluationRequest{Action: "sqs:SendMessage", Resource: "arn:aws:sqs:us-west-2:123456789012:example", PrincipalType: "Service", Principal: "sns.amazonaws.com"},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"resource_policy.Statement[0]"},
+			ExpectedReason:     "allowed by the resource-based policy",
+		},
+		{
+			Name: "resource policy anonymous principal",
+			ResourcePolicy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": "*",
+    "Action": "s3:GetObject",
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`,
+			Request:            PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key", PrincipalType: "AWS", Principal: "arn:aws:iam::210987654321:user/other"},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"resource_policy.Statement[0]"},
+			ExpectedReason:     "allowed by the resource-based policy",
+		},
+		{
+			Name:             "resource policy not principal",
+			IdentityPolicies: []string{allowAll},
+			ResourcePolicy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "DenyOthers",
+    "Effect": "Deny",
+    "NotPrincipal": {"AWS": "arn:aws:iam::123456789012:role/admin"},
+    "Action": "s3:*",
+    "Resource": "*"
+  }]
+}`,
+			Request:            PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key", PrincipalType: "AWS", Principal: "arn:aws:iam::123456789012:role/example"},
+			ExpectedDecision:   PolicyEvaluationDecisionExplicitDeny,
+			ExpectedStatements: []string{"resource_policy.DenyOthers", "identity_policies[0].AllowAll"},
+			ExpectedReason:     "explicitly denied by resource_policy.DenyOthers",
+		},
+		{
+			Name:             "resource policy not principal excluded",
+			IdentityPolicies: []string{allowAll},
+			ResourcePolicy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "DenyOthers",
+    "Effect": "Deny",
+    "NotPrincipal": {"AWS": "arn:aws:iam::123456789012:role/admin"},
+    "Action": "s3:*",
+    "Resource": "*"
+  }]
+}`,
+			Request:            PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::example/key", PrincipalType: "AWS", Principal: "arn:aws:iam::123456789012:role/admin"},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"identity_policies[0].AllowAll"},
+			ExpectedReason:     "allowed by an identity-based policy",
+		},
+		{
+			Name: "not action",
+			IdentityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "NotAction": "iam:*",
+    "Resource": "*"
+  }]
+}`},
+			Request:          PolicyEvaluationRequest{Action: "iam:PassRole", Resource: "*"},
+			ExpectedDecision: PolicyEvaluationDecisionImplicitDeny,
+			ExpectedReason:   "no identity-based or resource-based policy allows the request",
+		},
+		{
+			Name: "not action other service",
+			IdentityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "NotAction": "iam:*",
+    "Resource": "*"
+  }]
+}`},
+			Request:            PolicyEvaluationRequest{Action: "ec2:DescribeInstances", Resource: "*"},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"identity_policies[0].Statement[0]"},
+			ExpectedReason:     "allowed by an identity-based policy",
+		},
+		{
+			Name: "not resource",
+			IdentityPolicies: []string{allowAll, `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "DenyOtherBuckets",
+    "Effect": "Deny",
+    "Action": "s3:*",
+    "NotResource": ["arn:aws:s3:::example", "arn:aws:s3:::example/*"]
+  }]
+}`},
+			Request:            PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::other/key"},
+			ExpectedDecision:   PolicyEvaluationDecisionExplicitDeny,
+			ExpectedStatements: []string{"identity_policies[0].AllowAll", "identity_policies[1].DenyOtherBuckets"},
+			ExpectedReason:     "explicitly denied by identity_policies[1].DenyOtherBuckets",
+		},
+		{
+			Name: "condition met",
+			IdentityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "ec2:StartInstances",
+    "Resource": "*",
+    "Condition": {
+      "StringEquals": {"aws:ResourceTag/team": ["blue", "green"]},
+      "Bool": {"aws:MultiFactorAuthPresent": true}
+    }
+  }]
+}`},
+			Request: PolicyEvaluationRequest{Action: "ec2:StartInstances", Resource: "*", Context: map[string][]string{
+				"aws:ResourceTag/team":       {"green"},
+				"aws:MultiFactorAuthPresent": {"true"},
+			}},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"identity_policies[0].Statement[0]"},
+			ExpectedReason:     "allowed by an identity-based policy",
+		},
+		{
+			Name: "condition not met",
+			IdentityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "ec2:StartInstances",
+    "Resource": "*",
+    "Condition": {
+      "StringEquals": {"aws:ResourceTag/team": ["blue", "green"]},
+      "Bool": {"aws:MultiFactorAuthPresent": true}
+    }
+  }]
+}`},
+			Request: PolicyEvaluationRequest{Action: "ec2:StartInstances", Resource: "*", Context: map[string][]string{
+				"aws:ResourceTag/team": {"green"},
+			}},
+			ExpectedDecision: PolicyEvaluationDecisionImplicitDeny,
+			ExpectedReason:   "no identity-based or resource-based policy allows the request",
+		},
+		{
+			Name: "resource policy variable",
+			IdentityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "s3:*",
+    "Resource": "arn:aws:s3:::home/${aws:username}/*"
+  }]
+}`},
+			Request:            PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::home/alice/key", Context: map[string][]string{"aws:username": {"alice"}}},
+			ExpectedDecision:   PolicyEvaluationDecisionAllowed,
+			ExpectedStatements: []string{"identity_policies[0].Statement[0]"},
+			ExpectedReason:     "allowed by an identity-based policy",
+		},
+		{
+			Name: "resource policy variable other user",
+			IdentityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "s3:*",
+    "Resource": "arn:aws:s3:::home/${aws:username}/*"
+  }]
+}`},
+			Request:          PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "arn:aws:s3:::home/bob/key", Context: map[string][]string{"aws:username": {"alice"}}},
+			ExpectedDecision: PolicyEvaluationDecisionImplicitDeny,
+			ExpectedReason:   "no identity-based or resource-based policy allows the request",
+		},
+		{
+			Name: "unsupported effect",
+			IdentityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "allow", "Action": "*", "Resource": "*"}]
+}`},
+			Request:       PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "*"},
+			ExpectedError: "error evaluating identity_policies[0].Statement[0]: unsupported Effect (allow)",
+		},
+		{
+			Name: "no action",
+			IdentityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "NoAction", "Effect": "Allow", "Resource": "*"}]
+}`},
+			Request:       PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "*"},
+			ExpectedError: "error evaluating identity_policies[0].NoAction: statement has neither Action nor NotAction",
+		},
+		{
+			Name:           "resource policy no principal",
+			ResourcePolicy: allowAll,
+			Request:        PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "*"},
+			ExpectedError:  "error evaluating resource_policy.AllowAll: resource-based policy statement has neither Principal nor NotPrincipal",
+		},
+		{
+			Name: "unsupported condition operator",
+			IdentityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "*",
+    "Resource": "*",
+    "Condition": {"StringMatches": {"aws:username": "alice"}}
+  }]
+}`},
+			Request:       PolicyEvaluationRequest{Action: "s3:GetObject", Resource: "*"},
+			ExpectedError: "error evaluating identity_policies[0].Statement[0]: error evaluating condition StringMatches aws:username: unsupported condition operator (StringMatches)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			policies := &PolicyEvaluationPolicies{
+				IdentityPolicies:       testPolicyEvaluationDocs(t, testCase.IdentityPolicies...),
+				ServiceControlPolicies: testPolicyEvaluationDocs(t, testCase.ServiceControlPolicies...),
+			}
+
+			if testCase.ResourcePolicy != "" {
+				policies.ResourcePolicy = testPolicyEvaluationDocs(t, testCase.ResourcePolicy)[0]
+			}
+
+			if testCase.PermissionsBoundary != "" {
+				policies.PermissionsBoundary = testPolicyEvaluationDocs(t, testCase.PermissionsBoundary)[0]
+			}
+
+			got, err := EvaluatePolicies(policies, &testCase.Request)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || err.Error() != testCase.ExpectedError {
+					t.Fatalf("expected error %q, got %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got.Decision != testCase.ExpectedDecision {
+				t.Errorf("got decision %s, expected %s", got.Decision, testCase.ExpectedDecision)
+			}
+
+			if !reflect.DeepEqual(got.MatchedStatements, testCase.ExpectedStatements) {
+				t.Errorf("got matched statements %v, expected %v", got.MatchedStatements, testCase.ExpectedStatements)
+			}
+
+			if got.Reason != testCase.ExpectedReason {
+				t.Errorf("got reason %q, expected %q", got.Reason, testCase.ExpectedReason)
+			}
+		})
+	}
+}
+
+func TestPolicyConditionMatches(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Operator      string
+		Key           string
+		Values        []string
+		Version       string
+		Context       map[string][]string
+		Expected      bool
+		ExpectedError string
+	}{
+		{Name: "StringEquals", Operator: "StringEquals", Key: "aws:username", Values: []string{"alice"}, Context: map[string][]string{"aws:username": {"alice"}}, Expected: true},
+		{Name: "StringEquals case sensitive", Operator: "StringEquals", Key: "aws:username", Values: []string{"alice"}, Context: map[string][]string{"aws:username": {"Alice"}}},
+		{Name: "StringEquals key case insensitive", Operator: "StringEquals", Key: "AWS:UserName", Values: []string{"alice"}, Context: map[string][]string{"aws:username": {"alice"}}, Expected: true},
+		{Name: "StringEquals values ORed", Operator: "StringEquals", Key: "aws:username", Values: []string{"bob", "alice"}, Context: map[string][]string{"aws:username": {"alice"}}, Expected: true},
+		{Name: "StringEquals missing key", Operator: "StringEquals", Key: "aws:username", Values: []string{"alice"}},
+		{Name: "StringEquals empty values", Operator: "StringEquals", Key: "aws:username", Values: []string{"alice"}, Context: map[string][]string{"aws:username": {}}},
+		{Name: "StringNotEquals", Operator: "StringNotEquals", Key: "aws:username", Values: []string{"alice"}, Context: map[string][]string{"aws:username": {"bob"}}, Expected: true},
+		{Name: "StringNotEquals equal", Operator: "StringNotEquals", Key: "aws:username", Values: []string{"alice"}, Context: map[string][]string{"aws:username": {"alice"}}},
+		{Name: "StringNotEquals missing key", Operator: "StringNotEquals", Key: "aws:username", Values: []string{"alice"}, Expected: true},
+		{Name: "StringEqualsIgnoreCase", Operator: "StringEqualsIgnoreCase", Key: "aws:username", Values: []string{"alice"}, Context: map[string][]string{"aws:username": {"ALICE"}}, Expected: true},
+		{Name: "StringNotEqualsIgnoreCase", Operator: "StringNotEqualsIgnoreCase", Key: "aws:username", Values: []string{"alice"}, Context: map[string][]string{"aws:username": {"ALICE"}}},
+		{Name: "StringLike", Operator: "StringLike", Key: "s3:prefix", Values: []string{"home/*"}, Context: map[string][]string{"s3:prefix": {"home/alice/"}}, Expected: true},
+		{Name: "StringLike single character", Operator: "StringLike", Key: "s3:prefix", Values: []string{"v?"}, Context: map[string][]string{"s3:prefix": {"v1"}}, Expected: true},
+		{Name: "StringLike single character too long", Operator: "StringLike", Key: "s3:prefix", Values: []string{"v?"}, Context: map[string][]string{"s3:prefix": {"v10"}}},
+		{Name: "StringLike literal", Operator: "StringLike", Key: "s3:prefix", Values: []string{"a.b"}, Context: map[string][]string{"s3:prefix": {"axb"}}},
+		{Name: "StringNotLike", Operator: "StringNotLike", Key: "s3:prefix", Values: []string{"home/*"}, Context: map[string][]string{"s3:prefix": {"tmp/"}}, Expected: true},
+		{Name: "NumericEquals", Operator: "NumericEquals", Key: "s3:max-keys", Values: []string{"10"}, Context: map[string][]string{"s3:max-keys": {"10.0"}}, Expected: true},
+		{Name: "NumericNotEquals", Operator: "NumericNotEquals", Key: "s3:max-keys", Values: []string{"10"}, Context: map[string][]string{"s3:max-keys": {"10"}}},
+		{Name: "NumericLessThan", Operator: "NumericLessThan", Key: "s3:max-keys", Values: []string{"10"}, Context: map[string][]string{"s3:max-keys": {"9"}}, Expected: true},
+		{Name: "NumericLessThan equal", Operator: "NumericLessThan", Key: "s3:max-keys", Values: []string{"10"}, Context: map[string][]string{"s3:max-keys": {"10"}}},
+		{Name: "NumericLessThanEquals", Operator: "NumericLessThanEquals", Key: "s3:max-keys", Values: []string{"10"}, Context: map[string][]string{"s3:max-keys": {"10"}}, Expected: true},
+		{Name: "NumericGreaterThan", Operator: "NumericGreaterThan", Key: "s3:max-keys", Values: []string{"10"}, Context: map[string][]string{"s3:max-keys": {"11"}}, Expected: true},
+		{Name: "NumericGreaterThanEquals", Operator: "NumericGreaterThanEquals", Key: "s3:max-keys", Values: []string{"10"}, Context: map[string][]string{"s3:max-keys": {"9"}}},
+		{Name: "Numeric request value invalid", Operator: "NumericEquals", Key: "s3:max-keys", Values: []string{"10"}, Context: map[string][]string{"s3:max-keys": {"ten"}}},
+		{Name: "Numeric policy value invalid", Operator: "NumericEquals", Key: "s3:max-keys", Values: []string{"ten"}, Context: map[string][]string{"s3:max-keys": {"10"}}, ExpectedError: "invalid numeric value (ten)"},
+		{Name: "DateLessThan", Operator: "DateLessThan", Key: "aws:CurrentTime", Values: []string{"2021-01-01T00:00:00Z"}, Context: map[string][]string{"aws:CurrentTime": {"2020-12-31T23:59:59Z"}}, Expected: true},
+		{Name: "DateLessThan epoch", Operator: "DateLessThan", Key: "aws:EpochTime", Values: []string{"1609459200"}, Context: map[string][]string{"aws:EpochTime": {"1609459201"}}},
+		{Name: "DateEquals mixed formats", Operator: "DateEquals", Key: "aws:CurrentTime", Values: []string{"2021-01-01"}, Context: map[string][]string{"aws:CurrentTime": {"1609459200"}}, Expected: true},
+		{Name: "DateNotEquals", Operator: "DateNotEquals", Key: "aws:CurrentTime", Values: []string{"2021-01-01"}, Context: map[string][]string{"aws:CurrentTime": {"2021-01-02"}}, Expected: true},
+		{Name: "DateGreaterThan", Operator: "DateGreaterThan", Key: "aws:CurrentTime", Values: []string{"2021-01-01T00:00:00+01:00"}, Context: map[string][]string{"aws:CurrentTime": {"2021-01-01T00:00:00Z"}}, Expected: true},
+		{Name: "DateLessThanEquals", Operator: "DateLessThanEquals", Key: "aws:CurrentTime", Values: []string{"2021-01-01"}, Context: map[string][]string{"aws:CurrentTime": {"2021-01-01T00:00:00Z"}}, Expected: true},
+		{Name: "DateGreaterThanEquals", Operator: "DateGreaterThanEquals", Key: "aws:CurrentTime", Values: []string{"2021-01-02"}, Context: map[string][]string{"aws:CurrentTime": {"2021-01-01"}}},
+		{Name: "Date policy value invalid", Operator: "DateEquals", Key: "aws:CurrentTime", Values: []string{"yesterday"}, Context: map[string][]string{"aws:CurrentTime": {"2021-01-01"}}, ExpectedError: "invalid date value (yesterday)"},
+		{Name: "Bool", Operator: "Bool", Key: "aws:SecureTransport", Values: []string{"false"}, Context: map[string][]string{"aws:SecureTransport": {"FALSE"}}, Expected: true},
+		{Name: "Bool not equal", Operator: "Bool", Key: "aws:SecureTransport", Values: []string{"false"}, Context: map[string][]string{"aws:SecureTransport": {"true"}}},
+		{Name: "Bool missing key", Operator: "Bool", Key: "aws:SecureTransport", Values: []string{"false"}},
+		{Name: "Bool policy value invalid", Operator: "Bool", Key: "aws:SecureTransport", Values: []string{"no"}, Context: map[string][]string{"aws:SecureTransport": {"true"}}, ExpectedError: "invalid Bool value (no)"},
+		{Name: "BinaryEquals", Operator: "BinaryEquals", Key: "key", Values: []string{"QmluYXJ5VmFsdWU="}, Context: map[string][]string{"key": {"QmluYXJ5VmFsdWU="}}, Expected: true},
+		{Name: "IpAddress", Operator: "IpAddress", Key: "aws:SourceIp", Values: []string{"203.0.113.0/24"}, Context: map[string][]string{"aws:SourceIp": {"203.0.113.7"}}, Expected: true},
+		{Name: "IpAddress outside", Operator: "IpAddress", Key: "aws:SourceIp", Values: []string{"203.0.113.0/24"}, Context: map[string][]string{"aws:SourceIp": {"198.51.100.7"}}},
+		{Name: "IpAddress single", Operator: "IpAddress", Key: "aws:SourceIp", Values: []string{"203.0.113.7"}, Context: map[string][]string{"aws:SourceIp": {"203.0.113.7"}}, Expected: true},
+		{Name: "IpAddress IPv6", Operator: "IpAddress", Key: "aws:SourceIp", Values: []string{"2001:db8::/32"}, Context: map[string][]string{"aws:SourceIp": {"2001:db8::1"}}, Expected: true},
+		{Name: "IpAddress policy value invalid", Operator: "IpAddress", Key: "aws:SourceIp", Values: []string{"203.0.113.0/33"}, Context: map[string][]string{"aws:SourceIp": {"203.0.113.7"}}, ExpectedError: "invalid IP address value (203.0.113.0/33)"},
+		{Name: "NotIpAddress", Operator: "NotIpAddress", Key: "aws:SourceIp", Values: []string{"203.0.113.0/24", "198.51.100.0/24"}, Context: map[string][]string{"aws:SourceIp": {"192.0.2.1"}}, Expected: true},
+		{Name: "NotIpAddress inside", Operator: "NotIpAddress", Key: "aws:SourceIp", Values: []string{"203.0.113.0/24", "198.51.100.0/24"}, Context: map[string][]string{"aws:SourceIp": {"198.51.100.1"}}},
+		{Name: "ArnLike", Operator: "ArnLike", Key: "aws:SourceArn", Values: []string{"arn:aws:sns:*:123456789012:*"}, Context: map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}}, Expected: true},
+		{Name: "ArnLike wildcard does not span segments", Operator: "ArnLike", Key: "aws:SourceArn", Values: []string{"arn:aws:sns:*"}, Context: map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}}},
+		{Name: "ArnEquals", Operator: "ArnEquals", Key: "aws:SourceArn", Values: []string{"arn:aws:sns:us-west-2:123456789012:topic"}, Context: map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}}, Expected: true},
+		{Name: "ArnNotEquals", Operator: "ArnNotEquals", Key: "aws:SourceArn", Values: []string{"arn:aws:sns:us-west-2:123456789012:topic"}, Context: map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:other"}}, Expected: true},
+		{Name: "ArnNotLike", Operator: "ArnNotLike", Key: "aws:SourceArn", Values: []string{"arn:aws:sns:*:123456789012:*"}, Context: map[string][]string{"aws:SourceArn": {"arn:aws:sns:us-west-2:123456789012:topic"}}},
+		{Name: "Null true", Operator: "Null", Key: "aws:TokenIssueTime", Values: []string{"true"}, Expected: true},
+		{Name: "Null true present", Operator: "Null", Key: "aws:TokenIssueTime", Values: []string{"true"}, Context: map[string][]string{"aws:TokenIssueTime": {"2021-01-01"}}},
+		{Name: "Null false", Operator: "Null", Key: "aws:TokenIssueTime", Values: []string{"false"}, Context: map[string][]string{"aws:TokenIssueTime": {"2021-01-01"}}, Expected: true},
+		{Name: "Null policy value invalid", Operator: "Null", Key: "aws:TokenIssueTime", Values: []string{"maybe"}, ExpectedError: "invalid Null value (maybe)"},
+		{Name: "IfExists missing key", Operator: "StringEqualsIfExists", Key: "ec2:InstanceType", Values: []string{"t3.micro"}, Expected: true},
+		{Name: "IfExists present", Operator: "StringEqualsIfExists", Key: "ec2:InstanceType", Values: []string{"t3.micro"}, Context: map[string][]string{"ec2:InstanceType": {"m5.large"}}},
+		{Name: "IfExists negated missing key", Operator: "StringNotEqualsIfExists", Key: "ec2:InstanceType", Values: []string{"t3.micro"}, Expected: true},
+		{Name: "ForAllValues subset", Operator: "ForAllValues:StringEquals", Key: "aws:TagKeys", Values: []string{"team", "env"}, Context: map[string][]string{"aws:TagKeys": {"env"}}, Expected: true},
+		{Name: "ForAllValues not subset", Operator: "ForAllValues:StringEquals", Key: "aws:TagKeys", Values: []string{"team", "env"}, Context: map[string][]string{"aws:TagKeys": {"env", "owner"}}},
+		{Name: "ForAllValues missing key", Operator: "ForAllValues:StringEquals", Key: "aws:TagKeys", Values: []string{"team"}, Expected: true},
+		{Name: "ForAllValues negated", Operator: "ForAllValues:StringNotEquals", Key: "aws:TagKeys", Values: []string{"secret"}, Context: map[string][]string{"aws:TagKeys": {"env", "owner"}}, Expected: true},
+		{Name: "ForAllValues negated match", Operator: "ForAllValues:StringNotEquals", Key: "aws:TagKeys", Values: []string{"secret"}, Context: map[string][]string{"aws:TagKeys": {"env", "secret"}}},
+		{Name: "ForAllValues like", Operator: "ForAllValues:StringLike", Key: "aws:TagKeys", Values: []string{"app:*"}, Context: map[string][]string{"aws:TagKeys": {"app:name", "app:env"}}, Expected: true},
+		{Name: "ForAnyValue", Operator: "ForAnyValue:StringEquals", Key: "aws:TagKeys", Values: []string{"team"}, Context: map[string][]string{"aws:TagKeys": {"env", "team"}}, Expected: true},
+		{Name: "ForAnyValue no match", Operator: "ForAnyValue:StringEquals", Key: "aws:TagKeys", Values: []string{"team"}, Context: map[string][]string{"aws:TagKeys": {"env", "owner"}}},
+		{Name: "ForAnyValue missing key", Operator: "ForAnyValue:StringEquals", Key: "aws:TagKeys", Values: []string{"team"}},
+		{Name: "ForAnyValue missing key IfExists", Operator: "ForAnyValue:StringEqualsIfExists", Key: "aws:TagKeys", Values: []string{"team"}, Expected: true},
+		{Name: "ForAnyValue negated", Operator: "ForAnyValue:StringNotEquals", Key: "aws:TagKeys", Values: []string{"team"}, Context: map[string][]string{"aws:TagKeys": {"team", "env"}}, Expected: true},
+		{Name: "ForAnyValue negated all match", Operator: "ForAnyValue:StringNotEquals", Key: "aws:TagKeys", Values: []string{"team"}, Context: map[string][]string{"aws:TagKeys": {"team"}}},
+		{Name: "multivalued key without set operator", Operator: "StringEquals", Key: "aws:TagKeys", Values: []string{"team"}, Context: map[string][]string{"aws:TagKeys": {"env", "team"}}, Expected: true},
+		{Name: "multivalued key without set operator negated", Operator: "StringNotEquals", Key: "aws:TagKeys", Values: []string{"team"}, Context: map[string][]string{"aws:TagKeys": {"env", "team"}}},
+		{Name: "policy variable", Operator: "StringEquals", Key: "aws:ResourceTag/owner", Values: []string{"${aws:username}"}, Context: map[string][]string{"aws:ResourceTag/owner": {"alice"}, "aws:username": {"alice"}}, Expected: true},
+		{Name: "policy variable missing", Operator: "StringEquals", Key: "aws:ResourceTag/owner", Values: []string{"${aws:username}"}, Context: map[string][]string{"aws:ResourceTag/owner": {""}}},
+		{Name: "policy variable default", Operator: "StringEquals", Key: "aws:ResourceTag/owner", Values: []string{"${aws:username, 'nobody'}"}, Context: map[string][]string{"aws:ResourceTag/owner": {"nobody"}}, Expected: true},
+		{Name: "policy variable special character", Operator: "StringLike", Key: "s3:prefix", Values: []string{"${*}/*"}, Context: map[string][]string{"s3:prefix": {"*/home"}}, Expected: true},
+		{Name: "policy variable special character literal", Operator: "StringLike", Key: "s3:prefix", Values: []string{"${*}/*"}, Context: map[string][]string{"s3:prefix": {"x/home"}}},
+		{Name: "policy variable in like", Operator: "StringLike", Key: "s3:prefix", Values: []string{"home/${aws:username}/*"}, Context: map[string][]string{"s3:prefix": {"home/alice/docs"}, "aws:username": {"alice"}}, Expected: true},
+		{Name: "policy variable version 2008", Operator: "StringEquals", Key: "aws:ResourceTag/owner", Values: []string{"${aws:username}"}, Version: "2008-10-17", Context: map[string][]string{"aws:ResourceTag/owner": {"${aws:username}"}, "aws:username": {"alice"}}, Expected: true},
+		{Name: "unsupported operator", Operator: "StringMatches", Key: "aws:username", Values: []string{"alice"}, ExpectedError: "unsupported condition operator (StringMatches)"},
+		{Name: "unsupported set operator", Operator: "ForAllValues:Equals", Key: "aws:username", Values: []string{"alice"}, ExpectedError: "unsupported condition operator (ForAllValues:Equals)"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			version := testCase.Version
+
+			if version == "" {
+				version = policyVersion2012
+			}
+
+			e := newPolicyEvaluationContext(&PolicyEvaluationRequest{Context: testCase.Context})
+			condition := IAMPolicyStatementCondition{Test: testCase.Operator, Variable: testCase.Key, Values: testCase.Values}
+
+			got, err := e.conditionMatches(condition, version)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || err.Error() != testCase.ExpectedError {
+					t.Fatalf("expected error %q, got %v", testCase.ExpectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPolicyWildcardMatch(t *testing.T) {
+	testCases := []struct {
+		Pattern    string
+		Value      string
+		IgnoreCase bool
+		Expected   bool
+	}{
+		{Pattern: "*", Value: "", Expected: true},
+		{Pattern: "*", Value: "s3:GetObject", Expected: true},
+		{Pattern: "s3:*", Value: "s3:GetObject", Expected: true},
+		{Pattern: "s3:*", Value: "ec2:RunInstances"},
+		{Pattern: "s3:Get*", Value: "s3:getobject"},
+		{Pattern: "s3:Get*", Value: "s3:getobject", IgnoreCase: true, Expected: true},
+		{Pattern: "s3:*Object", Value: "s3:GetObject", Expected: true},
+		{Pattern: "s3:*Object", Value: "s3:GetObjectAcl"},
+		{Pattern: "s3:*Object*", Value: "s3:GetObjectAcl", Expected: true},
+		{Pattern: "s3:Get?bject", Value: "s3:GetObject", Expected: true},
+		{Pattern: "s3:Get?bject", Value: "s3:Getbject"},
+		{Pattern: "a*b*c", Value: "abbbc", Expected: true},
+		{Pattern: "a*b*c", Value: "acb"},
+		{Pattern: "s3:GetObject", Value: "s3:GetObject", Expected: true},
+		{Pattern: "", Value: "s3:GetObject"},
+	}
+
+	for _, testCase := range testCases {
+		if got := policyWildcardMatch(testCase.Pattern, testCase.Value, testCase.IgnoreCase); got != testCase.Expected {
+			t.Errorf("policyWildcardMatch(%q, %q, %t) = %t, expected %t", testCase.Pattern, testCase.Value, testCase.IgnoreCase, got, testCase.Expected)
+		}
+	}
+}
+
+func TestIAMPolicyStatementConditionSetUnmarshalJSONScalars(t *testing.T) {
+	var conditions IAMPolicyStatementConditionSet
+
+	if err := json.Unmarshal([]byte(`{"Bool": {"aws:SecureTransport": false}, "NumericLessThan": {"s3:max-keys": [10, 2.5]}}`), &conditions); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := make(map[string]interface{})
+
+	for _, condition := range conditions {
+		got[strings.Join([]string{condition.Test, condition.Variable}, " ")] = condition.Values
+	}
+
+	expected := map[string]interface{}{
+		"Bool aws:SecureTransport":    []string{"false"},
+		"NumericLessThan s3:max-keys": []string{"10", "2.5"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestExpandPolicyEvaluationDocStatementObject(t *testing.T) {
+	doc, err := expandPolicyEvaluationDoc("resource_policy", `{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "*"}}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(doc.Statements), 1; got != expected {
+		t.Fatalf("got %d statements, expected %d", got, expected)
+	}
+
+	if got, expected := doc.Statements[0].Effect, "Allow"; got != expected {
+		t.Errorf("got effect %q, expected %q", got, expected)
+	}
+
+	if got, expected := doc.Version, "2012-10-17"; got != expected {
+		t.Errorf("got version %q, expected %q", got, expected)
+	}
+
+	if _, err := expandPolicyEvaluationDoc("resource_policy", `{"Statement": "Allow"}`); err == nil {
+		t.Error("expected error for a string Statement")
+	}
+}
+
+func testPolicyEvaluationDocs(t *testing.T, policies ...string) []*IAMPolicyDoc {
+	t.Helper()
+
+	var docs []*IAMPolicyDoc
+
+	for _, policy := range policies {
+		doc := &IAMPolicyDoc{}
+
+		if err := json.Unmarshal([]byte(policy), doc); err != nil {
+			t.Fatalf("error parsing policy: %s", err)
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return docs
+}
@@ -1,9 +1,11 @@
 package iam
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 )
 
 type IAMPolicyDoc struct {
@@ -70,6 +72,41 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// UnmarshalJSON decodes a policy document whose Statement is a list of statements
+// or, as AWS also accepts, a single statement.
+func (s *IAMPolicyDoc) UnmarshalJSON(b []byte) error {
+	type iamPolicyDoc IAMPolicyDoc
+
+	var data struct {
+		iamPolicyDoc
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	*s = IAMPolicyDoc(data.iamPolicyDoc)
+
+	if statement := bytes.TrimSpace(data.Statement); len(statement) > 0 && statement[0] == '{' {
+		v := &IAMPolicyStatement{}
+
+		if err := json.Unmarshal(statement, v); err != nil {
+			return err
+		}
+
+		s.Statements = []*IAMPolicyStatement{v}
+
+		return nil
+	}
+
+	if len(data.Statement) > 0 {
+		return json.Unmarshal(data.Statement, &s.Statements)
+	}
+
+	return nil
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -143,7 +180,11 @@ func (ps *IAMPolicyStatementPrincipalSet) UnmarshalJSON(b []byte) error {
 			case []interface{}:
 				values := []string{}
 				for _, v := range value.([]interface{}) {
-					values = append(values, v.(string))
+					s, ok := v.(string)
+					if !ok {
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet.Identifiers", v)
+					}
+					values = append(values, s)
 				}
 				out = append(out, IAMPolicyStatementPrincipal{Type: key, Identifiers: values})
 			default:
@@ -195,10 +236,12 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 			switch var_values := var_values.(type) {
 			case string:
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
+			case bool, float64:
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{iamPolicyConditionValueString(var_values)}})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					values = append(values, iamPolicyConditionValueString(v))
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
 			}
@@ -209,6 +252,19 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// iamPolicyConditionValueString returns the string form of a condition value,
+// which may be a JSON boolean or number, e.g. "aws:SecureTransport": false.
+func iamPolicyConditionValueString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 func iamPolicyDecodeConfigStringList(lI []interface{}) interface{} {
 	if len(lI) == 1 {
 		return lI[0].(string)
@@ -0,0 +1,287 @@
+package iam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PolicyType is the kind of a policy document, which determines its validation rules.
+type PolicyType struct {
+	// Name describes the policy in errors, e.g. "managed policy".
+	Name string
+	// MaxLength is the maximum number of characters of the policy after removing whitespace.
+	MaxLength int
+	// ResourceBased policies must have a Principal or NotPrincipal in each statement.
+	// Identity-based policies must not.
+	ResourceBased bool
+	// NoResource policies must not have a Resource or NotResource in any statement, e.g. role trust policies.
+	NoResource bool
+	// UniqueSids policies must not have statements with the same Sid.
+	UniqueSids bool
+}
+
+// Policy types of the resources validating their policy documents at plan time.
+// Where a limit is an adjustable quota, MaxLength is the maximum the quota can be raised to.
+var (
+	PolicyTypeManaged    = PolicyType{Name: "managed policy", MaxLength: 6144, UniqueSids: true}
+	PolicyTypeRoleInline = PolicyType{Name: "inline role policy", MaxLength: 10240, UniqueSids: true}
+	PolicyTypeRoleTrust  = PolicyType{Name: "role trust policy", MaxLength: 4096, ResourceBased: true, NoResource: true, UniqueSids: true}
+	PolicyTypeKMSKey     = PolicyType{Name: "KMS key policy", MaxLength: 32768, ResourceBased: true}
+	PolicyTypeS3Bucket   = PolicyType{Name: "S3 bucket policy", MaxLength: 20480, ResourceBased: true}
+	PolicyTypeSNSTopic   = PolicyType{Name: "SNS topic policy", MaxLength: 30720, ResourceBased: true}
+	PolicyTypeSQSQueue   = PolicyType{Name: "SQS queue policy", MaxLength: 8192, ResourceBased: true}
+)
+
+var (
+	policyActionRegexp = regexp.MustCompile(`^[a-zA-Z0-9-]+:[a-zA-Z0-9*?]+$`)
+
+	policyDocumentElements  = []string{"Version", "Id", "Statement"}
+	policyStatementElements = []string{"Sid", "Effect", "Action", "NotAction", "Resource", "NotResource", "Principal", "NotPrincipal", "Condition"}
+)
+
+// PolicyCustomizeDiff returns a CustomizeDiffFunc validating the policy document of the attribute
+// when it changes and its new value is known at plan time.
+func PolicyCustomizeDiff(policyType PolicyType, key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.HasChange(key) || !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		policy, ok := diff.Get(key).(string)
+
+		if !ok || policy == "" {
+			return nil
+		}
+
+		if err := ValidatePolicyDocument(policyType, policy); err != nil {
+			return fmt.Errorf("%s: invalid %s: %w", key, policyType.Name, err)
+		}
+
+		return nil
+	}
+}
+
+// ValidatePolicyDocument returns the problems of the policy document that would fail requests to AWS,
+// or nil if none are found.
+func ValidatePolicyDocument(policyType PolicyType, policy string) error {
+	var errs *multierror.Error
+
+	var buffer bytes.Buffer
+
+	if err := json.Compact(&buffer, []byte(policy)); err != nil {
+		return fmt.Errorf("policy is not valid JSON: %w", err)
+	}
+
+	if n := utf8.RuneCount(buffer.Bytes()); n > policyType.MaxLength {
+		errs = multierror.Append(errs, fmt.Errorf("policy is %d characters after removing whitespace, which exceeds the limit of %d", n, policyType.MaxLength))
+	}
+
+	var raw map[string]interface{}
+
+	if err := json.Unmarshal(buffer.Bytes(), &raw); err != nil {
+		return fmt.Errorf("policy is not a JSON object")
+	}
+
+	errs = multierror.Append(errs, validatePolicyElements("policy", raw, policyDocumentElements)...)
+
+	if v, ok := raw["Version"]; ok && v != "2008-10-17" && v != policyVersion2012 {
+		errs = multierror.Append(errs, fmt.Errorf("Version must be %q or %q, got %v", policyVersion2012, "2008-10-17", v))
+	}
+
+	// A single statement need not be in a list.
+	var rawStatements []interface{}
+
+	switch v := raw["Statement"].(type) {
+	case nil:
+	case map[string]interface{}:
+		rawStatements = []interface{}{v}
+	case []interface{}:
+		rawStatements = v
+	default:
+		return multierror.Append(errs, fmt.Errorf("Statement must be an object or a list of objects")).ErrorOrNil()
+	}
+
+	if len(rawStatements) == 0 {
+		return multierror.Append(errs, fmt.Errorf("policy must have at least one Statement")).ErrorOrNil()
+	}
+
+	sids := make(map[string]bool)
+
+	for i, rawStatement := range rawStatements {
+		rawStatement, ok := rawStatement.(map[string]interface{})
+
+		if !ok {
+			errs = multierror.Append(errs, fmt.Errorf("Statement[%d] must be an object", i))
+			continue
+		}
+
+		name := fmt.Sprintf("Statement[%d]", i)
+
+		if v, ok := rawStatement["Sid"].(string); ok && v != "" {
+			name = fmt.Sprintf("Statement %q", v)
+
+			if sids[v] && policyType.UniqueSids {
+				errs = multierror.Append(errs, fmt.Errorf("%s: duplicate Sid", name))
+			}
+
+			sids[v] = true
+		}
+
+		errs = multierror.Append(errs, validatePolicyElements(name, rawStatement, policyStatementElements)...)
+
+		for _, k := range []string{"Principal", "NotPrincipal"} {
+			if v, ok := rawStatement[k].(string); ok && v != "*" {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s must be \"*\" or an object, got %q", name, k, v))
+			}
+		}
+
+		// Decode the statement with the policy model, which handles the different forms of its elements.
+		b, err := json.Marshal(rawStatement)
+
+		if err != nil {
+			return err
+		}
+
+		statement := &IAMPolicyStatement{}
+
+		if err := json.Unmarshal(b, statement); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+
+		for _, err := range validatePolicyStatement(policyType, statement) {
+			errs = multierror.Append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// validatePolicyElements returns errors for the unknown elements of a policy document or statement.
+// Element names are case sensitive.
+func validatePolicyElements(name string, raw map[string]interface{}, elements []string) []error {
+	var errs []error
+	var keys []string
+
+	for k := range raw {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		known := false
+
+		for _, element := range elements {
+			if k == element {
+				known = true
+				break
+			}
+		}
+
+		if !known {
+			errs = append(errs, fmt.Errorf("%s: unknown element %q, expected one of %s", name, k, strings.Join(elements, ", ")))
+		}
+	}
+
+	return errs
+}
+
+func validatePolicyStatement(policyType PolicyType, statement *IAMPolicyStatement) []error {
+	var errs []error
+
+	if statement.Effect != policyEffectAllow && statement.Effect != policyEffectDeny {
+		errs = append(errs, fmt.Errorf("Effect must be %q or %q, got %q", policyEffectAllow, policyEffectDeny, statement.Effect))
+	}
+
+	switch {
+	case statement.Actions != nil && statement.NotActions != nil:
+		errs = append(errs, fmt.Errorf("must not have both Action and NotAction"))
+	case statement.Actions == nil && statement.NotActions == nil:
+		errs = append(errs, fmt.Errorf("must have Action or NotAction"))
+	}
+
+	for _, v := range []interface{}{statement.Actions, statement.NotActions} {
+		actions, err := policyStringList(v)
+
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, action := range actions {
+			if action != "*" && !policyActionRegexp.MatchString(action) {
+				errs = append(errs, fmt.Errorf("invalid action %q, expected <service>:<action>", action))
+			}
+		}
+	}
+
+	hasResource := statement.Resources != nil || statement.NotResources != nil
+
+	switch {
+	case statement.Resources != nil && statement.NotResources != nil:
+		errs = append(errs, fmt.Errorf("must not have both Resource and NotResource"))
+	case policyType.NoResource && hasResource:
+		errs = append(errs, fmt.Errorf("Resource and NotResource are not allowed in a %s", policyType.Name))
+	case !policyType.ResourceBased && !hasResource:
+		errs = append(errs, fmt.Errorf("must have Resource or NotResource"))
+	}
+
+	hasPrincipal := statement.Principals != nil || statement.NotPrincipals != nil
+
+	switch {
+	case statement.Principals != nil && statement.NotPrincipals != nil:
+		errs = append(errs, fmt.Errorf("must not have both Principal and NotPrincipal"))
+	case policyType.ResourceBased && !hasPrincipal:
+		errs = append(errs, fmt.Errorf("must have Principal or NotPrincipal"))
+	case !policyType.ResourceBased && hasPrincipal:
+		errs = append(errs, fmt.Errorf("Principal and NotPrincipal are not allowed in an identity-based %s", policyType.Name))
+	}
+
+	if statement.NotPrincipals != nil && statement.Effect == policyEffectAllow {
+		log.Printf("[WARN] %s statement %q uses NotPrincipal with Allow, which grants access to all other principals", policyType.Name, statement.Sid)
+	}
+
+	var operators []string
+
+	for _, condition := range statement.Conditions {
+		if !validPolicyConditionOperator(condition.Test) {
+			operators = append(operators, condition.Test)
+		}
+	}
+
+	sort.Strings(operators)
+
+	for i, operator := range operators {
+		if i == 0 || operator != operators[i-1] {
+			errs = append(errs, fmt.Errorf("unknown condition operator %q", operator))
+		}
+	}
+
+	return errs
+}
+
+// validPolicyConditionOperator returns whether the condition operator is known,
+// including its IfExists and set operator variants.
+func validPolicyConditionOperator(operator string) bool {
+	operator = strings.TrimPrefix(operator, "ForAllValues:")
+	operator = strings.TrimPrefix(operator, "ForAnyValue:")
+
+	if operator == "Null" {
+		return true
+	}
+
+	_, ok := policyConditionOperators[strings.TrimSuffix(operator, "IfExists")]
+
+	return ok
+}
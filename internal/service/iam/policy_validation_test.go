@@ -0,0 +1,250 @@
+package iam
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+func TestValidatePolicyDocument(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		PolicyType     PolicyType
+		Policy         string
+		ExpectedErrors []string
+	}{
+		{
+			Name:       "valid managed policy",
+			PolicyType: PolicyTypeManaged,
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowRead",
+    "Effect": "Allow",
+    "Action": ["s3:GetObject", "s3:List*"],
+    "Resource": "*",
+    "Condition": {
+      "Bool": {"aws:SecureTransport": true},
+      "ForAnyValue:StringLikeIfExists": {"aws:TagKeys": ["app:*"]},
+      "Null": {"aws:TokenIssueTime": "false"}
+    }
+  }]
+}`,
+		},
+		{
+			Name:       "single statement object",
+			PolicyType: PolicyTypeManaged,
+			Policy:     `{"Version": "2012-10-17", "Statement": {"Effect": "Deny", "NotAction": "iam:*", "NotResource": "arn:aws:s3:::example"}}`,
+		},
+		{
+			Name:       "valid role trust policy",
+			PolicyType: PolicyTypeRoleTrust,
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": ["ec2.amazonaws.com", "lambda.amazonaws.com"]},
+    "Action": "sts:AssumeRole"
+  }]
+}`,
+		},
+		{
+			Name:       "valid S3 bucket policy",
+			PolicyType: PolicyTypeS3Bucket,
+			Policy: `{
+  "Version": "2012-10-17",
+  "Id": "BucketPolicy",
+  "Statement": [
+    {"Sid": "Public", "Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/*"},
+    {"Sid": "Public", "Effect": "Deny", "Principal": {"AWS": "*"}, "Action": "s3:*", "Resource": "arn:aws:s3:::example/*", "Condition": {"Bool": {"aws:SecureTransport": "false"}}}
+  ]
+}`,
+		},
+		{
+			Name:           "not JSON",
+			PolicyType:     PolicyTypeManaged,
+			Policy:         `{"Version": "2012-10-17",`,
+			ExpectedErrors: []string{"policy is not valid JSON: unexpected end of JSON input"},
+		},
+		{
+			Name:           "not an object",
+			PolicyType:     PolicyTypeManaged,
+			Policy:         `["Statement"]`,
+			ExpectedErrors: []string{"policy is not a JSON object"},
+		},
+		{
+			Name:           "no statement",
+			PolicyType:     PolicyTypeManaged,
+			Policy:         `{"Version": "2012-10-17", "Statement": []}`,
+			ExpectedErrors: []string{"policy must have at least one Statement"},
+		},
+		{
+			Name:       "invalid version and unknown elements",
+			PolicyType: PolicyTypeManaged,
+			Policy:     `{"Version": "2012-10-18", "statement": [], "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resources": "*", "Resource": "*"}]}`,
+			ExpectedErrors: []string{
+				`policy: unknown element "statement", expected one of Version, Id, Statement`,
+				`Version must be "2012-10-17" or "2008-10-17", got 2012-10-18`,
+				`Statement[0]: unknown element "Resources", expected one of Sid, Effect, Action, NotAction, Resource, NotResource, Principal, NotPrincipal, Condition`,
+			},
+		},
+		{
+			Name:       "mistyped effect",
+			PolicyType: PolicyTypeManaged,
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Sid": "Read", "Effect": "allow", "Action": "s3:GetObject", "Resource": "*"}]}`,
+			ExpectedErrors: []string{
+				`Statement "Read": Effect must be "Allow" or "Deny", got "allow"`,
+			},
+		},
+		{
+			Name:       "action elements",
+			PolicyType: PolicyTypeManaged,
+			Policy: `{"Version": "2012-10-17", "Statement": [
+  {"Effect": "Allow", "Resource": "*"},
+  {"Effect": "Allow", "Action": "s3:GetObject", "NotAction": "s3:PutObject", "Resource": "*"},
+  {"Effect": "Allow", "Action": ["GetObject", "s3:Get Object"], "Resource": "*"}
+]}`,
+			ExpectedErrors: []string{
+				"Statement[0]: must have Action or NotAction",
+				"Statement[1]: must not have both Action and NotAction",
+				`Statement[2]: invalid action "GetObject", expected <service>:<action>`,
+				`Statement[2]: invalid action "s3:Get Object", expected <service>:<action>`,
+			},
+		},
+		{
+			Name:       "resource elements",
+			PolicyType: PolicyTypeRoleInline,
+			Policy: `{"Version": "2012-10-17", "Statement": [
+  {"Effect": "Allow", "Action": "s3:GetObject"},
+  {"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*", "NotResource": "arn:aws:s3:::example"}
+]}`,
+			ExpectedErrors: []string{
+				"Statement[0]: must have Resource or NotResource",
+				"Statement[1]: must not have both Resource and NotResource",
+			},
+		},
+		{
+			Name:       "principal in identity policy",
+			PolicyType: PolicyTypeManaged,
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "s3:*", "Resource": "*"}]}`,
+			ExpectedErrors: []string{
+				"Statement[0]: Principal and NotPrincipal are not allowed in an identity-based managed policy",
+			},
+		},
+		{
+			Name:       "trust policy elements",
+			PolicyType: PolicyTypeRoleTrust,
+			Policy: `{"Version": "2012-10-17", "Statement": [
+  {"Sid": "Assume", "Effect": "Allow", "Action": "sts:AssumeRole"},
+  {"Sid": "Assume", "Effect": "Allow", "Principal": "ec2.amazonaws.com", "Action": "sts:AssumeRole", "Resource": "*"},
+  {"Effect": "Allow", "Principal": {"AWS": "*"}, "NotPrincipal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "sts:AssumeRole"}
+]}`,
+			ExpectedErrors: []string{
+				`Statement "Assume": must have Principal or NotPrincipal`,
+				`Statement "Assume": duplicate Sid`,
+				`Statement "Assume": Principal must be "*" or an object, got "ec2.amazonaws.com"`,
+				`Statement "Assume": Resource and NotResource are not allowed in a role trust policy`,
+				"Statement[2]: must not have both Principal and NotPrincipal",
+			},
+		},
+		{
+			Name:       "unsupported principal identifier",
+			PolicyType: PolicyTypeSQSQueue,
+			Policy:     `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": {"AWS": [123456789012]}, "Action": "sqs:SendMessage", "Resource": "*"}]}`,
+			ExpectedErrors: []string{
+				"Statement[0]: Unsupported data type float64 for IAMPolicyStatementPrincipalSet.Identifiers",
+			},
+		},
+		{
+			Name:       "unknown condition operators",
+			PolicyType: PolicyTypeKMSKey,
+			Policy: `{"Version": "2012-10-17", "Statement": [{
+  "Effect": "Allow",
+  "Principal": {"AWS": "arn:aws:iam::123456789012:root"},
+  "Action": "kms:*",
+  "Resource": "*",
+  "Condition": {"StringEqual": {"kms:ViaService": "s3.us-west-2.amazonaws.com", "kms:CallerAccount": "123456789012"}, "ForEachValue:StringEquals": {"aws:TagKeys": "app"}}
+}]}`,
+			ExpectedErrors: []string{
+				`Statement[0]: unknown condition operator "ForEachValue:StringEquals"`,
+				`Statement[0]: unknown condition operator "StringEqual"`,
+			},
+		},
+		{
+			Name:       "managed policy too long",
+			PolicyType: PolicyTypeManaged,
+			Policy:     testPolicyValidationPolicyOfLength(6145),
+			ExpectedErrors: []string{
+				"policy is 6145 characters after removing whitespace, which exceeds the limit of 6144",
+			},
+		},
+		{
+			Name:       "managed policy at limit",
+			PolicyType: PolicyTypeManaged,
+			Policy:     testPolicyValidationPolicyOfLength(6144),
+		},
+		{
+			Name:       "whitespace not counted",
+			PolicyType: PolicyTypeManaged,
+			Policy:     strings.Replace(testPolicyValidationPolicyOfLength(6144), ",", ",\n    ", -1),
+		},
+		{
+			Name:       "inline role policy limit",
+			PolicyType: PolicyTypeRoleInline,
+			Policy:     testPolicyValidationPolicyOfLength(10241),
+			ExpectedErrors: []string{
+				"policy is 10241 characters after removing whitespace, which exceeds the limit of 10240",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := ValidatePolicyDocument(testCase.PolicyType, testCase.Policy)
+
+			var got []string
+
+			if merr, ok := err.(*multierror.Error); ok {
+				for _, err := range merr.Errors {
+					got = append(got, err.Error())
+				}
+			} else if err != nil {
+				got = append(got, err.Error())
+			}
+
+			if strings.Join(got, "\n") != strings.Join(testCase.ExpectedErrors, "\n") {
+				t.Errorf("got errors:\n%s\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(testCase.ExpectedErrors, "\n"))
+			}
+		})
+	}
+}
+
+func TestValidPolicyConditionOperator(t *testing.T) {
+	for operator, expected := range map[string]bool{
+		"StringEquals":                      true,
+		"StringNotEqualsIgnoreCase":         true,
+		"ArnLikeIfExists":                   true,
+		"Null":                              true,
+		"ForAllValues:StringLike":           true,
+		"ForAnyValue:NumericLessThanEquals": true,
+		"ForAllValues:NullIfExists":         false,
+		"StringEqual":                       false,
+		"stringequals":                      false,
+		"ForAnyValues:StringEquals":         false,
+		"IfExists":                          false,
+	} {
+		if got := validPolicyConditionOperator(operator); got != expected {
+			t.Errorf("validPolicyConditionOperator(%q) = %t, expected %t", operator, got, expected)
+		}
+	}
+}
+
+// testPolicyValidationPolicyOfLength returns a valid managed policy of the given length without whitespace.
+func testPolicyValidationPolicyOfLength(n int) string {
+	format := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::%s"}]}`
+	padding := n - len(fmt.Sprintf(format, ""))
+
+	return fmt.Sprintf(format, strings.Repeat("a", padding))
+}
@@ -28,6 +28,8 @@ func ResourceRolePolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: PolicyCustomizeDiff(PolicyTypeRoleInline, "policy"),
+
 		Schema: map[string]*schema.Schema{
 			"policy": {
 				Type:             schema.TypeString,
@@ -60,7 +62,7 @@ func ResourceRolePolicy() *schema.Resource {
 }
 
 func resourceRolePolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	request := &iam.PutRolePolicyInput{
 		RoleName:       aws.String(d.Get("role").(string)),
@@ -86,7 +88,7 @@ func resourceRolePolicyPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	role, name, err := RolePolicyParseID(d.Id())
 	if err != nil {
@@ -148,7 +150,7 @@ func resourceRolePolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRolePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	role, name, err := RolePolicyParseID(d.Id())
 	if err != nil {
@@ -39,7 +39,7 @@ func ResourceRolePolicyAttachment() *schema.Resource {
 }
 
 func resourceRolePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
@@ -56,7 +56,7 @@ func resourceRolePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 	role := d.Get("role").(string)
 	policyARN := d.Get("policy_arn").(string)
 	// Human friendly ID for error messages since d.Id() is non-descriptive
@@ -113,7 +113,7 @@ func resourceRolePolicyAttachmentRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceRolePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 	role := d.Get("role").(string)
 	arn := d.Get("policy_arn").(string)
 
@@ -162,21 +162,17 @@ func DetachPolicyFromRole(conn *iam.IAM, role string, arn string) error {
 }
 
 func RoleHasPolicyARNAttachment(conn *iam.IAM, role string, policyARN string) (bool, error) {
-	hasPolicyAttachment := false
-	input := &iam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(role),
+	attachedPolicies, err := FindRoleAttachedPolicies(conn, role)
+
+	if err != nil {
+		return false, err
 	}
 
-	err := conn.ListAttachedRolePoliciesPages(input, func(page *iam.ListAttachedRolePoliciesOutput, lastPage bool) bool {
-		for _, p := range page.AttachedPolicies {
-			if aws.StringValue(p.PolicyArn) == policyARN {
-				hasPolicyAttachment = true
-				return false
-			}
+	for _, p := range attachedPolicies {
+		if aws.StringValue(p.PolicyArn) == policyARN {
+			return true, nil
 		}
+	}
 
-		return !lastPage
-	})
-
-	return hasPolicyAttachment, err
+	return false, nil
 }
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -14,7 +13,7 @@ func TestAccIAMUserDataSource_basic(t *testing.T) {
 	resourceName := "aws_iam_user.test"
 	dataSourceName := "data.aws_iam_user.test"
 
-	userName := fmt.Sprintf("test-datasource-user-%d", sdkacctest.RandInt())
+	userName := fmt.Sprintf("test-datasource-user-%d", acctest.RandInt(t))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -40,7 +39,7 @@ func TestAccIAMUserDataSource_tags(t *testing.T) {
 	resourceName := "aws_iam_user.test"
 	dataSourceName := "data.aws_iam_user.test"
 
-	userName := fmt.Sprintf("test-datasource-user-%d", sdkacctest.RandInt())
+	userName := fmt.Sprintf("test-datasource-user-%d", acctest.RandInt(t))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,10 +15,10 @@ import (
 
 func TestAccIAMUserPolicyAttachment_basic(t *testing.T) {
 	var out iam.ListAttachedUserPoliciesOutput
-	rName := sdkacctest.RandString(10)
-	policyName1 := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
-	policyName2 := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
-	policyName3 := fmt.Sprintf("test-policy-%s", sdkacctest.RandString(10))
+	rName := acctest.RandString(t, 10)
+	policyName1 := fmt.Sprintf("test-policy-%s", acctest.RandString(t, 10))
+	policyName2 := fmt.Sprintf("test-policy-%s", acctest.RandString(t, 10))
+	policyName3 := fmt.Sprintf("test-policy-%s", acctest.RandString(t, 10))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -81,7 +80,7 @@ func testAccCheckUserPolicyAttachmentExists(n string, c int, out *iam.ListAttach
 			return fmt.Errorf("No policy name is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 		user := rs.Primary.Attributes["user"]
 
 		attachedPolicies, err := conn.ListAttachedUserPolicies(&iam.ListAttachedUserPoliciesInput{
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,8 +20,8 @@ import (
 func TestAccIAMUser_basic(t *testing.T) {
 	var conf iam.GetUserOutput
 
-	name1 := fmt.Sprintf("test-user-%d", sdkacctest.RandInt())
-	name2 := fmt.Sprintf("test-user-%d", sdkacctest.RandInt())
+	name1 := fmt.Sprintf("test-user-%d", acctest.RandInt(t))
+	name2 := fmt.Sprintf("test-user-%d", acctest.RandInt(t))
 	path1 := "/"
 	path2 := "/path2/"
 	resourceName := "aws_iam_user.user"
@@ -61,7 +60,7 @@ func TestAccIAMUser_basic(t *testing.T) {
 func TestAccIAMUser_disappears(t *testing.T) {
 	var user iam.GetUserOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_iam_user.user"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -85,7 +84,7 @@ func TestAccIAMUser_disappears(t *testing.T) {
 func TestAccIAMUser_ForceDestroy_accessKey(t *testing.T) {
 	var user iam.GetUserOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_iam_user.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -115,7 +114,7 @@ func TestAccIAMUser_ForceDestroy_accessKey(t *testing.T) {
 func TestAccIAMUser_ForceDestroy_loginProfile(t *testing.T) {
 	var user iam.GetUserOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_iam_user.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -145,7 +144,7 @@ func TestAccIAMUser_ForceDestroy_loginProfile(t *testing.T) {
 func TestAccIAMUser_ForceDestroy_mfaDevice(t *testing.T) {
 	var user iam.GetUserOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_iam_user.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -175,7 +174,7 @@ func TestAccIAMUser_ForceDestroy_mfaDevice(t *testing.T) {
 func TestAccIAMUser_ForceDestroy_sshKey(t *testing.T) {
 	var user iam.GetUserOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_iam_user.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -204,7 +203,7 @@ func TestAccIAMUser_ForceDestroy_sshKey(t *testing.T) {
 func TestAccIAMUser_ForceDestroy_signingCertificate(t *testing.T) {
 	var user iam.GetUserOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_iam_user.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -234,8 +233,8 @@ func TestAccIAMUser_ForceDestroy_signingCertificate(t *testing.T) {
 func TestAccIAMUser_nameChange(t *testing.T) {
 	var conf iam.GetUserOutput
 
-	name1 := fmt.Sprintf("test-user-%d", sdkacctest.RandInt())
-	name2 := fmt.Sprintf("test-user-%d", sdkacctest.RandInt())
+	name1 := fmt.Sprintf("test-user-%d", acctest.RandInt(t))
+	name2 := fmt.Sprintf("test-user-%d", acctest.RandInt(t))
 	path := "/"
 	resourceName := "aws_iam_user.user"
 
@@ -271,7 +270,7 @@ func TestAccIAMUser_nameChange(t *testing.T) {
 func TestAccIAMUser_pathChange(t *testing.T) {
 	var conf iam.GetUserOutput
 
-	name := fmt.Sprintf("test-user-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("test-user-%d", acctest.RandInt(t))
 	path1 := "/"
 	path2 := "/updated/"
 	resourceName := "aws_iam_user.user"
@@ -308,7 +307,7 @@ func TestAccIAMUser_pathChange(t *testing.T) {
 func TestAccIAMUser_permissionsBoundary(t *testing.T) {
 	var user iam.GetUserOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_iam_user.user"
 
 	permissionsBoundary1 := fmt.Sprintf("arn:%s:iam::aws:policy/AdministratorAccess", acctest.Partition())
@@ -386,7 +385,7 @@ func TestAccIAMUser_permissionsBoundary(t *testing.T) {
 func TestAccIAMUser_tags(t *testing.T) {
 	var user iam.GetUserOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_iam_user.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -424,7 +423,7 @@ func TestAccIAMUser_tags(t *testing.T) {
 }
 
 func testAccCheckUserDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_user" {
@@ -459,7 +458,7 @@ func testAccCheckUserExists(n string, res *iam.GetUserOutput) resource.TestCheck
 			return fmt.Errorf("No User name is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 		resp, err := conn.GetUser(&iam.GetUserInput{
 			UserName: aws.String(rs.Primary.ID),
@@ -490,7 +489,7 @@ func testAccCheckUserAttributes(user *iam.GetUserOutput, name string, path strin
 
 func testAccCheckUserDisappears(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 		userName := aws.StringValue(getUserOutput.User.UserName)
 
@@ -523,7 +522,7 @@ func testAccCheckUserPermissionsBoundary(getUserOutput *iam.GetUserOutput, expec
 
 func testAccCheckUserCreatesAccessKey(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 		input := &iam.CreateAccessKeyInput{
 			UserName: getUserOutput.User.UserName,
@@ -539,7 +538,7 @@ func testAccCheckUserCreatesAccessKey(getUserOutput *iam.GetUserOutput) resource
 
 func testAccCheckUserCreatesLoginProfile(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 		password, err := tfiam.GeneratePassword(32)
 		if err != nil {
 			return err
@@ -559,7 +558,7 @@ func testAccCheckUserCreatesLoginProfile(getUserOutput *iam.GetUserOutput) resou
 
 func testAccCheckUserCreatesMFADevice(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 		createVirtualMFADeviceInput := &iam.CreateVirtualMFADeviceInput{
 			Path:                 getUserOutput.User.Path,
@@ -605,7 +604,7 @@ func testAccCheckUserUploadsSSHKey(getUserOutput *iam.GetUserOutput) resource.Te
 			return fmt.Errorf("error generating random SSH key: %w", err)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 		input := &iam.UploadSSHPublicKeyInput{
 			UserName:         getUserOutput.User.UserName,
@@ -623,7 +622,7 @@ func testAccCheckUserUploadsSSHKey(getUserOutput *iam.GetUserOutput) resource.Te
 
 func testAccCheckUserUploadSigningCertificate(getUserOutput *iam.GetUserOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 		signingCertificate, err := os.ReadFile("./test-fixtures/iam-ssl-unix-line-endings.pem")
 		if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // waitImageStatusAvailable waits for an Image to return Available
@@ -23,7 +24,7 @@ func waitImageStatusAvailable(conn *imagebuilder.Imagebuilder, imageBuildVersion
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*imagebuilder.Image); ok {
 		return v, err
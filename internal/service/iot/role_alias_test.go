@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,8 +15,8 @@ import (
 )
 
 func TestAccIoTRoleAlias_basic(t *testing.T) {
-	alias := sdkacctest.RandomWithPrefix("RoleAlias-")
-	alias2 := sdkacctest.RandomWithPrefix("RoleAlias2-")
+	alias := acctest.RandomWithPrefix(t, "RoleAlias-")
+	alias2 := acctest.RandomWithPrefix(t, "RoleAlias2-")
 
 	resourceName := "aws_iot_role_alias.ra"
 	resourceName2 := "aws_iot_role_alias.ra2"
@@ -80,7 +79,7 @@ func TestAccIoTRoleAlias_basic(t *testing.T) {
 }
 
 func testAccCheckRoleAliasDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_role_alias" {
 			continue
@@ -108,7 +107,7 @@ func testAccCheckRoleAliasExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 		role_arn := rs.Primary.Attributes["role_arn"]
 
 		roleAliasDescription, err := tfiot.GetRoleAliasDescription(conn, rs.Primary.ID)
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccIoTThing_basic(t *testing.T) {
 	var thing iot.DescribeThingOutput
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	thingName := fmt.Sprintf("tf_acc_thing_%s", rString)
 	resourceName := "aws_iot_thing.test"
 
@@ -49,7 +48,7 @@ func TestAccIoTThing_basic(t *testing.T) {
 
 func TestAccIoTThing_full(t *testing.T) {
 	var thing iot.DescribeThingOutput
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	thingName := fmt.Sprintf("tf_acc_thing_%s", rString)
 	typeName := fmt.Sprintf("tf_acc_type_%s", rString)
 	resourceName := "aws_iot_thing.test"
@@ -122,7 +121,7 @@ func testAccCheckIotThingExists(n string, thing *iot.DescribeThingOutput) resour
 			return fmt.Errorf("No IoT Thing ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 		params := &iot.DescribeThingInput{
 			ThingName: aws.String(rs.Primary.ID),
 		}
@@ -138,7 +137,7 @@ func testAccCheckIotThingExists(n string, thing *iot.DescribeThingOutput) resour
 }
 
 func testAccCheckThingDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_thing" {
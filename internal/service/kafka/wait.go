@@ -28,7 +28,7 @@ func waitClusterCreated(conn *kafka.Kafka, arn string, timeout time.Duration) (*
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*kafka.ClusterInfo); ok {
 		if state, stateInfo := aws.StringValue(output.State), output.StateInfo; state == kafka.ClusterStateFailed && stateInfo != nil {
@@ -49,7 +49,7 @@ func waitClusterDeleted(conn *kafka.Kafka, arn string, timeout time.Duration) (*
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*kafka.ClusterInfo); ok {
 		if state, stateInfo := aws.StringValue(output.State), output.StateInfo; state == kafka.ClusterStateFailed && stateInfo != nil {
@@ -70,7 +70,7 @@ func waitClusterOperationCompleted(conn *kafka.Kafka, arn string, timeout time.D
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*kafka.ClusterOperationInfo); ok {
 		if state, errorInfo := aws.StringValue(output.OperationState), output.ErrorInfo; state == ClusterOperationStateUpdateFailed && errorInfo != nil {
@@ -91,7 +91,7 @@ func waitConfigurationDeleted(conn *kafka.Kafka, arn string) (*kafka.DescribeCon
 		Timeout: configurationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*kafka.DescribeConfigurationOutput); ok {
 		return output, err
@@ -51,7 +51,7 @@ func ResourceStreamConsumer() *schema.Resource {
 }
 
 func resourceStreamConsumerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KinesisConn
+	conn := meta.(*conns.AWSClient).KinesisConn()
 
 	name := d.Get("name").(string)
 	streamArn := d.Get("stream_arn").(string)
@@ -80,7 +80,7 @@ func resourceStreamConsumerCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceStreamConsumerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KinesisConn
+	conn := meta.(*conns.AWSClient).KinesisConn()
 
 	consumer, err := FindStreamConsumerByARN(conn, d.Id())
 
@@ -112,7 +112,7 @@ func resourceStreamConsumerRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceStreamConsumerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KinesisConn
+	conn := meta.(*conns.AWSClient).KinesisConn()
 
 	input := &kinesis.DeregisterStreamConsumerInput{
 		ConsumerARN: aws.String(d.Id()),
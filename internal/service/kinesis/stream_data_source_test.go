@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,11 +16,11 @@ import (
 func TestAccKinesisStreamDataSource_basic(t *testing.T) {
 	var stream kinesis.StreamDescription
 
-	sn := fmt.Sprintf("terraform-kinesis-test-%d", sdkacctest.RandInt())
+	sn := fmt.Sprintf("terraform-kinesis-test-%d", acctest.RandInt(t))
 	config := fmt.Sprintf(testAccCheckStreamDataSourceConfig, sn)
 
 	updateShardCount := func() {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn()
 		_, err := conn.UpdateShardCount(&kinesis.UpdateShardCountInput{
 			ScalingType:      aws.String(kinesis.ScalingTypeUniformScaling),
 			StreamName:       aws.String(sn),
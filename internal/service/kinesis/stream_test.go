@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesis"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,7 +19,7 @@ import (
 func TestAccKinesisStream_basic(t *testing.T) {
 	var stream kinesis.StreamDescription
 	resourceName := "aws_kinesis_stream.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -50,7 +49,7 @@ func TestAccKinesisStream_basic(t *testing.T) {
 func TestAccKinesisStream_createMultipleConcurrentStreams(t *testing.T) {
 	var stream kinesis.StreamDescription
 	resourceName := "aws_kinesis_stream.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d-0", rInt) // We can get away with just import testing one of them
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -96,7 +95,7 @@ func TestAccKinesisStream_createMultipleConcurrentStreams(t *testing.T) {
 }
 
 func TestAccKinesisStream_encryptionWithoutKMSKeyThrowsError(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -114,7 +113,7 @@ func TestAccKinesisStream_encryptionWithoutKMSKeyThrowsError(t *testing.T) {
 
 func TestAccKinesisStream_encryption(t *testing.T) {
 	var stream kinesis.StreamDescription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_kinesis_stream.test"
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
@@ -172,7 +171,7 @@ func TestAccKinesisStream_shardCount(t *testing.T) {
 		}
 	}
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_kinesis_stream.test"
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
@@ -215,7 +214,7 @@ func TestAccKinesisStream_shardCount(t *testing.T) {
 func TestAccKinesisStream_retentionPeriod(t *testing.T) {
 	var stream kinesis.StreamDescription
 	resourceName := "aws_kinesis_stream.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -266,7 +265,7 @@ func TestAccKinesisStream_retentionPeriod(t *testing.T) {
 func TestAccKinesisStream_shardLevelMetrics(t *testing.T) {
 	var stream kinesis.StreamDescription
 	resourceName := "aws_kinesis_stream.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -317,7 +316,7 @@ func TestAccKinesisStream_shardLevelMetrics(t *testing.T) {
 func TestAccKinesisStream_enforceConsumerDeletion(t *testing.T) {
 	var stream kinesis.StreamDescription
 	resourceName := "aws_kinesis_stream.test"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	streamName := fmt.Sprintf("terraform-kinesis-test-%d", rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -347,7 +346,7 @@ func TestAccKinesisStream_enforceConsumerDeletion(t *testing.T) {
 
 func TestAccKinesisStream_tags(t *testing.T) {
 	var stream kinesis.StreamDescription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_kinesis_stream.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -406,7 +405,7 @@ func testAccCheckKinesisStreamExists(n string, stream *kinesis.StreamDescription
 			return fmt.Errorf("No Kinesis ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn()
 		describeOpts := &kinesis.DescribeStreamInput{
 			StreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -447,7 +446,7 @@ func testAccCheckKinesisStreamDestroy(s *terraform.State) error {
 		if rs.Type != "aws_kinesis_stream" {
 			continue
 		}
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn()
 		describeOpts := &kinesis.DescribeStreamInput{
 			StreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -467,7 +466,7 @@ func testAccCheckKinesisStreamDestroy(s *terraform.State) error {
 
 func testAccStreamRegisterStreamConsumer(stream *kinesis.StreamDescription, rStr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn()
 
 		if _, err := conn.RegisterStreamConsumer(&kinesis.RegisterStreamConsumerInput{
 			ConsumerName: aws.String(rStr),
@@ -501,7 +500,7 @@ func testAccCheckKinesisStreamTags(n string, tagCount int) resource.TestCheckFun
 
 func TestAccKinesisStream_updateKMSKeyID(t *testing.T) {
 	var stream kinesis.StreamDescription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceName := "aws_kinesis_stream.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -21,7 +22,7 @@ func waitStreamConsumerCreated(conn *kinesis.Kinesis, arn string) (*kinesis.Cons
 		Timeout: streamConsumerCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesis.ConsumerDescription); ok {
 		return v, err
@@ -39,7 +40,7 @@ func waitStreamConsumerDeleted(conn *kinesis.Kinesis, arn string) (*kinesis.Cons
 		Timeout: streamConsumerDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesis.ConsumerDescription); ok {
 		return v, err
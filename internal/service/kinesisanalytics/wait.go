@@ -26,7 +26,7 @@ func waitApplicationDeleted(conn *kinesisanalytics.KinesisAnalytics, name string
 		Timeout: applicationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -44,7 +44,7 @@ func waitApplicationStarted(conn *kinesisanalytics.KinesisAnalytics, name string
 		Timeout: applicationStartedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -62,7 +62,7 @@ func waitApplicationStopped(conn *kinesisanalytics.KinesisAnalytics, name string
 		Timeout: applicationStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -80,7 +80,7 @@ func waitApplicationUpdated(conn *kinesisanalytics.KinesisAnalytics, name string
 		Timeout: applicationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalytics.ApplicationDetail); ok {
 		return v, err
@@ -29,7 +29,7 @@ func waitApplicationDeleted(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name st
 		Timeout: applicationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -47,7 +47,7 @@ func waitApplicationStarted(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name st
 		Timeout: applicationStartedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -65,7 +65,7 @@ func waitApplicationStopped(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name st
 		Timeout: applicationStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -83,7 +83,7 @@ func waitApplicationUpdated(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name st
 		Timeout: applicationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.ApplicationDetail); ok {
 		return v, err
@@ -149,7 +149,7 @@ func waitSnapshotCreated(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicatio
 		Timeout: snapshotCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.SnapshotDetails); ok {
 		return v, err
@@ -167,7 +167,7 @@ func waitSnapshotDeleted(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicatio
 		Timeout: snapshotDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*kinesisanalyticsv2.SnapshotDetails); ok {
 		return v, err
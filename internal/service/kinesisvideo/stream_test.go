@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kinesisvideo"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,8 +19,8 @@ func TestAccKinesisVideoStream_basic(t *testing.T) {
 	var stream kinesisvideo.StreamInfo
 
 	resourceName := "aws_kinesis_video_stream.default"
-	rInt1 := sdkacctest.RandInt()
-	rInt2 := sdkacctest.RandInt()
+	rInt1 := acctest.RandInt(t)
+	rInt2 := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
@@ -61,9 +60,9 @@ func TestAccKinesisVideoStream_options(t *testing.T) {
 
 	resourceName := "aws_kinesis_video_stream.default"
 	kmsResourceName := "aws_kms_key.default"
-	rInt := sdkacctest.RandInt()
-	rName1 := sdkacctest.RandString(8)
-	rName2 := sdkacctest.RandString(8)
+	rInt := acctest.RandInt(t)
+	rName1 := acctest.RandString(t, 8)
+	rName2 := acctest.RandString(t, 8)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
@@ -105,7 +104,7 @@ func TestAccKinesisVideoStream_tags(t *testing.T) {
 	var stream kinesisvideo.StreamInfo
 
 	resourceName := "aws_kinesis_video_stream.default"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
@@ -151,7 +150,7 @@ func TestAccKinesisVideoStream_disappears(t *testing.T) {
 	var stream kinesisvideo.StreamInfo
 
 	resourceName := "aws_kinesis_video_stream.default"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(kinesisvideo.EndpointsID, t) },
@@ -173,7 +172,7 @@ func TestAccKinesisVideoStream_disappears(t *testing.T) {
 
 func testAccCheckKinesisVideoStreamDisappears(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoConn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -221,7 +220,7 @@ func testAccCheckKinesisVideoStreamExists(n string, stream *kinesisvideo.StreamI
 			return fmt.Errorf("No Kinesis ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoConn()
 		describeOpts := &kinesisvideo.DescribeStreamInput{
 			StreamARN: aws.String(rs.Primary.ID),
 		}
@@ -241,7 +240,7 @@ func testAccCheckKinesisVideoStreamDestroy(s *terraform.State) error {
 		if rs.Type != "aws_kinesis_video_stream" {
 			continue
 		}
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoConn()
 		describeOpts := &kinesisvideo.DescribeStreamInput{
 			StreamARN: aws.String(rs.Primary.ID),
 		}
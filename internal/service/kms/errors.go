@@ -0,0 +1,17 @@
+package kms
+
+import (
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// errorClassifier classifies the errors returned by the KMS API.
+var errorClassifier = tfresource.RegisterServiceErrors("kms", tfresource.ServiceErrors{
+	// Keys may not be found until their creation has propagated.
+	EventualConsistency: []tfresource.ErrorMatcher{
+		{Code: kms.ErrCodeNotFoundException},
+	},
+	NotFound: []tfresource.ErrorMatcher{
+		{Code: kms.ErrCodeNotFoundException},
+	},
+})
@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/kms"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
@@ -13,7 +12,7 @@ import (
 func TestAccKMSKeyDataSource_basic(t *testing.T) {
 	resourceName := "aws_kms_key.test"
 	dataSourceName := "data.aws_kms_key.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -47,7 +46,7 @@ func TestAccKMSKeyDataSource_basic(t *testing.T) {
 func TestAccKMSKeyDataSource_grantToken(t *testing.T) {
 	resourceName := "aws_kms_key.test"
 	dataSourceName := "data.aws_kms_key.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -81,7 +80,7 @@ func TestAccKMSKeyDataSource_grantToken(t *testing.T) {
 func TestAccKMSKeyDataSource_multiRegionConfiguration(t *testing.T) {
 	resourceName := "aws_kms_key.test"
 	dataSourceName := "data.aws_kms_key.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -3,11 +3,12 @@ package kms_test
 import (
 	"fmt"
 	"regexp"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/kms"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -49,7 +50,7 @@ func TestAccKMSKey_basic(t *testing.T) {
 
 func TestAccKMSKey_disappears(t *testing.T) {
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -72,7 +73,7 @@ func TestAccKMSKey_disappears(t *testing.T) {
 
 func TestAccKMSKey_multiRegion(t *testing.T) {
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -100,7 +101,7 @@ func TestAccKMSKey_multiRegion(t *testing.T) {
 
 func TestAccKMSKey_asymmetricKey(t *testing.T) {
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -123,7 +124,7 @@ func TestAccKMSKey_asymmetricKey(t *testing.T) {
 
 func TestAccKMSKey_policy(t *testing.T) {
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_key.test"
 	expectedPolicyText := `{"Version":"2012-10-17","Id":"kms-tf-1","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"*"},"Action":"kms:*","Resource":"*"}]}`
 
@@ -158,7 +159,7 @@ func TestAccKMSKey_policy(t *testing.T) {
 
 func TestAccKMSKey_policyBypass(t *testing.T) {
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -190,7 +191,7 @@ func TestAccKMSKey_policyBypass(t *testing.T) {
 
 func TestAccKMSKey_policyBypassUpdate(t *testing.T) {
 	var before, after kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -219,7 +220,7 @@ func TestAccKMSKey_policyBypassUpdate(t *testing.T) {
 
 func TestAccKMSKey_Policy_iamRole(t *testing.T) {
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -247,7 +248,7 @@ func TestAccKMSKey_Policy_iamRole(t *testing.T) {
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/7646
 func TestAccKMSKey_Policy_iamServiceLinkedRole(t *testing.T) {
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -274,7 +275,7 @@ func TestAccKMSKey_Policy_iamServiceLinkedRole(t *testing.T) {
 
 func TestAccKMSKey_isEnabled(t *testing.T) {
 	var key1, key2, key3 kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -319,7 +320,7 @@ func TestAccKMSKey_isEnabled(t *testing.T) {
 
 func TestAccKMSKey_tags(t *testing.T) {
 	var key kms.KeyMetadata
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -374,7 +375,7 @@ func testAccCheckKeyHasPolicy(name string, expectedPolicyText string) resource.T
 			return fmt.Errorf("No KMS Key ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn()
 
 		out, err := conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
 			KeyId:      aws.String(rs.Primary.ID),
@@ -400,7 +401,7 @@ func testAccCheckKeyHasPolicy(name string, expectedPolicyText string) resource.T
 }
 
 func testAccCheckKeyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kms_key" {
@@ -434,7 +435,7 @@ func testAccCheckKeyExists(name string, key *kms.KeyMetadata) resource.TestCheck
 			return fmt.Errorf("No KMS Key ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn()
 
 		outputRaw, err := tfresource.RetryWhenNotFound(tfkms.PropagationTimeout, func() (interface{}, error) {
 			return tfkms.FindKeyByID(conn, rs.Primary.ID)
@@ -729,3 +730,214 @@ resource "aws_kms_key" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func TestKMSKeyOffline_basic(t *testing.T) {
+	s := acctest.NewStubServer(t)
+	keys := testStubKeys(s)
+	kmsService := s.Service("kms", acctest.StubProtocolJSON)
+	resourceName := "aws_kms_key.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheckTerraformCLI(t) },
+		ProviderFactories: s.ProviderFactories(),
+		CheckDestroy:      testStubCheckKeysDestroyed(keys),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyOfflineConfig("Name", "tf-test-key"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Terraform acc test"),
+					resource.TestCheckResourceAttr(resourceName, "enable_key_rotation", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", kms.KeyUsageTypeEncryptDecrypt),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "tf-test-key"),
+					// The key policy is not yet valid and the new key is not found on the first attempts.
+					acctest.CheckStubCalls(kmsService, map[string]int{"CreateKey": 2, "TagResource": 0}),
+				),
+			},
+			{
+				Config: testAccKeyOfflineConfig("Environment", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKeyOfflineConfig(tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description = "Terraform acc test"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey, tagValue)
+}
+
+func testStubCheckKeysDestroyed(k *testStubKeyStore) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		k.lock.Lock()
+		defer k.lock.Unlock()
+
+		for keyID, key := range k.keys {
+			if state := aws.StringValue(key.KeyState); state != kms.KeyStatePendingDeletion {
+				return fmt.Errorf("KMS Key %s still exists in state %s", keyID, state)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testStubKeyStore stands in for the KMS keys of an account.
+type testStubKeyStore struct {
+	lock     sync.Mutex
+	keys     map[string]*kms.KeyMetadata
+	notFound map[string]bool
+	tags     map[string]map[string]string
+}
+
+func (k *testStubKeyStore) handle(f func(key *kms.KeyMetadata, r *acctest.StubRequest) acctest.StubResponse) acctest.StubHandler {
+	return func(r *acctest.StubRequest) acctest.StubResponse {
+		k.lock.Lock()
+		defer k.lock.Unlock()
+
+		var input struct {
+			KeyId *string
+		}
+
+		if err := r.DecodeJSON(&input); err != nil {
+			return acctest.StubErrorResponse("SerializationException", err.Error())
+		}
+
+		keyID := aws.StringValue(input.KeyId)
+		key, ok := k.keys[keyID]
+
+		if !ok || k.notFound[keyID] {
+			delete(k.notFound, keyID)
+
+			return acctest.StubErrorResponse(kms.ErrCodeNotFoundException, fmt.Sprintf("Key '%s' does not exist", keyID))
+		}
+
+		return f(key, r)
+	}
+}
+
+func testStubKeys(s *acctest.StubServer) *testStubKeyStore {
+	k := &testStubKeyStore{
+		keys:     make(map[string]*kms.KeyMetadata),
+		notFound: make(map[string]bool),
+		tags:     make(map[string]map[string]string),
+	}
+
+	var createKeyCalls int
+
+	s.Service("kms", acctest.StubProtocolJSON).
+		Handle("CreateKey", func(r *acctest.StubRequest) acctest.StubResponse {
+			k.lock.Lock()
+			defer k.lock.Unlock()
+
+			// Principals in the key policy are not yet known to KMS.
+			if createKeyCalls++; createKeyCalls == 1 {
+				return acctest.StubErrorResponse(kms.ErrCodeMalformedPolicyDocumentException, "Policy contains a statement with one or more invalid principals.")
+			}
+
+			var input kms.CreateKeyInput
+
+			if err := r.DecodeJSON(&input); err != nil {
+				return acctest.StubErrorResponse("SerializationException", err.Error())
+			}
+
+			keyID := fmt.Sprintf("%08d-0000-0000-0000-000000000000", len(k.keys)+1)
+			key := &kms.KeyMetadata{
+				Arn:                   aws.String(arn.ARN{Partition: "aws", Service: "kms", Region: acctest.StubRegion, AccountID: acctest.StubAccountID, Resource: "key/" + keyID}.String()),
+				CustomerMasterKeySpec: input.CustomerMasterKeySpec,
+				Description:           input.Description,
+				Enabled:               aws.Bool(true),
+				KeyId:                 aws.String(keyID),
+				KeyState:              aws.String(kms.KeyStateEnabled),
+				KeyUsage:              input.KeyUsage,
+				MultiRegion:           aws.Bool(false),
+				Origin:                aws.String(kms.OriginTypeAwsKms),
+			}
+
+			k.keys[keyID] = key
+			k.notFound[keyID] = true
+			k.tags[keyID] = make(map[string]string)
+
+			for _, tag := range input.Tags {
+				k.tags[keyID][aws.StringValue(tag.TagKey)] = aws.StringValue(tag.TagValue)
+			}
+
+			return acctest.StubResponse{Output: &kms.CreateKeyOutput{KeyMetadata: key}}
+		}).
+		Handle("DescribeKey", k.handle(func(key *kms.KeyMetadata, r *acctest.StubRequest) acctest.StubResponse {
+			return acctest.StubResponse{Output: &kms.DescribeKeyOutput{KeyMetadata: key}}
+		})).
+		Handle("GetKeyPolicy", k.handle(func(key *kms.KeyMetadata, r *acctest.StubRequest) acctest.StubResponse {
+			policy := fmt.Sprintf(`{"Version":"2012-10-17","Id":"key-default-1","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:%s:iam::%s:root"},"Action":"kms:*","Resource":"*"}]}`, "aws", acctest.StubAccountID)
+
+			return acctest.StubResponse{Output: &kms.GetKeyPolicyOutput{Policy: aws.String(policy)}}
+		})).
+		Handle("GetKeyRotationStatus", k.handle(func(key *kms.KeyMetadata, r *acctest.StubRequest) acctest.StubResponse {
+			return acctest.StubResponse{Output: &kms.GetKeyRotationStatusOutput{KeyRotationEnabled: aws.Bool(false)}}
+		})).
+		Handle("ListResourceTags", k.handle(func(key *kms.KeyMetadata, r *acctest.StubRequest) acctest.StubResponse {
+			var tags []*kms.Tag
+
+			for k, v := range k.tags[aws.StringValue(key.KeyId)] {
+				tags = append(tags, &kms.Tag{TagKey: aws.String(k), TagValue: aws.String(v)})
+			}
+
+			return acctest.StubResponse{Output: &kms.ListResourceTagsOutput{Tags: tags}}
+		})).
+		Handle("ScheduleKeyDeletion", k.handle(func(key *kms.KeyMetadata, r *acctest.StubRequest) acctest.StubResponse {
+			key.KeyState = aws.String(kms.KeyStatePendingDeletion)
+
+			return acctest.StubResponse{Output: &kms.ScheduleKeyDeletionOutput{KeyId: key.KeyId}}
+		})).
+		Handle("TagResource", k.handle(func(key *kms.KeyMetadata, r *acctest.StubRequest) acctest.StubResponse {
+			var input kms.TagResourceInput
+
+			if err := r.DecodeJSON(&input); err != nil {
+				return acctest.StubErrorResponse("SerializationException", err.Error())
+			}
+
+			for _, tag := range input.Tags {
+				k.tags[aws.StringValue(key.KeyId)][aws.StringValue(tag.TagKey)] = aws.StringValue(tag.TagValue)
+			}
+
+			return acctest.StubResponse{}
+		})).
+		Handle("UntagResource", k.handle(func(key *kms.KeyMetadata, r *acctest.StubRequest) acctest.StubResponse {
+			var input kms.UntagResourceInput
+
+			if err := r.DecodeJSON(&input); err != nil {
+				return acctest.StubErrorResponse("SerializationException", err.Error())
+			}
+
+			for _, tagKey := range input.TagKeys {
+				delete(k.tags[aws.StringValue(key.KeyId)], aws.StringValue(tagKey))
+			}
+
+			return acctest.StubResponse{}
+		})).
+		Handle("UpdateKeyDescription", k.handle(func(key *kms.KeyMetadata, r *acctest.StubRequest) acctest.StubResponse {
+			var input kms.UpdateKeyDescriptionInput
+
+			if err := r.DecodeJSON(&input); err != nil {
+				return acctest.StubErrorResponse("SerializationException", err.Error())
+			}
+
+			key.Description = input.Description
+
+			return acctest.StubResponse{}
+		}))
+
+	return k
+}
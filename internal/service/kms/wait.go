@@ -5,11 +5,11 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
@@ -46,7 +46,7 @@ func WaitKeyDeleted(conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
 		Timeout: KeyDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
 		return output, err
@@ -85,7 +85,7 @@ func WaitKeyMaterialImported(conn *kms.KMS, id string) (*kms.KeyMetadata, error)
 		Timeout: KeyMaterialImportedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
 		return output, err
@@ -106,6 +106,10 @@ func WaitKeyPolicyPropagated(conn *kms.KMS, id, policy string) error {
 			return false, err
 		}
 
+		if verify.PoliciesAreEquivalent(aws.StringValue(output), policy) {
+			return true, nil
+		}
+
 		equivalent, err := awspolicy.PoliciesAreEquivalent(aws.StringValue(output), policy)
 
 		if err != nil {
@@ -196,7 +200,7 @@ func WaitTagsPropagated(conn *kms.KMS, id string, tags tftags.KeyValueTags) erro
 	checkFunc := func() (bool, error) {
 		output, err := ListTags(conn, id)
 
-		if tfawserr.ErrCodeEquals(err, kms.ErrCodeNotFoundException) {
+		if errorClassifier.IsNotFoundError(err, kms.ErrCodeNotFoundException) {
 			return false, nil
 		}
 
@@ -222,7 +226,7 @@ func WaitReplicaExternalKeyCreated(conn *kms.KMS, id string) (*kms.KeyMetadata,
 		Timeout: ReplicaExternalKeyCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
 		return output, err
@@ -239,7 +243,7 @@ func WaitReplicaKeyCreated(conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
 		Timeout: ReplicaKeyCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
 		return output, err
@@ -107,7 +107,7 @@ func ResourceDataLakeSettings() *schema.Resource {
 }
 
 func resourceDataLakeSettingsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LakeFormationConn
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
 
 	input := &lakeformation.PutDataLakeSettingsInput{}
 
@@ -170,7 +170,7 @@ func resourceDataLakeSettingsCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LakeFormationConn
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
 
 	input := &lakeformation.GetDataLakeSettingsInput{}
 
@@ -205,7 +205,7 @@ func resourceDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceDataLakeSettingsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LakeFormationConn
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
 
 	input := &lakeformation.PutDataLakeSettingsInput{
 		DataLakeSettings: &lakeformation.DataLakeSettings{
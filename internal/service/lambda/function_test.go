@@ -14,7 +14,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/signer"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -37,7 +36,7 @@ func TestAccLambdaFunction_basic(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 	resourceName := "aws_lambda_function.test"
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
@@ -83,7 +82,7 @@ func TestAccLambdaFunction_unpublishedCodeUpdate(t *testing.T) {
 	}
 	defer os.Remove(updatedFilename)
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_versioned_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_versioned_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_versioned_%s", rString)
@@ -136,7 +135,7 @@ func TestAccLambdaFunction_unpublishedCodeUpdate(t *testing.T) {
 func TestAccLambdaFunction_disappears(t *testing.T) {
 	var function lambda.GetFunctionOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -169,7 +168,7 @@ func TestAccLambdaFunction_codeSigning(t *testing.T) {
 	}
 
 	var conf lambda.GetFunctionOutput
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_csc_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_csc_%s", rString)
 	resourceName := "aws_lambda_function.test"
@@ -228,7 +227,7 @@ func TestAccLambdaFunction_codeSigning(t *testing.T) {
 func TestAccLambdaFunction_concurrency(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_concurrency_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_concurrency_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_concurrency_%s", rString)
@@ -272,7 +271,7 @@ func TestAccLambdaFunction_concurrency(t *testing.T) {
 func TestAccLambdaFunction_concurrencyCycle(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_concurrency_cycle_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_concurrency_cycle_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_concurrency_cycle_%s", rString)
@@ -323,7 +322,7 @@ func TestAccLambdaFunction_concurrencyCycle(t *testing.T) {
 }
 
 func TestAccLambdaFunction_expectFilenameAndS3Attributes(t *testing.T) {
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_expect_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_expect_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_expect_%s", rString)
@@ -346,7 +345,7 @@ func TestAccLambdaFunction_expectFilenameAndS3Attributes(t *testing.T) {
 func TestAccLambdaFunction_envVariables(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_env_vars_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_env_vars_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_env_vars_%s", rString)
@@ -408,7 +407,7 @@ func TestAccLambdaFunction_envVariables(t *testing.T) {
 
 func TestAccLambdaFunction_EnvironmentVariables_noValue(t *testing.T) {
 	var conf lambda.GetFunctionOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -437,7 +436,7 @@ func TestAccLambdaFunction_EnvironmentVariables_noValue(t *testing.T) {
 func TestAccLambdaFunction_encryptedEnvVariables(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	keyDesc := fmt.Sprintf("tf_acc_key_lambda_func_encrypted_env_%s", rString)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_encrypted_env_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_encrypted_env_%s", rString)
@@ -484,7 +483,7 @@ func TestAccLambdaFunction_encryptedEnvVariables(t *testing.T) {
 func TestAccLambdaFunction_versioned(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_versioned_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_versioned_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_versioned_%s", rString)
@@ -528,7 +527,7 @@ func TestAccLambdaFunction_versionedUpdate(t *testing.T) {
 	}
 	defer os.Remove(path)
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_versioned_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_versioned_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_versioned_%s", rString)
@@ -604,7 +603,7 @@ func TestAccLambdaFunction_versionedUpdate(t *testing.T) {
 func TestAccLambdaFunction_enablePublish(t *testing.T) {
 	var conf1, conf2, conf3 lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_enable_publish_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_enable_publish_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_enable_publish_%s", rString)
@@ -668,7 +667,7 @@ func TestAccLambdaFunction_enablePublish(t *testing.T) {
 func TestAccLambdaFunction_disablePublish(t *testing.T) {
 	var conf1, conf2 lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_disable_publish_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_disable_publish_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_disable_publish_%s", rString)
@@ -721,7 +720,7 @@ func TestAccLambdaFunction_disablePublish(t *testing.T) {
 func TestAccLambdaFunction_deadLetter(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_dlconfig_%s", rString)
 	topicName := fmt.Sprintf("tf_acc_topic_lambda_func_dlconfig_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_dlconfig_%s", rString)
@@ -772,7 +771,7 @@ func TestAccLambdaFunction_deadLetter(t *testing.T) {
 func TestAccLambdaFunction_deadLetterUpdated(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_dlcfg_upd_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_dlcfg_upd_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_dlcfg_upd_%s", rString)
@@ -815,7 +814,7 @@ func TestAccLambdaFunction_deadLetterUpdated(t *testing.T) {
 }
 
 func TestAccLambdaFunction_nilDeadLetter(t *testing.T) {
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_nil_dlcfg_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_nil_dlcfg_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_nil_dlcfg_%s", rString)
@@ -840,7 +839,7 @@ func TestAccLambdaFunction_fileSystem(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 	resourceName := "aws_lambda_function.test"
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
@@ -906,7 +905,7 @@ func TestAccLambdaFunction_image(t *testing.T) {
 	imageV1ID := os.Getenv("AWS_LAMBDA_IMAGE_V1_ID")
 	imageV2ID := os.Getenv("AWS_LAMBDA_IMAGE_V2_ID")
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
@@ -968,7 +967,7 @@ func TestAccLambdaFunction_architectures(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 	resourceName := "aws_lambda_function.test"
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
@@ -1021,7 +1020,7 @@ func TestAccLambdaFunction_architecturesUpdate(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 	resourceName := "aws_lambda_function.test"
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
@@ -1074,7 +1073,7 @@ func TestAccLambdaFunction_architecturesWithLayer(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 	resourceName := "aws_lambda_function.test"
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
 	layerName := fmt.Sprintf("tf_acc_lambda_layer_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
@@ -1130,7 +1129,7 @@ func TestAccLambdaFunction_tracing(t *testing.T) {
 	}
 
 	var conf lambda.GetFunctionOutput
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_tracing_cfg_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_tracing_cfg_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_tracing_cfg_%s", rString)
@@ -1178,7 +1177,7 @@ func TestAccLambdaFunction_tracing(t *testing.T) {
 func TestAccLambdaFunction_KMSKeyARN_noEnvironmentVariables(t *testing.T) {
 	var function1 lambda.GetFunctionOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1208,7 +1207,7 @@ func TestAccLambdaFunction_KMSKeyARN_noEnvironmentVariables(t *testing.T) {
 func TestAccLambdaFunction_layers(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_layer_%s", rString)
 	layerName := fmt.Sprintf("tf_acc_layer_lambda_func_layer_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_layer_%s", rString)
@@ -1245,7 +1244,7 @@ func TestAccLambdaFunction_layers(t *testing.T) {
 func TestAccLambdaFunction_layersUpdate(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_layer_%s", rString)
 	layerName := fmt.Sprintf("tf_acc_lambda_layer_%s", rString)
 	layer2Name := fmt.Sprintf("tf_acc_lambda_layer2_%s", rString)
@@ -1293,7 +1292,7 @@ func TestAccLambdaFunction_layersUpdate(t *testing.T) {
 func TestAccLambdaFunction_vpc(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_vpc_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_vpc_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_vpc_%s", rString)
@@ -1332,7 +1331,7 @@ func TestAccLambdaFunction_vpc(t *testing.T) {
 func TestAccLambdaFunction_vpcRemoval(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -1368,7 +1367,7 @@ func TestAccLambdaFunction_vpcRemoval(t *testing.T) {
 func TestAccLambdaFunction_vpcUpdate(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_vpc_upd_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_vpc_upd_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_vpc_upd_%s", rString)
@@ -1421,7 +1420,7 @@ func TestAccLambdaFunction_vpcUpdate(t *testing.T) {
 func TestAccLambdaFunction_VPC_withInvocation(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_vpc_w_invc_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_vpc_w_invc_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_vpc_w_invc_%s", rString)
@@ -1456,7 +1455,7 @@ func TestAccLambdaFunction_VPC_withInvocation(t *testing.T) {
 func TestAccLambdaFunction_VPCPublishNo_changes(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_vpc_w_invc_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_vpc_w_invc_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_vpc_w_invc_%s", rString)
@@ -1497,7 +1496,7 @@ func TestAccLambdaFunction_VPCPublishNo_changes(t *testing.T) {
 func TestAccLambdaFunction_VPCPublishHas_changes(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_vpc_w_invc_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_vpc_w_invc_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_vpc_w_invc_%s", rString)
@@ -1539,7 +1538,7 @@ func TestAccLambdaFunction_VPCPublishHas_changes(t *testing.T) {
 func TestAccLambdaFunction_VPC_properIAMDependencies(t *testing.T) {
 	var function lambda.GetFunctionOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_lambda_function.test"
 	vpcResourceName := "aws_vpc.test"
 
@@ -1566,7 +1565,7 @@ func TestAccLambdaFunction_VPC_properIAMDependencies(t *testing.T) {
 func TestAccLambdaFunction_emptyVPC(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_empty_vpc_config_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_empty_vpc_config_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_empty_vpc_config_%s", rString)
@@ -1599,7 +1598,7 @@ func TestAccLambdaFunction_emptyVPC(t *testing.T) {
 func TestAccLambdaFunction_s3(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	bucketName := fmt.Sprintf("tf-acc-bucket-lambda-func-s3-%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_s3_%s", rString)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_s3_%s", rString)
@@ -1639,7 +1638,7 @@ func TestAccLambdaFunction_localUpdate(t *testing.T) {
 	}
 	defer os.Remove(path)
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_local_upd_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_local_upd_%s", rString)
 	resourceName := "aws_lambda_function.test"
@@ -1697,7 +1696,7 @@ func TestAccLambdaFunction_localUpdate(t *testing.T) {
 func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_local_upd_name_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_local_upd_name_%s", rString)
 	resourceName := "aws_lambda_function.test"
@@ -1767,7 +1766,7 @@ func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 	}
 	defer os.Remove(path)
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	bucketName := fmt.Sprintf("tf-acc-bucket-lambda-func-s3-upd-basic-%s", rString)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_s3_upd_basic_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_s3_upd_basic_%s", rString)
@@ -1830,7 +1829,7 @@ func TestAccLambdaFunction_S3Update_unversioned(t *testing.T) {
 	}
 	defer os.Remove(path)
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	bucketName := fmt.Sprintf("tf-acc-bucket-lambda-func-s3-upd-unver-%s", rString)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_s3_upd_unver_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_s3_upd_unver_%s", rString)
@@ -1887,7 +1886,7 @@ func TestAccLambdaFunction_S3Update_unversioned(t *testing.T) {
 func TestAccLambdaFunction_tags(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	resourceName := "aws_lambda_function.test"
 	funcName := fmt.Sprintf("tf_acc_lambda_func_tags_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_tags_%s", rString)
@@ -1945,7 +1944,7 @@ func TestAccLambdaFunction_tags(t *testing.T) {
 func TestAccLambdaFunction_runtimes(t *testing.T) {
 	var v lambda.GetFunctionOutput
 	resourceName := "aws_lambda_function.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	steps := []resource.TestStep{
 		{
@@ -1998,7 +1997,7 @@ func TestAccLambdaFunction_runtimes(t *testing.T) {
 }
 
 func TestAccLambdaFunction_Zip_validation(t *testing.T) {
-	rString := sdkacctest.RandString(8)
+	rString := acctest.RandString(t, 8)
 	funcName := fmt.Sprintf("tf_acc_lambda_func_expect_%s", rString)
 	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_expect_%s", rString)
 	roleName := fmt.Sprintf("tf_acc_role_lambda_func_expect_%s", rString)
@@ -2023,7 +2022,7 @@ func TestAccLambdaFunction_Zip_validation(t *testing.T) {
 }
 
 func testAccCheckLambdaFunctionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
@@ -2045,7 +2044,7 @@ func testAccCheckLambdaFunctionDestroy(s *terraform.State) error {
 
 func testAccCheckFunctionDisappears(function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		input := &lambda.DeleteFunctionInput{
 			FunctionName: function.Configuration.FunctionName,
@@ -2069,7 +2068,7 @@ func testAccCheckFunctionExists(res, funcName string, function *lambda.GetFuncti
 			return fmt.Errorf("Lambda function ID not set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		params := &lambda.GetFunctionInput{
 			FunctionName: aws.String(funcName),
@@ -2096,7 +2095,7 @@ func testAccCheckFunctionInvokeARN(name string, function *lambda.GetFunctionOutp
 func testAccInvokeFunction(function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		f := function.Configuration
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		// If the function is VPC-enabled this will create ENI automatically
 		_, err := conn.Invoke(&lambda.InvokeInput{
@@ -3420,7 +3419,7 @@ func TestFlattenLambdaImageConfigShouldNotFailWithEmptyImageConfig(t *testing.T)
 }
 
 func testAccPreCheckSingerSigningProfile(t *testing.T, platformID string) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn()
 
 	input := &signer.ListSigningPlatformsInput{}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceProvisionedConcurrencyConfig() *schema.Resource {
@@ -52,7 +53,7 @@ func ResourceProvisionedConcurrencyConfig() *schema.Resource {
 }
 
 func resourceProvisionedConcurrencyConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 	functionName := d.Get("function_name").(string)
 	qualifier := d.Get("qualifier").(string)
 
@@ -78,7 +79,7 @@ func resourceProvisionedConcurrencyConfigCreate(d *schema.ResourceData, meta int
 }
 
 func resourceProvisionedConcurrencyConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	functionName, qualifier, err := ProvisionedConcurrencyConfigParseID(d.Id())
 
@@ -111,7 +112,7 @@ func resourceProvisionedConcurrencyConfigRead(d *schema.ResourceData, meta inter
 }
 
 func resourceProvisionedConcurrencyConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	functionName, qualifier, err := ProvisionedConcurrencyConfigParseID(d.Id())
 
@@ -139,7 +140,7 @@ func resourceProvisionedConcurrencyConfigUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceProvisionedConcurrencyConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	functionName, qualifier, err := ProvisionedConcurrencyConfigParseID(d.Id())
 
@@ -207,7 +208,7 @@ func waitForLambdaProvisionedConcurrencyConfigStatusReady(conn *lambda.Lambda, f
 		Delay:   5 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -35,7 +36,7 @@ func waitEventSourceMappingCreate(conn *lambda.Lambda, id string) (*lambda.Event
 		Timeout: eventSourceMappingCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*lambda.EventSourceMappingConfiguration); ok {
 		return output, err
@@ -52,7 +53,7 @@ func waitEventSourceMappingDelete(conn *lambda.Lambda, id string) (*lambda.Event
 		Timeout: eventSourceMappingDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*lambda.EventSourceMappingConfiguration); ok {
 		return output, err
@@ -76,7 +77,7 @@ func waitEventSourceMappingUpdate(conn *lambda.Lambda, id string) (*lambda.Event
 		Timeout: eventSourceMappingUpdateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*lambda.EventSourceMappingConfiguration); ok {
 		return output, err
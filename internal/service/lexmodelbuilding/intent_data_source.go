@@ -64,7 +64,7 @@ func DataSourceIntent() *schema.Resource {
 }
 
 func dataSourceIntentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelBuildingConn
+	conn := meta.(*conns.AWSClient).LexModelBuildingConn()
 
 	intentName := d.Get("name").(string)
 	resp, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
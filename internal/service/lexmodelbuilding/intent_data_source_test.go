@@ -10,7 +10,7 @@ import (
 )
 
 func TestAccLexModelBuildingIntentDataSource_basic(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 	dataSourceName := "data.aws_lex_intent.test"
 	resourceName := "aws_lex_intent.test"
 
@@ -42,7 +42,7 @@ func TestAccLexModelBuildingIntentDataSource_basic(t *testing.T) {
 }
 
 func TestAccLexModelBuildingIntentDataSource_withVersion(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 	dataSourceName := "data.aws_lex_intent.test"
 	resourceName := "aws_lex_intent.test"
 
@@ -20,7 +20,7 @@ import (
 func TestAccLexModelBuildingIntent_basic(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -69,7 +69,7 @@ func TestAccLexModelBuildingIntent_basic(t *testing.T) {
 func TestAccLexModelBuildingIntent_createVersion(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -114,7 +114,7 @@ func TestAccLexModelBuildingIntent_createVersion(t *testing.T) {
 func TestAccLexModelBuildingIntent_conclusionStatement(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -170,7 +170,7 @@ func TestAccLexModelBuildingIntent_conclusionStatement(t *testing.T) {
 func TestAccLexModelBuildingIntent_confirmationPromptAndRejectionStatement(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -236,7 +236,7 @@ func TestAccLexModelBuildingIntent_confirmationPromptAndRejectionStatement(t *te
 func TestAccLexModelBuildingIntent_dialogCodeHook(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -272,7 +272,7 @@ func TestAccLexModelBuildingIntent_dialogCodeHook(t *testing.T) {
 func TestAccLexModelBuildingIntent_followUpPrompt(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -344,7 +344,7 @@ func TestAccLexModelBuildingIntent_followUpPrompt(t *testing.T) {
 func TestAccLexModelBuildingIntent_fulfillmentActivity(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -382,7 +382,7 @@ func TestAccLexModelBuildingIntent_fulfillmentActivity(t *testing.T) {
 func TestAccLexModelBuildingIntent_sampleUtterances(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -427,7 +427,7 @@ func TestAccLexModelBuildingIntent_sampleUtterances(t *testing.T) {
 func TestAccLexModelBuildingIntent_slots(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -483,7 +483,7 @@ func TestAccLexModelBuildingIntent_slots(t *testing.T) {
 func TestAccLexModelBuildingIntent_slotsCustom(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -530,7 +530,7 @@ func TestAccLexModelBuildingIntent_slotsCustom(t *testing.T) {
 func TestAccLexModelBuildingIntent_disappears(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -556,11 +556,11 @@ func TestAccLexModelBuildingIntent_disappears(t *testing.T) {
 func TestAccLexModelBuildingIntent_updateWithExternalChange(t *testing.T) {
 	var v lexmodelbuildingservice.GetIntentOutput
 	rName := "aws_lex_intent.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	testAccCheckAWSLexIntentUpdateDescription := func(provider *schema.Provider, _ *schema.Resource, resourceName string) resource.TestCheckFunc {
 		return func(s *terraform.State) error {
-			conn := provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+			conn := provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 			resourceState, ok := s.RootModule().Resources[resourceName]
 			if !ok {
@@ -628,7 +628,7 @@ func TestAccLexModelBuildingIntent_computeVersion(t *testing.T) {
 
 	intentResourceName := "aws_lex_intent.test"
 	botResourceName := "aws_lex_bot.test"
-	testIntentID := "test_intent_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testIntentID := "test_intent_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	version := "1"
 	updatedVersion := "2"
@@ -688,7 +688,7 @@ func testAccCheckIntentExistsWithVersion(rName, intentVersion string, output *le
 		}
 
 		var err error
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 		output, err = conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
 			Name:    aws.String(rs.Primary.ID),
@@ -711,7 +711,7 @@ func testAccCheckIntentExists(rName string, output *lexmodelbuildingservice.GetI
 
 func testAccCheckIntentNotExists(intentName, intentVersion string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 		_, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
 			Name:    aws.String(intentName),
@@ -729,7 +729,7 @@ func testAccCheckIntentNotExists(intentName, intentVersion string) resource.Test
 }
 
 func testAccCheckIntentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lex_intent" {
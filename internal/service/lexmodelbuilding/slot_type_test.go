@@ -18,7 +18,7 @@ import (
 func TestAccLexModelBuildingSlotType_basic(t *testing.T) {
 	var v lexmodelbuildingservice.GetSlotTypeOutput
 	rName := "aws_lex_slot_type.test"
-	testSlotTypeID := "test_slot_type_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testSlotTypeID := "test_slot_type_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -63,7 +63,7 @@ func TestAccLexModelBuildingSlotType_basic(t *testing.T) {
 func TestAccLexModelBuildingSlotType_createVersion(t *testing.T) {
 	var v lexmodelbuildingservice.GetSlotTypeOutput
 	rName := "aws_lex_slot_type.test"
-	testSlotTypeID := "test_slot_type_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testSlotTypeID := "test_slot_type_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -109,7 +109,7 @@ func TestAccLexModelBuildingSlotType_createVersion(t *testing.T) {
 func TestAccLexModelBuildingSlotType_description(t *testing.T) {
 	var v lexmodelbuildingservice.GetSlotTypeOutput
 	rName := "aws_lex_slot_type.test"
-	testSlotTypeID := "test_slot_type_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testSlotTypeID := "test_slot_type_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -153,7 +153,7 @@ func TestAccLexModelBuildingSlotType_description(t *testing.T) {
 func TestAccLexModelBuildingSlotType_enumerationValues(t *testing.T) {
 	var v lexmodelbuildingservice.GetSlotTypeOutput
 	rName := "aws_lex_slot_type.test"
-	testSlotTypeID := "test_slot_type_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testSlotTypeID := "test_slot_type_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -202,8 +202,8 @@ func TestAccLexModelBuildingSlotType_enumerationValues(t *testing.T) {
 func TestAccLexModelBuildingSlotType_name(t *testing.T) {
 	var v lexmodelbuildingservice.GetSlotTypeOutput
 	rName := "aws_lex_slot_type.test"
-	testSlotTypeID1 := "test_slot_type_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
-	testSlotTypeID2 := "test_slot_type_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testSlotTypeID1 := "test_slot_type_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
+	testSlotTypeID2 := "test_slot_type_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -247,7 +247,7 @@ func TestAccLexModelBuildingSlotType_name(t *testing.T) {
 func TestAccLexModelBuildingSlotType_valueSelectionStrategy(t *testing.T) {
 	var v lexmodelbuildingservice.GetSlotTypeOutput
 	rName := "aws_lex_slot_type.test"
-	testSlotTypeID := "test_slot_type_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testSlotTypeID := "test_slot_type_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -291,7 +291,7 @@ func TestAccLexModelBuildingSlotType_valueSelectionStrategy(t *testing.T) {
 func TestAccLexModelBuildingSlotType_disappears(t *testing.T) {
 	var v lexmodelbuildingservice.GetSlotTypeOutput
 	rName := "aws_lex_slot_type.test"
-	testSlotTypeID := "test_slot_type_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testSlotTypeID := "test_slot_type_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -320,7 +320,7 @@ func TestAccLexModelBuildingSlotType_computeVersion(t *testing.T) {
 
 	slotTypeResourceName := "aws_lex_slot_type.test"
 	intentResourceName := "aws_lex_intent.test"
-	testSlotTypeID := "test_slot_type_" + sdkacctest.RandStringFromCharSet(8, sdkacctest.CharSetAlpha)
+	testSlotTypeID := "test_slot_type_" + acctest.RandStringFromCharSet(t, 8, sdkacctest.CharSetAlpha)
 
 	version := "1"
 	updatedVersion := "2"
@@ -381,7 +381,7 @@ func testAccCheckSlotTypeExistsWithVersion(rName, slotTypeVersion string, v *lex
 			return fmt.Errorf("No Lex Slot Type ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 		output, err := tflexmodelbuilding.FindSlotTypeVersionByName(conn, rs.Primary.ID, slotTypeVersion)
 
@@ -401,7 +401,7 @@ func testAccCheckSlotTypeExists(rName string, output *lexmodelbuildingservice.Ge
 
 func testAccCheckSlotTypeNotExists(slotTypeName, slotTypeVersion string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 		_, err := tflexmodelbuilding.FindSlotTypeVersionByName(conn, slotTypeName, slotTypeVersion)
 
@@ -418,7 +418,7 @@ func testAccCheckSlotTypeNotExists(slotTypeName, slotTypeVersion string) resourc
 }
 
 func testAccCheckSlotTypeDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lex_slot_type" {
@@ -28,7 +28,7 @@ func waitBotVersionCreated(conn *lexmodelbuildingservice.LexModelBuildingService
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateChangeConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateChangeConf)
 
 	if output, ok := outputRaw.(*lexmodelbuildingservice.GetBotOutput); ok {
 		if status := aws.StringValue(output.Status); status == lexmodelbuildingservice.StatusFailed {
@@ -53,7 +53,7 @@ func waitBotDeleted(conn *lexmodelbuildingservice.LexModelBuildingService, name
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateChangeConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateChangeConf)
 
 	if output, ok := outputRaw.(*lexmodelbuildingservice.GetBotOutput); ok {
 		if status := aws.StringValue(output.Status); status == lexmodelbuildingservice.StatusFailed {
@@ -73,7 +73,7 @@ func waitLexBotAliasDeleted(conn *lexmodelbuildingservice.LexModelBuildingServic
 		Refresh: statusLexBotAlias(conn, botAliasName, botName),
 		Timeout: lexBotAliasDeletedTimeout,
 	}
-	outputRaw, err := stateChangeConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateChangeConf)
 
 	if v, ok := outputRaw.(*lexmodelbuildingservice.GetBotAliasOutput); ok {
 		return v, err
@@ -89,7 +89,7 @@ func waitLexIntentDeleted(conn *lexmodelbuildingservice.LexModelBuildingService,
 		Refresh: statusLexIntent(conn, intentId),
 		Timeout: lexIntentDeletedTimeout,
 	}
-	outputRaw, err := stateChangeConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateChangeConf)
 
 	if v, ok := outputRaw.(*lexmodelbuildingservice.GetIntentVersionsOutput); ok {
 		return v, err
@@ -105,7 +105,7 @@ func waitLexSlotTypeDeleted(conn *lexmodelbuildingservice.LexModelBuildingServic
 		Refresh: statusLexSlotType(conn, name, SlotTypeVersionLatest),
 		Timeout: lexSlotTypeDeletedTimeout,
 	}
-	outputRaw, err := stateChangeConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateChangeConf)
 
 	if v, ok := outputRaw.(*lexmodelbuildingservice.GetSlotTypeOutput); ok {
 		return v, err
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lightsail"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 
 func TestAccLightsailInstance_basic(t *testing.T) {
 	var conf lightsail.Instance
-	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt(t))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -51,7 +50,7 @@ func TestAccLightsailInstance_basic(t *testing.T) {
 
 func TestAccLightsailInstance_name(t *testing.T) {
 	var conf lightsail.Instance
-	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt(t))
 	lightsailNameWithSpaces := fmt.Sprint(lightsailName, "string with spaces")
 	lightsailNameWithStartingDigit := fmt.Sprintf("01-%s", lightsailName)
 	lightsailNameWithUnderscore := fmt.Sprintf("%s_123456", lightsailName)
@@ -100,7 +99,7 @@ func TestAccLightsailInstance_name(t *testing.T) {
 
 func TestAccLightsailInstance_tags(t *testing.T) {
 	var conf lightsail.Instance
-	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt(t))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -140,11 +139,11 @@ func TestAccLightsailInstance_tags(t *testing.T) {
 
 func TestAccLightsailInstance_disappears(t *testing.T) {
 	var conf lightsail.Instance
-	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", sdkacctest.RandInt())
+	lightsailName := fmt.Sprintf("tf-test-lightsail-%d", acctest.RandInt(t))
 
 	testDestroy := func(*terraform.State) error {
 		// reach out and DELETE the Instance
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn()
 		_, err := conn.DeleteInstance(&lightsail.DeleteInstanceInput{
 			InstanceName: aws.String(lightsailName),
 		})
@@ -192,7 +191,7 @@ func testAccCheckInstanceExists(n string, res *lightsail.Instance) resource.Test
 			return errors.New("No LightsailInstance ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn()
 
 		respInstance, err := conn.GetInstance(&lightsail.GetInstanceInput{
 			InstanceName: aws.String(rs.Primary.Attributes["name"]),
@@ -216,7 +215,7 @@ func testAccCheckInstanceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn()
 
 		respInstance, err := conn.GetInstance(&lightsail.GetInstanceInput{
 			InstanceName: aws.String(rs.Primary.Attributes["name"]),
@@ -241,7 +240,7 @@ func testAccCheckInstanceDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailConn()
 
 	input := &lightsail.GetInstancesInput{}
 
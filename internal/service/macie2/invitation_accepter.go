@@ -44,7 +44,7 @@ func ResourceInvitationAccepter() *schema.Resource {
 }
 
 func resourceMacie2InvitationAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	adminAccountID := d.Get("administrator_account_id").(string)
 	var invitationID string
@@ -105,7 +105,7 @@ func resourceMacie2InvitationAccepterCreate(ctx context.Context, d *schema.Resou
 }
 
 func resourceMacie2InvitationAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	var err error
 
@@ -134,7 +134,7 @@ func resourceMacie2InvitationAccepterRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceMacie2InvitationAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).Macie2Conn
+	conn := meta.(*conns.AWSClient).Macie2Conn()
 
 	input := &macie2.DisassociateFromAdministratorAccountInput{}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -23,7 +24,7 @@ func WaitBrokerCreated(conn *mq.MQ, id string) (*mq.DescribeBrokerResponse, erro
 		Timeout: BrokerCreateTimeout,
 		Refresh: StatusBroker(conn, id),
 	}
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(&stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerResponse); ok {
 		return output, err
@@ -44,7 +45,7 @@ func WaitBrokerDeleted(conn *mq.MQ, id string) (*mq.DescribeBrokerResponse, erro
 		Timeout: BrokerDeleteTimeout,
 		Refresh: StatusBroker(conn, id),
 	}
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(&stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerResponse); ok {
 		return output, err
@@ -62,7 +63,7 @@ func WaitBrokerRebooted(conn *mq.MQ, id string) (*mq.DescribeBrokerResponse, err
 		Timeout: BrokerRebootTimeout,
 		Refresh: StatusBroker(conn, id),
 	}
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(&stateConf)
 
 	if output, ok := outputRaw.(*mq.DescribeBrokerResponse); ok {
 		return output, err
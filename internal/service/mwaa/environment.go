@@ -1,26 +1,41 @@
 package mwaa
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mwaa"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	// Maximum amount of time to wait for an environment creation
+	environmentCreatedTimeout = 120 * time.Minute
+
+	// Maximum amount of time to wait for an environment update
+	environmentUpdatedTimeout = 90 * time.Minute
+
+	// Maximum amount of time to wait for an environment deletion
+	environmentDeletedTimeout = 90 * time.Minute
+)
+
 func ResourceEnvironment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceEnvironmentCreate,
-		Read:   resourceEnvironmentRead,
-		Update: resourceEnvironmentUpdate,
-		Delete: resourceEnvironmentDelete,
+		CreateWithoutTimeout: resourceEnvironmentCreate,
+		ReadWithoutTimeout:   resourceEnvironmentRead,
+		UpdateWithoutTimeout: resourceEnvironmentUpdate,
+		DeleteWithoutTimeout: resourceEnvironmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -235,8 +250,8 @@ func ResourceEnvironment() *schema.Resource {
 	}
 }
 
-func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MWAAConn
+func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MWAAConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -305,41 +320,37 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[INFO] Creating MWAA Environment: %s", input)
-	_, err := conn.CreateEnvironment(&input)
+	_, err := conn.CreateEnvironmentWithContext(ctx, &input)
 	if err != nil {
-		return fmt.Errorf("error creating MWAA Environment: %w", err)
+		return diag.FromErr(fmt.Errorf("error creating MWAA Environment: %w", err))
 	}
 
 	d.SetId(aws.StringValue(input.Name))
 
-	if _, err := waitEnvironmentCreated(conn, d.Id()); err != nil {
-		return fmt.Errorf("error waiting for MWAA Environment (%s) creation: %w", d.Id(), err)
+	if _, err := waitEnvironmentCreated(ctx, conn, d.Id(), environmentCreatedTimeout); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for MWAA Environment (%s) creation: %w", d.Id(), err))
 	}
 
-	return resourceEnvironmentRead(d, meta)
+	return resourceEnvironmentRead(ctx, d, meta)
 }
 
-func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MWAAConn
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MWAAConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	log.Printf("[INFO] Reading MWAA Environment: %s", d.Id())
 
-	environment, err := findEnvironmentByName(conn, d.Id())
-
-	if err != nil {
-		if tfawserr.ErrMessageContains(err, mwaa.ErrCodeResourceNotFoundException, "") && !d.IsNewResource() {
-			log.Printf("[WARN] MWAA Environment %q not found, removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
+	environment, err := FindEnvironmentByName(conn, d.Id())
 
-		return fmt.Errorf("error reading MWAA Environment (%s): %w", d.Id(), err)
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MWAA Environment %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
 	}
 
-	if environment == nil {
-		return fmt.Errorf("error reading MWAA Environment (%s): empty response", d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading MWAA Environment (%s): %w", d.Id(), err))
 	}
 
 	d.Set("airflow_configuration_options", aws.StringValueMap(environment.AirflowConfigurationOptions))
@@ -351,16 +362,16 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("execution_role_arn", environment.ExecutionRoleArn)
 	d.Set("kms_key", environment.KmsKey)
 	if err := d.Set("last_updated", flattenMwaaLastUpdate(environment.LastUpdate)); err != nil {
-		return fmt.Errorf("error reading MWAA Environment (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading MWAA Environment (%s): %w", d.Id(), err))
 	}
 	if err := d.Set("logging_configuration", flattenMwaaLoggingConfiguration(environment.LoggingConfiguration)); err != nil {
-		return fmt.Errorf("error reading MWAA Environment (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading MWAA Environment (%s): %w", d.Id(), err))
 	}
 	d.Set("max_workers", environment.MaxWorkers)
 	d.Set("min_workers", environment.MinWorkers)
 	d.Set("name", environment.Name)
 	if err := d.Set("network_configuration", flattenMwaaNetworkConfiguration(environment.NetworkConfiguration)); err != nil {
-		return fmt.Errorf("error reading MWAA Environment (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading MWAA Environment (%s): %w", d.Id(), err))
 	}
 	d.Set("plugins_s3_object_version", environment.PluginsS3ObjectVersion)
 	d.Set("plugins_s3_path", environment.PluginsS3Path)
@@ -377,18 +388,18 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	return nil
 }
 
-func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MWAAConn
+func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MWAAConn()
 
 	input := mwaa.UpdateEnvironmentInput{
 		Name: aws.String(d.Get("name").(string)),
@@ -465,14 +476,14 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[INFO] Updating MWAA Environment: %s", input)
-		_, err := conn.UpdateEnvironment(&input)
+		_, err := conn.UpdateEnvironmentWithContext(ctx, &input)
 
 		if err != nil {
-			return fmt.Errorf("error updating MWAA Environment (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating MWAA Environment (%s): %w", d.Id(), err))
 		}
 
-		if _, err := waitEnvironmentUpdated(conn, d.Id()); err != nil {
-			return fmt.Errorf("error waiting for MWAA Environment (%s) update: %w", d.Id(), err)
+		if _, err := waitEnvironmentUpdated(ctx, conn, d.Id(), environmentUpdatedTimeout); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for MWAA Environment (%s) update: %w", d.Id(), err))
 		}
 	}
 
@@ -480,18 +491,18 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating MWAA Environment (%s) tags: %s", d.Get("arn").(string), err)
+			return diag.FromErr(fmt.Errorf("error updating MWAA Environment (%s) tags: %s", d.Get("arn").(string), err))
 		}
 	}
 
-	return resourceEnvironmentRead(d, meta)
+	return resourceEnvironmentRead(ctx, d, meta)
 }
 
-func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).MWAAConn
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MWAAConn()
 
 	log.Printf("[INFO] Deleting MWAA Environment: %s", d.Id())
-	_, err := conn.DeleteEnvironment(&mwaa.DeleteEnvironmentInput{
+	_, err := conn.DeleteEnvironmentWithContext(ctx, &mwaa.DeleteEnvironmentInput{
 		Name: aws.String(d.Id()),
 	})
 	if err != nil {
@@ -499,13 +510,13 @@ func resourceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
 			return nil
 		}
 
-		return fmt.Errorf("error deleting MWAA Environment (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting MWAA Environment (%s): %w", d.Id(), err))
 	}
 
-	_, err = waitEnvironmentDeleted(conn, d.Id())
+	_, err = waitEnvironmentDeleted(ctx, conn, d.Id(), environmentDeletedTimeout)
 
 	if err != nil {
-		return fmt.Errorf("error waiting for MWAA Environment (%s) deletion: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for MWAA Environment (%s) deletion: %w", d.Id(), err))
 	}
 
 	return nil
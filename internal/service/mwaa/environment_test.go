@@ -4,21 +4,19 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mwaa"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfmwaa "github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func TestAccMWAAEnvironment_basic(t *testing.T) {
-	var environment mwaa.GetEnvironmentOutput
+	var environment mwaa.Environment
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_mwaa_environment.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -78,9 +76,9 @@ func TestAccMWAAEnvironment_basic(t *testing.T) {
 }
 
 func TestAccMWAAEnvironment_disappears(t *testing.T) {
-	var environment mwaa.GetEnvironmentOutput
+	var environment mwaa.Environment
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_mwaa_environment.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -102,9 +100,9 @@ func TestAccMWAAEnvironment_disappears(t *testing.T) {
 }
 
 func TestAccMWAAEnvironment_airflowOptions(t *testing.T) {
-	var environment mwaa.GetEnvironmentOutput
+	var environment mwaa.Environment
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_mwaa_environment.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -148,9 +146,9 @@ func TestAccMWAAEnvironment_airflowOptions(t *testing.T) {
 }
 
 func TestAccMWAAEnvironment_log(t *testing.T) {
-	var environment mwaa.GetEnvironmentOutput
+	var environment mwaa.Environment
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_mwaa_environment.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -233,9 +231,9 @@ func TestAccMWAAEnvironment_log(t *testing.T) {
 }
 
 func TestAccMWAAEnvironment_full(t *testing.T) {
-	var environment mwaa.GetEnvironmentOutput
+	var environment mwaa.Environment
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_mwaa_environment.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -307,9 +305,9 @@ func TestAccMWAAEnvironment_full(t *testing.T) {
 }
 
 func TestAccMWAAEnvironment_pluginsS3ObjectVersion(t *testing.T) {
-	var environment mwaa.GetEnvironmentOutput
+	var environment mwaa.Environment
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_mwaa_environment.test"
 	s3BucketObjectResourceName := "aws_s3_bucket_object.plugins"
 
@@ -347,7 +345,7 @@ func TestAccMWAAEnvironment_pluginsS3ObjectVersion(t *testing.T) {
 	})
 }
 
-func testAccCheckEnvironmentExists(resourceName string, environment *mwaa.GetEnvironmentOutput) resource.TestCheckFunc {
+func testAccCheckEnvironmentExists(resourceName string, environment *mwaa.Environment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -358,37 +356,34 @@ func testAccCheckEnvironmentExists(resourceName string, environment *mwaa.GetEnv
 			return fmt.Errorf("No MWAA Environment ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAConn
-		resp, err := conn.GetEnvironment(&mwaa.GetEnvironmentInput{
-			Name: aws.String(rs.Primary.ID),
-		})
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAConn()
+		output, err := tfmwaa.FindEnvironmentByName(conn, rs.Primary.ID)
 
 		if err != nil {
-			return fmt.Errorf("Error getting MWAA Environment: %s", err.Error())
+			return err
 		}
 
-		*environment = *resp
+		*environment = *output
 
 		return nil
 	}
 }
 
 func testAccCheckEnvironmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MWAAConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_mwaa_environment" {
 			continue
 		}
 
-		_, err := conn.GetEnvironment(&mwaa.GetEnvironmentInput{
-			Name: aws.String(rs.Primary.ID),
-		})
+		_, err := tfmwaa.FindEnvironmentByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
 
 		if err != nil {
-			if tfawserr.ErrMessageContains(err, mwaa.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
 			return err
 		}
 
@@ -1,3 +1,4 @@
+//go:generate go run ../../generate/findwait/main.go -Resource=Environment -Operation=GetEnvironment -IDField=Name -OutputField=Environment -NotFoundCodes=mwaa.ErrCodeResourceNotFoundException -StatusField=Status -Created=mwaa.EnvironmentStatusCreating:mwaa.EnvironmentStatusAvailable -Updated=mwaa.EnvironmentStatusUpdating:mwaa.EnvironmentStatusAvailable -Deleted=mwaa.EnvironmentStatusDeleting
 //go:generate go run ../../generate/tags/main.go -ListTagsOp=ListTags -ServiceTagsMap -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
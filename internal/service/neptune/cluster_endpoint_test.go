@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -19,7 +18,7 @@ import (
 
 func TestAccNeptuneClusterEndpoint_basic(t *testing.T) {
 	var dbCluster neptune.DBClusterEndpoint
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -56,7 +55,7 @@ func TestAccNeptuneClusterEndpoint_tags(t *testing.T) {
 	}
 
 	var v neptune.DBClusterEndpoint
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -101,7 +100,7 @@ func TestAccNeptuneClusterEndpoint_tags(t *testing.T) {
 
 func TestAccNeptuneClusterEndpoint_disappears(t *testing.T) {
 	var dbCluster neptune.DBClusterEndpoint
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -124,7 +123,7 @@ func TestAccNeptuneClusterEndpoint_disappears(t *testing.T) {
 
 func TestAccNeptuneClusterEndpoint_Disappears_cluster(t *testing.T) {
 	var dbCluster neptune.DBClusterEndpoint
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster_endpoint.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -150,7 +149,7 @@ func testAccCheckClusterEndpointDestroy(s *terraform.State) error {
 }
 
 func testAccCheckClusterEndpointDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*conns.AWSClient).NeptuneConn
+	conn := provider.Meta().(*conns.AWSClient).NeptuneConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_cluster_endpoint" {
@@ -187,7 +186,7 @@ func testAccCheckClusterEndpointExistsWithProvider(n string, v *neptune.DBCluste
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*conns.AWSClient).NeptuneConn
+		conn := provider.Meta().(*conns.AWSClient).NeptuneConn()
 		resp, err := tfneptune.FindEndpointByID(conn, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Neptune Cluster Endpoint (%s) not found: %w", rs.Primary.ID, err)
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 
 func TestAccNeptuneClusterInstance_basic(t *testing.T) {
 	var v neptune.DBInstance
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_neptune_cluster_instance.cluster_instances"
 	clusterResourceName := "aws_neptune_cluster.default"
@@ -74,7 +73,7 @@ func TestAccNeptuneClusterInstance_basic(t *testing.T) {
 
 func TestAccNeptuneClusterInstance_withAZ(t *testing.T) {
 	var v neptune.DBInstance
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_neptune_cluster_instance.cluster_instances"
 	availabiltyZonesDataSourceName := "data.aws_availability_zones.available"
@@ -100,7 +99,7 @@ func TestAccNeptuneClusterInstance_withAZ(t *testing.T) {
 
 func TestAccNeptuneClusterInstance_namePrefix(t *testing.T) {
 	var v neptune.DBInstance
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_neptune_cluster_instance.test"
 
@@ -126,7 +125,7 @@ func TestAccNeptuneClusterInstance_namePrefix(t *testing.T) {
 
 func TestAccNeptuneClusterInstance_withSubnetGroup(t *testing.T) {
 	var v neptune.DBInstance
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_neptune_cluster_instance.test"
 	subnetGroupResourceName := "aws_neptune_subnet_group.test"
@@ -151,7 +150,7 @@ func TestAccNeptuneClusterInstance_withSubnetGroup(t *testing.T) {
 
 func TestAccNeptuneClusterInstance_generatedName(t *testing.T) {
 	var v neptune.DBInstance
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_neptune_cluster_instance.test"
 
@@ -175,7 +174,7 @@ func TestAccNeptuneClusterInstance_generatedName(t *testing.T) {
 
 func TestAccNeptuneClusterInstance_kmsKey(t *testing.T) {
 	var v neptune.DBInstance
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_neptune_cluster_instance.cluster_instances"
 	kmsKeyResourceName := "aws_kms_key.test"
@@ -208,7 +207,7 @@ func testAccCheckClusterInstanceExists(n string, v *neptune.DBInstance) resource
 			return fmt.Errorf("No Neptune Instance ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn()
 		resp, err := conn.DescribeDBInstances(&neptune.DescribeDBInstancesInput{
 			DBInstanceIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 func TestAccNeptuneClusterParameterGroup_basic(t *testing.T) {
 	var v neptune.DBClusterParameterGroup
 
-	parameterGroupName := sdkacctest.RandomWithPrefix("cluster-parameter-group-test-terraform")
+	parameterGroupName := acctest.RandomWithPrefix(t, "cluster-parameter-group-test-terraform")
 
 	resourceName := "aws_neptune_cluster_parameter_group.test"
 
@@ -110,7 +109,7 @@ func TestAccNeptuneClusterParameterGroup_description(t *testing.T) {
 
 	resourceName := "aws_neptune_cluster_parameter_group.test"
 
-	parameterGroupName := sdkacctest.RandomWithPrefix("cluster-parameter-group-test-terraform")
+	parameterGroupName := acctest.RandomWithPrefix(t, "cluster-parameter-group-test-terraform")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -187,7 +186,7 @@ func TestAccNeptuneClusterParameterGroup_parameter(t *testing.T) {
 
 	resourceName := "aws_neptune_cluster_parameter_group.test"
 
-	parameterGroupName := sdkacctest.RandomWithPrefix("cluster-parameter-group-test-tf")
+	parameterGroupName := acctest.RandomWithPrefix(t, "cluster-parameter-group-test-tf")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -235,7 +234,7 @@ func TestAccNeptuneClusterParameterGroup_tags(t *testing.T) {
 
 	resourceName := "aws_neptune_cluster_parameter_group.test"
 
-	parameterGroupName := sdkacctest.RandomWithPrefix("cluster-parameter-group-test-tf")
+	parameterGroupName := acctest.RandomWithPrefix(t, "cluster-parameter-group-test-tf")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -280,7 +279,7 @@ func TestAccNeptuneClusterParameterGroup_tags(t *testing.T) {
 }
 
 func testAccCheckClusterParameterGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_cluster_parameter_group" {
@@ -335,7 +334,7 @@ func testAccCheckClusterParameterGroupExists(n string, v *neptune.DBClusterParam
 			return errors.New("No Neptune Cluster Parameter Group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn()
 
 		opts := neptune.DescribeDBClusterParameterGroupsInput{
 			DBClusterParameterGroupName: aws.String(rs.Primary.ID),
@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -21,7 +20,7 @@ import (
 
 func TestAccNeptuneCluster_basic(t *testing.T) {
 	var dbCluster neptune.DBCluster
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -63,7 +62,7 @@ func TestAccNeptuneCluster_basic(t *testing.T) {
 
 func TestAccNeptuneCluster_copyTagsToSnapshot(t *testing.T) {
 	var dbCluster neptune.DBCluster
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -143,7 +142,7 @@ func TestAccNeptuneCluster_namePrefix(t *testing.T) {
 
 func TestAccNeptuneCluster_takeFinalSnapshot(t *testing.T) {
 	var v neptune.DBCluster
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -175,7 +174,7 @@ func TestAccNeptuneCluster_takeFinalSnapshot(t *testing.T) {
 
 func TestAccNeptuneCluster_tags(t *testing.T) {
 	var v neptune.DBCluster
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -226,7 +225,7 @@ func TestAccNeptuneCluster_tags(t *testing.T) {
 
 func TestAccNeptuneCluster_updateIAMRoles(t *testing.T) {
 	var v neptune.DBCluster
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -274,7 +273,7 @@ func TestAccNeptuneCluster_kmsKey(t *testing.T) {
 	var v neptune.DBCluster
 	resourceName := "aws_neptune_cluster.test"
 	keyResourceName := "aws_kms_key.test"
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -307,7 +306,7 @@ func TestAccNeptuneCluster_kmsKey(t *testing.T) {
 func TestAccNeptuneCluster_encrypted(t *testing.T) {
 	var v neptune.DBCluster
 	resourceName := "aws_neptune_cluster.test"
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -340,7 +339,7 @@ func TestAccNeptuneCluster_encrypted(t *testing.T) {
 func TestAccNeptuneCluster_backupsUpdate(t *testing.T) {
 	var v neptune.DBCluster
 	resourceName := "aws_neptune_cluster.test"
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -384,7 +383,7 @@ func TestAccNeptuneCluster_backupsUpdate(t *testing.T) {
 func TestAccNeptuneCluster_iamAuth(t *testing.T) {
 	var v neptune.DBCluster
 	resourceName := "aws_neptune_cluster.test"
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -416,7 +415,7 @@ func TestAccNeptuneCluster_iamAuth(t *testing.T) {
 
 func TestAccNeptuneCluster_updateCloudWatchLogsExports(t *testing.T) {
 	var dbCluster neptune.DBCluster
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -464,7 +463,7 @@ func TestAccNeptuneCluster_updateCloudWatchLogsExports(t *testing.T) {
 
 func TestAccNeptuneCluster_deleteProtection(t *testing.T) {
 	var dbCluster neptune.DBCluster
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -511,7 +510,7 @@ func TestAccNeptuneCluster_deleteProtection(t *testing.T) {
 
 func TestAccNeptuneCluster_disappears(t *testing.T) {
 	var dbCluster neptune.DBCluster
-	rName := sdkacctest.RandomWithPrefix("tf-acc")
+	rName := acctest.RandomWithPrefix(t, "tf-acc")
 	resourceName := "aws_neptune_cluster.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -537,7 +536,7 @@ func testAccCheckClusterDestroy(s *terraform.State) error {
 }
 
 func testAccCheckClusterDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*conns.AWSClient).NeptuneConn
+	conn := provider.Meta().(*conns.AWSClient).NeptuneConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_cluster" {
@@ -587,7 +586,7 @@ func testAccCheckClusterExistsWithProvider(n string, v *neptune.DBCluster, provi
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*conns.AWSClient).NeptuneConn
+		conn := provider.Meta().(*conns.AWSClient).NeptuneConn()
 		resp, err := conn.DescribeDBClusters(&neptune.DescribeDBClustersInput{
 			DBClusterIdentifier: aws.String(rs.Primary.ID),
 		})
@@ -615,7 +614,7 @@ func testAccCheckClusterSnapshot(rName string) resource.TestCheckFunc {
 			}
 
 			awsClient := acctest.Provider.Meta().(*conns.AWSClient)
-			conn := awsClient.NeptuneConn
+			conn := awsClient.NeptuneConn()
 
 			log.Printf("[INFO] Deleting the Snapshot %s", rName)
 			_, snapDeleteErr := conn.DeleteDBClusterSnapshot(
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccNeptuneEventSubscription_basic(t *testing.T) {
 	var v neptune.EventSubscription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	rName := fmt.Sprintf("tf-acc-test-neptune-event-subs-%d", rInt)
 
 	resourceName := "aws_neptune_event_subscription.test"
@@ -61,7 +60,7 @@ func TestAccNeptuneEventSubscription_basic(t *testing.T) {
 
 func TestAccNeptuneEventSubscription_withPrefix(t *testing.T) {
 	var v neptune.EventSubscription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	startsWithPrefix := regexp.MustCompile("^tf-acc-test-neptune-event-subs-")
 
 	resourceName := "aws_neptune_event_subscription.test"
@@ -91,7 +90,7 @@ func TestAccNeptuneEventSubscription_withPrefix(t *testing.T) {
 
 func TestAccNeptuneEventSubscription_withSourceIDs(t *testing.T) {
 	var v neptune.EventSubscription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resourceName := "aws_neptune_event_subscription.test"
 
@@ -128,7 +127,7 @@ func TestAccNeptuneEventSubscription_withSourceIDs(t *testing.T) {
 
 func TestAccNeptuneEventSubscription_withCategories(t *testing.T) {
 	var v neptune.EventSubscription
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	rName := fmt.Sprintf("tf-acc-test-neptune-event-subs-%d", rInt)
 
 	resourceName := "aws_neptune_event_subscription.test"
@@ -175,7 +174,7 @@ func testAccCheckEventSubscriptionExists(n string, v *neptune.EventSubscription)
 			return fmt.Errorf("No Neptune Event Subscription is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn()
 
 		opts := neptune.DescribeEventSubscriptionsInput{
 			SubscriptionName: aws.String(rs.Primary.ID),
@@ -198,7 +197,7 @@ func testAccCheckEventSubscriptionExists(n string, v *neptune.EventSubscription)
 }
 
 func testAccCheckEventSubscriptionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).NeptuneConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_neptune_event_subscription" {
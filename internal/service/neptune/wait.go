@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -27,7 +28,7 @@ func WaitEventSubscriptionDeleted(conn *neptune.Neptune, subscriptionName string
 		Timeout: EventSubscriptionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*neptune.EventSubscription); ok {
 		return v, err
@@ -52,7 +53,7 @@ func WaitDBClusterDeleted(conn *neptune.Neptune, id string, timeout time.Duratio
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*neptune.DBCluster); ok {
 		return v, err
@@ -79,7 +80,7 @@ func WaitDBClusterAvailable(conn *neptune.Neptune, id string, timeout time.Durat
 		Delay:      30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*neptune.DBCluster); ok {
 		return v, err
@@ -97,7 +98,7 @@ func WaitDBClusterEndpointAvailable(conn *neptune.Neptune, id string) (*neptune.
 		Timeout: DBClusterEndpointAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*neptune.DBClusterEndpoint); ok {
 		return v, err
@@ -115,7 +116,7 @@ func WaitDBClusterEndpointDeleted(conn *neptune.Neptune, id string) (*neptune.DB
 		Timeout: DBClusterEndpointDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*neptune.DBClusterEndpoint); ok {
 		return v, err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -25,7 +26,7 @@ func waitFirewallCreated(ctx context.Context, conn *networkfirewall.NetworkFirew
 		Timeout: firewallTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*networkfirewall.Firewall); ok {
 		return v, err
@@ -46,7 +47,7 @@ func waitFirewallUpdated(ctx context.Context, conn *networkfirewall.NetworkFirew
 		Delay: 30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*string); ok {
 		return v, err
@@ -64,7 +65,7 @@ func waitFirewallDeleted(ctx context.Context, conn *networkfirewall.NetworkFirew
 		Timeout: firewallTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*networkfirewall.Firewall); ok {
 		return v, err
@@ -82,7 +83,7 @@ func waitFirewallPolicyDeleted(ctx context.Context, conn *networkfirewall.Networ
 		Timeout: firewallPolicyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*networkfirewall.FirewallPolicy); ok {
 		return v, err
@@ -100,7 +101,7 @@ func waitRuleGroupDeleted(ctx context.Context, conn *networkfirewall.NetworkFire
 		Timeout: ruleGroupDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*networkfirewall.RuleGroup); ok {
 		return v, err
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceInstance() *schema.Resource {
@@ -465,7 +466,7 @@ func resourceInstanceValidate(d *schema.ResourceData) error {
 }
 
 func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	req := &opsworks.DescribeInstancesInput{
 		InstanceIds: []*string{
@@ -574,7 +575,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	err := resourceInstanceValidate(d)
 	if err != nil {
@@ -737,7 +738,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	err := resourceInstanceValidate(d)
 	if err != nil {
@@ -818,7 +819,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	if v, ok := d.GetOk("status"); ok && v.(string) != "stopped" {
 		err := stopOpsworksInstance(d, meta, d.Timeout(schema.TimeoutDelete))
@@ -850,7 +851,7 @@ func resourceInstanceImport(
 }
 
 func startOpsworksInstance(d *schema.ResourceData, meta interface{}, wait bool, timeout time.Duration) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	instanceId := d.Id()
 
@@ -877,7 +878,7 @@ func startOpsworksInstance(d *schema.ResourceData, meta interface{}, wait bool,
 			Delay:      10 * time.Second,
 			MinTimeout: 3 * time.Second,
 		}
-		_, err = stateConf.WaitForState()
+		_, err = tfresource.WaitForState(stateConf)
 		if err != nil {
 			return fmt.Errorf("Error waiting for instance (%s) to become stopped: %s",
 				instanceId, err)
@@ -888,7 +889,7 @@ func startOpsworksInstance(d *schema.ResourceData, meta interface{}, wait bool,
 }
 
 func stopOpsworksInstance(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	instanceId := d.Id()
 
@@ -914,7 +915,7 @@ func stopOpsworksInstance(d *schema.ResourceData, meta interface{}, timeout time
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for instance (%s) to become stopped: %s",
 			instanceId, err)
@@ -43,7 +43,7 @@ func ResourceRDSDBInstance() *schema.Resource {
 }
 
 func resourceRDSDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	req := &opsworks.UpdateRdsDbInstanceInput{
 		RdsDbInstanceArn: aws.String(d.Get("rds_db_instance_arn").(string)),
@@ -72,7 +72,7 @@ func resourceRDSDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRDSDBInstanceDeregister(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	req := &opsworks.DeregisterRdsDbInstanceInput{
 		RdsDbInstanceArn: aws.String(d.Get("rds_db_instance_arn").(string)),
@@ -94,7 +94,7 @@ func resourceRDSDBInstanceDeregister(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceRDSDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	req := &opsworks.DescribeRdsDbInstancesInput{
 		StackId: aws.String(d.Get("stack_id").(string)),
@@ -131,7 +131,7 @@ func resourceRDSDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRDSDBInstanceRegister(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).OpsWorksConn
+	client := meta.(*conns.AWSClient).OpsWorksConn()
 
 	req := &opsworks.RegisterRdsDbInstanceInput{
 		StackId:          aws.String(d.Get("stack_id").(string)),
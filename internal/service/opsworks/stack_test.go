@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/opsworks"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,7 +18,7 @@ import (
 ///////////////////////////////
 
 func TestAccOpsWorksStack_noVPCBasic(t *testing.T) {
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt(t))
 	resourceName := "aws_opsworks_stack.tf-acc"
 	var opsstack opsworks.Stack
 
@@ -50,7 +49,7 @@ func TestAccOpsWorksStack_noVPCBasic(t *testing.T) {
 }
 
 func TestAccOpsWorksStack_noVPCChangeServiceRoleForceNew(t *testing.T) {
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt(t))
 	resourceName := "aws_opsworks_stack.tf-acc"
 	var before, after opsworks.Stack
 
@@ -87,7 +86,7 @@ func TestAccOpsWorksStack_noVPCChangeServiceRoleForceNew(t *testing.T) {
 }
 
 func TestAccOpsWorksStack_vpc(t *testing.T) {
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt(t))
 	resourceName := "aws_opsworks_stack.tf-acc"
 	var opsstack opsworks.Stack
 
@@ -136,7 +135,7 @@ func TestAccOpsWorksStack_vpc(t *testing.T) {
 }
 
 func TestAccOpsWorksStack_noVPCCreateTags(t *testing.T) {
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt(t))
 	resourceName := "aws_opsworks_stack.tf-acc"
 	var opsstack opsworks.Stack
 
@@ -181,7 +180,7 @@ func TestAccOpsWorksStack_noVPCCreateTags(t *testing.T) {
 
 func TestAccOpsWorksStack_CustomCookbooks_setPrivateProperties(t *testing.T) {
 	resourceName := "aws_opsworks_stack.tf-acc"
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt(t))
 	var opsstack opsworks.Stack
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -222,9 +221,9 @@ func TestAccOpsWorksStack_CustomCookbooks_setPrivateProperties(t *testing.T) {
 // to create Stack's prior to v0.9.0.
 // See https://github.com/hashicorp/terraform/issues/12842
 func TestAccOpsWorksStack_classicEndpoints(t *testing.T) {
-	stackName := fmt.Sprintf("tf-opsworks-acc-%d", sdkacctest.RandInt())
+	stackName := fmt.Sprintf("tf-opsworks-acc-%d", acctest.RandInt(t))
 	resourceName := "aws_opsworks_stack.main"
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	var opsstack opsworks.Stack
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -264,7 +263,7 @@ func testAccCheckStackRecreated(t *testing.T, before, after *opsworks.Stack) res
 }
 
 func testAccPreCheckStacks(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 	input := &opsworks.DescribeStacksInput{}
 
@@ -482,7 +481,7 @@ func testAccCheckStackExists(
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 		params := &opsworks.DescribeStacksInput{
 			StackIds: []*string{aws.String(rs.Primary.ID)},
@@ -513,7 +512,7 @@ func testAccCheckStackExists(
 }
 
 func testAccCheckStackDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_stack" {
 			continue
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/opsworks"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,8 +14,8 @@ import (
 )
 
 func TestAccOpsWorksUserProfile_basic(t *testing.T) {
-	rName := fmt.Sprintf("test-user-%d", sdkacctest.RandInt())
-	updateRName := fmt.Sprintf("test-user-%d", sdkacctest.RandInt())
+	rName := fmt.Sprintf("test-user-%d", acctest.RandInt(t))
+	updateRName := fmt.Sprintf("test-user-%d", acctest.RandInt(t))
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(opsworks.EndpointsID, t) },
 		ErrorCheck:   acctest.ErrorCheck(t, opsworks.EndpointsID),
@@ -75,7 +74,7 @@ func testAccCheckUserProfileExists(
 			return fmt.Errorf("User Profile user arn is missing, should be set.")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 		params := &opsworks.DescribeUserProfilesInput{
 			IamUserArns: []*string{aws.String(rs.Primary.Attributes["user_arn"])},
@@ -106,7 +105,7 @@ func testAccCheckUserProfileExists(
 }
 
 func testAccCheckUserProfileDestroy(s *terraform.State) error {
-	client := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn
+	client := acctest.Provider.Meta().(*conns.AWSClient).OpsWorksConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_opsworks_user_profile" {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const organizationsPolicyTypeStatusDisabled = "DISABLED"
@@ -168,7 +169,7 @@ func ResourceOrganization() *schema.Resource {
 }
 
 func resourceOrganizationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).OrganizationsConn
+	conn := meta.(*conns.AWSClient).OrganizationsConn()
 
 	createOpts := &organizations.CreateOrganizationInput{
 		FeatureSet: aws.String(d.Get("feature_set").(string)),
@@ -228,7 +229,7 @@ func resourceOrganizationCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceOrganizationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).OrganizationsConn
+	conn := meta.(*conns.AWSClient).OrganizationsConn()
 
 	log.Printf("[INFO] Reading Organization: %s", d.Id())
 	org, err := conn.DescribeOrganization(&organizations.DescribeOrganizationInput{})
@@ -325,7 +326,7 @@ func resourceOrganizationRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).OrganizationsConn
+	conn := meta.(*conns.AWSClient).OrganizationsConn()
 
 	if d.HasChange("aws_service_access_principals") {
 		oldRaw, newRaw := d.GetChange("aws_service_access_principals")
@@ -412,7 +413,7 @@ func resourceOrganizationUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).OrganizationsConn
+	conn := meta.(*conns.AWSClient).OrganizationsConn()
 
 	log.Printf("[INFO] Deleting Organization: %s", d.Id())
 
@@ -520,7 +521,7 @@ func waitForOrganizationDefaultRootPolicyTypeDisable(conn *organizations.Organiz
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -536,7 +537,7 @@ func waitForOrganizationDefaultRootPolicyTypeEnable(conn *organizations.Organiza
 		Timeout: 5 * time.Minute,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
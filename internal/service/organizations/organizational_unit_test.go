@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func testAccOrganizationalUnit_basic(t *testing.T) {
 	var unit organizations.OrganizationalUnit
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	name := fmt.Sprintf("tf_outest_%d", rInt)
 	resourceName := "aws_organizations_organizational_unit.test"
 
@@ -50,7 +49,7 @@ func testAccOrganizationalUnit_basic(t *testing.T) {
 func testAccOrganizationalUnit_disappears(t *testing.T) {
 	var unit organizations.OrganizationalUnit
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	name := fmt.Sprintf("tf_outest_%d", rInt)
 	resourceName := "aws_organizations_organizational_unit.test"
 
@@ -75,7 +74,7 @@ func testAccOrganizationalUnit_disappears(t *testing.T) {
 func testAccOrganizationalUnit_Name(t *testing.T) {
 	var unit organizations.OrganizationalUnit
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	name1 := fmt.Sprintf("tf_outest_%d", rInt)
 	name2 := fmt.Sprintf("tf_outest_%d", rInt+1)
 	resourceName := "aws_organizations_organizational_unit.test"
@@ -112,7 +111,7 @@ func testAccOrganizationalUnit_Name(t *testing.T) {
 func testAccOrganizationalUnit_Tags(t *testing.T) {
 	var unit organizations.OrganizationalUnit
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	name := fmt.Sprintf("tf_outest_%d", rInt)
 	resourceName := "aws_organizations_organizational_unit.test"
 
@@ -156,7 +155,7 @@ func testAccOrganizationalUnit_Tags(t *testing.T) {
 }
 
 func testAccCheckOrganizationalUnitDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_organizations_organizational_unit" {
@@ -195,7 +194,7 @@ func testAccCheckOrganizationalUnitExists(n string, ou *organizations.Organizati
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn()
 		params := &organizations.DescribeOrganizationalUnitInput{
 			OrganizationalUnitId: &rs.Primary.ID,
 		}
@@ -24,7 +24,7 @@ func DataSourceSites() *schema.Resource {
 }
 
 func dataSourceSitesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).OutpostsConn
+	conn := meta.(*conns.AWSClient).OutpostsConn()
 
 	input := &outposts.ListSitesInput{}
 
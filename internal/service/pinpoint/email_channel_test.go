@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,9 +18,9 @@ func TestAccPinpointEmailChannel_basic(t *testing.T) {
 	var channel pinpoint.EmailChannelResponse
 	resourceName := "aws_pinpoint_email_channel.test"
 
-	domain := acctest.RandomDomainName()
-	address1 := acctest.RandomEmailAddress(domain)
-	address2 := acctest.RandomEmailAddress(domain)
+	domain := acctest.RandomDomainName(t)
+	address1 := acctest.RandomEmailAddress(t, domain)
+	address2 := acctest.RandomEmailAddress(t, domain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
@@ -61,10 +60,10 @@ func TestAccPinpointEmailChannel_basic(t *testing.T) {
 func TestAccPinpointEmailChannel_set(t *testing.T) {
 	var channel pinpoint.EmailChannelResponse
 	resourceName := "aws_pinpoint_email_channel.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	domain := acctest.RandomDomainName()
-	address := acctest.RandomEmailAddress(domain)
+	domain := acctest.RandomDomainName(t)
+	address := acctest.RandomEmailAddress(t, domain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
@@ -91,10 +90,10 @@ func TestAccPinpointEmailChannel_set(t *testing.T) {
 func TestAccPinpointEmailChannel_noRole(t *testing.T) {
 	var channel pinpoint.EmailChannelResponse
 	resourceName := "aws_pinpoint_email_channel.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	domain := acctest.RandomDomainName()
-	address := acctest.RandomEmailAddress(domain)
+	domain := acctest.RandomDomainName(t)
+	address := acctest.RandomEmailAddress(t, domain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
@@ -122,8 +121,8 @@ func TestAccPinpointEmailChannel_disappears(t *testing.T) {
 	var channel pinpoint.EmailChannelResponse
 	resourceName := "aws_pinpoint_email_channel.test"
 
-	domain := acctest.RandomDomainName()
-	address := acctest.RandomEmailAddress(domain)
+	domain := acctest.RandomDomainName(t)
+	address := acctest.RandomEmailAddress(t, domain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
@@ -154,7 +153,7 @@ func testAccCheckEmailChannelExists(n string, channel *pinpoint.EmailChannelResp
 			return fmt.Errorf("No Pinpoint Email Channel with that application ID exists")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn()
 
 		// Check if the app exists
 		params := &pinpoint.GetEmailChannelInput{
@@ -314,7 +313,7 @@ resource "aws_ses_domain_identity" "test" {
 }
 
 func testAccCheckEmailChannelDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_pinpoint_email_channel" {
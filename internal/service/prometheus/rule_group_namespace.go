@@ -44,7 +44,7 @@ func ResourceRuleGroupNamespace() *schema.Resource {
 }
 
 func resourceRuleGroupNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).PrometheusConn
+	conn := meta.(*conns.AWSClient).PrometheusConn()
 
 	workspaceID := d.Get("workspace_id").(string)
 	name := d.Get("name").(string)
@@ -71,7 +71,7 @@ func resourceRuleGroupNamespaceCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceRuleGroupNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).PrometheusConn
+	conn := meta.(*conns.AWSClient).PrometheusConn()
 
 	input := &prometheusservice.PutRuleGroupsNamespaceInput{
 		Name:        aws.String(d.Get("name").(string)),
@@ -94,7 +94,7 @@ func resourceRuleGroupNamespaceUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceRuleGroupNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).PrometheusConn
+	conn := meta.(*conns.AWSClient).PrometheusConn()
 
 	rgn, err := FindRuleGroupNamespaceByArn(ctx, conn, d.Id())
 
@@ -120,7 +120,7 @@ func resourceRuleGroupNamespaceRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceRuleGroupNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).PrometheusConn
+	conn := meta.(*conns.AWSClient).PrometheusConn()
 
 	log.Printf("[DEBUG] Deleting Prometheus Rule Group Namespace: (%s)", d.Id())
 	_, err := conn.DeleteRuleGroupsNamespaceWithContext(ctx, &prometheusservice.DeleteRuleGroupsNamespaceInput{
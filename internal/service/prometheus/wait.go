@@ -24,7 +24,7 @@ func waitAlertManagerDefinitionCreated(ctx context.Context, conn *prometheusserv
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
 		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.AlertManagerDefinitionStatusCodeCreationFailed {
@@ -45,7 +45,7 @@ func waitAlertManagerDefinitionUpdated(ctx context.Context, conn *prometheusserv
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
 		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.AlertManagerDefinitionStatusCodeUpdateFailed {
@@ -66,7 +66,7 @@ func waitAlertManagerDefinitionDeleted(ctx context.Context, conn *prometheusserv
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
 		return output, err
@@ -84,7 +84,7 @@ func waitWorkspaceCreated(ctx context.Context, conn *prometheusservice.Prometheu
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*prometheusservice.WorkspaceSummary); ok {
 		return v, err
@@ -102,7 +102,7 @@ func waitWorkspaceDeleted(ctx context.Context, conn *prometheusservice.Prometheu
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*prometheusservice.WorkspaceSummary); ok {
 		return v, err
@@ -119,7 +119,7 @@ func waitRuleGroupNamespaceDeleted(ctx context.Context, conn *prometheusservice.
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -136,7 +136,7 @@ func waitRuleGroupNamespaceCreated(ctx context.Context, conn *prometheusservice.
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -153,7 +153,7 @@ func waitRuleGroupNamespaceUpdated(ctx context.Context, conn *prometheusservice.
 		Timeout: workspaceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*prometheusservice.RuleGroupsNamespaceDescription); ok {
 		return output, err
@@ -67,7 +67,7 @@ func ResourceLedger() *schema.Resource {
 }
 
 func resourceLedgerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).QLDBConn
+	conn := meta.(*conns.AWSClient).QLDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -109,7 +109,7 @@ func resourceLedgerCreate(d *schema.ResourceData, meta interface{}) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = tfresource.WaitForState(stateConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for QLDB Ledger status to be \"%s\": %s", qldb.LedgerStateActive, err)
 	}
@@ -119,7 +119,7 @@ func resourceLedgerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLedgerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).QLDBConn
+	conn := meta.(*conns.AWSClient).QLDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -180,7 +180,7 @@ func resourceLedgerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLedgerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).QLDBConn
+	conn := meta.(*conns.AWSClient).QLDBConn()
 
 	if d.HasChange("permissions_mode") {
 		updateOpts := &qldb.UpdateLedgerPermissionsModeInput{
@@ -218,7 +218,7 @@ func resourceLedgerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLedgerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).QLDBConn
+	conn := meta.(*conns.AWSClient).QLDBConn()
 	deleteLedgerOpts := &qldb.DeleteLedgerInput{
 		Name: aws.String(d.Id()),
 	}
@@ -295,7 +295,7 @@ func WaitForLedgerDeletion(conn *qldb.QLDB, ledgerName string) error {
 		},
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(&stateConf)
 
 	return err
 }
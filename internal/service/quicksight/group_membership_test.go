@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,8 +15,8 @@ import (
 )
 
 func TestAccQuickSightGroupMembership_basic(t *testing.T) {
-	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	memberName := "tfacctest" + sdkacctest.RandString(10)
+	groupName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	memberName := "tfacctest" + acctest.RandString(t, 10)
 	resourceName := "aws_quicksight_group_membership.default"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -42,8 +41,8 @@ func TestAccQuickSightGroupMembership_basic(t *testing.T) {
 }
 
 func TestAccQuickSightGroupMembership_disappears(t *testing.T) {
-	groupName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	memberName := "tfacctest" + sdkacctest.RandString(10)
+	groupName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	memberName := "tfacctest" + acctest.RandString(t, 10)
 	resourceName := "aws_quicksight_group_membership.default"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -65,7 +64,7 @@ func TestAccQuickSightGroupMembership_disappears(t *testing.T) {
 }
 
 func testAccCheckQuickSightGroupMembershipDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_quicksight_group_membership" {
@@ -109,7 +108,7 @@ func testAccCheckQuickSightGroupMembershipExists(resourceName string) resource.T
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
 
 		listInput := &quicksight.ListGroupMembershipsInput{
 			AwsAccountId: aws.String(awsAccountID),
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
 		Name: "aws_quicksight_data_source",
 		F:    sweepsDataSource,
 	})
@@ -30,7 +30,7 @@ func sweepsDataSource(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).QuickSightConn
+	conn := client.(*conns.AWSClient).QuickSightConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -19,9 +18,9 @@ import (
 
 func TestAccQuickSightUser_basic(t *testing.T) {
 	var user quicksight.User
-	rName1 := "tfacctest" + sdkacctest.RandString(10)
+	rName1 := "tfacctest" + acctest.RandString(t, 10)
 	resourceName1 := "aws_quicksight_user." + rName1
-	rName2 := "tfacctest" + sdkacctest.RandString(10)
+	rName2 := "tfacctest" + acctest.RandString(t, 10)
 	resourceName2 := "aws_quicksight_user." + rName2
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -52,7 +51,7 @@ func TestAccQuickSightUser_basic(t *testing.T) {
 
 func TestAccQuickSightUser_withInvalidFormattedEmailStillWorks(t *testing.T) {
 	var user quicksight.User
-	rName := "tfacctest" + sdkacctest.RandString(10)
+	rName := "tfacctest" + acctest.RandString(t, 10)
 	resourceName := "aws_quicksight_user." + rName
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -81,7 +80,7 @@ func TestAccQuickSightUser_withInvalidFormattedEmailStillWorks(t *testing.T) {
 
 func TestAccQuickSightUser_disappears(t *testing.T) {
 	var user quicksight.User
-	rName := "tfacctest" + sdkacctest.RandString(10)
+	rName := "tfacctest" + acctest.RandString(t, 10)
 	resourceName := "aws_quicksight_user." + rName
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -114,7 +113,7 @@ func testAccCheckQuickSightUserExists(resourceName string, user *quicksight.User
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
 
 		input := &quicksight.DescribeUserInput{
 			AwsAccountId: aws.String(awsAccountID),
@@ -139,7 +138,7 @@ func testAccCheckQuickSightUserExists(resourceName string, user *quicksight.User
 }
 
 func testAccCheckQuickSightUserDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_quicksight_user" {
 			continue
@@ -171,7 +170,7 @@ func testAccCheckQuickSightUserDestroy(s *terraform.State) error {
 
 func testAccCheckQuickSightUserDisappears(v *quicksight.User) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
 
 		arn, err := arn.Parse(aws.StringValue(v.Arn))
 		if err != nil {
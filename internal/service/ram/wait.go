@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -21,7 +22,7 @@ func WaitResourceShareInvitationAccepted(conn *ram.RAM, arn string, timeout time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareInvitation); ok {
 		return v, err
@@ -39,7 +40,7 @@ func WaitResourceShareOwnedBySelfDisassociated(conn *ram.RAM, arn string, timeou
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShare); ok {
 		return v, err
@@ -57,7 +58,7 @@ func WaitResourceShareOwnedBySelfActive(conn *ram.RAM, arn string, timeout time.
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShare); ok {
 		return v, err
@@ -75,7 +76,7 @@ func WaitResourceShareOwnedBySelfDeleted(conn *ram.RAM, arn string, timeout time
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShare); ok {
 		return v, err
@@ -92,7 +93,7 @@ func WaitResourceSharePrincipalAssociated(conn *ram.RAM, resourceShareARN, princ
 		Timeout: PrincipalAssociationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		return v, err
@@ -109,7 +110,7 @@ func WaitResourceSharePrincipalDisassociated(conn *ram.RAM, resourceShareARN, pr
 		Timeout: PrincipalDisassociationTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*ram.ResourceShareAssociation); ok {
 		return v, err
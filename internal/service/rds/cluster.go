@@ -1,7 +1,7 @@
 package rds
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,10 +31,10 @@ const (
 
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceClusterCreate,
-		Read:   resourceClusterRead,
-		Update: resourceClusterUpdate,
-		Delete: resourceClusterDelete,
+		CreateWithoutTimeout: resourceClusterCreate,
+		ReadWithoutTimeout:   resourceClusterRead,
+		UpdateWithoutTimeout: resourceClusterUpdate,
+		DeleteWithoutTimeout: resourceClusterDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceClusterImport,
 		},
@@ -493,8 +494,8 @@ func resourceClusterImport(
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -594,28 +595,29 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] RDS Cluster restore from snapshot configuration: %s", opts)
-		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-			_, err := conn.RestoreDBClusterFromSnapshot(&opts)
-			if err != nil {
+		_, err := tfresource.RetryWhenContext(
+			ctx,
+			tfiam.PropagationTimeout,
+			func() (interface{}, error) {
+				return conn.RestoreDBClusterFromSnapshot(&opts)
+			},
+			func(err error) (bool, error) {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
-					return resource.RetryableError(err)
+					return true, err
 				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if tfresource.TimedOut(err) {
-			_, err = conn.RestoreDBClusterFromSnapshot(&opts)
-		}
+
+				return false, err
+			},
+		)
 		if err != nil {
-			return fmt.Errorf("Error creating RDS Cluster: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating RDS Cluster: %s", err))
 		}
 	} else if v, ok := d.GetOk("s3_import"); ok {
 		if _, ok := d.GetOk("master_password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_password": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_password": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("master_username"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_username": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "master_username": required field is not set`, d.Get("name").(string)))
 		}
 		s3_bucket := v.([]interface{})[0].(map[string]interface{})
 		createOpts := &rds.RestoreDBClusterFromS3Input{
@@ -695,36 +697,36 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] RDS Cluster restore options: %s", createOpts)
 		// Retry for IAM/S3 eventual consistency
-		var resp *rds.RestoreDBClusterFromS3Output
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			var err error
-			resp, err = conn.RestoreDBClusterFromS3(createOpts)
-			if err != nil {
+		resp, err := tfresource.RetryWhenContext(
+			ctx,
+			5*time.Minute,
+			func() (interface{}, error) {
+				return conn.RestoreDBClusterFromS3(createOpts)
+			},
+			func(err error) (bool, error) {
 				// InvalidParameterValue: Files from the specified Amazon S3 bucket cannot be downloaded.
 				// Make sure that you have created an AWS Identity and Access Management (IAM) role that lets Amazon RDS access Amazon S3 for you.
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Files from the specified Amazon S3 bucket cannot be downloaded") {
-					return resource.RetryableError(err)
+					return true, err
 				}
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "S3_SNAPSHOT_INGESTION") {
-					return resource.RetryableError(err)
+					return true, err
 				}
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "S3 bucket cannot be found") {
-					return resource.RetryableError(err)
+					return true, err
 				}
-				return resource.NonRetryableError(err)
-			}
-			log.Printf("[DEBUG]: RDS Cluster create response: %s", resp)
-			return nil
-		})
-		if tfresource.TimedOut(err) {
-			resp, err = conn.RestoreDBClusterFromS3(createOpts)
-		}
+
+				return false, err
+			},
+		)
 
 		if err != nil {
 			log.Printf("[ERROR] Error creating RDS Cluster: %s", err)
-			return err
+			return diag.FromErr(err)
 		}
 
+		log.Printf("[DEBUG]: RDS Cluster create response: %s", resp)
+
 	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
 		pointInTime := v.([]interface{})[0].(map[string]interface{})
 		createOpts := &rds.RestoreDBClusterToPointInTimeInput{
@@ -744,7 +746,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if createOpts.RestoreToTime == nil && createOpts.UseLatestRestorableTime == nil {
-			return fmt.Errorf(`provider.aws: aws_rds_cluster: %s: Either "restore_to_time" or "use_latest_restorable_time" must be set`, d.Get("database_name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_rds_cluster: %s: Either "restore_to_time" or "use_latest_restorable_time" must be set`, d.Get("database_name").(string)))
 		}
 
 		if attr, ok := pointInTime["restore_type"].(string); ok {
@@ -818,7 +820,7 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		resp, err := conn.RestoreDBClusterToPointInTime(createOpts)
 		if err != nil {
 			log.Printf("[ERROR] Error restoring RDS Cluster: %s", err)
-			return err
+			return diag.FromErr(err)
 		}
 
 		log.Printf("[DEBUG]: RDS Cluster restore response: %s", resp)
@@ -927,23 +929,22 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] RDS Cluster create options: %s", createOpts)
-		var resp *rds.CreateDBClusterOutput
-		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-			var err error
-			resp, err = conn.CreateDBCluster(createOpts)
-			if err != nil {
+		resp, err := tfresource.RetryWhenContext(
+			ctx,
+			tfiam.PropagationTimeout,
+			func() (interface{}, error) {
+				return conn.CreateDBCluster(createOpts)
+			},
+			func(err error) (bool, error) {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
-					return resource.RetryableError(err)
+					return true, err
 				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if tfresource.TimedOut(err) {
-			resp, err = conn.CreateDBCluster(createOpts)
-		}
+
+				return false, err
+			},
+		)
 		if err != nil {
-			return fmt.Errorf("error creating RDS cluster: %s", err)
+			return diag.FromErr(fmt.Errorf("error creating RDS cluster: %s", err))
 		}
 
 		log.Printf("[DEBUG]: RDS Cluster create response: %s", resp)
@@ -966,16 +967,18 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Wait, catching any errors
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("RDS Cluster (%s) create", d.Id()),
+	})
 	if err != nil {
-		return fmt.Errorf("Error waiting for RDS Cluster state to be \"available\": %s", err)
+		return diag.FromErr(fmt.Errorf("Error waiting for RDS Cluster state to be \"available\": %s", err))
 	}
 
 	if v, ok := d.GetOk("iam_roles"); ok {
 		for _, role := range v.(*schema.Set).List() {
 			err := setIamRoleToRdsCluster(d.Id(), role.(string), conn)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -986,21 +989,21 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[INFO] RDS Cluster (%s) configuration requires ModifyDBCluster: %s", d.Id(), modifyDbClusterInput)
 		_, err := conn.ModifyDBCluster(modifyDbClusterInput)
 		if err != nil {
-			return fmt.Errorf("error modifying RDS Cluster (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error modifying RDS Cluster (%s): %s", d.Id(), err))
 		}
 
 		log.Printf("[INFO] Waiting for RDS Cluster (%s) to be available", d.Id())
-		err = waitForRDSClusterUpdate(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+		err = waitForRDSClusterUpdate(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return fmt.Errorf("error waiting for RDS Cluster (%s) to be available: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for RDS Cluster (%s) to be available: %s", d.Id(), err))
 		}
 	}
 
-	return resourceClusterRead(d, meta)
+	return resourceClusterRead(ctx, d, meta)
 }
 
-func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -1018,11 +1021,11 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error describing RDS Cluster (%s): %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error describing RDS Cluster (%s): %s", d.Id(), err))
 	}
 
 	if resp == nil {
-		return fmt.Errorf("Error retrieving RDS cluster: empty response for: %s", input)
+		return diag.FromErr(fmt.Errorf("Error retrieving RDS cluster: empty response for: %s", input))
 	}
 
 	var dbc *rds.DBCluster
@@ -1040,7 +1043,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := d.Set("availability_zones", aws.StringValueSlice(dbc.AvailabilityZones)); err != nil {
-		return fmt.Errorf("error setting availability_zones: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting availability_zones: %s", err))
 	}
 
 	d.Set("arn", dbc.DBClusterArn)
@@ -1054,7 +1057,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		cm = append(cm, aws.StringValue(m.DBInstanceIdentifier))
 	}
 	if err := d.Set("cluster_members", cm); err != nil {
-		return fmt.Errorf("error setting cluster_members: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting cluster_members: %s", err))
 	}
 
 	d.Set("cluster_resource_id", dbc.DbClusterResourceId)
@@ -1072,7 +1075,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("deletion_protection", dbc.DeletionProtection)
 
 	if err := d.Set("enabled_cloudwatch_logs_exports", aws.StringValueSlice(dbc.EnabledCloudwatchLogsExports)); err != nil {
-		return fmt.Errorf("error setting enabled_cloudwatch_logs_exports: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting enabled_cloudwatch_logs_exports: %s", err))
 	}
 
 	d.Set("endpoint", dbc.Endpoint)
@@ -1088,7 +1091,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		roles = append(roles, aws.StringValue(r.RoleArn))
 	}
 	if err := d.Set("iam_roles", roles); err != nil {
-		return fmt.Errorf("error setting iam_roles: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting iam_roles: %s", err))
 	}
 
 	d.Set("kms_key_id", dbc.KmsKeyId)
@@ -1100,7 +1103,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("replication_source_identifier", dbc.ReplicationSourceIdentifier)
 
 	if err := d.Set("scaling_configuration", flattenRDSScalingConfigurationInfo(dbc.ScalingConfigurationInfo)); err != nil {
-		return fmt.Errorf("error setting scaling_configuration: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting scaling_configuration: %s", err))
 	}
 
 	d.Set("storage_encrypted", dbc.StorageEncrypted)
@@ -1111,22 +1114,22 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		vpcg = append(vpcg, aws.StringValue(g.VpcSecurityGroupId))
 	}
 	if err := d.Set("vpc_security_group_ids", vpcg); err != nil {
-		return fmt.Errorf("error setting vpc_security_group_ids: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting vpc_security_group_ids: %s", err))
 	}
 
 	tags, err := ListTags(conn, aws.StringValue(dbc.DBClusterArn))
 	if err != nil {
-		return fmt.Errorf("error listing tags for RDS Cluster (%s): %s", aws.StringValue(dbc.DBClusterArn), err)
+		return diag.FromErr(fmt.Errorf("error listing tags for RDS Cluster (%s): %s", aws.StringValue(dbc.DBClusterArn), err))
 	}
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	// Fetch and save Global Cluster if engine mode global
@@ -1138,7 +1141,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		// Ignore the following API error for regions/partitions that do not support RDS Global Clusters:
 		// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
 		if err != nil && !tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Access Denied to API Version: APIGlobalDatabases") {
-			return fmt.Errorf("error reading RDS Global Cluster information for DB Cluster (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error reading RDS Global Cluster information for DB Cluster (%s): %s", d.Id(), err))
 		}
 
 		if globalCluster != nil {
@@ -1149,8 +1152,8 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn()
 	requestUpdate := false
 
 	req := &rds.ModifyDBClusterInput{
@@ -1262,35 +1265,36 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if requestUpdate {
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			_, err := conn.ModifyDBCluster(req)
-			if err != nil {
+		_, err := tfresource.RetryWhenContext(
+			ctx,
+			5*time.Minute,
+			func() (interface{}, error) {
+				return conn.ModifyDBCluster(req)
+			},
+			func(err error) (bool, error) {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
-					return resource.RetryableError(err)
+					return true, err
 				}
 
 				if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBClusterStateFault, "Cannot modify engine version without a primary instance in DB cluster") {
-					return resource.NonRetryableError(err)
+					return false, err
 				}
 
 				if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBClusterStateFault, "") {
-					return resource.RetryableError(err)
+					return true, err
 				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if tfresource.TimedOut(err) {
-			_, err = conn.ModifyDBCluster(req)
-		}
+
+				return false, err
+			},
+		)
 		if err != nil {
-			return fmt.Errorf("Failed to modify RDS Cluster (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("Failed to modify RDS Cluster (%s): %s", d.Id(), err))
 		}
 
 		log.Printf("[INFO] Waiting for RDS Cluster (%s) to be available", d.Id())
-		err = waitForRDSClusterUpdate(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		err = waitForRDSClusterUpdate(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf("error waiting for RDS Cluster (%s) to be available: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for RDS Cluster (%s) to be available: %s", d.Id(), err))
 		}
 	}
 
@@ -1300,11 +1304,11 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		n := nRaw.(string)
 
 		if o == "" {
-			return errors.New("Existing RDS Clusters cannot be added to an existing RDS Global Cluster")
+			return diag.Errorf("Existing RDS Clusters cannot be added to an existing RDS Global Cluster")
 		}
 
 		if n != "" {
-			return errors.New("Existing RDS Clusters cannot be migrated between existing RDS Global Clusters")
+			return diag.Errorf("Existing RDS Clusters cannot be migrated between existing RDS Global Clusters")
 		}
 
 		input := &rds.RemoveFromGlobalClusterInput{
@@ -1316,7 +1320,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, err := conn.RemoveFromGlobalCluster(input)
 
 		if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeGlobalClusterNotFoundFault) && !tfawserr.ErrMessageContains(err, "InvalidParameterValue", "is not found in global cluster") {
-			return fmt.Errorf("error removing RDS Cluster (%s) from RDS Global Cluster: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error removing RDS Cluster (%s) from RDS Global Cluster: %s", d.Id(), err))
 		}
 	}
 
@@ -1337,14 +1341,14 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		for _, role := range enableRoles.List() {
 			err := setIamRoleToRdsCluster(d.Id(), role.(string), conn)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 
 		for _, role := range removeRoles.List() {
 			err := removeIamRoleFromRdsCluster(d.Id(), role.(string), conn)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -1353,15 +1357,15 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating tags: %s", err)
+			return diag.FromErr(fmt.Errorf("error updating tags: %s", err))
 		}
 	}
 
-	return resourceClusterRead(d, meta)
+	return resourceClusterRead(ctx, d, meta)
 }
 
-func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn()
 	log.Printf("[DEBUG] Destroying RDS Cluster (%s)", d.Id())
 
 	// Automatically remove from global cluster to bypass this error on deletion:
@@ -1376,7 +1380,7 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 		_, err := conn.RemoveFromGlobalCluster(input)
 
 		if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeGlobalClusterNotFoundFault) && !tfawserr.ErrMessageContains(err, "InvalidParameterValue", "is not found in global cluster") {
-			return fmt.Errorf("error removing RDS Cluster (%s) from RDS Global Cluster: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error removing RDS Cluster (%s) from RDS Global Cluster: %s", d.Id(), err))
 		}
 	}
 
@@ -1391,39 +1395,40 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 		if name, present := d.GetOk("final_snapshot_identifier"); present {
 			deleteOpts.FinalDBSnapshotIdentifier = aws.String(name.(string))
 		} else {
-			return fmt.Errorf("RDS Cluster FinalSnapshotIdentifier is required when a final snapshot is required")
+			return diag.FromErr(fmt.Errorf("RDS Cluster FinalSnapshotIdentifier is required when a final snapshot is required"))
 		}
 	}
 
 	log.Printf("[DEBUG] RDS Cluster delete options: %s", deleteOpts)
 
-	err := resource.Retry(rdsClusterTimeoutDelete, func() *resource.RetryError {
-		_, err := conn.DeleteDBCluster(&deleteOpts)
-		if err != nil {
+	_, err := tfresource.RetryWhenContext(
+		ctx,
+		rdsClusterTimeoutDelete,
+		func() (interface{}, error) {
+			return conn.DeleteDBCluster(&deleteOpts)
+		},
+		func(err error) (bool, error) {
 			if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBClusterStateFault, "is not currently in the available state") {
-				return resource.RetryableError(err)
+				return true, err
 			}
 			if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBClusterStateFault, "cluster is a part of a global cluster") {
-				return resource.RetryableError(err)
-			}
-			if tfawserr.ErrMessageContains(err, rds.ErrCodeDBClusterNotFoundFault, "") {
-				return nil
+				return true, err
 			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
 
-	if tfresource.TimedOut(err) {
-		_, err = conn.DeleteDBCluster(&deleteOpts)
+			return false, err
+		},
+	)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting RDS Cluster (%s): %s", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting RDS Cluster (%s): %s", d.Id(), err))
 	}
 
-	if err := WaitForClusterDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for RDS Cluster (%s) deletion: %s", d.Id(), err)
+	if err := WaitForClusterDeletion(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for RDS Cluster (%s) deletion: %s", d.Id(), err))
 	}
 
 	return nil
@@ -1506,7 +1511,7 @@ var resourceClusterUpdatePendingStates = []string{
 	"upgrading",
 }
 
-func waitForRDSClusterUpdate(conn *rds.RDS, id string, timeout time.Duration) error {
+func waitForRDSClusterUpdate(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    resourceClusterUpdatePendingStates,
 		Target:     []string{"available"},
@@ -1515,11 +1520,13 @@ func waitForRDSClusterUpdate(conn *rds.RDS, id string, timeout time.Duration) er
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("RDS Cluster (%s) update", id),
+	})
 	return err
 }
 
-func WaitForClusterDeletion(conn *rds.RDS, id string, timeout time.Duration) error {
+func WaitForClusterDeletion(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    resourceClusterDeletePendingStates,
 		Target:     []string{"destroyed"},
@@ -1529,7 +1536,9 @@ func WaitForClusterDeletion(conn *rds.RDS, id string, timeout time.Duration) err
 		Delay:      30 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("RDS Cluster (%s) delete", id),
+	})
 
 	return err
 }
@@ -0,0 +1,20 @@
+package rds
+
+import (
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// errorClassifier classifies the errors returned by the RDS API.
+var errorClassifier = tfresource.RegisterServiceErrors("rds", tfresource.ServiceErrors{
+	NotFound: []tfresource.ErrorMatcher{
+		{Code: rds.ErrCodeDBClusterNotFoundFault},
+		{Code: rds.ErrCodeDBInstanceNotFoundFault},
+		{Code: rds.ErrCodeDBProxyNotFoundFault},
+		{Code: rds.ErrCodeSubscriptionNotFoundFault},
+	},
+	Unsupported: []tfresource.ErrorMatcher{
+		// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
+		{Code: "InvalidParameterValue", Message: "Access Denied to API Version"},
+	},
+})
@@ -3,7 +3,6 @@ package rds
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -95,7 +94,7 @@ func FindDBClusterByID(conn *rds.RDS, id string) (*rds.DBCluster, error) {
 
 	output, err := conn.DescribeDBClusters(input)
 
-	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+	if errorClassifier.IsNotFoundError(err, rds.ErrCodeDBClusterNotFoundFault) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -125,7 +124,7 @@ func FindDBInstanceByID(conn *rds.RDS, id string) (*rds.DBInstance, error) {
 
 	output, err := conn.DescribeDBInstances(input)
 
-	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+	if errorClassifier.IsNotFoundError(err, rds.ErrCodeDBInstanceNotFoundFault) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -155,7 +154,7 @@ func FindDBProxyByName(conn *rds.RDS, name string) (*rds.DBProxy, error) {
 
 	output, err := conn.DescribeDBProxies(input)
 
-	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyNotFoundFault) {
+	if errorClassifier.IsNotFoundError(err, rds.ErrCodeDBProxyNotFoundFault) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -176,7 +175,7 @@ func FindEventSubscriptionByID(conn *rds.RDS, id string) (*rds.EventSubscription
 
 	output, err := conn.DescribeEventSubscriptions(input)
 
-	if tfawserr.ErrCodeEquals(err, rds.ErrCodeSubscriptionNotFoundFault) {
+	if errorClassifier.IsNotFoundError(err, rds.ErrCodeSubscriptionNotFoundFault) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -109,7 +110,7 @@ func ResourceGlobalCluster() *schema.Resource {
 }
 
 func resourceGlobalClusterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	input := &rds.CreateGlobalClusterInput{
 		GlobalClusterIdentifier: aws.String(d.Get("global_cluster_identifier").(string)),
@@ -161,7 +162,7 @@ func resourceGlobalClusterCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	globalCluster, err := DescribeGlobalCluster(conn, d.Id())
 
@@ -205,7 +206,7 @@ func resourceGlobalClusterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGlobalClusterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	input := &rds.ModifyGlobalClusterInput{
 		DeletionProtection:      aws.Bool(d.Get("deletion_protection").(bool)),
@@ -250,7 +251,7 @@ func resourceGlobalClusterGetIdByARN(conn *rds.RDS, arn string) string {
 }
 
 func resourceGlobalClusterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	if d.Get("force_destroy").(bool) {
 		for _, globalClusterMemberRaw := range d.Get("global_cluster_members").(*schema.Set).List() {
@@ -443,7 +444,7 @@ func waitForRdsGlobalClusterCreation(conn *rds.RDS, globalClusterID string) erro
 	}
 
 	log.Printf("[DEBUG] Waiting for RDS Global Cluster (%s) availability", globalClusterID)
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -458,7 +459,7 @@ func waitForRdsGlobalClusterUpdate(conn *rds.RDS, globalClusterID string) error
 	}
 
 	log.Printf("[DEBUG] Waiting for RDS Global Cluster (%s) availability", globalClusterID)
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -476,7 +477,7 @@ func WaitForGlobalClusterDeletion(conn *rds.RDS, globalClusterID string) error {
 	}
 
 	log.Printf("[DEBUG] Waiting for RDS Global Cluster (%s) deletion", globalClusterID)
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	if tfresource.NotFound(err) {
 		return nil
@@ -604,7 +605,7 @@ func resourceGlobalClusterUpgradeEngineVersion(d *schema.ResourceData, conn *rds
 		return err
 	}
 	for _, clusterMember := range globalCluster.GlobalClusterMembers {
-		err := waitForRDSClusterUpdate(conn, resourceGlobalClusterGetIdByARN(conn, aws.StringValue(clusterMember.DBClusterArn)), d.Timeout(schema.TimeoutUpdate))
+		err := waitForRDSClusterUpdate(context.Background(), conn, resourceGlobalClusterGetIdByARN(conn, aws.StringValue(clusterMember.DBClusterArn)), d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,10 +26,10 @@ import (
 
 func ResourceInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceInstanceCreate,
-		Read:   resourceInstanceRead,
-		Update: resourceInstanceUpdate,
-		Delete: resourceInstanceDelete,
+		CreateWithoutTimeout: resourceInstanceCreate,
+		ReadWithoutTimeout:   resourceInstanceRead,
+		UpdateWithoutTimeout: resourceInstanceUpdate,
+		DeleteWithoutTimeout: resourceInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceInstanceImport,
 		},
@@ -490,8 +492,8 @@ func ResourceInstance() *schema.Resource {
 	}
 }
 
-func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -665,21 +667,21 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] DB Instance Replica create configuration: %#v", opts)
 		_, err := conn.CreateDBInstanceReadReplica(&opts)
 		if err != nil {
-			return fmt.Errorf("Error creating DB Instance: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating DB Instance: %s", err))
 		}
 	} else if v, ok := d.GetOk("s3_import"); ok {
 
 		if _, ok := d.GetOk("allocated_storage"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("engine"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("username"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, d.Get("name").(string)))
 		}
 
 		s3_bucket := v.([]interface{})[0].(map[string]interface{})
@@ -710,10 +712,10 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if _, ok := d.GetOk("character_set_name"); ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "character_set_name" doesn't work with with restores"`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "character_set_name" doesn't work with with restores"`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("timezone"); ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "timezone" doesn't work with with restores"`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "timezone" doesn't work with with restores"`, d.Get("name").(string)))
 		}
 
 		attr := d.Get("backup_retention_period")
@@ -796,7 +798,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] DB Instance S3 Restore configuration: %#v", opts)
 		var err error
 		// Retry for IAM eventual consistency
-		err = resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
 			_, err = conn.RestoreDBInstanceFromS3(&opts)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "ENHANCED_MONITORING") {
@@ -820,7 +822,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			_, err = conn.RestoreDBInstanceFromS3(&opts)
 		}
 		if err != nil {
-			return fmt.Errorf("Error creating DB Instance: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating DB Instance: %s", err))
 		}
 
 		d.SetId(d.Get("identifier").(string))
@@ -840,12 +842,15 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+			Description: fmt.Sprintf("RDS DB Instance (%s) create", d.Id()),
+			Reason:      dbInstanceStatusInfos,
+		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
-		return resourceInstanceRead(d, meta)
+		return resourceInstanceRead(ctx, d, meta)
 	} else if _, ok := d.GetOk("snapshot_identifier"); ok {
 		opts := rds.RestoreDBInstanceFromDBSnapshotInput{
 			AutoMinorVersionUpgrade: aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
@@ -1038,7 +1043,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error creating DB Instance: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating DB Instance: %s", err))
 		}
 	} else if v, ok := d.GetOk("restore_to_point_in_time"); ok {
 		if input := expandRestoreToPointInTime(v.([]interface{})); input != nil {
@@ -1130,21 +1135,21 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 
 			_, err := conn.RestoreDBInstanceToPointInTime(input)
 			if err != nil {
-				return fmt.Errorf("error creating DB Instance: %w", err)
+				return diag.FromErr(fmt.Errorf("error creating DB Instance: %w", err))
 			}
 		}
 	} else {
 		if _, ok := d.GetOk("allocated_storage"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "allocated_storage": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("engine"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "engine": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("password"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "password": required field is not set`, d.Get("name").(string)))
 		}
 		if _, ok := d.GetOk("username"); !ok {
-			return fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, d.Get("name").(string))
+			return diag.FromErr(fmt.Errorf(`provider.aws: aws_db_instance: %s: "username": required field is not set`, d.Get("name").(string)))
 		}
 
 		opts := rds.CreateDBInstanceInput{
@@ -1281,7 +1286,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] DB Instance create configuration: %#v", opts)
 		var err error
 		var createdDBInstanceOutput *rds.CreateDBInstanceOutput
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			createdDBInstanceOutput, err = conn.CreateDBInstance(&opts)
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "ENHANCED_MONITORING") {
@@ -1297,9 +1302,9 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "") {
 				opts.MasterUserPassword = aws.String("********")
-				return fmt.Errorf("Error creating DB Instance: %s, %+v", err, opts)
+				return diag.FromErr(fmt.Errorf("Error creating DB Instance: %s, %+v", err, opts))
 			}
-			return fmt.Errorf("Error creating DB Instance: %s", err)
+			return diag.FromErr(fmt.Errorf("Error creating DB Instance: %s", err))
 		}
 		// This is added here to avoid unnecessary modification when ca_cert_identifier is the default one
 		if attr, ok := d.GetOk("ca_cert_identifier"); ok && attr.(string) != aws.StringValue(createdDBInstanceOutput.DBInstance.CACertificateIdentifier) {
@@ -1320,9 +1325,12 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("RDS DB Instance (%s) create", d.Id()),
+		Reason:      dbInstanceStatusInfos,
+	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if requiresModifyDbInstance {
@@ -1331,13 +1339,13 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[INFO] DB Instance (%s) configuration requires ModifyDBInstance: %s", d.Id(), modifyDbInstanceInput)
 		_, err := conn.ModifyDBInstance(modifyDbInstanceInput)
 		if err != nil {
-			return fmt.Errorf("error modifying DB Instance (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error modifying DB Instance (%s): %s", d.Id(), err))
 		}
 
 		log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
-		err = waitUntilDBInstanceAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
+		err = waitUntilDBInstanceAvailableAfterUpdate(ctx, d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err))
 		}
 	}
 
@@ -1349,21 +1357,21 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[INFO] DB Instance (%s) configuration requires RebootDBInstance: %s", d.Id(), rebootDbInstanceInput)
 		_, err := conn.RebootDBInstance(rebootDbInstanceInput)
 		if err != nil {
-			return fmt.Errorf("error rebooting DB Instance (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error rebooting DB Instance (%s): %s", d.Id(), err))
 		}
 
 		log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
-		err = waitUntilDBInstanceAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
+		err = waitUntilDBInstanceAvailableAfterUpdate(ctx, d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err))
 		}
 	}
 
-	return resourceInstanceRead(d, meta)
+	return resourceInstanceRead(ctx, d, meta)
 }
 
-func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -1376,7 +1384,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading DB Instance (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading DB Instance (%s): %w", d.Id(), err))
 	}
 
 	d.Set("name", v.DBName)
@@ -1439,7 +1447,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("monitoring_role_arn", v.MonitoringRoleArn)
 
 	if err := d.Set("enabled_cloudwatch_logs_exports", flex.FlattenStringList(v.EnabledCloudwatchLogsExports)); err != nil {
-		return fmt.Errorf("error setting enabled_cloudwatch_logs_exports: %s", err)
+		return diag.FromErr(fmt.Errorf("error setting enabled_cloudwatch_logs_exports: %s", err))
 	}
 
 	d.Set("domain", "")
@@ -1455,18 +1463,18 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	tags, err := ListTags(conn, d.Get("arn").(string))
 
 	if err != nil {
-		return fmt.Errorf("error listing tags for RDS DB Instance (%s): %s", d.Get("arn").(string), err)
+		return diag.FromErr(fmt.Errorf("error listing tags for RDS DB Instance (%s): %s", d.Get("arn").(string), err))
 	}
 
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
 	}
 
 	// Create an empty schema.Set to hold all vpc security group ids
@@ -1493,7 +1501,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		replicas = append(replicas, *v)
 	}
 	if err := d.Set("replicas", replicas); err != nil {
-		return fmt.Errorf("Error setting replicas attribute: %#v, error: %#v", replicas, err)
+		return diag.FromErr(fmt.Errorf("Error setting replicas attribute: %#v, error: %#v", replicas, err))
 	}
 
 	d.Set("replica_mode", v.ReplicaMode)
@@ -1506,8 +1514,8 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	input := &rds.DeleteDBInstanceInput{
 		DBInstanceIdentifier:   aws.String(d.Id()),
@@ -1522,7 +1530,7 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := d.GetOk("final_snapshot_identifier"); ok {
 			input.FinalDBSnapshotIdentifier = aws.String(v.(string))
 		} else {
-			return fmt.Errorf("final_snapshot_identifier is required when skip_final_snapshot is false")
+			return diag.FromErr(fmt.Errorf("final_snapshot_identifier is required when skip_final_snapshot is false"))
 		}
 	}
 
@@ -1534,17 +1542,17 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil && !tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBInstanceStateFault, "is already being deleted") {
-		return fmt.Errorf("error deleting DB Instance (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting DB Instance (%s): %w", d.Id(), err))
 	}
 
-	if _, err := waitDBInstanceDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for DB Instance (%s) delete: %w", d.Id(), err)
+	if _, err := waitDBInstanceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for DB Instance (%s) delete: %w", d.Id(), err))
 	}
 
 	return nil
 }
 
-func waitUntilDBInstanceAvailableAfterUpdate(id string, conn *rds.RDS, timeout time.Duration) error {
+func waitUntilDBInstanceAvailableAfterUpdate(ctx context.Context, id string, conn *rds.RDS, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    resourceInstanceUpdatePendingStates,
 		Target:     []string{"available", "storage-optimization"},
@@ -1553,12 +1561,15 @@ func waitUntilDBInstanceAvailableAfterUpdate(id string, conn *rds.RDS, timeout t
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second, // Wait 30 secs before starting
 	}
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{
+		Description: fmt.Sprintf("RDS DB Instance (%s) update", id),
+		Reason:      dbInstanceStatusInfos,
+	})
 	return err
 }
 
-func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	req := &rds.ModifyDBInstanceInput{
 		ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
@@ -1751,7 +1762,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	if requestUpdate {
 		log.Printf("[DEBUG] DB Instance Modification request: %s", req)
 
-		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, tfiam.PropagationTimeout, func() *resource.RetryError {
 			_, err := conn.ModifyDBInstance(req)
 
 			// Retry for IAM eventual consistency
@@ -1771,13 +1782,13 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		if err != nil {
-			return fmt.Errorf("Error modifying DB Instance %s: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("Error modifying DB Instance %s: %s", d.Id(), err))
 		}
 
 		log.Printf("[DEBUG] Waiting for DB Instance (%s) to be available", d.Id())
-		err = waitUntilDBInstanceAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
+		err = waitUntilDBInstanceAvailableAfterUpdate(ctx, d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err))
 		}
 	}
 
@@ -1795,11 +1806,11 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 			_, err := conn.PromoteReadReplica(&opts)
 			if err != nil {
-				return fmt.Errorf("Error promoting database: %#v", err)
+				return diag.FromErr(fmt.Errorf("Error promoting database: %#v", err))
 			}
 			d.Set("replicate_source_db", "")
 		} else {
-			return fmt.Errorf("cannot elect new source database for replication")
+			return diag.FromErr(fmt.Errorf("cannot elect new source database for replication"))
 		}
 	}
 
@@ -1807,12 +1818,12 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating RDS DB Instance (%s) tags: %s", d.Get("arn").(string), err)
+			return diag.FromErr(fmt.Errorf("error updating RDS DB Instance (%s) tags: %s", d.Get("arn").(string), err))
 		}
 
 	}
 
-	return resourceInstanceRead(d, meta)
+	return resourceInstanceRead(ctx, d, meta)
 }
 
 // resourceInstanceRetrieve fetches DBInstance information from the AWS
@@ -1872,6 +1883,27 @@ func resourceInstanceStateRefreshFunc(id string, conn *rds.RDS) resource.StateRe
 	}
 }
 
+// dbInstanceStatusInfos returns the status messages of the RDS DB Instance returned by a status refresh function.
+func dbInstanceStatusInfos(v interface{}) string {
+	dbInstance, ok := v.(*rds.DBInstance)
+
+	if !ok || dbInstance == nil {
+		return ""
+	}
+
+	var messages []string
+
+	for _, statusInfo := range dbInstance.StatusInfos {
+		if statusInfo == nil || aws.StringValue(statusInfo.Message) == "" {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("%s: %s", aws.StringValue(statusInfo.Status), aws.StringValue(statusInfo.Message)))
+	}
+
+	return strings.Join(messages, "; ")
+}
+
 // Database instance status: http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Overview.DBInstance.Status.html
 var resourceInstanceCreatePendingStates = []string{
 	"backing-up",
@@ -217,7 +217,7 @@ func DataSourceInstance() *schema.Resource {
 }
 
 func dataSourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	opts := &rds.DescribeDBInstancesInput{
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -121,7 +122,7 @@ func ResourceProxy() *schema.Resource {
 }
 
 func resourceProxyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -166,7 +167,7 @@ func resourceProxyCreate(d *schema.ResourceData, meta interface{}) error {
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
-	_, err = stateChangeConf.WaitForState()
+	_, err = tfresource.WaitForState(stateChangeConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for DB Proxy creation: %s", err)
 	}
@@ -250,7 +251,7 @@ func flattenDbProxyAuths(userAuthConfigs []*rds.UserAuthConfigInfo) []interface{
 }
 
 func resourceProxyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -318,7 +319,7 @@ func resourceProxyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceProxyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	if d.HasChanges(
 		"auth",
@@ -366,7 +367,7 @@ func resourceProxyUpdate(d *schema.ResourceData, meta interface{}) error {
 			Timeout: d.Timeout(schema.TimeoutUpdate),
 		}
 
-		_, err = stateChangeConf.WaitForState()
+		_, err = tfresource.WaitForState(stateChangeConf)
 		if err != nil {
 			return fmt.Errorf("Error waiting for DB Proxy update: %s", err)
 		}
@@ -384,7 +385,7 @@ func resourceProxyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceProxyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	params := rds.DeleteDBProxyInput{
 		DBProxyName: aws.String(d.Id()),
@@ -401,7 +402,7 @@ func resourceProxyDelete(d *schema.ResourceData, meta interface{}) error {
 		Timeout: d.Timeout(schema.TimeoutDelete),
 	}
 
-	_, err = stateChangeConf.WaitForState()
+	_, err = tfresource.WaitForState(stateChangeConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for DB Proxy deletion: %s", err)
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceProxyDefaultTargetGroup() *schema.Resource {
@@ -94,7 +95,7 @@ func ResourceProxyDefaultTargetGroup() *schema.Resource {
 }
 
 func resourceProxyDefaultTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	tg, err := resourceProxyDefaultTargetGroupGet(conn, d.Id())
 
@@ -133,7 +134,7 @@ func resourceProxyDefaultTargetGroupUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceProxyDefaultTargetGroupCreateUpdate(d *schema.ResourceData, meta interface{}, timeout string) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+	conn := meta.(*conns.AWSClient).RDSConn()
 
 	params := rds.ModifyDBProxyTargetGroupInput{
 		DBProxyName:     aws.String(d.Get("db_proxy_name").(string)),
@@ -157,7 +158,7 @@ func resourceProxyDefaultTargetGroupCreateUpdate(d *schema.ResourceData, meta in
 		Timeout: d.Timeout(timeout),
 	}
 
-	_, err = stateChangeConf.WaitForState()
+	_, err = tfresource.WaitForState(stateChangeConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for DB Proxy default target group update: %s", err)
 	}
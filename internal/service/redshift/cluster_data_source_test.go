@@ -5,14 +5,13 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/redshift"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccRedshiftClusterDataSource_basic(t *testing.T) {
 	dataSourceName := "data.aws_redshift_cluster.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -49,7 +48,7 @@ func TestAccRedshiftClusterDataSource_basic(t *testing.T) {
 func TestAccRedshiftClusterDataSource_vpc(t *testing.T) {
 	dataSourceName := "data.aws_redshift_cluster.test"
 	subnetGroupResourceName := "aws_redshift_subnet_group.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
@@ -72,7 +71,7 @@ func TestAccRedshiftClusterDataSource_vpc(t *testing.T) {
 func TestAccRedshiftClusterDataSource_logging(t *testing.T) {
 	dataSourceName := "data.aws_redshift_cluster.test"
 	bucketResourceName := "aws_s3_bucket.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { acctest.PreCheck(t) },
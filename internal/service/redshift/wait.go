@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -27,7 +28,7 @@ func waitClusterDeleted(conn *redshift.Redshift, id string, timeout time.Duratio
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*redshift.Cluster); ok {
 		return output, err
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 func TestAccResourceGroupsGroup_Resource_basic(t *testing.T) {
 	var v resourcegroups.Group
 	resourceName := "aws_resourcegroups_group.test"
-	n := fmt.Sprintf("test-group-%d", sdkacctest.RandInt())
+	n := fmt.Sprintf("test-group-%d", acctest.RandInt(t))
 
 	desc1 := "Hello World"
 	desc2 := "Foo Bar"
@@ -71,7 +70,7 @@ func TestAccResourceGroupsGroup_Resource_basic(t *testing.T) {
 func TestAccResourceGroupsGroup_Resource_tags(t *testing.T) {
 	var v resourcegroups.Group
 	resourceName := "aws_resourcegroups_group.test"
-	n := fmt.Sprintf("test-group-%d", sdkacctest.RandInt())
+	n := fmt.Sprintf("test-group-%d", acctest.RandInt(t))
 	desc1 := "Hello World"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -125,7 +124,7 @@ func testAccCheckResourceGroupExists(n string, v *resourcegroups.Group) resource
 			return fmt.Errorf("No resource group name is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsConn()
 
 		resp, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
@@ -150,7 +149,7 @@ func testAccCheckResourceGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsConn()
 		resp, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
 		})
@@ -0,0 +1,20 @@
+package route53
+
+import (
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// errorClassifier classifies the errors returned by the Route 53 API.
+var errorClassifier = tfresource.RegisterServiceErrors("route53", tfresource.ServiceErrors{
+	// DNSSEC cannot be disabled until the key-signing key has been removed from the parent zone's DS record.
+	EventualConsistency: []tfresource.ErrorMatcher{
+		{Code: route53.ErrCodeKeySigningKeyInParentDSRecord},
+	},
+	Unsupported: []tfresource.ErrorMatcher{
+		// For example from us-west-2 Route53 key signing key
+		{Code: route53.ErrCodeInvalidKeySigningKeyStatus, Message: "cannot be deleted because"},
+		// For example from us-west-2 Route53 zone
+		{Code: route53.ErrCodeKeySigningKeyInParentDSRecord, Message: "Due to DNS lookup failure"},
+	},
+})
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -38,7 +39,7 @@ func waitChangeInfoStatusInsync(conn *route53.Route53, changeID string) (*route5
 		Timeout:      changeTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*route53.ChangeInfo); ok {
 		return output, err
@@ -55,7 +56,7 @@ func waitHostedZoneDNSSECStatusUpdated(conn *route53.Route53, hostedZoneID strin
 		Timeout:    hostedZoneDNSSECStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*route53.DNSSECStatus); ok {
 		if err != nil && output != nil && output.ServeSignature != nil && output.StatusMessage != nil {
@@ -87,7 +88,7 @@ func waitKeySigningKeyStatusUpdated(conn *route53.Route53, hostedZoneID string,
 		Timeout:    keySigningKeyStatusTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*route53.KeySigningKey); ok {
 		if err != nil && output != nil && output.Status != nil && output.StatusMessage != nil {
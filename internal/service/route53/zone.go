@@ -121,7 +121,7 @@ func ResourceZone() *schema.Resource {
 }
 
 func resourceZoneCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	region := meta.(*conns.AWSClient).Region
@@ -181,7 +181,7 @@ func resourceZoneCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceZoneRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -273,7 +273,7 @@ func resourceZoneRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceZoneUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 	region := meta.(*conns.AWSClient).Region
 
 	if d.HasChange("comment") {
@@ -334,7 +334,7 @@ func resourceZoneUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceZoneDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	if d.Get("force_destroy").(bool) {
 		if err := deleteAllRecordsInHostedZoneId(d.Id(), d.Get("name").(string), conn); err != nil {
@@ -494,7 +494,7 @@ func disableDNSSECForZone(conn *route53.Route53, hostedZoneId string) error {
 		output, err = conn.DisableHostedZoneDNSSEC(input)
 
 		if err != nil {
-			if tfawserr.ErrCodeEquals(err, route53.ErrCodeKeySigningKeyInParentDSRecord) {
+			if errorClassifier.IsEventualConsistencyError(err) {
 				log.Printf("[DEBUG] Unable to disable DNS SEC for zone %s because key-signing key in parent DS record. Retrying... (%s)", hostedZoneId, err)
 				return resource.RetryableError(err)
 			}
@@ -719,7 +719,7 @@ func route53WaitForChangeSynchronization(conn *route53.Route53, changeID string)
 		},
 	}
 
-	_, err := conf.WaitForState()
+	_, err := tfresource.WaitForState(&conf)
 
 	return err
 }
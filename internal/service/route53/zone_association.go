@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func ResourceZoneAssociation() *schema.Resource {
@@ -52,7 +53,7 @@ func ResourceZoneAssociation() *schema.Resource {
 }
 
 func resourceZoneAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	vpcRegion := meta.(*conns.AWSClient).Region
 	vpcID := d.Get("vpc_id").(string)
@@ -89,7 +90,7 @@ func resourceZoneAssociationCreate(d *schema.ResourceData, meta interface{}) err
 			Refresh:    resourceZoneAssociationRefreshFunc(conn, CleanChangeID(aws.StringValue(output.ChangeInfo.Id)), d.Id()),
 		}
 
-		if _, err := wait.WaitForState(); err != nil {
+		if _, err := tfresource.WaitForState(&wait); err != nil {
 			return fmt.Errorf("error waiting for Route 53 Zone Association (%s) synchronization: %w", d.Id(), err)
 		}
 	}
@@ -98,7 +99,7 @@ func resourceZoneAssociationCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceZoneAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	zoneID, vpcID, vpcRegion, err := ZoneAssociationParseID(d.Id())
 
@@ -146,7 +147,7 @@ func resourceZoneAssociationRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceZoneAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53Conn
+	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	zoneID, vpcID, vpcRegion, err := ZoneAssociationParseID(d.Id())
 
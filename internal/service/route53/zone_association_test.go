@@ -18,7 +18,7 @@ import (
 func TestAccRoute53ZoneAssociation_basic(t *testing.T) {
 	resourceName := "aws_route53_zone_association.test"
 
-	domainName := acctest.RandomFQDomainName()
+	domainName := acctest.RandomFQDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -44,7 +44,7 @@ func TestAccRoute53ZoneAssociation_basic(t *testing.T) {
 func TestAccRoute53ZoneAssociation_disappears(t *testing.T) {
 	resourceName := "aws_route53_zone_association.test"
 
-	domainName := acctest.RandomFQDomainName()
+	domainName := acctest.RandomFQDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -68,7 +68,7 @@ func TestAccRoute53ZoneAssociation_Disappears_vpc(t *testing.T) {
 	resourceName := "aws_route53_zone_association.test"
 	vpcResourceName := "aws_vpc.bar"
 
-	domainName := acctest.RandomFQDomainName()
+	domainName := acctest.RandomFQDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -92,7 +92,7 @@ func TestAccRoute53ZoneAssociation_Disappears_zone(t *testing.T) {
 	resourceName := "aws_route53_zone_association.test"
 	route53ZoneResourceName := "aws_route53_zone.foo"
 
-	domainName := acctest.RandomFQDomainName()
+	domainName := acctest.RandomFQDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -119,7 +119,7 @@ func TestAccRoute53ZoneAssociation_crossAccount(t *testing.T) {
 	// check for the instances in each region
 	var providers []*schema.Provider
 
-	domainName := acctest.RandomFQDomainName()
+	domainName := acctest.RandomFQDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -153,7 +153,7 @@ func TestAccRoute53ZoneAssociation_crossRegion(t *testing.T) {
 	// check for the instances in each region
 	var providers []*schema.Provider
 
-	domainName := acctest.RandomFQDomainName()
+	domainName := acctest.RandomFQDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
@@ -181,7 +181,7 @@ func TestAccRoute53ZoneAssociation_crossRegion(t *testing.T) {
 }
 
 func testAccCheckRoute53ZoneAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_zone_association" {
 			continue
@@ -227,7 +227,7 @@ func testAccCheckRoute53ZoneAssociationExists(resourceName string) resource.Test
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
 
 		hostedZoneSummary, err := tfroute53.GetZoneAssociation(conn, zoneID, vpcID, vpcRegion)
 
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -83,7 +82,7 @@ func TestAccRoute53Zone_basic(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
 	resourceName := "aws_route53_zone.test"
-	zoneName := acctest.RandomDomainName()
+	zoneName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -116,7 +115,7 @@ func TestAccRoute53Zone_disappears(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
 	resourceName := "aws_route53_zone.test"
-	zoneName := acctest.RandomDomainName()
+	zoneName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -139,7 +138,7 @@ func TestAccRoute53Zone_disappears(t *testing.T) {
 func TestAccRoute53Zone_multiple(t *testing.T) {
 	var zone0, zone1, zone2, zone3, zone4 route53.GetHostedZoneOutput
 
-	domainName := acctest.RandomDomainName()
+	domainName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -170,7 +169,7 @@ func TestAccRoute53Zone_comment(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
 	resourceName := "aws_route53_zone.test"
-	zoneName := acctest.RandomDomainName()
+	zoneName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -207,7 +206,7 @@ func TestAccRoute53Zone_delegationSetID(t *testing.T) {
 
 	delegationSetResourceName := "aws_route53_delegation_set.test"
 	resourceName := "aws_route53_zone.test"
-	zoneName := acctest.RandomDomainName()
+	zoneName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -236,7 +235,7 @@ func TestAccRoute53Zone_forceDestroy(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
 	resourceName := "aws_route53_zone.test"
-	zoneName := acctest.RandomDomainName()
+	zoneName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -249,8 +248,8 @@ func TestAccRoute53Zone_forceDestroy(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRoute53ZoneExists(resourceName, &zone),
 					// Add >100 records to verify pagination works ok
-					testAccCreateRandomRoute53RecordsInZoneId(&zone, 100),
-					testAccCreateRandomRoute53RecordsInZoneId(&zone, 5),
+					testAccCreateRandomRoute53RecordsInZoneId(t, &zone, 100),
+					testAccCreateRandomRoute53RecordsInZoneId(t, &zone, 5),
 				),
 			},
 		},
@@ -261,7 +260,7 @@ func TestAccRoute53Zone_ForceDestroy_trailingPeriod(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
 	resourceName := "aws_route53_zone.test"
-	zoneName := acctest.RandomDomainName()
+	zoneName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -274,8 +273,8 @@ func TestAccRoute53Zone_ForceDestroy_trailingPeriod(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRoute53ZoneExists(resourceName, &zone),
 					// Add >100 records to verify pagination works ok
-					testAccCreateRandomRoute53RecordsInZoneId(&zone, 100),
-					testAccCreateRandomRoute53RecordsInZoneId(&zone, 5),
+					testAccCreateRandomRoute53RecordsInZoneId(t, &zone, 100),
+					testAccCreateRandomRoute53RecordsInZoneId(t, &zone, 5),
 				),
 			},
 		},
@@ -286,7 +285,7 @@ func TestAccRoute53Zone_tags(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
 	resourceName := "aws_route53_zone.test"
-	zoneName := acctest.RandomDomainName()
+	zoneName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -332,10 +331,10 @@ func TestAccRoute53Zone_tags(t *testing.T) {
 func TestAccRoute53Zone_VPC_single(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_zone.test"
 	vpcResourceName := "aws_vpc.test1"
-	zoneName := acctest.RandomDomainName()
+	zoneName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -364,11 +363,11 @@ func TestAccRoute53Zone_VPC_single(t *testing.T) {
 func TestAccRoute53Zone_VPC_multiple(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_zone.test"
 	vpcResourceName1 := "aws_vpc.test1"
 	vpcResourceName2 := "aws_vpc.test2"
-	zoneName := acctest.RandomDomainName()
+	zoneName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -398,11 +397,11 @@ func TestAccRoute53Zone_VPC_multiple(t *testing.T) {
 func TestAccRoute53Zone_VPC_updates(t *testing.T) {
 	var zone route53.GetHostedZoneOutput
 
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_zone.test"
 	vpcResourceName1 := "aws_vpc.test1"
 	vpcResourceName2 := "aws_vpc.test2"
-	zoneName := acctest.RandomDomainName()
+	zoneName := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -444,7 +443,7 @@ func testAccCheckRoute53ZoneDestroy(s *terraform.State) error {
 }
 
 func testAccCheckRoute53ZoneDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*conns.AWSClient).Route53Conn
+	conn := provider.Meta().(*conns.AWSClient).Route53Conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_zone" {
 			continue
@@ -458,14 +457,14 @@ func testAccCheckRoute53ZoneDestroyWithProvider(s *terraform.State, provider *sc
 	return nil
 }
 
-func testAccCreateRandomRoute53RecordsInZoneId(zone *route53.GetHostedZoneOutput, recordsCount int) resource.TestCheckFunc {
-	return testAccCreateRandomRoute53RecordsInZoneIdWithProvider(func() *schema.Provider { return acctest.Provider }, zone, recordsCount)
+func testAccCreateRandomRoute53RecordsInZoneId(t *testing.T, zone *route53.GetHostedZoneOutput, recordsCount int) resource.TestCheckFunc {
+	return testAccCreateRandomRoute53RecordsInZoneIdWithProvider(t, func() *schema.Provider { return acctest.Provider }, zone, recordsCount)
 }
 
-func testAccCreateRandomRoute53RecordsInZoneIdWithProvider(providerF func() *schema.Provider, zone *route53.GetHostedZoneOutput, recordsCount int) resource.TestCheckFunc {
+func testAccCreateRandomRoute53RecordsInZoneIdWithProvider(t *testing.T, providerF func() *schema.Provider, zone *route53.GetHostedZoneOutput, recordsCount int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		provider := providerF()
-		conn := provider.Meta().(*conns.AWSClient).Route53Conn
+		conn := provider.Meta().(*conns.AWSClient).Route53Conn()
 
 		var changes []*route53.Change
 		if recordsCount > 100 {
@@ -475,7 +474,7 @@ func testAccCreateRandomRoute53RecordsInZoneIdWithProvider(providerF func() *sch
 			changes = append(changes, &route53.Change{
 				Action: aws.String("UPSERT"),
 				ResourceRecordSet: &route53.ResourceRecordSet{
-					Name: aws.String(fmt.Sprintf("%d-tf-acc-random.%s", sdkacctest.RandInt(), *zone.HostedZone.Name)),
+					Name: aws.String(fmt.Sprintf("%d-tf-acc-random.%s", acctest.RandInt(t), *zone.HostedZone.Name)),
 					Type: aws.String("CNAME"),
 					ResourceRecords: []*route53.ResourceRecord{
 						{Value: aws.String(fmt.Sprintf("random.%s", *zone.HostedZone.Name))},
@@ -519,7 +518,7 @@ func testAccCheckRoute53ZoneExistsWithProvider(n string, zone *route53.GetHosted
 		}
 
 		provider := providerF()
-		conn := provider.Meta().(*conns.AWSClient).Route53Conn
+		conn := provider.Meta().(*conns.AWSClient).Route53Conn()
 		resp, err := conn.GetHostedZone(&route53.GetHostedZoneInput{Id: aws.String(rs.Primary.ID)})
 		if err != nil {
 			return fmt.Errorf("Hosted zone err: %v", err)
@@ -553,7 +552,7 @@ func testAccCheckRoute53ZoneExistsWithProvider(n string, zone *route53.GetHosted
 
 func testAccCheckRoute53ZoneDisappears(zone *route53.GetHostedZoneOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
 
 		input := &route53.DeleteHostedZoneInput{
 			Id: zone.HostedZone.Id,
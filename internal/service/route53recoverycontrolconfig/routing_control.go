@@ -49,7 +49,7 @@ func ResourceRoutingControl() *schema.Resource {
 }
 
 func resourceRoutingControlCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn()
 
 	input := &r53rcc.CreateRoutingControlInput{
 		ClientToken:        aws.String(resource.UniqueId()),
@@ -82,7 +82,7 @@ func resourceRoutingControlCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceRoutingControlRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn()
 
 	input := &r53rcc.DescribeRoutingControlInput{
 		RoutingControlArn: aws.String(d.Id()),
@@ -114,7 +114,7 @@ func resourceRoutingControlRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRoutingControlUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn()
 
 	input := &r53rcc.UpdateRoutingControlInput{
 		RoutingControlName: aws.String(d.Get("name").(string)),
@@ -131,7 +131,7 @@ func resourceRoutingControlUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceRoutingControlDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn
+	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn()
 
 	input := &r53rcc.DeleteRoutingControlInput{
 		RoutingControlArn: aws.String(d.Id()),
@@ -5,6 +5,7 @@ import (
 
 	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -21,7 +22,7 @@ func waitRoute53RecoveryControlConfigClusterCreated(conn *r53rcc.Route53Recovery
 		MinTimeout: route53RecoveryControlConfigMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeClusterOutput); ok {
 		return output, err
@@ -40,7 +41,7 @@ func waitRoute53RecoveryControlConfigClusterDeleted(conn *r53rcc.Route53Recovery
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeClusterOutput); ok {
 		return output, err
@@ -58,7 +59,7 @@ func waitRoute53RecoveryControlConfigRoutingControlCreated(conn *r53rcc.Route53R
 		MinTimeout: route53RecoveryControlConfigMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeRoutingControlOutput); ok {
 		return output, err
@@ -77,7 +78,7 @@ func waitRoute53RecoveryControlConfigRoutingControlDeleted(conn *r53rcc.Route53R
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeRoutingControlOutput); ok {
 		return output, err
@@ -95,7 +96,7 @@ func waitRoute53RecoveryControlConfigControlPanelCreated(conn *r53rcc.Route53Rec
 		MinTimeout: route53RecoveryControlConfigMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeControlPanelOutput); ok {
 		return output, err
@@ -114,7 +115,7 @@ func waitRoute53RecoveryControlConfigControlPanelDeleted(conn *r53rcc.Route53Rec
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeControlPanelOutput); ok {
 		return output, err
@@ -132,7 +133,7 @@ func waitRoute53RecoveryControlConfigSafetyRuleCreated(conn *r53rcc.Route53Recov
 		MinTimeout: route53RecoveryControlConfigMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeSafetyRuleOutput); ok {
 		return output, err
@@ -151,7 +152,7 @@ func waitRoute53RecoveryControlConfigSafetyRuleDeleted(conn *r53rcc.Route53Recov
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*r53rcc.DescribeSafetyRuleOutput); ok {
 		return output, err
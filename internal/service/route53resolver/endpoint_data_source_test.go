@@ -6,14 +6,13 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/route53resolver"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccRoute53ResolverEndpointDataSource_basic(t *testing.T) {
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rInt := sdkacctest.RandInt()
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rInt := acctest.RandInt(t)
 	direction := "INBOUND"
 	resourceName := "aws_route53_resolver_endpoint.foo"
 	datasourceName := "data.aws_route53_resolver_endpoint.foo"
@@ -41,8 +40,8 @@ func TestAccRoute53ResolverEndpointDataSource_basic(t *testing.T) {
 }
 
 func TestAccRoute53ResolverEndpointDataSource_filter(t *testing.T) {
-	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rInt := sdkacctest.RandInt()
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rInt := acctest.RandInt(t)
 	direction := "OUTBOUND"
 	resourceName := "aws_route53_resolver_endpoint.foo"
 	datasourceName := "data.aws_route53_resolver_endpoint.foo"
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -53,7 +54,7 @@ func WaitQueryLogConfigAssociationCreated(conn *route53resolver.Route53Resolver,
 		Timeout: QueryLogConfigAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.ResolverQueryLogConfigAssociation); ok {
 		return v, err
@@ -71,7 +72,7 @@ func WaitQueryLogConfigAssociationDeleted(conn *route53resolver.Route53Resolver,
 		Timeout: QueryLogConfigAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.ResolverQueryLogConfigAssociation); ok {
 		return v, err
@@ -89,7 +90,7 @@ func WaitQueryLogConfigCreated(conn *route53resolver.Route53Resolver, queryLogCo
 		Timeout: QueryLogConfigCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.ResolverQueryLogConfig); ok {
 		return v, err
@@ -107,7 +108,7 @@ func WaitQueryLogConfigDeleted(conn *route53resolver.Route53Resolver, queryLogCo
 		Timeout: QueryLogConfigDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.ResolverQueryLogConfig); ok {
 		return v, err
@@ -125,7 +126,7 @@ func WaitDNSSECConfigCreated(conn *route53resolver.Route53Resolver, dnssecConfig
 		Timeout: DNSSECConfigCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.ResolverDnssecConfig); ok {
 		return v, err
@@ -143,7 +144,7 @@ func WaitDNSSECConfigDisabled(conn *route53resolver.Route53Resolver, dnssecConfi
 		Timeout: DNSSECConfigDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.ResolverDnssecConfig); ok {
 		return v, err
@@ -167,7 +168,7 @@ func WaitFirewallDomainListUpdated(conn *route53resolver.Route53Resolver, firewa
 		Timeout: FirewallDomainListUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.FirewallDomainList); ok {
 		if aws.StringValue(v.Status) != route53resolver.FirewallDomainListStatusComplete {
@@ -188,7 +189,7 @@ func WaitFirewallDomainListDeleted(conn *route53resolver.Route53Resolver, firewa
 		Timeout: FirewallDomainListDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.FirewallDomainList); ok {
 		return v, err
@@ -206,7 +207,7 @@ func WaitFirewallRuleGroupAssociationCreated(conn *route53resolver.Route53Resolv
 		Timeout: FirewallRuleGroupAssociationCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.FirewallRuleGroupAssociation); ok {
 		return v, err
@@ -224,7 +225,7 @@ func WaitFirewallRuleGroupAssociationUpdated(conn *route53resolver.Route53Resolv
 		Timeout: FirewallRuleGroupAssociationUpdatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.FirewallRuleGroupAssociation); ok {
 		return v, err
@@ -242,7 +243,7 @@ func WaitFirewallRuleGroupAssociationDeleted(conn *route53resolver.Route53Resolv
 		Timeout: FirewallRuleGroupAssociationDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*route53resolver.FirewallRuleGroupAssociation); ok {
 		return v, err
@@ -56,7 +56,7 @@ func DataSourceBucket() *schema.Resource {
 }
 
 func dataSourceBucketRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -95,18 +95,18 @@ func dataSourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func bucketLocation(client *conns.AWSClient, d *schema.ResourceData, bucket string) error {
-	region, err := s3manager.GetBucketRegionWithClient(context.Background(), client.S3Conn, bucket, func(r *request.Request) {
+	region, err := s3manager.GetBucketRegionWithClient(context.Background(), client.S3Conn(), bucket, func(r *request.Request) {
 		// By default, GetBucketRegion forces virtual host addressing, which
 		// is not compatible with many non-AWS implementations. Instead, pass
 		// the provider s3_force_path_style configuration, which defaults to
 		// false, but allows override.
-		r.Config.S3ForcePathStyle = client.S3Conn.Config.S3ForcePathStyle
+		r.Config.S3ForcePathStyle = client.S3Conn().Config.S3ForcePathStyle
 
 		// By default, GetBucketRegion uses anonymous credentials when doing
 		// a HEAD request to get the bucket region. This breaks in aws-cn regions
 		// when the account doesn't have an ICP license to host public content.
 		// Use the current credentials when getting the bucket region.
-		r.Config.Credentials = client.S3Conn.Config.Credentials
+		r.Config.Credentials = client.S3Conn().Config.Credentials
 	})
 	if err != nil {
 		return err
@@ -122,7 +122,7 @@ func bucketLocation(client *conns.AWSClient, d *schema.ResourceData, bucket stri
 		d.Set("hosted_zone_id", hostedZoneID)
 	}
 
-	_, websiteErr := client.S3Conn.GetBucketWebsite(
+	_, websiteErr := client.S3Conn().GetBucketWebsite(
 		&s3.GetBucketWebsiteInput{
 			Bucket: aws.String(bucket),
 		},
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 const rfc1123RegexPattern = `^[a-zA-Z]{3}, [0-9]+ [a-zA-Z]+ [0-9]{4} [0-9:]+ [A-Z]+$`
 
 func TestAccS3BucketObjectDataSource_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	var rObj s3.GetObjectOutput
 	var dsObj s3.GetObjectOutput
@@ -53,7 +52,7 @@ func TestAccS3BucketObjectDataSource_basic(t *testing.T) {
 
 func TestAccS3BucketObjectDataSource_basicViaAccessPoint(t *testing.T) {
 	var dsObj, rObj s3.GetObjectOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	dataSourceName := "data.aws_s3_bucket_object.test"
 	resourceName := "aws_s3_bucket_object.test"
@@ -79,7 +78,7 @@ func TestAccS3BucketObjectDataSource_basicViaAccessPoint(t *testing.T) {
 }
 
 func TestAccS3BucketObjectDataSource_readableBody(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	var rObj s3.GetObjectOutput
 	var dsObj s3.GetObjectOutput
@@ -113,7 +112,7 @@ func TestAccS3BucketObjectDataSource_readableBody(t *testing.T) {
 }
 
 func TestAccS3BucketObjectDataSource_kmsEncrypted(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	var rObj s3.GetObjectOutput
 	var dsObj s3.GetObjectOutput
@@ -149,7 +148,7 @@ func TestAccS3BucketObjectDataSource_kmsEncrypted(t *testing.T) {
 }
 
 func TestAccS3BucketObjectDataSource_bucketKeyEnabled(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	var rObj s3.GetObjectOutput
 	var dsObj s3.GetObjectOutput
@@ -186,7 +185,7 @@ func TestAccS3BucketObjectDataSource_bucketKeyEnabled(t *testing.T) {
 }
 
 func TestAccS3BucketObjectDataSource_allParams(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	var rObj s3.GetObjectOutput
 	var dsObj s3.GetObjectOutput
@@ -237,7 +236,7 @@ func TestAccS3BucketObjectDataSource_allParams(t *testing.T) {
 }
 
 func TestAccS3BucketObjectDataSource_objectLockLegalHoldOff(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	var rObj s3.GetObjectOutput
 	var dsObj s3.GetObjectOutput
@@ -271,7 +270,7 @@ func TestAccS3BucketObjectDataSource_objectLockLegalHoldOff(t *testing.T) {
 }
 
 func TestAccS3BucketObjectDataSource_objectLockLegalHoldOn(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	retainUntilDate := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
 
 	var rObj s3.GetObjectOutput
@@ -314,7 +313,7 @@ func TestAccS3BucketObjectDataSource_leadingSlash(t *testing.T) {
 	dataSourceName2 := "data.aws_s3_bucket_object.obj2"
 	dataSourceName3 := "data.aws_s3_bucket_object.obj3"
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceOnlyConf, conf := testAccObjectDataSourceConfig_leadingSlash(rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -368,7 +367,7 @@ func TestAccS3BucketObjectDataSource_multipleSlashes(t *testing.T) {
 	dataSourceName2 := "data.aws_s3_bucket_object.obj2"
 	dataSourceName3 := "data.aws_s3_bucket_object.obj3"
 
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 	resourceOnlyConf, conf := testAccObjectDataSourceConfig_multipleSlashes(rInt)
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -411,7 +410,7 @@ func TestAccS3BucketObjectDataSource_multipleSlashes(t *testing.T) {
 func TestAccS3BucketObjectDataSource_singleSlashAsKey(t *testing.T) {
 	var dsObj s3.GetObjectOutput
 	dataSourceName := "data.aws_s3_bucket_object.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
@@ -440,7 +439,7 @@ func testAccCheckObjectExistsDataSource(n string, obj *s3.GetObjectOutput) resou
 			return fmt.Errorf("S3 object data source ID not set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 		out, err := conn.GetObject(
 			&s3.GetObjectInput{
 				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
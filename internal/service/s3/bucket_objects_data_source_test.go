@@ -5,14 +5,13 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func TestAccS3BucketObjectsDataSource_basic(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
@@ -38,7 +37,7 @@ func TestAccS3BucketObjectsDataSource_basic(t *testing.T) {
 }
 
 func TestAccS3BucketObjectsDataSource_basicViaAccessPoint(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
@@ -64,7 +63,7 @@ func TestAccS3BucketObjectsDataSource_basicViaAccessPoint(t *testing.T) {
 }
 
 func TestAccS3BucketObjectsDataSource_all(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
@@ -95,7 +94,7 @@ func TestAccS3BucketObjectsDataSource_all(t *testing.T) {
 }
 
 func TestAccS3BucketObjectsDataSource_prefixes(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
@@ -125,7 +124,7 @@ func TestAccS3BucketObjectsDataSource_prefixes(t *testing.T) {
 }
 
 func TestAccS3BucketObjectsDataSource_encoded(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
@@ -151,7 +150,7 @@ func TestAccS3BucketObjectsDataSource_encoded(t *testing.T) {
 }
 
 func TestAccS3BucketObjectsDataSource_maxKeys(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
@@ -177,7 +176,7 @@ func TestAccS3BucketObjectsDataSource_maxKeys(t *testing.T) {
 }
 
 func TestAccS3BucketObjectsDataSource_startAfter(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
@@ -202,7 +201,7 @@ func TestAccS3BucketObjectsDataSource_startAfter(t *testing.T) {
 }
 
 func TestAccS3BucketObjectsDataSource_fetchOwner(t *testing.T) {
-	rInt := sdkacctest.RandInt()
+	rInt := acctest.RandInt(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(t) },
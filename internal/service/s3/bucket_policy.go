@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -27,6 +28,8 @@ func ResourceBucketPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tfiam.PolicyCustomizeDiff(tfiam.PolicyTypeS3Bucket, "policy"),
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -45,7 +48,7 @@ func ResourceBucketPolicy() *schema.Resource {
 }
 
 func resourceBucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	policy := d.Get("policy").(string)
@@ -80,7 +83,7 @@ func resourceBucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	log.Printf("[DEBUG] S3 bucket policy, read for bucket: %s", d.Id())
 	pol, err := conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
@@ -91,7 +94,7 @@ func resourceBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	if err == nil && pol.Policy != nil {
 		v = *pol.Policy
 	}
-	if err := d.Set("policy", v); err != nil {
+	if err := d.Set("policy", verify.PolicyToSet(v)); err != nil {
 		return err
 	}
 	if err := d.Set("bucket", d.Id()); err != nil {
@@ -102,7 +105,7 @@ func resourceBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 
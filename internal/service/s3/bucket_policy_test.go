@@ -6,7 +6,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -15,7 +14,7 @@ import (
 )
 
 func TestAccS3BucketPolicy_basic(t *testing.T) {
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt(t))
 	partition := acctest.Partition()
 
 	expectedPolicyText := fmt.Sprintf(`{
@@ -59,7 +58,7 @@ func TestAccS3BucketPolicy_basic(t *testing.T) {
 }
 
 func TestAccS3BucketPolicy_policyUpdate(t *testing.T) {
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt(t))
 	partition := acctest.Partition()
 
 	expectedPolicyText1 := fmt.Sprintf(`{
@@ -144,7 +143,7 @@ func testAccCheckBucketHasPolicy(n string, expectedPolicyText string) resource.T
 			return fmt.Errorf("No S3 Bucket ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		policy, err := conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
 			Bucket: aws.String(rs.Primary.ID),
@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccS3BucketPublicAccessBlock_basic(t *testing.T) {
 	var config s3.PublicAccessBlockConfiguration
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt(t))
 	resourceName := "aws_s3_bucket_public_access_block.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -50,7 +49,7 @@ func TestAccS3BucketPublicAccessBlock_basic(t *testing.T) {
 
 func TestAccS3BucketPublicAccessBlock_disappears(t *testing.T) {
 	var config s3.PublicAccessBlockConfiguration
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt(t))
 	resourceName := "aws_s3_bucket_public_access_block.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -73,7 +72,7 @@ func TestAccS3BucketPublicAccessBlock_disappears(t *testing.T) {
 
 func TestAccS3BucketPublicAccessBlock_Disappears_bucket(t *testing.T) {
 	var config s3.PublicAccessBlockConfiguration
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt(t))
 	resourceName := "aws_s3_bucket_public_access_block.bucket"
 	bucketResourceName := "aws_s3_bucket.bucket"
 
@@ -97,7 +96,7 @@ func TestAccS3BucketPublicAccessBlock_Disappears_bucket(t *testing.T) {
 
 func TestAccS3BucketPublicAccessBlock_blockPublicACLs(t *testing.T) {
 	var config1, config2, config3 s3.PublicAccessBlockConfiguration
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt(t))
 	resourceName := "aws_s3_bucket_public_access_block.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -138,7 +137,7 @@ func TestAccS3BucketPublicAccessBlock_blockPublicACLs(t *testing.T) {
 
 func TestAccS3BucketPublicAccessBlock_blockPublicPolicy(t *testing.T) {
 	var config1, config2, config3 s3.PublicAccessBlockConfiguration
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt(t))
 	resourceName := "aws_s3_bucket_public_access_block.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -179,7 +178,7 @@ func TestAccS3BucketPublicAccessBlock_blockPublicPolicy(t *testing.T) {
 
 func TestAccS3BucketPublicAccessBlock_ignorePublicACLs(t *testing.T) {
 	var config1, config2, config3 s3.PublicAccessBlockConfiguration
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt(t))
 	resourceName := "aws_s3_bucket_public_access_block.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -220,7 +219,7 @@ func TestAccS3BucketPublicAccessBlock_ignorePublicACLs(t *testing.T) {
 
 func TestAccS3BucketPublicAccessBlock_restrictPublicBuckets(t *testing.T) {
 	var config1, config2, config3 s3.PublicAccessBlockConfiguration
-	name := fmt.Sprintf("tf-test-bucket-%d", sdkacctest.RandInt())
+	name := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt(t))
 	resourceName := "aws_s3_bucket_public_access_block.bucket"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -270,7 +269,7 @@ func testAccCheckBucketPublicAccessBlockExists(n string, config *s3.PublicAccess
 			return fmt.Errorf("No S3 Bucket ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		input := &s3.GetPublicAccessBlockInput{
 			Bucket: aws.String(rs.Primary.ID),
@@ -317,7 +316,7 @@ func testAccCheckBucketPublicAccessBlockDisappears(n string) resource.TestCheckF
 			return fmt.Errorf("No S3 Bucket ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		deleteInput := &s3.DeletePublicAccessBlockInput{
 			Bucket: aws.String(rs.Primary.ID),
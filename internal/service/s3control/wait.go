@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -28,7 +29,7 @@ func waitPublicAccessBlockConfigurationBlockPublicACLsUpdated(conn *s3control.S3
 		ContinuousTargetOccurence: propagationContinuousTargetOccurence,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*s3control.PublicAccessBlockConfiguration); ok {
 		return output, err
@@ -46,7 +47,7 @@ func waitPublicAccessBlockConfigurationBlockPublicPolicyUpdated(conn *s3control.
 		ContinuousTargetOccurence: propagationContinuousTargetOccurence,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*s3control.PublicAccessBlockConfiguration); ok {
 		return output, err
@@ -64,7 +65,7 @@ func waitPublicAccessBlockConfigurationIgnorePublicACLsUpdated(conn *s3control.S
 		ContinuousTargetOccurence: propagationContinuousTargetOccurence,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*s3control.PublicAccessBlockConfiguration); ok {
 		return output, err
@@ -82,7 +83,7 @@ func waitPublicAccessBlockConfigurationRestrictPublicBucketsUpdated(conn *s3cont
 		ContinuousTargetOccurence: propagationContinuousTargetOccurence,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*s3control.PublicAccessBlockConfiguration); ok {
 		return output, err
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3outposts"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -16,7 +15,7 @@ import (
 
 func TestAccS3OutpostsEndpoint_basic(t *testing.T) {
 	resourceName := "aws_s3outposts_endpoint.test"
-	rInt := sdkacctest.RandIntRange(0, 255)
+	rInt := acctest.RandIntRange(t, 0, 255)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
@@ -49,7 +48,7 @@ func TestAccS3OutpostsEndpoint_basic(t *testing.T) {
 
 func TestAccS3OutpostsEndpoint_disappears(t *testing.T) {
 	resourceName := "aws_s3outposts_endpoint.test"
-	rInt := sdkacctest.RandIntRange(0, 255)
+	rInt := acctest.RandIntRange(t, 0, 255)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
@@ -70,7 +69,7 @@ func TestAccS3OutpostsEndpoint_disappears(t *testing.T) {
 }
 
 func testAccCheckEndpointDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).S3OutpostsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3OutpostsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3outposts_endpoint" {
@@ -102,7 +101,7 @@ func testAccCheckEndpointExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("no resource ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3OutpostsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3OutpostsConn()
 
 		endpoint, err := tfs3outposts.FindEndpoint(conn, rs.Primary.ID)
 
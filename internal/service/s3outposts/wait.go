@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/s3outposts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -27,7 +28,7 @@ func waitEndpointStatusCreated(conn *s3outposts.S3Outposts, endpointArn string)
 		Timeout: endpointStatusCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*s3outposts.Endpoint); ok {
 		return v, err
@@ -144,7 +144,7 @@ func ResourceNotebookInstance() *schema.Resource {
 }
 
 func resourceNotebookInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -214,7 +214,7 @@ func resourceNotebookInstanceCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNotebookInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -314,7 +314,7 @@ func resourceNotebookInstanceRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceNotebookInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -416,7 +416,7 @@ func resourceNotebookInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 					return resource.NonRetryableError(fmt.Errorf("error starting sagemaker notebook instance (%s): %s", d.Id(), err))
 				}
 
-				_, err = stateConf.WaitForState()
+				_, err = tfresource.WaitForState(stateConf)
 				if err != nil {
 					return resource.RetryableError(fmt.Errorf("error waiting for sagemaker notebook instance (%s) to start: %s", d.Id(), err))
 				}
@@ -429,7 +429,7 @@ func resourceNotebookInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 					return fmt.Errorf("error starting sagemaker notebook instance (%s): %s", d.Id(), err)
 				}
 
-				_, err = stateConf.WaitForState()
+				_, err = tfresource.WaitForState(stateConf)
 			}
 			if err != nil {
 				return fmt.Errorf("Error waiting for sagemaker notebook instance to start: %s", err)
@@ -445,7 +445,7 @@ func resourceNotebookInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNotebookInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 
 	describeNotebookInput := &sagemaker.DescribeNotebookInstanceInput{
 		NotebookInstanceName: aws.String(d.Id()),
@@ -46,7 +46,7 @@ func WaitNotebookInstanceInService(conn *sagemaker.SageMaker, notebookName strin
 		Timeout: NotebookInstanceInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		return output, err
@@ -67,7 +67,7 @@ func WaitNotebookInstanceStopped(conn *sagemaker.SageMaker, notebookName string)
 		Timeout: NotebookInstanceStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		return output, err
@@ -87,7 +87,7 @@ func WaitNotebookInstanceDeleted(conn *sagemaker.SageMaker, notebookName string)
 		Timeout: NotebookInstanceDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeNotebookInstanceOutput); ok {
 		return output, err
@@ -108,7 +108,7 @@ func WaitModelPackageGroupCompleted(conn *sagemaker.SageMaker, name string) (*sa
 		Timeout: ModelPackageGroupCompletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeModelPackageGroupOutput); ok {
 		return output, err
@@ -128,7 +128,7 @@ func WaitModelPackageGroupDeleted(conn *sagemaker.SageMaker, name string) (*sage
 		Timeout: ModelPackageGroupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeModelPackageGroupOutput); ok {
 		return output, err
@@ -149,7 +149,7 @@ func WaitImageCreated(conn *sagemaker.SageMaker, name string) (*sagemaker.Descri
 		Timeout: ImageCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageOutput); ok {
 		return output, err
@@ -167,7 +167,7 @@ func WaitImageDeleted(conn *sagemaker.SageMaker, name string) (*sagemaker.Descri
 		Timeout: ImageDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageOutput); ok {
 		return output, err
@@ -187,7 +187,7 @@ func WaitImageVersionCreated(conn *sagemaker.SageMaker, name string) (*sagemaker
 		Timeout: ImageVersionCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageVersionOutput); ok {
 		return output, err
@@ -205,7 +205,7 @@ func WaitImageVersionDeleted(conn *sagemaker.SageMaker, name string) (*sagemaker
 		Timeout: ImageVersionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeImageVersionOutput); ok {
 		return output, err
@@ -227,7 +227,7 @@ func WaitDomainInService(conn *sagemaker.SageMaker, domainID string) (*sagemaker
 		Timeout: DomainInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeDomainOutput); ok {
 		return output, err
@@ -247,7 +247,7 @@ func WaitDomainDeleted(conn *sagemaker.SageMaker, domainID string) (*sagemaker.D
 		Timeout: DomainDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeDomainOutput); ok {
 		return output, err
@@ -265,7 +265,7 @@ func WaitFeatureGroupCreated(conn *sagemaker.SageMaker, name string) (*sagemaker
 		Timeout: FeatureGroupCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFeatureGroupOutput); ok {
 		if status, reason := aws.StringValue(output.FeatureGroupStatus), aws.StringValue(output.FailureReason); status == sagemaker.FeatureGroupStatusCreateFailed && reason != "" {
@@ -287,7 +287,7 @@ func WaitFeatureGroupDeleted(conn *sagemaker.SageMaker, name string) (*sagemaker
 		Timeout: FeatureGroupDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFeatureGroupOutput); ok {
 		if status, reason := aws.StringValue(output.FeatureGroupStatus), aws.StringValue(output.FailureReason); status == sagemaker.FeatureGroupStatusDeleteFailed && reason != "" {
@@ -313,7 +313,7 @@ func WaitUserProfileInService(conn *sagemaker.SageMaker, domainID, userProfileNa
 		Timeout: UserProfileInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
 		return output, err
@@ -333,7 +333,7 @@ func WaitUserProfileDeleted(conn *sagemaker.SageMaker, domainID, userProfileName
 		Timeout: UserProfileDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
 		return output, err
@@ -354,7 +354,7 @@ func WaitAppInService(conn *sagemaker.SageMaker, domainID, userProfileName, appT
 		Timeout: AppInServiceTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeAppOutput); ok {
 		return output, err
@@ -376,7 +376,7 @@ func WaitAppDeleted(conn *sagemaker.SageMaker, domainID, userProfileName, appTyp
 		Timeout: AppDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeAppOutput); ok {
 		return output, err
@@ -394,7 +394,7 @@ func WaitFlowDefinitionActive(conn *sagemaker.SageMaker, name string) (*sagemake
 		Timeout: FlowDefinitionActiveTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFlowDefinitionOutput); ok {
 		if status, reason := aws.StringValue(output.FlowDefinitionStatus), aws.StringValue(output.FailureReason); status == sagemaker.FlowDefinitionStatusFailed && reason != "" {
@@ -416,7 +416,7 @@ func WaitFlowDefinitionDeleted(conn *sagemaker.SageMaker, name string) (*sagemak
 		Timeout: FlowDefinitionDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sagemaker.DescribeFlowDefinitionOutput); ok {
 		if status, reason := aws.StringValue(output.FlowDefinitionStatus), aws.StringValue(output.FailureReason); status == sagemaker.FlowDefinitionStatusFailed && reason != "" {
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 )
 
 func testAccInsight_basic(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_securityhub_insight.test"
 
 	resource.Test(t, resource.TestCase{
@@ -52,7 +51,7 @@ func testAccInsight_basic(t *testing.T) {
 }
 
 func testAccInsight_disappears(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_securityhub_insight.test"
 
 	resource.Test(t, resource.TestCase{
@@ -74,7 +73,7 @@ func testAccInsight_disappears(t *testing.T) {
 }
 
 func testAccInsight_DateFilters(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_securityhub_insight.test"
 
 	endDate := time.Now().Add(5 * time.Minute).Format(time.RFC1123)
@@ -126,7 +125,7 @@ func testAccInsight_DateFilters(t *testing.T) {
 }
 
 func testAccInsight_IPFilters(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_securityhub_insight.test"
 
 	resource.Test(t, resource.TestCase{
@@ -156,7 +155,7 @@ func testAccInsight_IPFilters(t *testing.T) {
 }
 
 func testAccInsight_KeywordFilters(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_securityhub_insight.test"
 
 	resource.Test(t, resource.TestCase{
@@ -186,7 +185,7 @@ func testAccInsight_KeywordFilters(t *testing.T) {
 }
 
 func testAccInsight_MapFilters(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_securityhub_insight.test"
 
 	resource.Test(t, resource.TestCase{
@@ -218,7 +217,7 @@ func testAccInsight_MapFilters(t *testing.T) {
 }
 
 func testAccInsight_MultipleFilters(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_securityhub_insight.test"
 
 	resource.Test(t, resource.TestCase{
@@ -276,8 +275,8 @@ func testAccInsight_MultipleFilters(t *testing.T) {
 }
 
 func testAccInsight_Name(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	rNameUpdated := sdkacctest.RandomWithPrefix("tf-acc-test-update")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rNameUpdated := acctest.RandomWithPrefix(t, "tf-acc-test-update")
 
 	resourceName := "aws_securityhub_insight.test"
 
@@ -313,7 +312,7 @@ func testAccInsight_Name(t *testing.T) {
 }
 
 func testAccInsight_NumberFilters(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_securityhub_insight.test"
 
 	resource.Test(t, resource.TestCase{
@@ -370,7 +369,7 @@ func testAccInsight_NumberFilters(t *testing.T) {
 }
 
 func testAccInsight_GroupByAttribute(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_securityhub_insight.test"
 
 	resource.Test(t, resource.TestCase{
@@ -405,7 +404,7 @@ func testAccInsight_GroupByAttribute(t *testing.T) {
 }
 
 func testAccInsight_WorkflowStatus(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_securityhub_insight.test"
 
 	resource.Test(t, resource.TestCase{
@@ -437,7 +436,7 @@ func testAccInsight_WorkflowStatus(t *testing.T) {
 }
 
 func testAccCheckInsightDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_securityhub_insight" {
@@ -471,7 +470,7 @@ func testAccCheckInsightExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn()
 
 		insight, err := tfsecurityhub.FindInsight(context.Background(), conn, rs.Primary.ID)
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -27,7 +28,7 @@ func waitAdminAccountEnabled(conn *securityhub.SecurityHub, adminAccountID strin
 		Timeout: adminAccountEnabledTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*securityhub.AdminAccount); ok {
 		return output, err
@@ -45,7 +46,7 @@ func waitAdminAccountNotFound(conn *securityhub.SecurityHub, adminAccountID stri
 		Timeout: adminAccountNotFoundTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*securityhub.AdminAccount); ok {
 		return output, err
@@ -62,7 +63,7 @@ func waitStandardsSubscriptionCreated(conn *securityhub.SecurityHub, arn string)
 		Timeout: standardsSubscriptionCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*securityhub.StandardsSubscription); ok {
 		return output, err
@@ -79,7 +80,7 @@ func waitStandardsSubscriptionDeleted(conn *securityhub.SecurityHub, arn string)
 		Timeout: standardsSubscriptionDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*securityhub.StandardsSubscription); ok {
 		return output, err
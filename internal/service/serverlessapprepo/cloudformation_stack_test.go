@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	serverlessrepository "github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -25,7 +24,7 @@ import (
 
 func TestAccServerlessAppRepoCloudFormationStack_basic(t *testing.T) {
 	var stack cloudformation.Stack
-	stackName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	stackName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	appARN := testAccCloudFormationApplicationID()
 	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
 
@@ -76,7 +75,7 @@ func TestAccServerlessAppRepoCloudFormationStack_basic(t *testing.T) {
 
 func TestAccServerlessAppRepoCloudFormationStack_disappears(t *testing.T) {
 	var stack cloudformation.Stack
-	stackName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	stackName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	appARN := testAccCloudFormationApplicationID()
 	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
 
@@ -100,7 +99,7 @@ func TestAccServerlessAppRepoCloudFormationStack_disappears(t *testing.T) {
 
 func TestAccServerlessAppRepoCloudFormationStack_versioned(t *testing.T) {
 	var stack1, stack2, stack3 cloudformation.Stack
-	stackName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	stackName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	appARN := testAccCloudFormationApplicationID()
 	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
 
@@ -157,7 +156,7 @@ func TestAccServerlessAppRepoCloudFormationStack_versioned(t *testing.T) {
 
 func TestAccServerlessAppRepoCloudFormationStack_paired(t *testing.T) {
 	var stack cloudformation.Stack
-	stackName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	stackName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	appARN := testAccCloudFormationApplicationID()
 	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
 
@@ -185,7 +184,7 @@ func TestAccServerlessAppRepoCloudFormationStack_paired(t *testing.T) {
 
 func TestAccServerlessAppRepoCloudFormationStack_tags(t *testing.T) {
 	var stack cloudformation.Stack
-	stackName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	stackName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	appARN := testAccCloudFormationApplicationID()
 	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
 
@@ -230,9 +229,9 @@ func TestAccServerlessAppRepoCloudFormationStack_tags(t *testing.T) {
 
 func TestAccServerlessAppRepoCloudFormationStack_update(t *testing.T) {
 	var stack cloudformation.Stack
-	stackName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	initialName := sdkacctest.RandomWithPrefix("FuncName1")
-	updatedName := sdkacctest.RandomWithPrefix("FuncName2")
+	stackName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	initialName := acctest.RandomWithPrefix(t, "FuncName1")
+	updatedName := acctest.RandomWithPrefix(t, "FuncName2")
 	appARN := testAccCloudFormationApplicationID()
 	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
 
@@ -272,7 +271,7 @@ func testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(n stri
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn()
 		params := &cloudformation.DescribeStacksInput{
 			StackName: aws.String(rs.Primary.ID),
 		}
@@ -535,7 +534,7 @@ resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-ro
 }
 
 func testAccCheckAmiDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ami" {
@@ -566,7 +565,7 @@ func testAccCheckAmiDestroy(s *terraform.State) error {
 }
 
 func testAccCheckCloudFormationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack" {
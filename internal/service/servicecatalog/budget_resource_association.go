@@ -39,7 +39,7 @@ func ResourceBudgetResourceAssociation() *schema.Resource {
 }
 
 func resourceBudgetResourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.AssociateBudgetWithResourceInput{
 		BudgetName: aws.String(d.Get("budget_name").(string)),
@@ -81,7 +81,7 @@ func resourceBudgetResourceAssociationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceBudgetResourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	budgetName, resourceID, err := BudgetResourceAssociationParseID(d.Id())
 
@@ -112,7 +112,7 @@ func resourceBudgetResourceAssociationRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceBudgetResourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	budgetName, resourceID, err := BudgetResourceAssociationParseID(d.Id())
 
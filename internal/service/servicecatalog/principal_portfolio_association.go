@@ -54,7 +54,7 @@ func ResourcePrincipalPortfolioAssociation() *schema.Resource {
 }
 
 func resourcePrincipalPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.AssociatePrincipalWithPortfolioInput{
 		PortfolioId:  aws.String(d.Get("portfolio_id").(string)),
@@ -104,7 +104,7 @@ func resourcePrincipalPortfolioAssociationCreate(d *schema.ResourceData, meta in
 }
 
 func resourcePrincipalPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	acceptLanguage, principalARN, portfolioID, err := PrincipalPortfolioAssociationParseID(d.Id())
 
@@ -141,7 +141,7 @@ func resourcePrincipalPortfolioAssociationRead(d *schema.ResourceData, meta inte
 }
 
 func resourcePrincipalPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	acceptLanguage, principalARN, portfolioID, err := PrincipalPortfolioAssociationParseID(d.Id())
 
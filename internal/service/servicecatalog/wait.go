@@ -75,7 +75,7 @@ func WaitProductReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, produ
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProductAsAdminOutput); ok {
 		return output, err
@@ -92,7 +92,7 @@ func WaitProductDeleted(conn *servicecatalog.ServiceCatalog, acceptLanguage, pro
 		Timeout: ProductDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil, nil
@@ -109,7 +109,7 @@ func WaitTagOptionReady(conn *servicecatalog.ServiceCatalog, id string) (*servic
 		Timeout: TagOptionReadyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.TagOptionDetail); ok {
 		return output, err
@@ -126,7 +126,7 @@ func WaitTagOptionDeleted(conn *servicecatalog.ServiceCatalog, id string) error
 		Timeout: TagOptionDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil
@@ -149,7 +149,7 @@ func WaitPortfolioShareReady(conn *servicecatalog.ServiceCatalog, portfolioID, s
 		Timeout: PortfolioShareCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.PortfolioShareDetail); ok {
 		return output, err
@@ -172,7 +172,7 @@ func WaitPortfolioShareCreatedWithToken(conn *servicecatalog.ServiceCatalog, tok
 		Timeout: PortfolioShareCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
 		return output, err
@@ -189,7 +189,7 @@ func WaitPortfolioShareDeleted(conn *servicecatalog.ServiceCatalog, portfolioID,
 		Timeout: PortfolioShareCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if tfresource.NotFound(err) {
 		return nil, nil
@@ -210,7 +210,7 @@ func WaitPortfolioShareDeletedWithToken(conn *servicecatalog.ServiceCatalog, tok
 		Timeout: PortfolioShareCreateTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
 		return output, err
@@ -227,7 +227,7 @@ func WaitOrganizationsAccessStable(conn *servicecatalog.ServiceCatalog) (string,
 		Timeout: OrganizationsAccessStableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.GetAWSOrganizationsAccessStatusOutput); ok {
 		return aws.StringValue(output.AccessStatus), err
@@ -247,7 +247,7 @@ func WaitConstraintReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeConstraintOutput); ok {
 		return output, err
@@ -264,7 +264,7 @@ func WaitConstraintDeleted(conn *servicecatalog.ServiceCatalog, acceptLanguage,
 		Timeout: ConstraintDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -280,7 +280,7 @@ func WaitProductPortfolioAssociationReady(conn *servicecatalog.ServiceCatalog, a
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.PortfolioDetail); ok {
 		return output, err
@@ -297,7 +297,7 @@ func WaitProductPortfolioAssociationDeleted(conn *servicecatalog.ServiceCatalog,
 		Timeout: ProductPortfolioAssociationDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -310,7 +310,7 @@ func WaitServiceActionReady(conn *servicecatalog.ServiceCatalog, acceptLanguage,
 		Timeout: ServiceActionReadyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.ServiceActionDetail); ok {
 		return output, err
@@ -327,7 +327,7 @@ func WaitServiceActionDeleted(conn *servicecatalog.ServiceCatalog, acceptLanguag
 		Timeout: ServiceActionDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil
@@ -344,7 +344,7 @@ func WaitBudgetResourceAssociationReady(conn *servicecatalog.ServiceCatalog, bud
 		Timeout: BudgetResourceAssociationReadyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.BudgetDetail); ok {
 		return output, err
@@ -361,7 +361,7 @@ func WaitBudgetResourceAssociationDeleted(conn *servicecatalog.ServiceCatalog, b
 		Timeout: BudgetResourceAssociationDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -374,7 +374,7 @@ func WaitTagOptionResourceAssociationReady(conn *servicecatalog.ServiceCatalog,
 		Timeout: TagOptionResourceAssociationReadyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.ResourceDetail); ok {
 		return output, err
@@ -391,7 +391,7 @@ func WaitTagOptionResourceAssociationDeleted(conn *servicecatalog.ServiceCatalog
 		Timeout: TagOptionResourceAssociationDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -407,7 +407,7 @@ func WaitProvisioningArtifactReady(conn *servicecatalog.ServiceCatalog, id, prod
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProvisioningArtifactOutput); ok {
 		return output, err
@@ -424,7 +424,7 @@ func WaitProvisioningArtifactDeleted(conn *servicecatalog.ServiceCatalog, id, pr
 		Timeout: ProvisioningArtifactDeletedTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
 		return nil
@@ -448,7 +448,7 @@ func WaitPrincipalPortfolioAssociationReady(conn *servicecatalog.ServiceCatalog,
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.Principal); ok {
 		return output, err
@@ -466,7 +466,7 @@ func WaitPrincipalPortfolioAssociationDeleted(conn *servicecatalog.ServiceCatalo
 		NotFoundChecks: 1,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -482,7 +482,7 @@ func WaitLaunchPathsReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, p
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.([]*servicecatalog.LaunchPathSummary); ok {
 		return output, err
@@ -502,7 +502,7 @@ func WaitProvisionedProductReady(conn *servicecatalog.ServiceCatalog, acceptLang
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeProvisionedProductOutput); ok {
 		return output, err
@@ -519,7 +519,7 @@ func WaitProvisionedProductTerminated(conn *servicecatalog.ServiceCatalog, accep
 		Timeout: ProvisionedProductDeleteTimeout,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
@@ -535,7 +535,7 @@ func WaitRecordReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id str
 		MinTimeout:                MinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicecatalog.DescribeRecordOutput); ok {
 		return output, err
@@ -552,7 +552,7 @@ func WaitPortfolioConstraintsReady(conn *servicecatalog.ServiceCatalog, acceptLa
 		Timeout: PortfolioConstraintsReadyTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.([]*servicecatalog.ConstraintDetail); ok {
 		return output, err
@@ -24,7 +24,7 @@ func WaitOperationSuccess(conn *servicediscovery.ServiceDiscovery, operationID s
 		Timeout: OperationSuccessTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*servicediscovery.Operation); ok {
 		// Error messages can also be contained in the response with FAIL status
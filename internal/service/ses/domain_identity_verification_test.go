@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ses"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -29,7 +28,7 @@ func testAccDomainIdentityDomainFromEnv(t *testing.T) string {
 
 func TestAccSESDomainIdentityVerification_basic(t *testing.T) {
 	rootDomain := testAccDomainIdentityDomainFromEnv(t)
-	domain := fmt.Sprintf("tf-acc-%d.%s", sdkacctest.RandInt(), rootDomain)
+	domain := fmt.Sprintf("tf-acc-%d.%s", acctest.RandInt(t), rootDomain)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -46,7 +45,7 @@ func TestAccSESDomainIdentityVerification_basic(t *testing.T) {
 }
 
 func TestAccSESDomainIdentityVerification_timeout(t *testing.T) {
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -63,7 +62,7 @@ func TestAccSESDomainIdentityVerification_timeout(t *testing.T) {
 }
 
 func TestAccSESDomainIdentityVerification_nonexistent(t *testing.T) {
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -91,7 +90,7 @@ func testAccCheckDomainIdentityVerificationPassed(n string) resource.TestCheckFu
 		}
 
 		domain := rs.Primary.ID
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn()
 
 		params := &ses.GetIdentityVerificationAttributesInput{
 			Identities: []*string{
@@ -13,7 +13,7 @@ import (
 )
 
 func TestAccSESDomainMailFrom_basic(t *testing.T) {
-	dn := acctest.RandomDomain()
+	dn := acctest.RandomDomain(t)
 	domain := dn.String()
 	mailFromDomain1 := dn.Subdomain("bounce1").String()
 	mailFromDomain2 := dn.Subdomain("bounce2").String()
@@ -53,7 +53,7 @@ func TestAccSESDomainMailFrom_basic(t *testing.T) {
 }
 
 func TestAccSESDomainMailFrom_disappears(t *testing.T) {
-	dn := acctest.RandomDomain()
+	dn := acctest.RandomDomain(t)
 	domain := dn.String()
 	mailFromDomain := dn.Subdomain("bounce").String()
 	resourceName := "aws_ses_domain_mail_from.test"
@@ -77,7 +77,7 @@ func TestAccSESDomainMailFrom_disappears(t *testing.T) {
 }
 
 func TestAccSESDomainMailFrom_Disappears_identity(t *testing.T) {
-	dn := acctest.RandomDomain()
+	dn := acctest.RandomDomain(t)
 	domain := dn.String()
 	mailFromDomain := dn.Subdomain("bounce").String()
 	resourceName := "aws_ses_domain_mail_from.test"
@@ -101,7 +101,7 @@ func TestAccSESDomainMailFrom_Disappears_identity(t *testing.T) {
 }
 
 func TestAccSESDomainMailFrom_behaviorOnMxFailure(t *testing.T) {
-	domain := acctest.RandomDomain().String()
+	domain := acctest.RandomDomain(t).String()
 	resourceName := "aws_ses_domain_mail_from.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -145,7 +145,7 @@ func testAccCheckDomainMailFromExists(n string) resource.TestCheckFunc {
 		}
 
 		domain := rs.Primary.ID
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn()
 
 		params := &ses.GetIdentityMailFromDomainAttributesInput{
 			Identities: []*string{
@@ -167,7 +167,7 @@ func testAccCheckDomainMailFromExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckSESDomainMailFromDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ses_domain_mail_from" {
@@ -192,7 +192,7 @@ func testAccCheckSESDomainMailFromDestroy(s *terraform.State) error {
 
 func testAccCheckDomainMailFromDisappears(identity string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESConn()
 
 		input := &ses.SetIdentityMailFromDomainInput{
 			Identity:       aws.String(identity),
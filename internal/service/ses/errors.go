@@ -0,0 +1,13 @@
+package ses
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// errorClassifier classifies the errors returned by the SES API.
+var errorClassifier = tfresource.RegisterServiceErrors("ses", tfresource.ServiceErrors{
+	Unsupported: []tfresource.ErrorMatcher{
+		// For example from GovCloud SES.SetActiveReceiptRuleSet.
+		{Code: "InvalidAction", Message: "Unavailable Operation"},
+	},
+})
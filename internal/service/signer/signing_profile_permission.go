@@ -77,7 +77,7 @@ func ResourceSigningProfilePermission() *schema.Resource {
 }
 
 func resourceSigningProfilePermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SignerConn
+	conn := meta.(*conns.AWSClient).SignerConn()
 
 	profileName := d.Get("profile_name").(string)
 
@@ -165,7 +165,7 @@ func resourceSigningProfilePermissionCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceSigningProfilePermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SignerConn
+	conn := meta.(*conns.AWSClient).SignerConn()
 
 	listProfilePermissionsInput := &signer.ListProfilePermissionsInput{
 		ProfileName: aws.String(d.Get("profile_name").(string)),
@@ -239,7 +239,7 @@ func getProfilePermission(permissions []*signer.Permission, statementId string)
 }
 
 func resourceSigningProfilePermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SignerConn
+	conn := meta.(*conns.AWSClient).SignerConn()
 
 	profileName := d.Get("profile_name").(string)
 
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/signer"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccSignerSigningProfile_basic(t *testing.T) {
 	resourceName := "aws_signer_signing_profile.test_sp"
-	rString := sdkacctest.RandString(48)
+	rString := acctest.RandString(t, 48)
 	profileName := fmt.Sprintf("tf_acc_sp_basic_%s", rString)
 
 	var conf signer.GetSigningProfileOutput
@@ -156,7 +155,7 @@ func TestAccSignerSigningProfile_signatureValidityPeriod(t *testing.T) {
 }
 
 func testAccPreCheckSingerSigningProfile(t *testing.T, platformID string) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn()
 
 	input := &signer.ListSigningPlatformsInput{}
 
@@ -276,7 +275,7 @@ func testAccCheckSigningProfileExists(res string, sp *signer.GetSigningProfileOu
 			return fmt.Errorf("Signing Profile with that ARN does not exist")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn()
 
 		params := &signer.GetSigningProfileInput{
 			ProfileName: aws.String(rs.Primary.ID),
@@ -294,7 +293,7 @@ func testAccCheckSigningProfileExists(res string, sp *signer.GetSigningProfileOu
 }
 
 func testAccCheckSigningProfileDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn()
 
 	time.Sleep(5 * time.Second)
 
@@ -31,7 +31,7 @@ func ResourceDomain() *schema.Resource {
 }
 
 func resourceDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SimpleDBConn
+	conn := meta.(*conns.AWSClient).SimpleDBConn()
 
 	name := d.Get("name").(string)
 	input := &simpledb.CreateDomainInput{
@@ -47,7 +47,7 @@ func resourceDomainCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SimpleDBConn
+	conn := meta.(*conns.AWSClient).SimpleDBConn()
 
 	input := &simpledb.DomainMetadataInput{
 		DomainName: aws.String(d.Id()),
@@ -69,7 +69,7 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SimpleDBConn
+	conn := meta.(*conns.AWSClient).SimpleDBConn()
 
 	input := &simpledb.DeleteDomainInput{
 		DomainName: aws.String(d.Id()),
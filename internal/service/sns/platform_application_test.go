@@ -12,7 +12,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -153,7 +152,7 @@ func TestAccSNSPlatformApplication_basic(t *testing.T) {
 	resourceName := "aws_sns_platform_application.test"
 
 	for _, platform := range platforms {
-		name := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
+		name := fmt.Sprintf("tf-acc-%d", acctest.RandInt(t))
 		platformPrincipalCheck := resource.TestCheckNoResourceAttr(resourceName, "platform_principal")
 		if platform.Principal != "" {
 			platformPrincipalCheck = resource.TestCheckResourceAttrSet(resourceName, "platform_principal")
@@ -209,7 +208,7 @@ func TestAccSNSPlatformApplication_basicAttributes(t *testing.T) {
 		t.Run(platform.Name, func(*testing.T) {
 			for _, tc := range testCases {
 				t.Run(fmt.Sprintf("%s/%s", platform.Name, tc.AttributeKey), func(*testing.T) {
-					name := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
+					name := fmt.Sprintf("tf-acc-%d", acctest.RandInt(t))
 
 					resource.ParallelTest(t, resource.TestCase{
 						PreCheck:     func() { acctest.PreCheck(t) },
@@ -258,9 +257,9 @@ func TestAccSNSPlatformApplication_iamRoleAttributes(t *testing.T) {
 		t.Run(platform.Name, func(*testing.T) {
 			for _, tc := range testCases {
 				t.Run(fmt.Sprintf("%s/%s", platform.Name, tc), func(*testing.T) {
-					iamRoleName1 := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
-					iamRoleName2 := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
-					name := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
+					iamRoleName1 := fmt.Sprintf("tf-acc-%d", acctest.RandInt(t))
+					iamRoleName2 := fmt.Sprintf("tf-acc-%d", acctest.RandInt(t))
+					name := fmt.Sprintf("tf-acc-%d", acctest.RandInt(t))
 
 					resource.ParallelTest(t, resource.TestCase{
 						PreCheck:     func() { acctest.PreCheck(t) },
@@ -311,9 +310,9 @@ func TestAccSNSPlatformApplication_snsTopicAttributes(t *testing.T) {
 		t.Run(platform.Name, func(*testing.T) {
 			for _, tc := range testCases {
 				t.Run(fmt.Sprintf("%s/%s", platform.Name, tc), func(*testing.T) {
-					snsTopicName1 := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
-					snsTopicName2 := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
-					name := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
+					snsTopicName1 := fmt.Sprintf("tf-acc-%d", acctest.RandInt(t))
+					snsTopicName2 := fmt.Sprintf("tf-acc-%d", acctest.RandInt(t))
+					name := fmt.Sprintf("tf-acc-%d", acctest.RandInt(t))
 
 					resource.ParallelTest(t, resource.TestCase{
 						PreCheck:     func() { acctest.PreCheck(t) },
@@ -360,7 +359,7 @@ func testAccCheckPlatformApplicationExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("missing ID: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 		input := &sns.GetPlatformApplicationAttributesInput{
 			PlatformApplicationArn: aws.String(rs.Primary.ID),
@@ -374,7 +373,7 @@ func testAccCheckPlatformApplicationExists(name string) resource.TestCheckFunc {
 }
 
 func testAccCheckPlatformApplicationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_platform_application" {
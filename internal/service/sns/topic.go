@@ -61,8 +61,7 @@ func ResourceTopic() *schema.Resource {
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
+					return verify.PolicyToSet(v.(string))
 				},
 			},
 			"delivery_policy": {
@@ -181,7 +180,7 @@ func ResourceTopic() *schema.Resource {
 }
 
 func resourceTopicCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -350,7 +349,7 @@ func resourceTopicCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	// update mutable attributes
 	if d.HasChange("application_failure_feedback_role_arn") {
@@ -491,7 +490,7 @@ func resourceTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -525,7 +524,7 @@ func resourceTopicRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("kms_master_key_id", attributeOutput.Attributes["KmsMasterKeyId"])
 		d.Set("lambda_failure_feedback_role_arn", attributeOutput.Attributes["LambdaFailureFeedbackRoleArn"])
 		d.Set("lambda_success_feedback_role_arn", attributeOutput.Attributes["LambdaSuccessFeedbackRoleArn"])
-		d.Set("policy", attributeOutput.Attributes["Policy"])
+		d.Set("policy", verify.PolicyToSet(aws.StringValue(attributeOutput.Attributes["Policy"])))
 		d.Set("sqs_failure_feedback_role_arn", attributeOutput.Attributes["SQSFailureFeedbackRoleArn"])
 		d.Set("sqs_success_feedback_role_arn", attributeOutput.Attributes["SQSSuccessFeedbackRoleArn"])
 		d.Set("firehose_success_feedback_role_arn", attributeOutput.Attributes["FirehoseSuccessFeedbackRoleArn"])
@@ -629,7 +628,7 @@ func resourceTopicRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	log.Printf("[DEBUG] SNS Delete Topic: %s", d.Id())
 	_, err := conn.DeleteTopic(&sns.DeleteTopicInput{
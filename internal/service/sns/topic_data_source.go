@@ -29,7 +29,7 @@ func DataSourceTopic() *schema.Resource {
 }
 
 func dataSourceTopicsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	resourceArn := ""
 	name := d.Get("name").(string)
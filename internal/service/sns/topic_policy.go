@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -24,6 +25,8 @@ func ResourceTopicPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tfiam.PolicyCustomizeDiff(tfiam.PolicyTypeSNSTopic, "policy"),
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:         schema.TypeString,
@@ -58,7 +61,7 @@ func resourceTopicPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 	// Retry the update in the event of an eventually consistent style of
 	// error, where say an IAM resource is successfully created but not
 	// actually available. See https://github.com/hashicorp/terraform/issues/3660
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 	_, err := verify.RetryOnAWSCode("InvalidParameter", func() (interface{}, error) {
 		return conn.SetTopicAttributes(&req)
 	})
@@ -70,7 +73,7 @@ func resourceTopicPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTopicPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	attributeOutput, err := conn.GetTopicAttributes(&sns.GetTopicAttributesInput{
 		TopicArn: aws.String(d.Id()),
@@ -99,7 +102,7 @@ func resourceTopicPolicyRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	d.Set("policy", policy)
+	d.Set("policy", verify.PolicyToSet(aws.StringValue(policy)))
 	d.Set("arn", attrmap["TopicArn"])
 	d.Set("owner", attrmap["Owner"])
 
@@ -120,7 +123,7 @@ func resourceTopicPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	// error, where say an IAM resource is successfully created but not
 	// actually available. See https://github.com/hashicorp/terraform/issues/3660
 	log.Printf("[DEBUG] Resetting SNS Topic Policy to default: %s", req)
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 	_, err := verify.RetryOnAWSCode("InvalidParameter", func() (interface{}, error) {
 		return conn.SetTopicAttributes(&req)
 	})
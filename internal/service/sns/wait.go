@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -20,7 +21,7 @@ func waitSubscriptionConfirmed(conn *sns.SNS, id, expectedValue string, timeout
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sns.GetSubscriptionAttributesOutput); ok {
 		return output, err
@@ -37,7 +38,7 @@ func waitSubscriptionDeleted(conn *sns.SNS, id string) (*sns.GetSubscriptionAttr
 		Timeout: subscriptionDeleteTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*sns.GetSubscriptionAttributesOutput); ok {
 		return output, err
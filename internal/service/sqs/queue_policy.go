@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -33,6 +34,8 @@ func ResourceQueuePolicy() *schema.Resource {
 		MigrateState:  QueuePolicyMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: tfiam.PolicyCustomizeDiff(tfiam.PolicyTypeSQSQueue, "policy"),
+
 		Schema: map[string]*schema.Schema{
 			"policy": {
 				Type:             schema.TypeString,
@@ -51,7 +54,7 @@ func ResourceQueuePolicy() *schema.Resource {
 }
 
 func resourceQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	policyAttributes := map[string]string{
 		sqs.QueueAttributeNamePolicy: d.Get("policy").(string),
@@ -81,7 +84,7 @@ func resourceQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueuePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	policy, err := FindQueuePolicyByURL(conn, d.Id())
 
@@ -95,14 +98,14 @@ func resourceQueuePolicyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading SQS Queue Policy (%s): %w", d.Id(), err)
 	}
 
-	d.Set("policy", policy)
+	d.Set("policy", verify.PolicyToSet(policy))
 	d.Set("queue_url", d.Id())
 
 	return nil
 }
 
 func resourceQueuePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	log.Printf("[DEBUG] Deleting SQS Queue Policy: %s", d.Id())
 	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
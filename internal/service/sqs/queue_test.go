@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"sync"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/sqs"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -21,7 +23,7 @@ import (
 func TestAccSQSQueue_basic(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -65,7 +67,7 @@ func TestAccSQSQueue_basic(t *testing.T) {
 func TestAccSQSQueue_disappears(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -200,7 +202,7 @@ func TestAccSQSQueue_NamePrefix_fifoQueue(t *testing.T) {
 func TestAccSQSQueue_tags(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -245,7 +247,7 @@ func TestAccSQSQueue_tags(t *testing.T) {
 func TestAccSQSQueue_update(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -311,7 +313,7 @@ func TestAccSQSQueue_update(t *testing.T) {
 func TestAccSQSQueue_policy(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -343,7 +345,7 @@ func TestAccSQSQueue_policy(t *testing.T) {
 func TestAccSQSQueue_recentlyDeleted(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -372,7 +374,7 @@ func TestAccSQSQueue_recentlyDeleted(t *testing.T) {
 func TestAccSQSQueue_redrivePolicy(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -401,7 +403,7 @@ func TestAccSQSQueue_redrivePolicy(t *testing.T) {
 func TestAccSQSQueue_fifoQueue(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := fmt.Sprintf("%s.fifo", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
+	rName := fmt.Sprintf("%s.fifo", acctest.RandomWithPrefix(t, acctest.ResourcePrefix))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -428,7 +430,7 @@ func TestAccSQSQueue_fifoQueue(t *testing.T) {
 }
 
 func TestAccSQSQueue_FIFOQueue_expectNameError(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -447,7 +449,7 @@ func TestAccSQSQueue_FIFOQueue_expectNameError(t *testing.T) {
 func TestAccSQSQueue_FIFOQueue_contentBasedDeduplication(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := fmt.Sprintf("%s.fifo", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
+	rName := fmt.Sprintf("%s.fifo", acctest.RandomWithPrefix(t, acctest.ResourcePrefix))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -475,7 +477,7 @@ func TestAccSQSQueue_FIFOQueue_contentBasedDeduplication(t *testing.T) {
 func TestAccSQSQueue_FIFOQueue_highThroughputMode(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := fmt.Sprintf("%s.fifo", sdkacctest.RandomWithPrefix(acctest.ResourcePrefix))
+	rName := fmt.Sprintf("%s.fifo", acctest.RandomWithPrefix(t, acctest.ResourcePrefix))
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -511,7 +513,7 @@ func TestAccSQSQueue_FIFOQueue_highThroughputMode(t *testing.T) {
 }
 
 func TestAccSQSQueue_StandardQueue_expectContentBasedDeduplicationError(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -530,7 +532,7 @@ func TestAccSQSQueue_StandardQueue_expectContentBasedDeduplicationError(t *testi
 func TestAccSQSQueue_encryption(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -566,7 +568,7 @@ func TestAccSQSQueue_encryption(t *testing.T) {
 func TestAccSQSQueue_zeroVisibilityTimeoutSeconds(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -594,7 +596,7 @@ func TestAccSQSQueue_zeroVisibilityTimeoutSeconds(t *testing.T) {
 func TestAccSQSQueue_defaultKMSDataKeyReusePeriodSeconds(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -668,7 +670,7 @@ func testAccCheckQueueExists(resourceName string, v *map[string]string) resource
 			return fmt.Errorf("No SQS Queue URL is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn()
 
 		output, err := tfsqs.FindQueueAttributesByURL(conn, rs.Primary.ID)
 
@@ -683,7 +685,7 @@ func testAccCheckQueueExists(resourceName string, v *map[string]string) resource
 }
 
 func testAccCheckQueueDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
@@ -929,3 +931,212 @@ resource "aws_sqs_queue" "test" {
 }
 `, rName)
 }
+
+func TestSQSQueueOffline_basic(t *testing.T) {
+	s := acctest.NewStubServer(t)
+	queues := testStubQueues(s)
+	resourceName := "aws_sqs_queue.test"
+	rName := "tf-test-queue"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheckTerraformCLI(t) },
+		ProviderFactories: s.ProviderFactories(),
+		CheckDestroy:      testStubCheckQueuesDestroyed(queues),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueOfflineConfig(rName, 60, "Name", rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "arn", arn.ARN{Partition: "aws", Service: "sqs", Region: acctest.StubRegion, AccountID: acctest.StubAccountID, Resource: rName}.String()),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "url", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "60"),
+				),
+			},
+			{
+				Config: testAccQueueOfflineConfig(rName, 90, "Environment", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "visibility_timeout_seconds", "90"),
+					acctest.CheckStubCalls(s.Service("sqs", acctest.StubProtocolQuery), map[string]int{"CreateQueue": 1, "SetQueueAttributes": 1, "TagQueue": 1, "UntagQueue": 1}),
+				),
+			},
+		},
+	})
+}
+
+func TestSQSQueueOffline_withoutTerraformCLI(t *testing.T) {
+	s := acctest.NewStubServer(t)
+	queues := testStubQueues(s)
+	rName := "tf-test-queue"
+
+	state := s.ApplyResource("aws_sqs_queue", nil, map[string]interface{}{
+		"name":                       rName,
+		"visibility_timeout_seconds": 60,
+		"tags":                       map[string]interface{}{"Name": rName},
+	})
+
+	if got, expected := state.ID, fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", acctest.StubRegion, acctest.StubAccountID, rName); got != expected {
+		t.Errorf("got ID %s, expected %s", got, expected)
+	}
+
+	if got, expected := state.Attributes["visibility_timeout_seconds"], "60"; got != expected {
+		t.Errorf("got visibility_timeout_seconds %s, expected %s", got, expected)
+	}
+
+	state = s.ApplyResource("aws_sqs_queue", state, map[string]interface{}{
+		"name":                       rName,
+		"visibility_timeout_seconds": 90,
+		"tags":                       map[string]interface{}{"Environment": "test"},
+	})
+
+	if got, expected := state.Attributes["visibility_timeout_seconds"], "90"; got != expected {
+		t.Errorf("got visibility_timeout_seconds %s, expected %s", got, expected)
+	}
+
+	if got, expected := state.Attributes["tags.Environment"], "test"; got != expected {
+		t.Errorf("got tags.Environment %s, expected %s", got, expected)
+	}
+
+	if state := s.ApplyResource("aws_sqs_queue", state, nil); state != nil {
+		t.Errorf("got state %v after destroy, expected none", state)
+	}
+
+	if err := testStubCheckQueuesDestroyed(queues)(nil); err != nil {
+		t.Error(err)
+	}
+
+	if err := acctest.CheckStubCalls(s.Service("sqs", acctest.StubProtocolQuery), map[string]int{"CreateQueue": 1, "DeleteQueue": 1, "SetQueueAttributes": 1, "TagQueue": 1, "UntagQueue": 1})(nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func testAccQueueOfflineConfig(rName string, visibilityTimeoutSeconds int, tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                       = %[1]q
+  visibility_timeout_seconds = %[2]d
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, visibilityTimeoutSeconds, tagKey, tagValue)
+}
+
+func testStubCheckQueuesDestroyed(q *testStubQueueStore) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		q.lock.Lock()
+		defer q.lock.Unlock()
+
+		if n := len(q.attributes); n != 0 {
+			return fmt.Errorf("%d SQS Queues still exist", n)
+		}
+
+		return nil
+	}
+}
+
+// testStubQueueStore stands in for the SQS queues of an account.
+type testStubQueueStore struct {
+	lock       sync.Mutex
+	attributes map[string]map[string]string
+	stale      map[string]map[string]string
+	tags       map[string]map[string]string
+}
+
+func (q *testStubQueueStore) copyAttributes(url string) map[string]string {
+	attributes := make(map[string]string, len(q.attributes[url]))
+
+	for k, v := range q.attributes[url] {
+		attributes[k] = v
+	}
+
+	return attributes
+}
+
+func (q *testStubQueueStore) handle(f func(url string, r *acctest.StubRequest) acctest.StubResponse) acctest.StubHandler {
+	return func(r *acctest.StubRequest) acctest.StubResponse {
+		q.lock.Lock()
+		defer q.lock.Unlock()
+
+		url := r.Params.Get("QueueUrl")
+
+		if _, ok := q.attributes[url]; !ok {
+			return acctest.StubErrorResponse(sqs.ErrCodeQueueDoesNotExist, "The specified queue does not exist for this wsdl version.")
+		}
+
+		return f(url, r)
+	}
+}
+
+func testStubQueues(s *acctest.StubServer) *testStubQueueStore {
+	q := &testStubQueueStore{
+		attributes: make(map[string]map[string]string),
+		stale:      make(map[string]map[string]string),
+		tags:       make(map[string]map[string]string),
+	}
+
+	s.Service("sqs", acctest.StubProtocolQuery).
+		Handle("CreateQueue", func(r *acctest.StubRequest) acctest.StubResponse {
+			q.lock.Lock()
+			defer q.lock.Unlock()
+
+			name := r.Params.Get("QueueName")
+			url := fmt.Sprintf("https://sqs.%s.amazonaws.com/%s/%s", acctest.StubRegion, acctest.StubAccountID, name)
+
+			q.attributes[url] = r.ParamMap("Attribute", "Name", "Value")
+			q.attributes[url][sqs.QueueAttributeNameQueueArn] = arn.ARN{Partition: "aws", Service: "sqs", Region: acctest.StubRegion, AccountID: acctest.StubAccountID, Resource: name}.String()
+			q.tags[url] = r.ParamMap("Tag", "Key", "Value")
+
+			return acctest.StubResponse{Output: &sqs.CreateQueueOutput{QueueUrl: aws.String(url)}}
+		}).
+		Handle("DeleteQueue", q.handle(func(url string, r *acctest.StubRequest) acctest.StubResponse {
+			delete(q.attributes, url)
+			delete(q.tags, url)
+
+			return acctest.StubResponse{}
+		})).
+		Handle("GetQueueAttributes", q.handle(func(url string, r *acctest.StubRequest) acctest.StubResponse {
+			attributes, ok := q.stale[url]
+
+			if ok {
+				delete(q.stale, url)
+			} else {
+				attributes = q.copyAttributes(url)
+			}
+
+			return acctest.StubResponse{Output: &sqs.GetQueueAttributesOutput{Attributes: aws.StringMap(attributes)}}
+		})).
+		Handle("ListQueueTags", q.handle(func(url string, r *acctest.StubRequest) acctest.StubResponse {
+			return acctest.StubResponse{Output: &sqs.ListQueueTagsOutput{Tags: aws.StringMap(q.tags[url])}}
+		})).
+		Handle("SetQueueAttributes", q.handle(func(url string, r *acctest.StubRequest) acctest.StubResponse {
+			// The updated attributes are not returned until the change has propagated.
+			q.stale[url] = q.copyAttributes(url)
+
+			for k, v := range r.ParamMap("Attribute", "Name", "Value") {
+				q.attributes[url][k] = v
+			}
+
+			return acctest.StubResponse{}
+		})).
+		Handle("TagQueue", q.handle(func(url string, r *acctest.StubRequest) acctest.StubResponse {
+			for k, v := range r.ParamMap("Tag", "Key", "Value") {
+				q.tags[url][k] = v
+			}
+
+			return acctest.StubResponse{}
+		})).
+		Handle("UntagQueue", q.handle(func(url string, r *acctest.StubRequest) acctest.StubResponse {
+			for _, k := range r.ParamList("TagKey") {
+				delete(q.tags[url], k)
+			}
+
+			return acctest.StubResponse{}
+		}))
+
+	return q
+}
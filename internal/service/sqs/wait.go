@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	awspolicy "github.com/jen20/awspolicyequivalence"
 )
 
@@ -49,6 +50,10 @@ func waitQueueAttributesPropagated(conn *sqs.SQS, url string, expected map[strin
 
 			switch k {
 			case sqs.QueueAttributeNamePolicy:
+				if verify.PoliciesAreEquivalent(g, e) {
+					continue
+				}
+
 				equivalent, err := awspolicy.PoliciesAreEquivalent(g, e)
 
 				if err != nil {
@@ -118,7 +123,7 @@ func waitQueueDeleted(conn *sqs.SQS, url string) error {
 		ContinuousTargetOccurence: 3,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := tfresource.WaitForState(stateConf)
 
 	return err
 }
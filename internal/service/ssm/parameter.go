@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -42,9 +44,9 @@ func ResourceParameter() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 2048),
 			},
 			"description": {
-				Type:         schema.TypeString,
+				Type:         nullable.TypeNullableString,
 				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024),
+				ValidateFunc: nullable.ValidateTypeStringNullableStringLenBetween(0, 1024),
 			},
 			"tier": {
 				Type:         schema.TypeString,
@@ -115,7 +117,7 @@ func ResourceParameter() *schema.Resource {
 }
 
 func resourceParameterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -134,9 +136,7 @@ func resourceParameterCreate(d *schema.ResourceData, meta interface{}) error {
 		paramInput.DataType = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("description"); ok {
-		paramInput.Description = aws.String(v.(string))
-	}
+	paramInput.Description = flex.ExpandNullableString(nullable.StringFromResourceData(d, "description"))
 
 	if keyID, ok := d.GetOk("key_id"); ok && d.Get("type").(string) == ssm.ParameterTypeSecureString {
 		paramInput.SetKeyId(keyID.(string))
@@ -177,7 +177,7 @@ func resourceParameterCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceParameterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -245,7 +245,7 @@ func resourceParameterRead(d *schema.ResourceData, meta interface{}) error {
 
 	detail := describeResp.Parameters[0]
 	d.Set("key_id", detail.KeyId)
-	d.Set("description", detail.Description)
+	d.Set("description", flex.FlattenNullableString(detail.Description))
 	d.Set("tier", ssm.ParameterTierStandard)
 	if detail.Tier != nil {
 		d.Set("tier", detail.Tier)
@@ -276,7 +276,7 @@ func resourceParameterRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceParameterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		paramInput := &ssm.PutParameterInput{
@@ -292,8 +292,9 @@ func resourceParameterUpdate(d *schema.ResourceData, meta interface{}) error {
 			paramInput.DataType = aws.String(d.Get("data_type").(string))
 		}
 
+		// A description that is removed is cleared by setting it to the empty string.
 		if d.HasChange("description") {
-			paramInput.Description = aws.String(d.Get("description").(string))
+			paramInput.Description = flex.ExpandNullableString(nullable.StringFromResourceData(d, "description"))
 		}
 
 		if d.HasChange("key_id") && d.Get("type").(string) == ssm.ParameterTypeSecureString {
@@ -324,7 +325,7 @@ func resourceParameterUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceParameterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	_, err := conn.DeleteParameter(&ssm.DeleteParameterInput{
 		Name: aws.String(d.Get("name").(string)),
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccSSMPatchBaseline_basic(t *testing.T) {
 	var before, after ssm.PatchBaselineIdentity
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(t, 10)
 	resourceName := "aws_ssm_patch_baseline.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -70,7 +69,7 @@ func TestAccSSMPatchBaseline_basic(t *testing.T) {
 
 func TestAccSSMPatchBaseline_tags(t *testing.T) {
 	var patch ssm.PatchBaselineIdentity
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(t, 10)
 	resourceName := "aws_ssm_patch_baseline.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -114,7 +113,7 @@ func TestAccSSMPatchBaseline_tags(t *testing.T) {
 
 func TestAccSSMPatchBaseline_disappears(t *testing.T) {
 	var identity ssm.PatchBaselineIdentity
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(t, 10)
 	resourceName := "aws_ssm_patch_baseline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -137,7 +136,7 @@ func TestAccSSMPatchBaseline_disappears(t *testing.T) {
 
 func TestAccSSMPatchBaseline_operatingSystem(t *testing.T) {
 	var before, after ssm.PatchBaselineIdentity
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(t, 10)
 	resourceName := "aws_ssm_patch_baseline.test"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -180,7 +179,7 @@ func TestAccSSMPatchBaseline_operatingSystem(t *testing.T) {
 
 func TestAccSSMPatchBaseline_approveUntilDateParam(t *testing.T) {
 	var before, after ssm.PatchBaselineIdentity
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(t, 10)
 	resourceName := "aws_ssm_patch_baseline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -229,7 +228,7 @@ func TestAccSSMPatchBaseline_approveUntilDateParam(t *testing.T) {
 
 func TestAccSSMPatchBaseline_sources(t *testing.T) {
 	var before, after ssm.PatchBaselineIdentity
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(t, 10)
 	resourceName := "aws_ssm_patch_baseline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -281,7 +280,7 @@ func TestAccSSMPatchBaseline_sources(t *testing.T) {
 
 func TestAccSSMPatchBaseline_approvedPatchesNonSec(t *testing.T) {
 	var ssmPatch ssm.PatchBaselineIdentity
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(t, 10)
 	resourceName := "aws_ssm_patch_baseline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -308,7 +307,7 @@ func TestAccSSMPatchBaseline_approvedPatchesNonSec(t *testing.T) {
 
 func TestAccSSMPatchBaseline_rejectPatchesAction(t *testing.T) {
 	var ssmPatch ssm.PatchBaselineIdentity
-	name := sdkacctest.RandString(10)
+	name := acctest.RandString(t, 10)
 	resourceName := "aws_ssm_patch_baseline.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -354,7 +353,7 @@ func testAccCheckPatchBaselineExists(n string, patch *ssm.PatchBaselineIdentity)
 			return fmt.Errorf("No SSM Patch Baseline ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 		resp, err := conn.DescribePatchBaselines(&ssm.DescribePatchBaselinesInput{
 			Filters: []*ssm.PatchOrchestratorFilter{
@@ -380,7 +379,7 @@ func testAccCheckPatchBaselineExists(n string, patch *ssm.PatchBaselineIdentity)
 }
 
 func testAccCheckPatchBaselineDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_patch_baseline" {
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -24,7 +23,7 @@ func TestAccSSMResourceDataSync_basic(t *testing.T) {
 		CheckDestroy: testAccCheckResourceDataSyncDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSsmResourceDataSyncConfig(sdkacctest.RandInt(), sdkacctest.RandString(5)),
+				Config: testAccSsmResourceDataSyncConfig(acctest.RandInt(t), acctest.RandString(t, 5)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckResourceDataSyncExists(resourceName),
 				),
@@ -39,7 +38,7 @@ func TestAccSSMResourceDataSync_basic(t *testing.T) {
 }
 
 func TestAccSSMResourceDataSync_update(t *testing.T) {
-	rName := sdkacctest.RandString(5)
+	rName := acctest.RandString(t, 5)
 	resourceName := "aws_ssm_resource_data_sync.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -49,7 +48,7 @@ func TestAccSSMResourceDataSync_update(t *testing.T) {
 		CheckDestroy: testAccCheckResourceDataSyncDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccSsmResourceDataSyncConfig(sdkacctest.RandInt(), rName),
+				Config: testAccSsmResourceDataSyncConfig(acctest.RandInt(t), rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckResourceDataSyncExists(resourceName),
 				),
@@ -60,7 +59,7 @@ func TestAccSSMResourceDataSync_update(t *testing.T) {
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccSsmResourceDataSyncConfigUpdate(sdkacctest.RandInt(), rName),
+				Config: testAccSsmResourceDataSyncConfigUpdate(acctest.RandInt(t), rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckResourceDataSyncExists(resourceName),
 				),
@@ -70,7 +69,7 @@ func TestAccSSMResourceDataSync_update(t *testing.T) {
 }
 
 func testAccCheckResourceDataSyncDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssm_resource_data_sync" {
@@ -30,7 +30,7 @@ func DataSourceInstances() *schema.Resource {
 }
 
 func dataSourceInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSOAdminConn
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
 
 	var instances []*ssoadmin.InstanceMetadata
 	var arns, ids []string
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -26,7 +27,7 @@ func waitAccountAssignmentCreated(conn *ssoadmin.SSOAdmin, instanceArn, requestI
 		MinTimeout: awsSSOAdminAccountAssignmentMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
 		return v, err
 	}
@@ -44,7 +45,7 @@ func waitAccountAssignmentDeleted(conn *ssoadmin.SSOAdmin, instanceArn, requestI
 		MinTimeout: awsSSOAdminAccountAssignmentMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 	if v, ok := outputRaw.(*ssoadmin.AccountAssignmentOperationStatus); ok {
 		return v, err
 	}
@@ -60,7 +61,7 @@ func waitPermissionSetProvisioned(conn *ssoadmin.SSOAdmin, instanceArn, requestI
 		Refresh: statusPermissionSetProvisioning(conn, instanceArn, requestID),
 		Timeout: awsSSOAdminPermissionSetProvisionTimeout,
 	}
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(&stateConf)
 	if v, ok := outputRaw.(*ssoadmin.PermissionSetProvisioningStatus); ok {
 		return v, err
 	}
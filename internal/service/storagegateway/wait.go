@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -30,7 +31,7 @@ func waitStorageGatewayGatewayConnected(conn *storagegateway.StorageGateway, gat
 		ContinuousTargetOccurence: storageGatewayGatewayConnectedContinuousTargetOccurence, // Gateway activations can take a few seconds and can trigger a reboot of the Gateway
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	switch output := outputRaw.(type) {
 	case *storagegateway.DescribeGatewayInformationOutput:
@@ -48,7 +49,7 @@ func waitStorageGatewayGatewayJoinDomainJoined(conn *storagegateway.StorageGatew
 		Timeout: storageGatewayGatewayJoinDomainJoinedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.DescribeSMBSettingsOutput); ok {
 		return output, err
@@ -66,7 +67,7 @@ func waitStorediSCSIVolumeAvailable(conn *storagegateway.StorageGateway, volumeA
 		Timeout: storediSCSIVolumeAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.DescribeStorediSCSIVolumesOutput); ok {
 		return output, err
@@ -85,7 +86,7 @@ func waitNFSFileShareAvailable(conn *storagegateway.StorageGateway, fileShareArn
 		Delay:   nfsFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.NFSFileShareInfo); ok {
 		return output, err
@@ -104,7 +105,7 @@ func waitNFSFileShareDeleted(conn *storagegateway.StorageGateway, fileShareArn s
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.NFSFileShareInfo); ok {
 		return output, err
@@ -122,7 +123,7 @@ func waitSMBFileShareCreated(conn *storagegateway.StorageGateway, arn string, ti
 		Delay:   smbFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.SMBFileShareInfo); ok {
 		return output, err
@@ -141,7 +142,7 @@ func waitSMBFileShareDeleted(conn *storagegateway.StorageGateway, arn string, ti
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.SMBFileShareInfo); ok {
 		return output, err
@@ -159,7 +160,7 @@ func waitSMBFileShareUpdated(conn *storagegateway.StorageGateway, arn string, ti
 		Delay:   smbFileShareAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.SMBFileShareInfo); ok {
 		return output, err
@@ -178,7 +179,7 @@ func waitFileSystemAssociationAvailable(conn *storagegateway.StorageGateway, fil
 		Delay:   fileSystemAssociationAvailableDelay,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.FileSystemAssociationInfo); ok {
 		return output, err
@@ -197,7 +198,7 @@ func waitFileSystemAssociationDeleted(conn *storagegateway.StorageGateway, fileS
 		NotFoundChecks: 1,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*storagegateway.FileSystemAssociationInfo); ok {
 		return output, err
@@ -25,7 +25,7 @@ func waitCanaryReady(conn *synthetics.Synthetics, name string) (*synthetics.Cana
 		Timeout: canaryCreatedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*synthetics.Canary); ok {
 		if status := output.Status; aws.StringValue(status.State) == synthetics.CanaryStateError {
@@ -52,7 +52,7 @@ func waitCanaryStopped(conn *synthetics.Synthetics, name string) (*synthetics.Ca
 		Timeout: canaryStoppedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*synthetics.Canary); ok {
 		if status := output.Status; aws.StringValue(status.State) == synthetics.CanaryStateError {
@@ -78,7 +78,7 @@ func waitCanaryRunning(conn *synthetics.Synthetics, name string) (*synthetics.Ca
 		Timeout: canaryRunningTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*synthetics.Canary); ok {
 		if status := output.Status; aws.StringValue(status.State) == synthetics.CanaryStateError {
@@ -99,7 +99,7 @@ func waitCanaryDeleted(conn *synthetics.Synthetics, name string) (*synthetics.Ca
 		Timeout: canaryDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*synthetics.Canary); ok {
 		if status := output.Status; aws.StringValue(status.State) == synthetics.CanaryStateError {
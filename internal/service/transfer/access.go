@@ -114,7 +114,7 @@ func ResourceAccess() *schema.Resource {
 }
 
 func resourceAccessCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 
 	externalID := d.Get("external_id").(string)
 	serverID := d.Get("server_id").(string)
@@ -161,7 +161,7 @@ func resourceAccessCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAccessRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 
 	serverID, externalID, err := AccessParseResourceID(d.Id())
 
@@ -199,7 +199,7 @@ func resourceAccessRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAccessUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 
 	serverID, externalID, err := AccessParseResourceID(d.Id())
 
@@ -247,7 +247,7 @@ func resourceAccessUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAccessDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 
 	serverID, externalID, err := AccessParseResourceID(d.Id())
 
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/transfer"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 func testAccUser_basic(t *testing.T) {
 	var conf transfer.DescribedUser
 	resourceName := "aws_transfer_user.test"
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -48,7 +47,7 @@ func testAccUser_basic(t *testing.T) {
 func testAccUser_posix(t *testing.T) {
 	var conf transfer.DescribedUser
 	resourceName := "aws_transfer_user.test"
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -87,8 +86,8 @@ func testAccUser_posix(t *testing.T) {
 func testAccUser_modifyWithOptions(t *testing.T) {
 	var conf transfer.DescribedUser
 	resourceName := "aws_transfer_user.test"
-	rName := sdkacctest.RandString(10)
-	rName2 := sdkacctest.RandString(10)
+	rName := acctest.RandString(t, 10)
+	rName2 := acctest.RandString(t, 10)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
@@ -134,7 +133,7 @@ func testAccUser_modifyWithOptions(t *testing.T) {
 func testAccUser_disappears(t *testing.T) {
 	var serverConf transfer.DescribedServer
 	var userConf transfer.DescribedUser
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(t, 10)
 	resourceName := "aws_transfer_user.test"
 
 	resource.Test(t, resource.TestCase{
@@ -168,16 +167,16 @@ func testAccUser_UserName_Validation(t *testing.T) {
 				ExpectError: regexp.MustCompile(`Invalid "user_name": `),
 			},
 			{
-				Config:      testAccUserName_validation(sdkacctest.RandString(2)),
+				Config:      testAccUserName_validation(acctest.RandString(t, 2)),
 				ExpectError: regexp.MustCompile(`Invalid "user_name": `),
 			},
 			{
-				Config:             testAccUserName_validation(sdkacctest.RandString(33)),
+				Config:             testAccUserName_validation(acctest.RandString(t, 33)),
 				ExpectNonEmptyPlan: true,
 				PlanOnly:           true,
 			},
 			{
-				Config:      testAccUserName_validation(sdkacctest.RandString(101)),
+				Config:      testAccUserName_validation(acctest.RandString(t, 101)),
 				ExpectError: regexp.MustCompile(`Invalid "user_name": `),
 			},
 			{
@@ -195,7 +194,7 @@ func testAccUser_UserName_Validation(t *testing.T) {
 
 func testAccUser_homeDirectoryMappings(t *testing.T) {
 	var conf transfer.DescribedUser
-	rName := sdkacctest.RandString(10)
+	rName := acctest.RandString(t, 10)
 	resourceName := "aws_transfer_user.test"
 
 	resource.Test(t, resource.TestCase{
@@ -238,7 +237,7 @@ func testAccCheckUserExists(n string, res *transfer.DescribedUser) resource.Test
 			return fmt.Errorf("No Transfer User ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
 
 		userName := rs.Primary.Attributes["user_name"]
 		serverID := rs.Primary.Attributes["server_id"]
@@ -256,7 +255,7 @@ func testAccCheckUserExists(n string, res *transfer.DescribedUser) resource.Test
 }
 
 func testAccCheckUserDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_transfer_user" {
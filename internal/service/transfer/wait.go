@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -20,7 +21,7 @@ func waitServerCreated(conn *transfer.Transfer, id string, timeout time.Duration
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -37,7 +38,7 @@ func waitServerDeleted(conn *transfer.Transfer, id string) (*transfer.DescribedS
 		Timeout: serverDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -54,7 +55,7 @@ func waitServerStarted(conn *transfer.Transfer, id string, timeout time.Duration
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -71,7 +72,7 @@ func waitServerStopped(conn *transfer.Transfer, id string, timeout time.Duration
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedServer); ok {
 		return output, err
@@ -88,7 +89,7 @@ func waitUserDeleted(conn *transfer.Transfer, serverID, userName string) (*trans
 		Timeout: userDeletedTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if output, ok := outputRaw.(*transfer.DescribedUser); ok {
 		return output, err
@@ -7,7 +7,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/waf"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -17,7 +16,7 @@ import (
 
 func TestAccWAFByteMatchSet_basic(t *testing.T) {
 	var v waf.ByteMatchSet
-	byteMatchSet := fmt.Sprintf("byteMatchSet-%s", sdkacctest.RandString(5))
+	byteMatchSet := fmt.Sprintf("byteMatchSet-%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_byte_match_set.byte_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -61,8 +60,8 @@ func TestAccWAFByteMatchSet_basic(t *testing.T) {
 
 func TestAccWAFByteMatchSet_changeNameForceNew(t *testing.T) {
 	var before, after waf.ByteMatchSet
-	byteMatchSet := fmt.Sprintf("byteMatchSet-%s", sdkacctest.RandString(5))
-	byteMatchSetNewName := fmt.Sprintf("byteMatchSet-%s", sdkacctest.RandString(5))
+	byteMatchSet := fmt.Sprintf("byteMatchSet-%s", acctest.RandString(t, 5))
+	byteMatchSetNewName := fmt.Sprintf("byteMatchSet-%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_byte_match_set.byte_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -98,7 +97,7 @@ func TestAccWAFByteMatchSet_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFByteMatchSet_changeTuples(t *testing.T) {
 	var before, after waf.ByteMatchSet
-	byteMatchSetName := fmt.Sprintf("byteMatchSet-%s", sdkacctest.RandString(5))
+	byteMatchSetName := fmt.Sprintf("byteMatchSet-%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_byte_match_set.byte_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -166,7 +165,7 @@ func TestAccWAFByteMatchSet_changeTuples(t *testing.T) {
 
 func TestAccWAFByteMatchSet_noTuples(t *testing.T) {
 	var byteSet waf.ByteMatchSet
-	byteMatchSetName := fmt.Sprintf("byteMatchSet-%s", sdkacctest.RandString(5))
+	byteMatchSetName := fmt.Sprintf("byteMatchSet-%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_byte_match_set.byte_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -194,7 +193,7 @@ func TestAccWAFByteMatchSet_noTuples(t *testing.T) {
 
 func TestAccWAFByteMatchSet_disappears(t *testing.T) {
 	var v waf.ByteMatchSet
-	byteMatchSet := fmt.Sprintf("byteMatchSet-%s", sdkacctest.RandString(5))
+	byteMatchSet := fmt.Sprintf("byteMatchSet-%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_byte_match_set.byte_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -217,7 +216,7 @@ func TestAccWAFByteMatchSet_disappears(t *testing.T) {
 
 func testAccCheckByteMatchSetDisappears(v *waf.ByteMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn()
 
 		wr := tfwaf.NewRetryer(conn)
 		_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
@@ -271,7 +270,7 @@ func testAccCheckByteMatchSetExists(n string, v *waf.ByteMatchSet) resource.Test
 			return fmt.Errorf("No WAF ByteMatchSet ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn()
 		resp, err := conn.GetByteMatchSet(&waf.GetByteMatchSetInput{
 			ByteMatchSetId: aws.String(rs.Primary.ID),
 		})
@@ -295,7 +294,7 @@ func testAccCheckByteMatchSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn()
 		resp, err := conn.GetByteMatchSet(
 			&waf.GetByteMatchSetInput{
 				ByteMatchSetId: aws.String(rs.Primary.ID),
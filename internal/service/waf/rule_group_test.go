@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -22,8 +21,8 @@ func TestAccWAFRuleGroup_basic(t *testing.T) {
 	var group waf.RuleGroup
 	var idx int
 
-	ruleName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	ruleName := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
+	groupName := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -61,9 +60,9 @@ func TestAccWAFRuleGroup_basic(t *testing.T) {
 func TestAccWAFRuleGroup_changeNameForceNew(t *testing.T) {
 	var before, after waf.RuleGroup
 
-	ruleName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	newGroupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	ruleName := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
+	groupName := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
+	newGroupName := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -101,8 +100,8 @@ func TestAccWAFRuleGroup_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFRuleGroup_disappears(t *testing.T) {
 	var group waf.RuleGroup
-	ruleName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	ruleName := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
+	groupName := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -128,10 +127,10 @@ func TestAccWAFRuleGroup_changeActivatedRules(t *testing.T) {
 	var groupBefore, groupAfter waf.RuleGroup
 	var idx0, idx1, idx2, idx3 int
 
-	groupName := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	ruleName1 := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	ruleName2 := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
-	ruleName3 := fmt.Sprintf("tfacc%s", sdkacctest.RandString(5))
+	groupName := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
+	ruleName1 := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
+	ruleName2 := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
+	ruleName3 := fmt.Sprintf("tfacc%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -222,7 +221,7 @@ func computeActivatedRuleWithRuleId(rule *waf.Rule, actionType string, priority
 
 func TestAccWAFRuleGroup_tags(t *testing.T) {
 	var group waf.RuleGroup
-	groupName := fmt.Sprintf("test%s", sdkacctest.RandString(5))
+	groupName := fmt.Sprintf("test%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -273,7 +272,7 @@ func TestAccWAFRuleGroup_tags(t *testing.T) {
 
 func TestAccWAFRuleGroup_noActivatedRules(t *testing.T) {
 	var group waf.RuleGroup
-	groupName := fmt.Sprintf("test%s", sdkacctest.RandString(5))
+	groupName := fmt.Sprintf("test%s", acctest.RandString(t, 5))
 	resourceName := "aws_waf_rule_group.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -296,7 +295,7 @@ func TestAccWAFRuleGroup_noActivatedRules(t *testing.T) {
 
 func testAccCheckRuleGroupDisappears(group *waf.RuleGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn()
 
 		rResp, err := conn.ListActivatedRulesInRuleGroup(&waf.ListActivatedRulesInRuleGroupInput{
 			RuleGroupId: group.RuleGroupId,
@@ -346,7 +345,7 @@ func testAccCheckRuleGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn()
 		resp, err := conn.GetRuleGroup(&waf.GetRuleGroupInput{
 			RuleGroupId: aws.String(rs.Primary.ID),
 		})
@@ -378,7 +377,7 @@ func testAccCheckRuleGroupExists(n string, group *waf.RuleGroup) resource.TestCh
 			return fmt.Errorf("No WAF Rule Group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFConn()
 		resp, err := conn.GetRuleGroup(&waf.GetRuleGroupInput{
 			RuleGroupId: aws.String(rs.Primary.ID),
 		})
@@ -9,7 +9,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,7 +19,7 @@ import (
 
 func TestAccWAFRegionalWebACL_basic(t *testing.T) {
 	var v waf.WebACL
-	wafAclName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafregional_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -53,7 +52,7 @@ func TestAccWAFRegionalWebACL_basic(t *testing.T) {
 
 func TestAccWAFRegionalWebACL_tags(t *testing.T) {
 	var v waf.WebACL
-	wafAclName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafregional_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -98,7 +97,7 @@ func TestAccWAFRegionalWebACL_tags(t *testing.T) {
 
 func TestAccWAFRegionalWebACL_createRateBased(t *testing.T) {
 	var v waf.WebACL
-	wafAclName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafregional_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -129,7 +128,7 @@ func TestAccWAFRegionalWebACL_createRateBased(t *testing.T) {
 
 func TestAccWAFRegionalWebACL_createGroup(t *testing.T) {
 	var v waf.WebACL
-	wafAclName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafregional_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -160,8 +159,8 @@ func TestAccWAFRegionalWebACL_createGroup(t *testing.T) {
 
 func TestAccWAFRegionalWebACL_changeNameForceNew(t *testing.T) {
 	var before, after waf.WebACL
-	wafAclName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
-	wafAclNewName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
+	wafAclNewName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafregional_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -203,8 +202,8 @@ func TestAccWAFRegionalWebACL_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFRegionalWebACL_changeDefaultAction(t *testing.T) {
 	var before, after waf.WebACL
-	wafAclName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
-	wafAclNewName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
+	wafAclNewName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafregional_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -246,7 +245,7 @@ func TestAccWAFRegionalWebACL_changeDefaultAction(t *testing.T) {
 
 func TestAccWAFRegionalWebACL_disappears(t *testing.T) {
 	var v waf.WebACL
-	wafAclName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafregional_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -269,7 +268,7 @@ func TestAccWAFRegionalWebACL_disappears(t *testing.T) {
 
 func TestAccWAFRegionalWebACL_noRules(t *testing.T) {
 	var v waf.WebACL
-	wafAclName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafregional_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -301,7 +300,7 @@ func TestAccWAFRegionalWebACL_changeRules(t *testing.T) {
 	var v waf.WebACL
 	var r waf.Rule
 	var idx int
-	wafAclName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	wafAclName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafregional_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -346,7 +345,7 @@ func TestAccWAFRegionalWebACL_changeRules(t *testing.T) {
 
 func TestAccWAFRegionalWebACL_logging(t *testing.T) {
 	var webACL1, webACL2, webACL3 waf.WebACL
-	rName := fmt.Sprintf("wafacl%s", sdkacctest.RandString(5))
+	rName := fmt.Sprintf("wafacl%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafregional_web_acl.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -414,7 +413,7 @@ func computeWafRegionalWebAclRuleIndex(ruleId **string, priority int, ruleType s
 
 func testAccCheckWebACLDisappears(v *waf.WebACL) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn()
 		region := acctest.Provider.Meta().(*conns.AWSClient).Region
 
 		wr := tfwafregional.NewRetryer(conn, region)
@@ -462,7 +461,7 @@ func testAccCheckWebACLDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn()
 		resp, err := conn.GetWebACL(
 			&waf.GetWebACLInput{
 				WebACLId: aws.String(rs.Primary.ID),
@@ -496,7 +495,7 @@ func testAccCheckWebACLExists(n string, v *waf.WebACL) resource.TestCheckFunc {
 			return fmt.Errorf("No WebACL ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn()
 		resp, err := conn.GetWebACL(&waf.GetWebACLInput{
 			WebACLId: aws.String(rs.Primary.ID),
 		})
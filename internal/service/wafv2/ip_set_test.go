@@ -8,7 +8,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,7 +17,7 @@ import (
 
 func TestAccWAFV2IPSet_basic(t *testing.T) {
 	var v wafv2.IPSet
-	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipSetName := fmt.Sprintf("ip-set-%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafv2_ip_set.ip_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -66,7 +65,7 @@ func TestAccWAFV2IPSet_basic(t *testing.T) {
 
 func TestAccWAFV2IPSet_disappears(t *testing.T) {
 	var r wafv2.IPSet
-	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipSetName := fmt.Sprintf("ip-set-%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafv2_ip_set.ip_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -89,7 +88,7 @@ func TestAccWAFV2IPSet_disappears(t *testing.T) {
 
 func TestAccWAFV2IPSet_ipv6(t *testing.T) {
 	var v wafv2.IPSet
-	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipSetName := fmt.Sprintf("ip-set-%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafv2_ip_set.ip_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -125,7 +124,7 @@ func TestAccWAFV2IPSet_ipv6(t *testing.T) {
 
 func TestAccWAFV2IPSet_minimal(t *testing.T) {
 	var v wafv2.IPSet
-	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipSetName := fmt.Sprintf("ip-set-%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafv2_ip_set.ip_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -158,8 +157,8 @@ func TestAccWAFV2IPSet_minimal(t *testing.T) {
 
 func TestAccWAFV2IPSet_changeNameForceNew(t *testing.T) {
 	var before, after wafv2.IPSet
-	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
-	ipSetNewName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipSetName := fmt.Sprintf("ip-set-%s", acctest.RandString(t, 5))
+	ipSetNewName := fmt.Sprintf("ip-set-%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafv2_ip_set.ip_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -198,7 +197,7 @@ func TestAccWAFV2IPSet_changeNameForceNew(t *testing.T) {
 
 func TestAccWAFV2IPSet_tags(t *testing.T) {
 	var v wafv2.IPSet
-	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipSetName := fmt.Sprintf("ip-set-%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafv2_ip_set.ip_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -247,7 +246,7 @@ func TestAccWAFV2IPSet_tags(t *testing.T) {
 
 func TestAccWAFV2IPSet_large(t *testing.T) {
 	var v wafv2.IPSet
-	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	ipSetName := fmt.Sprintf("ip-set-%s", acctest.RandString(t, 5))
 	resourceName := "aws_wafv2_ip_set.ip_set"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -284,7 +283,7 @@ func testAccCheckIPSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn()
 		resp, err := conn.GetIPSet(
 			&wafv2.GetIPSetInput{
 				Id:    aws.String(rs.Primary.ID),
@@ -324,7 +323,7 @@ func testAccCheckIPSetExists(n string, v *wafv2.IPSet) resource.TestCheckFunc {
 			return fmt.Errorf("No WAFv2 IPSet ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn()
 		resp, err := conn.GetIPSet(&wafv2.GetIPSetInput{
 			Id:    aws.String(rs.Primary.ID),
 			Name:  aws.String(rs.Primary.Attributes["name"]),
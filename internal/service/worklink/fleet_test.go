@@ -18,7 +18,7 @@ import (
 )
 
 func TestAccWorkLinkFleet_basic(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -46,7 +46,7 @@ func TestAccWorkLinkFleet_basic(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_displayName(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -79,7 +79,7 @@ func TestAccWorkLinkFleet_displayName(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_optimizeForEndUserLocation(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -112,7 +112,7 @@ func TestAccWorkLinkFleet_optimizeForEndUserLocation(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_auditStreamARN(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -138,7 +138,7 @@ func TestAccWorkLinkFleet_auditStreamARN(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_network(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -181,7 +181,7 @@ func TestAccWorkLinkFleet_network(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_deviceCaCertificate(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 	fName := "test-fixtures/worklink-device-ca-certificate.pem"
 
@@ -215,7 +215,7 @@ func TestAccWorkLinkFleet_deviceCaCertificate(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_identityProvider(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 	fName := "test-fixtures/saml-metadata.xml"
 
@@ -247,7 +247,7 @@ func TestAccWorkLinkFleet_identityProvider(t *testing.T) {
 }
 
 func TestAccWorkLinkFleet_disappears(t *testing.T) {
-	rName := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	rName := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_fleet.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -279,7 +279,7 @@ func testAccCheckFleetDisappears(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("No resource ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn()
 
 		input := &worklink.DeleteFleetInput{
 			FleetArn: aws.String(rs.Primary.ID),
@@ -305,7 +305,7 @@ func testAccCheckFleetDisappears(resourceName string) resource.TestCheckFunc {
 }
 
 func testAccCheckFleetDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_worklink_fleet" {
@@ -342,7 +342,7 @@ func testAccCheckFleetExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Worklink Fleet ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn()
 		_, err := conn.DescribeFleetMetadata(&worklink.DescribeFleetMetadataInput{
 			FleetArn: aws.String(rs.Primary.ID),
 		})
@@ -352,7 +352,7 @@ func testAccCheckFleetExists(n string) resource.TestCheckFunc {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn()
 
 	input := &worklink.ListFleetsInput{
 		MaxResults: aws.Int64(1),
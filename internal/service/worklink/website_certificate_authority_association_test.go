@@ -18,7 +18,7 @@ import (
 )
 
 func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_basic(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_website_certificate_authority_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -47,10 +47,10 @@ func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_basic(t *tes
 }
 
 func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_displayName(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_website_certificate_authority_association.test"
-	displayName1 := fmt.Sprintf("tf-website-certificate-%s", sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha))
-	displayName2 := fmt.Sprintf("tf-website-certificate-%s", sdkacctest.RandStringFromCharSet(5, sdkacctest.CharSetAlpha))
+	displayName1 := fmt.Sprintf("tf-website-certificate-%s", acctest.RandStringFromCharSet(t, 5, sdkacctest.CharSetAlpha))
+	displayName2 := fmt.Sprintf("tf-website-certificate-%s", acctest.RandStringFromCharSet(t, 5, sdkacctest.CharSetAlpha))
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, worklink.EndpointsID),
@@ -81,7 +81,7 @@ func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_displayName(
 }
 
 func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_disappears(t *testing.T) {
-	suffix := sdkacctest.RandStringFromCharSet(20, sdkacctest.CharSetAlpha)
+	suffix := acctest.RandStringFromCharSet(t, 20, sdkacctest.CharSetAlpha)
 	resourceName := "aws_worklink_website_certificate_authority_association.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -103,7 +103,7 @@ func TestAccWorkLinkWebsiteCertificateAuthorityAssociation_WorkLink_disappears(t
 }
 
 func testAccCheckWebsiteCertificateAuthorityAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_worklink_website_certificate_authority_association" {
@@ -139,7 +139,7 @@ func testAccCheckWebsiteCertificateAuthorityAssociationDisappears(resourceName s
 			return fmt.Errorf("No resource ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn()
 		fleetArn, websiteCaID, err := tfworklink.DecodeWebsiteCertificateAuthorityAssociationResourceID(rs.Primary.ID)
 		if err != nil {
 			return err
@@ -185,7 +185,7 @@ func testAccCheckWebsiteCertificateAuthorityAssociationExists(n string) resource
 			return fmt.Errorf("WorkLink Fleet ARN is missing, should be set.")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkLinkConn()
 		fleetArn, websiteCaID, err := tfworklink.DecodeWebsiteCertificateAuthorityAssociationResourceID(rs.Primary.ID)
 		if err != nil {
 			return err
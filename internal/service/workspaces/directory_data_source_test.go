@@ -5,14 +5,13 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func testAccDirectoryDataSource_basic(t *testing.T) {
-	rName := sdkacctest.RandString(8)
-	domain := acctest.RandomDomainName()
+	rName := acctest.RandString(t, 8)
+	domain := acctest.RandomDomainName(t)
 
 	resourceName := "aws_workspaces_directory.test"
 	dataSourceName := "data.aws_workspaces_directory.test"
@@ -7,7 +7,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,13 +17,13 @@ import (
 
 func testAccDirectory_basic(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(t, 8)
 
 	resourceName := "aws_workspaces_directory.main"
 	directoryResourceName := "aws_directory_service_directory.main"
 	iamRoleDataSourceName := "data.aws_iam_role.workspaces-default"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -87,11 +86,11 @@ func testAccDirectory_basic(t *testing.T) {
 
 func testAccDirectory_disappears(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(t, 8)
 
 	resourceName := "aws_workspaces_directory.main"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -118,11 +117,11 @@ func testAccDirectory_disappears(t *testing.T) {
 
 func testAccDirectory_subnetIDs(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(t, 8)
 
 	resourceName := "aws_workspaces_directory.main"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -153,11 +152,11 @@ func testAccDirectory_subnetIDs(t *testing.T) {
 
 func testAccDirectory_tags(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(t, 8)
 
 	resourceName := "aws_workspaces_directory.main"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -206,11 +205,11 @@ func testAccDirectory_tags(t *testing.T) {
 
 func testAccDirectory_selfServicePermissions(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(t, 8)
 
 	resourceName := "aws_workspaces_directory.main"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -241,11 +240,11 @@ func testAccDirectory_selfServicePermissions(t *testing.T) {
 
 func testAccDirectory_workspaceAccessProperties(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(t, 8)
 
 	resourceName := "aws_workspaces_directory.main"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -279,12 +278,12 @@ func testAccDirectory_workspaceAccessProperties(t *testing.T) {
 
 func testAccDirectory_workspaceCreationProperties(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(t, 8)
 
 	resourceName := "aws_workspaces_directory.main"
 	resourceSecurityGroup := "aws_security_group.test"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -315,12 +314,12 @@ func testAccDirectory_workspaceCreationProperties(t *testing.T) {
 
 func testAccDirectory_workspaceCreationProperties_customSecurityGroupId_defaultOu(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(t, 8)
 
 	resourceName := "aws_workspaces_directory.main"
 	resourceSecurityGroup := "aws_security_group.test"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -367,11 +366,11 @@ func testAccDirectory_workspaceCreationProperties_customSecurityGroupId_defaultO
 
 func testAccDirectory_ipGroupIDs(t *testing.T) {
 	var v workspaces.WorkspaceDirectory
-	rName := sdkacctest.RandString(8)
+	rName := acctest.RandString(t, 8)
 
 	resourceName := "aws_workspaces_directory.test"
 
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckHasIAMRole(t, "workspaces_DefaultRole") },
@@ -676,7 +675,7 @@ func TestFlattenWorkspaceCreationProperties(t *testing.T) {
 }
 
 func testAccCheckDirectoryDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_workspaces_directory" {
@@ -710,7 +709,7 @@ func testAccCheckDirectoryExists(n string, v *workspaces.WorkspaceDirectory) res
 			return fmt.Errorf("No WorkSpaces Directory ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
 
 		output, err := tfworkspaces.FindDirectoryByID(conn, rs.Primary.ID)
 
@@ -725,7 +724,7 @@ func testAccCheckDirectoryExists(n string, v *workspaces.WorkspaceDirectory) res
 }
 
 func testAccPreCheckWorkspacesDirectory(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
 
 	input := &workspaces.DescribeWorkspaceDirectoriesInput{}
 
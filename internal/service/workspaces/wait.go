@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -38,7 +39,7 @@ func WaitDirectoryRegistered(conn *workspaces.WorkSpaces, directoryID string) (*
 		Timeout: DirectoryRegisteredTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*workspaces.WorkspaceDirectory); ok {
 		return v, err
@@ -59,7 +60,7 @@ func WaitDirectoryDeregistered(conn *workspaces.WorkSpaces, directoryID string)
 		Timeout: DirectoryDeregisteredTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*workspaces.WorkspaceDirectory); ok {
 		return v, err
@@ -79,7 +80,7 @@ func WaitWorkspaceAvailable(conn *workspaces.WorkSpaces, workspaceID string, tim
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*workspaces.Workspace); ok {
 		return v, err
@@ -113,7 +114,7 @@ func WaitWorkspaceTerminated(conn *workspaces.WorkSpaces, workspaceID string, ti
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*workspaces.Workspace); ok {
 		return v, err
@@ -138,7 +139,7 @@ func WaitWorkspaceUpdated(conn *workspaces.WorkSpaces, workspaceID string, timeo
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*workspaces.Workspace); ok {
 		return v, err
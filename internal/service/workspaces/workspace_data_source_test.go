@@ -5,14 +5,13 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 )
 
 func testAccWorkspaceDataSource_byWorkspaceID(t *testing.T) {
-	rName := sdkacctest.RandString(8)
-	domain := acctest.RandomDomainName()
+	rName := acctest.RandString(t, 8)
+	domain := acctest.RandomDomainName(t)
 
 	dataSourceName := "data.aws_workspaces_workspace.test"
 	resourceName := "aws_workspaces_workspace.test"
@@ -46,8 +45,8 @@ func testAccWorkspaceDataSource_byWorkspaceID(t *testing.T) {
 }
 
 func testAccWorkspaceDataSource_byDirectoryID_userName(t *testing.T) {
-	rName := sdkacctest.RandString(8)
-	domain := acctest.RandomDomainName()
+	rName := acctest.RandString(t, 8)
+	domain := acctest.RandomDomainName(t)
 
 	dataSourceName := "data.aws_workspaces_workspace.test"
 	resourceName := "aws_workspaces_workspace.test"
@@ -8,7 +8,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/workspaces"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -18,8 +17,8 @@ import (
 
 func testAccWorkspace_basic(t *testing.T) {
 	var v workspaces.Workspace
-	rName := sdkacctest.RandString(8)
-	domain := acctest.RandomDomainName()
+	rName := acctest.RandString(t, 8)
+	domain := acctest.RandomDomainName(t)
 
 	resourceName := "aws_workspaces_workspace.test"
 	directoryResourceName := "aws_workspaces_directory.test"
@@ -69,8 +68,8 @@ func testAccWorkspace_basic(t *testing.T) {
 
 func testAccWorkspace_tags(t *testing.T) {
 	var v1, v2, v3 workspaces.Workspace
-	rName := sdkacctest.RandString(8)
-	domain := acctest.RandomDomainName()
+	rName := acctest.RandString(t, 8)
+	domain := acctest.RandomDomainName(t)
 
 	resourceName := "aws_workspaces_workspace.test"
 
@@ -122,8 +121,8 @@ func testAccWorkspace_tags(t *testing.T) {
 
 func testAccWorkspace_workspaceProperties(t *testing.T) {
 	var v1, v2, v3 workspaces.Workspace
-	rName := sdkacctest.RandString(8)
-	domain := acctest.RandomDomainName()
+	rName := acctest.RandString(t, 8)
+	domain := acctest.RandomDomainName(t)
 
 	resourceName := "aws_workspaces_workspace.test"
 
@@ -189,9 +188,9 @@ func testAccWorkspace_workspaceProperties(t *testing.T) {
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/13558
 func testAccWorkspace_workspaceProperties_runningModeAlwaysOn(t *testing.T) {
 	var v1 workspaces.Workspace
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_workspaces_workspace.test"
-	domain := acctest.RandomDomainName()
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -226,8 +225,8 @@ func testAccWorkspace_workspaceProperties_runningModeAlwaysOn(t *testing.T) {
 }
 
 func testAccWorkspace_validateRootVolumeSize(t *testing.T) {
-	rName := sdkacctest.RandString(8)
-	domain := acctest.RandomDomainName()
+	rName := acctest.RandString(t, 8)
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -244,8 +243,8 @@ func testAccWorkspace_validateRootVolumeSize(t *testing.T) {
 }
 
 func testAccWorkspace_validateUserVolumeSize(t *testing.T) {
-	rName := sdkacctest.RandString(8)
-	domain := acctest.RandomDomainName()
+	rName := acctest.RandString(t, 8)
+	domain := acctest.RandomDomainName(t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
@@ -263,8 +262,8 @@ func testAccWorkspace_validateUserVolumeSize(t *testing.T) {
 
 func testAccWorkspace_recreate(t *testing.T) {
 	var v workspaces.Workspace
-	rName := sdkacctest.RandString(8)
-	domain := acctest.RandomDomainName()
+	rName := acctest.RandString(t, 8)
+	domain := acctest.RandomDomainName(t)
 
 	resourceName := "aws_workspaces_workspace.test"
 
@@ -298,8 +297,8 @@ func testAccWorkspace_recreate(t *testing.T) {
 
 func testAccWorkspace_timeout(t *testing.T) {
 	var v workspaces.Workspace
-	rName := sdkacctest.RandString(8)
-	domain := acctest.RandomDomainName()
+	rName := acctest.RandString(t, 8)
+	domain := acctest.RandomDomainName(t)
 
 	resourceName := "aws_workspaces_workspace.test"
 
@@ -326,7 +325,7 @@ func testAccWorkspace_timeout(t *testing.T) {
 }
 
 func testAccCheckWorkspaceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_workspaces_workspace" {
@@ -360,7 +359,7 @@ func testAccCheckWorkspaceExists(n string, v *workspaces.Workspace) resource.Tes
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
 
 		output, err := conn.DescribeWorkspaces(&workspaces.DescribeWorkspacesInput{
 			WorkspaceIds: []*string{aws.String(rs.Primary.ID)},
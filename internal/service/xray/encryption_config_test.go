@@ -5,7 +5,6 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/xray"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -36,7 +35,7 @@ func TestAccXRayEncryptionConfig_basic(t *testing.T) {
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccEncryptionWithKeyConfig(),
+				Config: testAccEncryptionWithKeyConfig(acctest.RandString(t, 8)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckXrayEncryptionConfigExists(resourceName, &EncryptionConfig),
 					resource.TestCheckResourceAttr(resourceName, "type", "KMS"),
@@ -64,7 +63,7 @@ func testAccCheckXrayEncryptionConfigExists(n string, EncryptionConfig *xray.Enc
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No XRay Encryption Config ID is set")
 		}
-		conn := acctest.Provider.Meta().(*conns.AWSClient).XRayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).XRayConn()
 
 		config, err := conn.GetEncryptionConfig(&xray.GetEncryptionConfigInput{})
 
@@ -86,7 +85,7 @@ resource "aws_xray_encryption_config" "test" {
 `
 }
 
-func testAccEncryptionWithKeyConfig() string {
+func testAccEncryptionWithKeyConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
   description             = "Terraform acc test %s"
@@ -115,5 +114,5 @@ resource "aws_xray_encryption_config" "test" {
   type   = "KMS"
   key_id = aws_kms_key.test.arn
 }
-`, sdkacctest.RandString(8))
+`, rName)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/xray"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -20,7 +21,7 @@ func waitEncryptionConfigAvailable(conn *xray.XRay) (*xray.EncryptionConfig, err
 		Timeout: encryptionConfigAvailableTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForState(stateConf)
 
 	if v, ok := outputRaw.(*xray.EncryptionConfig); ok {
 		return v, err
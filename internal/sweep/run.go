@@ -0,0 +1,563 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	flagSweepDryRun = flag.Bool("sweep-dry-run", false, "List the resources that Sweepers would delete without deleting any")
+	flagSweepMinAge = flag.Duration("sweep-min-age", 0, "Only delete resources created at least this long ago")
+	flagSweepReport = flag.String("sweep-report", "", "Path of a JSON file to write the report of swept resources to")
+	flagSweepTags   = flag.String("sweep-tags", "", "Comma separated list of key=value tags that resources must have to be deleted")
+)
+
+const (
+	ResourceStatusDeleted = "deleted"
+	ResourceStatusFailed  = "failed"
+	// ResourceStatusPlanned is the status of resources that would be deleted by a dry run.
+	ResourceStatusPlanned = "planned"
+	ResourceStatusSkipped = "skipped"
+)
+
+// sweeperUnsupportedReason is why sweepers that do not delete resources through SweepOrchestrator are skipped
+// by restricted runs.
+const sweeperUnsupportedReason = "unsupported in dry-run or filter mode: sweeper does not use SweepOrchestrator"
+
+// creationTimeAttributes are the names of attributes that contain the RFC 3339 creation time of resources.
+var creationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"launch_time",
+}
+
+// sweepers are the sweepers registered with AddTestSweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper to be run in dependency order when the -sweep flag is used with `go test`.
+// The sweeper is also registered with the Plugin SDK.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	sweepers[name] = s
+}
+
+// TestMain runs the registered sweepers in the regions of the -sweep flag, or the tests if the flag is not set.
+// Sweepers run in waves: each sweeper runs after all of its dependencies have run in an earlier wave.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flag.Lookup("sweep").Value.String()
+
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	options, err := newRunOptions(*flagSweepDryRun, *flagSweepTags, *flagSweepMinAge)
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	allowFailures := flag.Lookup("sweep-allow-failures").Value.String() == "true"
+	report, err := runSweepers(strings.Split(regions, ","), filterSweepers(flag.Lookup("sweep-run").Value.String(), sweepers), options, allowFailures)
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+	}
+
+	if path := *flagSweepReport; path != "" {
+		if err := report.write(path); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+	}
+
+	if err != nil || report.failed() {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// Report is the machine-readable outcome of a run of sweepers.
+type Report struct {
+	DryRun    bool             `json:"dry_run"`
+	Resources []ResourceResult `json:"resources"`
+	Sweepers  []SweeperResult  `json:"sweepers"`
+
+	lock sync.Mutex
+}
+
+// ResourceResult is the outcome of sweeping a single resource.
+type ResourceResult struct {
+	CreationTime *time.Time        `json:"creation_time,omitempty"`
+	Error        string            `json:"error,omitempty"`
+	ID           string            `json:"id"`
+	Reason       string            `json:"reason,omitempty"`
+	Region       string            `json:"region"`
+	ResourceType string            `json:"resource_type"`
+	Status       string            `json:"status"`
+	Tags         map[string]string `json:"tags,omitempty"`
+}
+
+// SweeperResult is the outcome of running a sweeper in a region.
+type SweeperResult struct {
+	Error   string `json:"error,omitempty"`
+	Name    string `json:"name"`
+	Reason  string `json:"reason,omitempty"`
+	Region  string `json:"region"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Wave    int    `json:"wave"`
+}
+
+func (r *Report) addResource(result ResourceResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.Resources = append(r.Resources, result)
+}
+
+func (r *Report) addSweeper(result SweeperResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.Sweepers = append(r.Sweepers, result)
+}
+
+func (r *Report) failed() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, v := range r.Sweepers {
+		if v.Error != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Report) write(path string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	b, err := json.MarshalIndent(r, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("error encoding sweeper report: %w", err)
+	}
+
+	if err := ioutil.WriteFile(path, b, 0644); err != nil {
+		return fmt.Errorf("error writing sweeper report (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+// runOptions restrict the resources that a run of sweepers deletes.
+type runOptions struct {
+	dryRun bool
+	minAge time.Duration
+	tags   map[string]string
+}
+
+func newRunOptions(dryRun bool, tags string, minAge time.Duration) (runOptions, error) {
+	options := runOptions{
+		dryRun: dryRun,
+		minAge: minAge,
+		tags:   make(map[string]string),
+	}
+
+	for _, tag := range strings.Split(tags, ",") {
+		if tag = strings.TrimSpace(tag); tag == "" {
+			continue
+		}
+
+		parts := strings.SplitN(tag, "=", 2)
+
+		if len(parts) != 2 || parts[0] == "" {
+			return runOptions{}, fmt.Errorf("invalid sweeper tag filter (%s), expected key=value", tag)
+		}
+
+		options.tags[parts[0]] = parts[1]
+	}
+
+	return options, nil
+}
+
+// restricted returns whether sweepers may only delete resources through SweepOrchestrator.
+func (o runOptions) restricted() bool {
+	return o.dryRun || o.minAge > 0 || len(o.tags) > 0
+}
+
+// sweeperRun is the running sweeper.
+type sweeperRun struct {
+	name    string
+	options runOptions
+	region  string
+	report  *Report
+
+	// orchestrated is whether the sweeper has passed resources to SweepOrchestrator.
+	orchestrated bool
+}
+
+// currentRun is the running sweeper, if sweepers are being run by TestMain.
+var currentRun *sweeperRun
+
+// runSweepers runs the sweepers in each region in waves ordered by their dependencies.
+// Unless failures are allowed, a region's remaining waves are not run once a sweeper fails.
+// Restricted runs skip, rather than fail, sweepers that do not delete resources through SweepOrchestrator.
+func runSweepers(regions []string, sweepers map[string]*resource.Sweeper, options runOptions, allowFailures bool) (*Report, error) {
+	report := &Report{
+		DryRun:    options.dryRun,
+		Resources: []ResourceResult{},
+		Sweepers:  []SweeperResult{},
+	}
+
+	waves, err := sweeperWaves(sweepers)
+
+	if err != nil {
+		return report, err
+	}
+
+	defer func() {
+		currentRun = nil
+	}()
+
+	for _, region := range regions {
+		log.Printf("[DEBUG] Running Sweepers for region (%s) in %d waves", region, len(waves))
+		start := time.Now()
+
+		for i, wave := range waves {
+			var failed bool
+
+			for _, name := range wave {
+				currentRun = &sweeperRun{
+					name:    name,
+					options: options,
+					region:  region,
+					report:  report,
+				}
+
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+				result := SweeperResult{
+					Name:   name,
+					Region: region,
+					Wave:   i,
+				}
+
+				err := sweepers[name].F(region)
+
+				if options.restricted() && !currentRun.orchestrated {
+					log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): %s", name, region, sweeperUnsupportedReason)
+
+					if err != nil {
+						log.Printf("[WARN] Error running skipped Sweeper (%s) in region (%s): %s", name, region, err)
+					}
+
+					result.Skipped = true
+					result.Reason = sweeperUnsupportedReason
+				} else if err != nil {
+					log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+					result.Error = err.Error()
+					failed = true
+				}
+
+				report.addSweeper(result)
+			}
+
+			if failed && !allowFailures {
+				break
+			}
+		}
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+	}
+
+	return report, nil
+}
+
+// sweeperWaves groups the sweepers into waves so that each sweeper is in a later wave than all of its dependencies.
+// Sweepers in each wave are ordered by name.
+func sweeperWaves(sweepers map[string]*resource.Sweeper) ([][]string, error) {
+	waveOf := make(map[string]int, len(sweepers))
+	visiting := make(map[string]bool)
+
+	var visit func(name string) (int, error)
+	visit = func(name string) (int, error) {
+		if wave, ok := waveOf[name]; ok {
+			return wave, nil
+		}
+
+		if visiting[name] {
+			return 0, fmt.Errorf("sweeper (%s) has a circular dependency", name)
+		}
+
+		visiting[name] = true
+		wave := 0
+
+		for _, dependency := range sweepers[name].Dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				return 0, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+
+			dependencyWave, err := visit(dependency)
+
+			if err != nil {
+				return 0, err
+			}
+
+			if dependencyWave >= wave {
+				wave = dependencyWave + 1
+			}
+		}
+
+		visiting[name] = false
+		waveOf[name] = wave
+
+		return wave, nil
+	}
+
+	var waves [][]string
+
+	for name := range sweepers {
+		wave, err := visit(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for len(waves) <= wave {
+			waves = append(waves, nil)
+		}
+
+		waves[wave] = append(waves[wave], name)
+	}
+
+	for _, wave := range waves {
+		sort.Strings(wave)
+	}
+
+	return waves, nil
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma separated filters, and all of their dependencies.
+// All sweepers are returned if there are no filters.
+func filterSweepers(f string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if f == "" {
+		return source
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(name string)
+	add = func(name string) {
+		s, ok := source[name]
+
+		if !ok {
+			return
+		}
+
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		result[name] = s
+
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range source {
+		for _, filter := range strings.Split(strings.ToLower(f), ",") {
+			if filter != "" && strings.Contains(strings.ToLower(name), filter) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// plan returns the resources to delete, recording the resources that are not to be deleted in the report.
+// Resources are read to get their tags and creation time if needed.
+func (run *sweeperRun) plan(sweepResources []*SweepResource) []*SweepResource {
+	var result []*SweepResource
+
+	for _, sweepResource := range sweepResources {
+		sweepResource.result = &ResourceResult{
+			ID:           sweepResource.d.Id(),
+			Region:       run.region,
+			ResourceType: run.name,
+		}
+
+		if reason := run.skipReason(sweepResource); reason != "" {
+			sweepResource.result.Status = ResourceStatusSkipped
+			sweepResource.result.Reason = reason
+			run.report.addResource(*sweepResource.result)
+			continue
+		}
+
+		if run.options.dryRun {
+			fmt.Printf("\t- %s (%s) in %s\n", run.name, sweepResource.result.ID, run.region)
+			sweepResource.result.Status = ResourceStatusPlanned
+			run.report.addResource(*sweepResource.result)
+			continue
+		}
+
+		// Restricted runs use read-only clients for listing.
+		if run.options.restricted() {
+			client, err := sharedRegionalSweepClient(run.region, false)
+
+			if err != nil {
+				run.recordDeletion(sweepResource, err)
+				continue
+			}
+
+			sweepResource.meta = client
+		}
+
+		result = append(result, sweepResource)
+	}
+
+	return result
+}
+
+// skipReason returns why the resource is not to be deleted, or "" if it is.
+// Resources are only read by restricted runs.
+func (run *sweeperRun) skipReason(sweepResource *SweepResource) string {
+	options := run.options
+
+	if !options.restricted() {
+		return ""
+	}
+
+	if err := ReadResource(sweepResource.resource, sweepResource.d, sweepResource.meta); err != nil {
+		return fmt.Sprintf("error reading resource: %s", err)
+	}
+
+	if sweepResource.d.Id() == "" {
+		return "resource not found"
+	}
+
+	tags := resourceTags(sweepResource.resource, sweepResource.d)
+	sweepResource.result.Tags = tags
+	sweepResource.result.CreationTime = resourceCreationTime(sweepResource.resource, sweepResource.d)
+
+	for k, v := range options.tags {
+		if tags[k] != v {
+			return fmt.Sprintf("tag %q is not %q", k, v)
+		}
+	}
+
+	if options.minAge > 0 {
+		creationTime := sweepResource.result.CreationTime
+
+		if creationTime == nil {
+			return "creation time unknown"
+		}
+
+		if age := time.Since(*creationTime); age < options.minAge {
+			return fmt.Sprintf("created %s ago", age.Round(time.Second))
+		}
+	}
+
+	return ""
+}
+
+func (run *sweeperRun) recordDeletion(sweepResource *SweepResource, err error) {
+	result := *sweepResource.result
+
+	if err != nil {
+		result.Status = ResourceStatusFailed
+		result.Error = err.Error()
+	} else {
+		result.Status = ResourceStatusDeleted
+	}
+
+	run.report.addResource(result)
+}
+
+func resourceTags(r *schema.Resource, d *schema.ResourceData) map[string]string {
+	for _, k := range []string{"tags_all", "tags"} {
+		if _, ok := r.Schema[k]; !ok {
+			continue
+		}
+
+		if v, ok := d.Get(k).(map[string]interface{}); ok && len(v) > 0 {
+			tags := make(map[string]string, len(v))
+
+			for k, v := range v {
+				tags[k] = v.(string)
+			}
+
+			return tags
+		}
+	}
+
+	return nil
+}
+
+func resourceCreationTime(r *schema.Resource, d *schema.ResourceData) *time.Time {
+	for _, k := range creationTimeAttributes {
+		if v, ok := r.Schema[k]; !ok || v.Type != schema.TypeString {
+			continue
+		}
+
+		if t, err := time.Parse(time.RFC3339, d.Get(k).(string)); err == nil {
+			return &t
+		}
+	}
+
+	return nil
+}
+
+// ReadResource reads the current state of the resource into its ResourceData.
+func ReadResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
+		var diags diag.Diagnostics
+
+		if resource.ReadContext != nil {
+			diags = resource.ReadContext(context.Background(), d, meta)
+		} else {
+			diags = resource.ReadWithoutTimeout(context.Background(), d, meta)
+		}
+
+		for i := range diags {
+			if diags[i].Severity == diag.Error {
+				return fmt.Errorf("error reading resource: %s", diags[i].Summary)
+			}
+		}
+
+		return nil
+	}
+
+	return resource.Read(d, meta)
+}
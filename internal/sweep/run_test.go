@@ -0,0 +1,406 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testSweepers(dependencies map[string][]string) map[string]*resource.Sweeper {
+	sweepers := make(map[string]*resource.Sweeper, len(dependencies))
+
+	for name, v := range dependencies {
+		sweepers[name] = &resource.Sweeper{
+			Name:         name,
+			Dependencies: v,
+		}
+	}
+
+	return sweepers
+}
+
+func TestSweeperWaves(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Dependencies  map[string][]string
+		Expected      [][]string
+		ExpectedError bool
+	}{
+		{
+			Name: "no dependencies",
+			Dependencies: map[string][]string{
+				"aws_vpc":    nil,
+				"aws_subnet": nil,
+			},
+			Expected: [][]string{
+				{"aws_subnet", "aws_vpc"},
+			},
+		},
+		{
+			Name: "dependencies",
+			Dependencies: map[string][]string{
+				"aws_instance":          nil,
+				"aws_network_interface": {"aws_instance"},
+				"aws_subnet":            {"aws_instance", "aws_network_interface"},
+				"aws_security_group":    {"aws_network_interface"},
+				"aws_vpc":               {"aws_subnet", "aws_security_group"},
+				"aws_sqs_queue":         nil,
+			},
+			Expected: [][]string{
+				{"aws_instance", "aws_sqs_queue"},
+				{"aws_network_interface"},
+				{"aws_security_group", "aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		{
+			Name: "circular dependency",
+			Dependencies: map[string][]string{
+				"aws_subnet": {"aws_vpc"},
+				"aws_vpc":    {"aws_subnet"},
+			},
+			ExpectedError: true,
+		},
+		{
+			Name: "missing dependency",
+			Dependencies: map[string][]string{
+				"aws_vpc": {"aws_subnet"},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := sweeperWaves(testSweepers(testCase.Dependencies))
+
+			if testCase.ExpectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	sweepers := testSweepers(map[string][]string{
+		"aws_instance":  nil,
+		"aws_subnet":    {"aws_instance"},
+		"aws_vpc":       {"aws_subnet"},
+		"aws_sqs_queue": nil,
+	})
+
+	testCases := []struct {
+		Filter   string
+		Expected []string
+	}{
+		{
+			Filter:   "",
+			Expected: []string{"aws_instance", "aws_sqs_queue", "aws_subnet", "aws_vpc"},
+		},
+		{
+			Filter:   "VPC",
+			Expected: []string{"aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		{
+			Filter:   "sqs,instance",
+			Expected: []string{"aws_instance", "aws_sqs_queue"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		var got []string
+
+		for name := range filterSweepers(testCase.Filter, sweepers) {
+			got = append(got, name)
+		}
+
+		sort.Strings(got)
+
+		if !reflect.DeepEqual(got, testCase.Expected) {
+			t.Errorf("filter %q: got %v, expected %v", testCase.Filter, got, testCase.Expected)
+		}
+	}
+}
+
+// testSweepResources returns resources whose deletions are recorded, keyed by ID.
+func testSweepResources(t *testing.T, meta interface{}, deleted *sync.Map) []*SweepResource {
+	t.Helper()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			switch d.Id() {
+			case "new":
+				d.Set("creation_time", time.Now().Format(time.RFC3339))
+				d.Set("tags", map[string]string{"Owner": "sandbox"})
+			case "old":
+				d.Set("creation_time", time.Now().Add(-48*time.Hour).Format(time.RFC3339))
+				d.Set("tags", map[string]string{"Owner": "sandbox"})
+			case "other":
+				d.Set("creation_time", time.Now().Add(-48*time.Hour).Format(time.RFC3339))
+				d.Set("tags", map[string]string{"Owner": "production"})
+			case "gone":
+				d.SetId("")
+			}
+
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			deleted.Store(d.Id(), meta)
+
+			return nil
+		},
+	}
+
+	var sweepResources []*SweepResource
+
+	for _, id := range []string{"new", "old", "other", "gone"} {
+		d := r.Data(nil)
+		d.SetId(id)
+
+		sweepResources = append(sweepResources, NewSweepResource(r, d, meta))
+	}
+
+	return sweepResources
+}
+
+func TestSweepOrchestratorRun(t *testing.T) {
+	const region = "test-region"
+
+	testCases := []struct {
+		Name             string
+		DryRun           bool
+		MinAge           time.Duration
+		Tags             string
+		ExpectedStatuses map[string]string
+	}{
+		{
+			Name: "unrestricted",
+			ExpectedStatuses: map[string]string{
+				"new":   ResourceStatusDeleted,
+				"old":   ResourceStatusDeleted,
+				"other": ResourceStatusDeleted,
+				"gone":  ResourceStatusDeleted,
+			},
+		},
+		{
+			Name:   "dry run",
+			DryRun: true,
+			ExpectedStatuses: map[string]string{
+				"new":   ResourceStatusPlanned,
+				"old":   ResourceStatusPlanned,
+				"other": ResourceStatusPlanned,
+				"gone":  ResourceStatusSkipped,
+			},
+		},
+		{
+			Name: "tags",
+			Tags: "Owner=sandbox",
+			ExpectedStatuses: map[string]string{
+				"new":   ResourceStatusDeleted,
+				"old":   ResourceStatusDeleted,
+				"other": ResourceStatusSkipped,
+				"gone":  ResourceStatusSkipped,
+			},
+		},
+		{
+			Name:   "tags and minimum age",
+			MinAge: 24 * time.Hour,
+			Tags:   "Owner=sandbox",
+			ExpectedStatuses: map[string]string{
+				"new":   ResourceStatusSkipped,
+				"old":   ResourceStatusDeleted,
+				"other": ResourceStatusSkipped,
+				"gone":  ResourceStatusSkipped,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			options, err := newRunOptions(testCase.DryRun, testCase.Tags, testCase.MinAge)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// Restricted runs delete resources using the shared client.
+			SweeperClients = map[string]interface{}{region: "writable"}
+			report := &Report{}
+			currentRun = &sweeperRun{
+				name:    "aws_test",
+				options: options,
+				region:  region,
+				report:  report,
+			}
+			defer func() {
+				currentRun = nil
+			}()
+
+			var deleted sync.Map
+
+			if err := SweepOrchestrator(testSweepResources(t, "listing", &deleted)); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := make(map[string]string)
+
+			for _, v := range report.Resources {
+				got[v.ID] = v.Status
+
+				if v.ResourceType != "aws_test" || v.Region != region {
+					t.Errorf("unexpected resource type or region: %v", v)
+				}
+
+				meta, ok := deleted.Load(v.ID)
+
+				if ok != (v.Status == ResourceStatusDeleted) {
+					t.Errorf("resource (%s) with status %s deleted: %t", v.ID, v.Status, ok)
+				}
+
+				if ok && options.restricted() && meta != "writable" {
+					t.Errorf("resource (%s) deleted with client %v, expected shared client", v.ID, meta)
+				}
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedStatuses) {
+				t.Errorf("got %v, expected %v", got, testCase.ExpectedStatuses)
+			}
+		})
+	}
+}
+
+func TestRunSweepersRestricted(t *testing.T) {
+	const region = "test-region"
+
+	testCases := []struct {
+		Name            string
+		DryRun          bool
+		ExpectedFailed  bool
+		ExpectedRun     []string
+		ExpectedSkipped []string
+	}{
+		{
+			Name:           "unrestricted",
+			ExpectedFailed: true,
+			ExpectedRun:    []string{"aws_direct", "aws_orchestrated"},
+		},
+		{
+			Name:            "dry run",
+			DryRun:          true,
+			ExpectedRun:     []string{"aws_direct", "aws_orchestrated", "aws_dependent"},
+			ExpectedSkipped: []string{"aws_direct", "aws_dependent"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			options, err := newRunOptions(testCase.DryRun, "", 0)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var run []string
+
+			sweepers := testSweepers(map[string][]string{
+				"aws_dependent":    {"aws_direct"},
+				"aws_direct":       nil,
+				"aws_orchestrated": nil,
+			})
+			sweepers["aws_dependent"].F = func(region string) error {
+				run = append(run, "aws_dependent")
+				return nil
+			}
+			sweepers["aws_direct"].F = func(region string) error {
+				run = append(run, "aws_direct")
+				return errors.New("ReadOnlyClient: DeleteThing is not allowed by a read-only client")
+			}
+			sweepers["aws_orchestrated"].F = func(region string) error {
+				run = append(run, "aws_orchestrated")
+				return SweepOrchestrator(nil)
+			}
+
+			report, err := runSweepers([]string{region}, sweepers, options, false)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := report.failed(); got != testCase.ExpectedFailed {
+				t.Errorf("got failed %t, expected %t", got, testCase.ExpectedFailed)
+			}
+
+			if !reflect.DeepEqual(run, testCase.ExpectedRun) {
+				t.Errorf("got sweepers run %v, expected %v", run, testCase.ExpectedRun)
+			}
+
+			var skipped []string
+
+			for _, v := range report.Sweepers {
+				if v.Skipped {
+					skipped = append(skipped, v.Name)
+
+					if v.Error != "" || v.Reason != sweeperUnsupportedReason {
+						t.Errorf("unexpected skipped sweeper result: %v", v)
+					}
+				}
+			}
+
+			if !reflect.DeepEqual(skipped, testCase.ExpectedSkipped) {
+				t.Errorf("got sweepers skipped %v, expected %v", skipped, testCase.ExpectedSkipped)
+			}
+		})
+	}
+}
+
+func TestNewRunOptions(t *testing.T) {
+	options, err := newRunOptions(false, "Owner=sandbox, Environment=", 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := map[string]string{"Owner": "sandbox", "Environment": ""}; !reflect.DeepEqual(options.tags, expected) {
+		t.Errorf("got %v, expected %v", options.tags, expected)
+	}
+
+	if !options.restricted() {
+		t.Error("expected tag filters to restrict run")
+	}
+
+	if _, err := newRunOptions(false, "Owner", 0); err == nil {
+		t.Error("expected error for tag filter without value")
+	}
+}
@@ -9,11 +9,9 @@ import (
 	"log"
 	"os"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -34,10 +32,24 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
 
+// readOnlySweeperClients is a shared cache of regional read-only conns.AWSClient.
+var readOnlySweeperClients = make(map[string]interface{})
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
-// functions for a given region
+// functions for a given region.
+// The client is read-only if sweepers may only delete resources through SweepOrchestrator,
+// e.g. during a dry run.
 func SharedRegionalSweepClient(region string) (interface{}, error) {
-	if client, ok := SweeperClients[region]; ok {
+	return sharedRegionalSweepClient(region, currentRun != nil && currentRun.options.restricted())
+}
+
+func sharedRegionalSweepClient(region string, readOnly bool) (interface{}, error) {
+	cache := SweeperClients
+	if readOnly {
+		cache = readOnlySweeperClients
+	}
+
+	if client, ok := cache[region]; ok {
 		return client, nil
 	}
 
@@ -55,28 +67,33 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 
 	conf := &conns.Config{
 		MaxRetries: 5,
+		ReadOnly:   readOnly,
 		Region:     region,
 	}
 
 	if role := os.Getenv(conns.EnvVarAssumeRoleARN); role != "" {
-		conf.AssumeRoleARN = role
+		assumeRole := conns.AssumeRole{
+			DurationSeconds: defaultSweeperAssumeRoleDurationSeconds,
+			RoleARN:         role,
+		}
 
-		conf.AssumeRoleDurationSeconds = defaultSweeperAssumeRoleDurationSeconds
 		if v := os.Getenv(conns.EnvVarAssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarAssumeRoleDuration, err)
 			}
-			conf.AssumeRoleDurationSeconds = d
+			assumeRole.DurationSeconds = d
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleExternalID); v != "" {
-			conf.AssumeRoleExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleSessionName); v != "" {
-			conf.AssumeRoleSessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []conns.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -85,7 +102,7 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 		return nil, fmt.Errorf("error getting AWS client: %w", err)
 	}
 
-	SweeperClients[region] = client
+	cache[region] = client
 
 	return client, nil
 }
@@ -94,6 +111,7 @@ type SweepResource struct {
 	d        *schema.ResourceData
 	meta     interface{}
 	resource *schema.Resource
+	result   *ResourceResult
 }
 
 func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
@@ -108,7 +126,17 @@ func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorContext deletes the resources in parallel, retrying on throttling errors.
+// When sweepers are run by TestMain, only the resources that match the run's filters are deleted,
+// none are deleted during a dry run, and the outcome for each resource is added to the run's report.
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	run := currentRun
+
+	if run != nil {
+		run.orchestrated = true
+		sweepResources = run.plan(sweepResources)
+	}
+
 	var g multierror.Group
 
 	for _, sweepResource := range sweepResources {
@@ -119,7 +147,7 @@ func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResour
 				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 
 				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
+					if tfresource.IsThrottlingError(err) {
 						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
 						return resource.RetryableError(err)
 					}
@@ -134,6 +162,10 @@ func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResour
 				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 			}
 
+			if run != nil {
+				run.recordDeletion(sweepResource, err)
+			}
+
 			return err
 		})
 	}
@@ -142,61 +174,9 @@ func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResour
 }
 
 // Check sweeper API call error for reasons to skip sweeping
-// These include missing API endpoints and unsupported API calls
+// These include missing API endpoints, unsupported API calls and the errors services register as unsupported
 func SkipSweepError(err error) bool {
-	// Ignore missing API endpoints
-	if tfawserr.ErrMessageContains(err, "RequestError", "send request failed") {
-		return true
-	}
-	// Ignore unsupported API calls
-	if tfawserr.ErrMessageContains(err, "UnsupportedOperation", "") {
-		return true
-	}
-	// Ignore more unsupported API calls
-	// InvalidParameterValue: Use of cache security groups is not permitted in this API version for your account.
-	if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "not permitted in this API version for your account") {
-		return true
-	}
-	// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
-	if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Access Denied to API Version") {
-		return true
-	}
-	// GovCloud has endpoints that respond with (no message provided):
-	// AccessDeniedException:
-	// Since acceptance test sweepers are best effort and this response is very common,
-	// we allow bypassing this error globally instead of individual test sweeper fixes.
-	if tfawserr.ErrMessageContains(err, "AccessDeniedException", "") {
-		return true
-	}
-	// Example: BadRequestException: vpc link not supported for region us-gov-west-1
-	if tfawserr.ErrMessageContains(err, "BadRequestException", "not supported") {
-		return true
-	}
-	// Example: InvalidAction: The action DescribeTransitGatewayAttachments is not valid for this web service
-	if tfawserr.ErrMessageContains(err, "InvalidAction", "is not valid") {
-		return true
-	}
-	// For example from GovCloud SES.SetActiveReceiptRuleSet.
-	if tfawserr.ErrMessageContains(err, "InvalidAction", "Unavailable Operation") {
-		return true
-	}
-	// For example from us-west-2 Route53 key signing key
-	if tfawserr.ErrMessageContains(err, "InvalidKeySigningKeyStatus", "cannot be deleted because") {
-		return true
-	}
-	// For example from us-west-2 Route53 zone
-	if tfawserr.ErrMessageContains(err, "KeySigningKeyInParentDSRecord", "Due to DNS lookup failure") {
-		return true
-	}
-	// For example from us-gov-west-1 CloudwatchEvents archive
-	if tfawserr.ErrMessageContains(err, "UnknownOperationException", "Operation is disabled in this region") {
-		return true
-	}
-	// For example from us-west-2 ECR public repository
-	if tfawserr.ErrMessageContains(err, "UnsupportedCommandException", "command is only supported in") {
-		return true
-	}
-	return false
+	return tfresource.IsUnsupportedError(err)
 }
 
 func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
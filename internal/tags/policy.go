@@ -0,0 +1,143 @@
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+const (
+	// PolicyEnforcementError fails plans for resources whose tags violate the policy.
+	PolicyEnforcementError = "error"
+	// PolicyEnforcementWarn warns of resources whose tags violate the policy.
+	PolicyEnforcementWarn = "warn"
+)
+
+const (
+	PolicyKeyCaseCamel  = "camel"
+	PolicyKeyCaseKebab  = "kebab"
+	PolicyKeyCaseLower  = "lower"
+	PolicyKeyCasePascal = "pascal"
+	PolicyKeyCaseSnake  = "snake"
+	PolicyKeyCaseUpper  = "upper"
+)
+
+// PolicyEnforcement_Values returns all valid tag policy enforcement values.
+func PolicyEnforcement_Values() []string {
+	return []string{
+		PolicyEnforcementError,
+		PolicyEnforcementWarn,
+	}
+}
+
+// PolicyKeyCase_Values returns all valid tag policy key cases.
+func PolicyKeyCase_Values() []string {
+	return []string{
+		PolicyKeyCaseCamel,
+		PolicyKeyCaseKebab,
+		PolicyKeyCaseLower,
+		PolicyKeyCasePascal,
+		PolicyKeyCaseSnake,
+		PolicyKeyCaseUpper,
+	}
+}
+
+// policyKeyCaseSegmentRegexps match the segments of tag keys in each case.
+var policyKeyCaseSegmentRegexps = map[string]*regexp.Regexp{
+	PolicyKeyCaseCamel:  regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	PolicyKeyCaseKebab:  regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+	PolicyKeyCaseLower:  regexp.MustCompile(`^[^A-Z]*$`),
+	PolicyKeyCasePascal: regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	PolicyKeyCaseSnake:  regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`),
+	PolicyKeyCaseUpper:  regexp.MustCompile(`^[^a-z]*$`),
+}
+
+// policyKeySegmentSeparators separate the namespaced segments of tag keys, e.g. "kubernetes.io/cluster/name".
+var policyKeySegmentSeparators = regexp.MustCompile(`[:/.]`)
+
+// PolicyConfig contains rules that resource tags must follow.
+type PolicyConfig struct {
+	Enforcement  string
+	KeyCase      string
+	RequiredKeys []string
+	// ValuePatterns are the patterns, keyed by tag key, that tag values must match in full.
+	ValuePatterns map[string]*regexp.Regexp
+}
+
+// PolicyViolation is a tag that breaks a rule of a tag policy.
+type PolicyViolation struct {
+	Key    string
+	Reason string
+}
+
+func (v PolicyViolation) String() string {
+	return fmt.Sprintf("tag %q %s", v.Key, v.Reason)
+}
+
+// IsWarning returns whether violations of the policy are warnings rather than errors.
+func (config *PolicyConfig) IsWarning() bool {
+	return config != nil && config.Enforcement == PolicyEnforcementWarn
+}
+
+// PolicyViolations returns the tags that break the rules of a given tag policy, ordered by key.
+func (tags KeyValueTags) PolicyViolations(config *PolicyConfig) []PolicyViolation {
+	if config == nil {
+		return nil
+	}
+
+	var violations []PolicyViolation
+
+	for _, k := range config.RequiredKeys {
+		if _, ok := tags[k]; !ok {
+			violations = append(violations, PolicyViolation{
+				Key:    k,
+				Reason: "is required",
+			})
+		}
+	}
+
+	for k, v := range tags.IgnoreAWS().Map() {
+		if pattern, ok := config.ValuePatterns[k]; ok && !pattern.MatchString(v) {
+			violations = append(violations, PolicyViolation{
+				Key:    k,
+				Reason: fmt.Sprintf("value %q does not match pattern %q", v, pattern),
+			})
+		}
+
+		if !keyHasCase(k, config.KeyCase) {
+			violations = append(violations, PolicyViolation{
+				Key:    k,
+				Reason: fmt.Sprintf("key is not %s case", config.KeyCase),
+			})
+		}
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Key < violations[j].Key
+	})
+
+	return violations
+}
+
+// keyHasCase returns whether each segment of the tag key is in the specified case.
+// Any key is in the empty case.
+func keyHasCase(k, keyCase string) bool {
+	re, ok := policyKeyCaseSegmentRegexps[keyCase]
+
+	if !ok {
+		return true
+	}
+
+	for _, segment := range policyKeySegmentSeparators.Split(k, -1) {
+		if segment != "" && !re.MatchString(segment) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CompilePolicyValuePattern compiles a tag policy value pattern, which must match tag values in full.
+func CompilePolicyValuePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(fmt.Sprintf("^(?:%s)$", pattern))
+}
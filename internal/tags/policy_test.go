@@ -0,0 +1,117 @@
+package tags
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestKeyValueTagsPolicyViolations(t *testing.T) {
+	testCases := []struct {
+		name   string
+		tags   KeyValueTags
+		config *PolicyConfig
+		want   []PolicyViolation
+	}{
+		{
+			name:   "nil config",
+			tags:   New(map[string]string{"key1": "value1"}),
+			config: nil,
+			want:   nil,
+		},
+		{
+			name: "required keys present",
+			tags: New(map[string]string{"CostCenter": "1234", "Owner": "team"}),
+			config: &PolicyConfig{
+				RequiredKeys: []string{"CostCenter", "Owner"},
+			},
+			want: nil,
+		},
+		{
+			name: "required keys missing",
+			tags: New(map[string]string{"Owner": "team"}),
+			config: &PolicyConfig{
+				RequiredKeys: []string{"Owner", "CostCenter", "Environment"},
+			},
+			want: []PolicyViolation{
+				{Key: "CostCenter", Reason: "is required"},
+				{Key: "Environment", Reason: "is required"},
+			},
+		},
+		{
+			name: "value patterns",
+			tags: New(map[string]string{"Environment": "production", "CostCenter": "12a4", "Owner": "team"}),
+			config: &PolicyConfig{
+				ValuePatterns: map[string]*regexp.Regexp{
+					"CostCenter":  regexp.MustCompile(`^(?:[0-9]{4})$`),
+					"Environment": regexp.MustCompile(`^(?:dev|prod)$`),
+					"Missing":     regexp.MustCompile(`^(?:.+)$`),
+				},
+			},
+			want: []PolicyViolation{
+				{Key: "CostCenter", Reason: `value "12a4" does not match pattern "^(?:[0-9]{4})$"`},
+				{Key: "Environment", Reason: `value "production" does not match pattern "^(?:dev|prod)$"`},
+			},
+		},
+		{
+			name: "pascal case",
+			tags: New(map[string]string{"CostCenter": "1", "costCenter": "2", "kubernetes.io/cluster/Name": "3", "aws:cloudformation:stack-name": "4"}),
+			config: &PolicyConfig{
+				KeyCase: PolicyKeyCasePascal,
+			},
+			want: []PolicyViolation{
+				{Key: "costCenter", Reason: "key is not pascal case"},
+				{Key: "kubernetes.io/cluster/Name", Reason: "key is not pascal case"},
+			},
+		},
+		{
+			name: "kebab case",
+			tags: New(map[string]string{"cost-center": "1", "cost_center": "2", "owner": "3"}),
+			config: &PolicyConfig{
+				KeyCase: PolicyKeyCaseKebab,
+			},
+			want: []PolicyViolation{
+				{Key: "cost_center", Reason: "key is not kebab case"},
+			},
+		},
+		{
+			name: "lower case",
+			tags: New(map[string]string{"cost_center": "1", "Owner": "2"}),
+			config: &PolicyConfig{
+				KeyCase: PolicyKeyCaseLower,
+			},
+			want: []PolicyViolation{
+				{Key: "Owner", Reason: "key is not lower case"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.PolicyViolations(testCase.config)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, expected %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestCompilePolicyValuePattern(t *testing.T) {
+	re, err := CompilePolicyValuePattern(`dev|prod`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for value, expected := range map[string]bool{
+		"dev":        true,
+		"prod":       true,
+		"production": false,
+		"predev":     false,
+	} {
+		if got := re.MatchString(value); got != expected {
+			t.Errorf("value %q: got %t, expected %t", value, got, expected)
+		}
+	}
+}
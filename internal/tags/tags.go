@@ -4,6 +4,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ManagedKeysAttribute is the attribute recording the tag keys managed by a resource
+// when the provider only manages the tag keys that resources set.
+const ManagedKeysAttribute = "managed_tag_keys"
+
+// ManagedKeysSchema returns the schema to use for the tag keys managed by a resource.
+func ManagedKeysSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
 // TagsSchema returns the schema to use for tags.
 //
 func TagsSchema() *schema.Schema {
@@ -0,0 +1,253 @@
+package tfresource
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrorClass is a class of AWS API error that callers handle in the same way regardless of service.
+type ErrorClass int
+
+const (
+	// ErrorClassEventualConsistency errors are returned until a change has propagated and should be retried.
+	ErrorClassEventualConsistency ErrorClass = iota
+	// ErrorClassNotFound errors are returned for resources that do not exist.
+	ErrorClassNotFound
+	// ErrorClassThrottling errors are returned when request rate limits are exceeded and should be retried.
+	ErrorClassThrottling
+	// ErrorClassUnsupported errors are returned for operations that are not supported in a partition, region or account.
+	ErrorClassUnsupported
+)
+
+// ErrorMatcher matches AWS errors by code and, optionally, message.
+type ErrorMatcher struct {
+	Code string
+	// Message is a substring of the error message. An empty message matches any message.
+	Message string
+}
+
+// Match returns whether the error is, or wraps, a matching AWS error.
+func (m ErrorMatcher) Match(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var awsErr awserr.Error
+
+	return errors.As(err, &awsErr) && awsErr.Code() == m.Code && strings.Contains(awsErr.Message(), m.Message)
+}
+
+// ServiceErrors are the errors with which an AWS service reports each class of error.
+type ServiceErrors struct {
+	EventualConsistency []ErrorMatcher
+	NotFound            []ErrorMatcher
+	Throttling          []ErrorMatcher
+	Unsupported         []ErrorMatcher
+}
+
+func (e ServiceErrors) matchers(class ErrorClass) []ErrorMatcher {
+	switch class {
+	case ErrorClassEventualConsistency:
+		return e.EventualConsistency
+	case ErrorClassNotFound:
+		return e.NotFound
+	case ErrorClassThrottling:
+		return e.Throttling
+	case ErrorClassUnsupported:
+		return e.Unsupported
+	}
+
+	return nil
+}
+
+// commonErrors are the errors with which all AWS services may report each class of error.
+var commonErrors = ServiceErrors{
+	// The codes retried as throttling errors by the AWS SDK.
+	Throttling: []ErrorMatcher{
+		{Code: "EC2ThrottledException"},
+		{Code: "PriorRequestNotComplete"},
+		{Code: "ProvisionedThroughputExceededException"},
+		{Code: "RequestLimitExceeded"},
+		{Code: "RequestThrottled"},
+		{Code: "RequestThrottledException"},
+		{Code: "SlowDown"},
+		{Code: "ThrottledException"},
+		{Code: "Throttling"},
+		{Code: "ThrottlingException"},
+		{Code: "TooManyRequestsException"},
+		{Code: "TransactionInProgressException"},
+	},
+	Unsupported: []ErrorMatcher{
+		// Missing API endpoints.
+		{Code: "RequestError", Message: "send request failed"},
+		{Code: "UnsupportedOperation"},
+		// GovCloud has endpoints that respond with (no message provided):
+		// AccessDeniedException:
+		{Code: "AccessDeniedException"},
+		// Example: InvalidAction: The action DescribeTransitGatewayAttachments is not valid for this web service
+		{Code: "InvalidAction", Message: "is not valid"},
+	},
+}
+
+var (
+	serviceErrors     = make(map[string]ServiceErrors)
+	serviceErrorsLock sync.RWMutex
+)
+
+// ErrorClassifier classifies the errors returned by an AWS service.
+type ErrorClassifier struct {
+	service string
+}
+
+// RegisterServiceErrors registers the errors with which an AWS service reports each class of error,
+// adding to any previously registered for the service, and returns the service's classifier.
+// Service packages register their errors during initialization.
+func RegisterServiceErrors(service string, errs ServiceErrors) *ErrorClassifier {
+	serviceErrorsLock.Lock()
+	defer serviceErrorsLock.Unlock()
+
+	v := serviceErrors[service]
+	v.EventualConsistency = append(v.EventualConsistency, errs.EventualConsistency...)
+	v.NotFound = append(v.NotFound, errs.NotFound...)
+	v.Throttling = append(v.Throttling, errs.Throttling...)
+	v.Unsupported = append(v.Unsupported, errs.Unsupported...)
+	serviceErrors[service] = v
+
+	return &ErrorClassifier{service: service}
+}
+
+// Is returns whether the error is of the class for the service, or for all services.
+func (c *ErrorClassifier) Is(class ErrorClass, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if class == ErrorClassNotFound && NotFound(err) {
+		return true
+	}
+
+	if class == ErrorClassThrottling && isThrottlingStatusCode(err) {
+		return true
+	}
+
+	if matchAny(commonErrors.matchers(class), err) {
+		return true
+	}
+
+	serviceErrorsLock.RLock()
+	defer serviceErrorsLock.RUnlock()
+
+	return matchAny(serviceErrors[c.service].matchers(class), err)
+}
+
+// IsEventualConsistencyError returns whether the error is returned by the service until a change has propagated.
+func (c *ErrorClassifier) IsEventualConsistencyError(err error) bool {
+	return c.Is(ErrorClassEventualConsistency, err)
+}
+
+// IsNotFoundError returns whether the error is returned by the service for a resource that does not exist.
+// If codes are specified only errors with those codes match, so that a finder does not mistake
+// a related resource that does not exist, e.g. a DB instance, for the one it finds.
+// The codes need not be registered; a registered not found error with one of the codes
+// additionally requires the error's message to match.
+func (c *ErrorClassifier) IsNotFoundError(err error, codes ...string) bool {
+	if len(codes) == 0 {
+		return c.Is(ErrorClassNotFound, err)
+	}
+
+	if err == nil {
+		return false
+	}
+
+	if NotFound(err) {
+		return true
+	}
+
+	var awsErr awserr.Error
+
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+
+	serviceErrorsLock.RLock()
+	defer serviceErrorsLock.RUnlock()
+
+	for _, code := range codes {
+		if awsErr.Code() != code {
+			continue
+		}
+
+		var matchers []ErrorMatcher
+
+		for _, m := range serviceErrors[c.service].NotFound {
+			if m.Code == code {
+				matchers = append(matchers, m)
+			}
+		}
+
+		if len(matchers) == 0 || matchAny(matchers, err) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsThrottlingError returns whether the error is returned by the service when request rate limits are exceeded.
+func (c *ErrorClassifier) IsThrottlingError(err error) bool {
+	return c.Is(ErrorClassThrottling, err)
+}
+
+// IsUnsupportedError returns whether the error is returned by the service for an unsupported operation.
+func (c *ErrorClassifier) IsUnsupportedError(err error) bool {
+	return c.Is(ErrorClassUnsupported, err)
+}
+
+// IsErrorClass returns whether the error is of the class for any service.
+// Use an ErrorClassifier when the service returning the error is known.
+func IsErrorClass(class ErrorClass, err error) bool {
+	if (&ErrorClassifier{}).Is(class, err) {
+		return true
+	}
+
+	serviceErrorsLock.RLock()
+	defer serviceErrorsLock.RUnlock()
+
+	for _, v := range serviceErrors {
+		if matchAny(v.matchers(class), err) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsThrottlingError returns whether the error is returned by any service when request rate limits are exceeded.
+func IsThrottlingError(err error) bool {
+	return IsErrorClass(ErrorClassThrottling, err)
+}
+
+// IsUnsupportedError returns whether the error is returned by any service for an unsupported operation.
+func IsUnsupportedError(err error) bool {
+	return IsErrorClass(ErrorClassUnsupported, err)
+}
+
+func matchAny(matchers []ErrorMatcher, err error) bool {
+	for _, m := range matchers {
+		if m.Match(err) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isThrottlingStatusCode(err error) bool {
+	var requestFailure awserr.RequestFailure
+
+	return errors.As(err, &requestFailure) && requestFailure.StatusCode() == http.StatusTooManyRequests
+}
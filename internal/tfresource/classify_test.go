@@ -0,0 +1,344 @@
+package tfresource_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+var testErrorClassifier = tfresource.RegisterServiceErrors("tfresourcetest", tfresource.ServiceErrors{
+	EventualConsistency: []tfresource.ErrorMatcher{
+		{Code: "InvalidParameterValue", Message: "role cannot be assumed"},
+	},
+	NotFound: []tfresource.ErrorMatcher{
+		{Code: "ResourceNotFoundException"},
+		{Code: "ParentNotFoundException"},
+		{Code: "InvalidParameterValue", Message: "does not exist"},
+	},
+	Throttling: []tfresource.ErrorMatcher{
+		{Code: "LimitExceededException", Message: "Rate exceeded"},
+	},
+	Unsupported: []tfresource.ErrorMatcher{
+		{Code: "InvalidParameterValue", Message: "not supported in this region"},
+	},
+})
+
+func TestErrorMatcherMatch(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Matcher  tfresource.ErrorMatcher
+		Err      error
+		Expected bool
+	}{
+		{
+			Name:    "nil error",
+			Matcher: tfresource.ErrorMatcher{Code: "TestCode"},
+		},
+		{
+			Name:     "code",
+			Matcher:  tfresource.ErrorMatcher{Code: "TestCode"},
+			Err:      awserr.New("TestCode", "TestMessage", nil),
+			Expected: true,
+		},
+		{
+			Name:    "other code",
+			Matcher: tfresource.ErrorMatcher{Code: "TestCode"},
+			Err:     awserr.New("TestCodeOther", "TestMessage", nil),
+		},
+		{
+			Name:     "code and message",
+			Matcher:  tfresource.ErrorMatcher{Code: "TestCode", Message: "Message"},
+			Err:      awserr.New("TestCode", "TestMessage", nil),
+			Expected: true,
+		},
+		{
+			Name:    "code and other message",
+			Matcher: tfresource.ErrorMatcher{Code: "TestCode", Message: "Other"},
+			Err:     awserr.New("TestCode", "TestMessage", nil),
+		},
+		{
+			Name:     "wrapped AWS error",
+			Matcher:  tfresource.ErrorMatcher{Code: "TestCode", Message: "Message"},
+			Err:      fmt.Errorf("test: %w", awserr.New("TestCode", "TestMessage", nil)),
+			Expected: true,
+		},
+		{
+			Name:    "flattened AWS error",
+			Matcher: tfresource.ErrorMatcher{Code: "TestCode", Message: "Message"},
+			Err:     fmt.Errorf("error deleting resource: %s", awserr.New("TestCode", "TestMessage", nil)),
+		},
+		{
+			Name:    "other error",
+			Matcher: tfresource.ErrorMatcher{Code: "TestCode"},
+			Err:     errors.New("TestCode"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Matcher.Match(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestErrorClassifierIs(t *testing.T) {
+	otherErrorClassifier := tfresource.RegisterServiceErrors("tfresourcetestother", tfresource.ServiceErrors{})
+
+	testCases := []struct {
+		Name             string
+		Classifier       *tfresource.ErrorClassifier
+		Class            tfresource.ErrorClass
+		Err              error
+		Expected         bool
+		ExpectedAnyClass bool
+	}{
+		{
+			Name:       "nil error",
+			Classifier: testErrorClassifier,
+			Class:      tfresource.ErrorClassThrottling,
+		},
+		{
+			Name:             "common throttling error",
+			Classifier:       otherErrorClassifier,
+			Class:            tfresource.ErrorClassThrottling,
+			Err:              awserr.New("ThrottlingException", "Rate exceeded", nil),
+			Expected:         true,
+			ExpectedAnyClass: true,
+		},
+		{
+			Name:             "throttling status code",
+			Classifier:       otherErrorClassifier,
+			Class:            tfresource.ErrorClassThrottling,
+			Err:              awserr.NewRequestFailure(awserr.New("TestCode", "TestMessage", nil), http.StatusTooManyRequests, "test"),
+			Expected:         true,
+			ExpectedAnyClass: true,
+		},
+		{
+			Name:       "flattened common throttling error",
+			Classifier: otherErrorClassifier,
+			Class:      tfresource.ErrorClassThrottling,
+			Err:        errors.New("error deleting resource: Throttling: Rate exceeded"),
+		},
+		{
+			Name:             "service throttling error",
+			Classifier:       testErrorClassifier,
+			Class:            tfresource.ErrorClassThrottling,
+			Err:              awserr.New("LimitExceededException", "Rate exceeded", nil),
+			Expected:         true,
+			ExpectedAnyClass: true,
+		},
+		{
+			Name:             "other service throttling error",
+			Classifier:       otherErrorClassifier,
+			Class:            tfresource.ErrorClassThrottling,
+			Err:              awserr.New("LimitExceededException", "Rate exceeded", nil),
+			ExpectedAnyClass: true,
+		},
+		{
+			Name:       "service error other message",
+			Classifier: testErrorClassifier,
+			Class:      tfresource.ErrorClassThrottling,
+			Err:        awserr.New("LimitExceededException", "Quota exceeded", nil),
+		},
+		{
+			Name:             "service eventual consistency error",
+			Classifier:       testErrorClassifier,
+			Class:            tfresource.ErrorClassEventualConsistency,
+			Err:              awserr.New("InvalidParameterValue", "The role cannot be assumed", nil),
+			Expected:         true,
+			ExpectedAnyClass: true,
+		},
+		{
+			Name:       "service eventual consistency error other class",
+			Classifier: testErrorClassifier,
+			Class:      tfresource.ErrorClassUnsupported,
+			Err:        awserr.New("InvalidParameterValue", "The role cannot be assumed", nil),
+		},
+		{
+			Name:             "service not found error",
+			Classifier:       testErrorClassifier,
+			Class:            tfresource.ErrorClassNotFound,
+			Err:              awserr.New("ResourceNotFoundException", "TestMessage", nil),
+			Expected:         true,
+			ExpectedAnyClass: true,
+		},
+		{
+			Name:             "not found error",
+			Classifier:       otherErrorClassifier,
+			Class:            tfresource.ErrorClassNotFound,
+			Err:              &resource.NotFoundError{},
+			Expected:         true,
+			ExpectedAnyClass: true,
+		},
+		{
+			Name:             "common unsupported error",
+			Classifier:       otherErrorClassifier,
+			Class:            tfresource.ErrorClassUnsupported,
+			Err:              awserr.New("InvalidAction", "The action DescribeTransitGatewayAttachments is not valid for this web service", nil),
+			Expected:         true,
+			ExpectedAnyClass: true,
+		},
+		{
+			Name:             "service unsupported error",
+			Classifier:       testErrorClassifier,
+			Class:            tfresource.ErrorClassUnsupported,
+			Err:              awserr.New("InvalidParameterValue", "Operation not supported in this region", nil),
+			Expected:         true,
+			ExpectedAnyClass: true,
+		},
+		{
+			Name:       "other error",
+			Classifier: testErrorClassifier,
+			Class:      tfresource.ErrorClassUnsupported,
+			Err:        errors.New("test"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Classifier.Is(testCase.Class, testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+
+			if got := tfresource.IsErrorClass(testCase.Class, testCase.Err); got != testCase.ExpectedAnyClass {
+				t.Errorf("got %t for any service, expected %t", got, testCase.ExpectedAnyClass)
+			}
+		})
+	}
+}
+
+func TestErrorClassifierIsNotFoundError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Codes    []string
+		Expected bool
+	}{
+		{
+			Name:     "any code",
+			Err:      awserr.New("ParentNotFoundException", "TestMessage", nil),
+			Expected: true,
+		},
+		{
+			Name:     "code",
+			Err:      awserr.New("ResourceNotFoundException", "TestMessage", nil),
+			Codes:    []string{"ResourceNotFoundException"},
+			Expected: true,
+		},
+		{
+			Name:  "other code",
+			Err:   awserr.New("ParentNotFoundException", "TestMessage", nil),
+			Codes: []string{"ResourceNotFoundException"},
+		},
+		{
+			Name:     "unregistered code",
+			Err:      awserr.New("OtherNotFoundException", "TestMessage", nil),
+			Codes:    []string{"OtherNotFoundException"},
+			Expected: true,
+		},
+		{
+			Name:     "wrapped code",
+			Err:      fmt.Errorf("finding: %w", awserr.New("OtherNotFoundException", "TestMessage", nil)),
+			Codes:    []string{"ResourceNotFoundException", "OtherNotFoundException"},
+			Expected: true,
+		},
+		{
+			Name:     "registered code and message",
+			Err:      awserr.New("InvalidParameterValue", "Thing thing-1 does not exist", nil),
+			Codes:    []string{"InvalidParameterValue"},
+			Expected: true,
+		},
+		{
+			Name:  "registered code and other message",
+			Err:   awserr.New("InvalidParameterValue", "TestMessage", nil),
+			Codes: []string{"InvalidParameterValue"},
+		},
+		{
+			Name:  "non-AWS error",
+			Err:   errors.New("ResourceNotFoundException"),
+			Codes: []string{"ResourceNotFoundException"},
+		},
+		{
+			Name:     "not found error",
+			Err:      &resource.NotFoundError{},
+			Codes:    []string{"ResourceNotFoundException"},
+			Expected: true,
+		},
+		{
+			Name:  "nil error",
+			Codes: []string{"ResourceNotFoundException"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testErrorClassifier.IsNotFoundError(testCase.Err, testCase.Codes...); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRetryWhenEventualConsistencyError(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "non-retryable AWS error",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("InvalidParameterValue", "TestMessage", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error timeout",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("InvalidParameterValue", "The role cannot be assumed", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error success",
+			F: func() (interface{}, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, awserr.New("InvalidParameterValue", "The role cannot be assumed", nil)
+				}
+
+				return nil, nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := tfresource.RetryWhenEventualConsistencyError(5*time.Second, testCase.F, testErrorClassifier)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
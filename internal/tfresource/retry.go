@@ -17,11 +17,11 @@ import (
 type Retryable func(error) (bool, error)
 
 // RetryWhenContext retries the function `f` when the error it returns satisfies `predicate`.
-// `f` is retried until `timeout` expires.
+// `f` is retried until `timeout` expires or `ctx` is done.
 func RetryWhenContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
 	var output interface{}
 
-	err := resource.Retry(timeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		var err error
 
 		output, err = f()
@@ -38,6 +38,11 @@ func RetryWhenContext(ctx context.Context, timeout time.Duration, f func() (inte
 		return nil
 	})
 
+	// The last retryable error is not the reason the retries stopped if the context is done.
+	if err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
 	if TimedOut(err) {
 		output, err = f()
 	}
@@ -71,6 +76,22 @@ func RetryWhenAWSErrCodeEquals(timeout time.Duration, f func() (interface{}, err
 	return RetryWhenAWSErrCodeEqualsContext(context.Background(), timeout, f, codes...)
 }
 
+// RetryWhenEventualConsistencyErrorContext retries the specified function when it returns one of the service's eventual consistency errors.
+func RetryWhenEventualConsistencyErrorContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), classifier *ErrorClassifier) (interface{}, error) {
+	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
+		if classifier.IsEventualConsistencyError(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenEventualConsistencyError retries the specified function when it returns one of the service's eventual consistency errors.
+func RetryWhenEventualConsistencyError(timeout time.Duration, f func() (interface{}, error), classifier *ErrorClassifier) (interface{}, error) {
+	return RetryWhenEventualConsistencyErrorContext(context.Background(), timeout, f, classifier)
+}
+
 // RetryWhenNotFoundContext retries the specified function when it returns a resource.NotFoundError.
 func RetryWhenNotFoundContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
 	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
@@ -158,6 +179,10 @@ func RetryConfigContext(ctx context.Context, delay time.Duration, delayRand time
 		c.PollInterval = pollInterval
 	}
 
+	if StateChangeConfHook != nil {
+		StateChangeConfHook(c)
+	}
+
 	_, waitErr := c.WaitForStateContext(ctx)
 
 	// Need to acquire the lock here to be able to avoid race using resultErr as
@@ -166,8 +191,9 @@ func RetryConfigContext(ctx context.Context, delay time.Duration, delayRand time
 	defer resultErrMu.Unlock()
 
 	// resultErr may be nil because the wait timed out and resultErr was never
-	// set; this is still an error
-	if resultErr == nil {
+	// set; this is still an error. If the context is done, the last retryable
+	// error is not the reason the retries stopped
+	if resultErr == nil || (waitErr != nil && waitErr == ctx.Err()) {
 		return waitErr
 	}
 	// resultErr takes precedence over waitErr if both are set because it is
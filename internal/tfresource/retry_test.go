@@ -246,3 +246,34 @@ func TestRetryConfigContext_error(t *testing.T) {
 		t.Fatal("timeout")
 	}
 }
+
+func TestRetryWhenContext_canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	var calls int32
+	f := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+
+		return nil, errors.New("retryable")
+	}
+
+	start := time.Now()
+	_, err := tfresource.RetryWhenContext(ctx, 1*time.Minute, f, func(err error) (bool, error) {
+		return true, err
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context deadline exceeded, got: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected prompt return, took %s", elapsed)
+	}
+
+	if atomic.LoadInt32(&calls) == 0 {
+		t.Error("expected at least one call")
+	}
+}
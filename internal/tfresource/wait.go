@@ -2,6 +2,10 @@ package tfresource
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -50,7 +54,7 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 		PollInterval:              opts.PollInterval,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	_, err := WaitForStateContext(ctx, stateConf, ProgressOpts{})
 
 	return err
 }
@@ -62,3 +66,147 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 func WaitUntil(timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
 	return WaitUntilContext(context.Background(), timeout, f, opts)
 }
+
+// DefaultProgressInterval is the default interval between the progress log lines of WaitForStateContext.
+const DefaultProgressInterval = 1 * time.Minute
+
+// StateChangeConfHook, if set, is called with a copy of the configuration of each state change
+// before it is waited for by WaitForState, WaitForStateContext or RetryConfigContext, e.g. to poll
+// without delays when replaying recorded AWS API calls in acceptance tests.
+// Waiters must not call the WaitForState methods of resource.StateChangeConf directly.
+var StateChangeConfHook func(*resource.StateChangeConf)
+
+// ProgressOpts configures how WaitForStateContext logs the progress of a state change.
+type ProgressOpts struct {
+	Description string                   // Operation being waited for, e.g. "RDS DB Instance (db-1) create". Defaults to the target states.
+	Interval    time.Duration            // Interval between progress log lines. Defaults to DefaultProgressInterval.
+	Reason      func(interface{}) string // Returns the reason for the status of the refreshed object, if any.
+}
+
+// WaitForState waits for the state change configured by `stateConf` to complete.
+// It is WaitForStateContext without cancellation or progress options.
+func WaitForState(stateConf *resource.StateChangeConf) (interface{}, error) {
+	return WaitForStateContext(context.Background(), stateConf, ProgressOpts{})
+}
+
+// WaitForStateContext waits for the state change configured by `stateConf` to complete.
+// Progress is logged every interval with the elapsed time, the current status and the reason for the last
+// transitional (pending) status.
+// It returns as soon as `ctx` is done, e.g. when the provider is stopped, even if a refresh is in progress.
+func WaitForStateContext(ctx context.Context, stateConf *resource.StateChangeConf, opts ProgressOpts) (interface{}, error) {
+	p := &progress{
+		description: opts.Description,
+		reason:      opts.Reason,
+		start:       time.Now(),
+	}
+
+	if p.description == "" {
+		p.description = fmt.Sprintf("state %s", strings.Join(stateConf.Target, ", "))
+	}
+
+	interval := opts.Interval
+
+	if interval <= 0 {
+		interval = DefaultProgressInterval
+	}
+
+	conf := *stateConf
+	conf.Refresh = p.refresh(stateConf.Refresh, stateConf.Pending)
+
+	if StateChangeConfHook != nil {
+		StateChangeConfHook(&conf)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go p.report(interval, done)
+
+	output, err := conf.WaitForStateContext(ctx)
+
+	if err != nil && err == ctx.Err() {
+		log.Printf("[WARN] Stopped waiting for %s after %s: %s", p.description, p.elapsed(), err)
+	}
+
+	return output, err
+}
+
+// progress records the progress of a state change.
+type progress struct {
+	description string
+	reason      func(interface{}) string
+	start       time.Time
+
+	mu         sync.Mutex
+	status     string
+	lastReason string
+}
+
+// refresh returns a refresh function that calls `f` and records the returned status and,
+// for pending statuses, the reason for it.
+func (p *progress) refresh(f resource.StateRefreshFunc, pending []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, status, err := f()
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		p.status = status
+
+		if p.reason != nil && output != nil && isPending(status, pending) {
+			if reason := p.reason(output); reason != "" {
+				p.lastReason = reason
+			}
+		}
+
+		return output, status, err
+	}
+}
+
+// report logs the progress every interval until `done` is closed.
+func (p *progress) report(interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			log.Printf("[INFO] %s", p)
+		}
+	}
+}
+
+func (p *progress) elapsed() time.Duration {
+	return time.Since(p.start).Round(time.Second)
+}
+
+func (p *progress) String() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := p.status
+
+	if status == "" {
+		status = "unknown"
+	}
+
+	s := fmt.Sprintf("Waiting for %s: %s elapsed, status: %s", p.description, p.elapsed(), status)
+
+	if p.lastReason != "" {
+		s += fmt.Sprintf(", last transitional reason: %s", p.lastReason)
+	}
+
+	return s
+}
+
+func isPending(status string, pending []string) bool {
+	for _, v := range pending {
+		if v == status {
+			return true
+		}
+	}
+
+	return false
+}
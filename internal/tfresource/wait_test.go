@@ -1,11 +1,18 @@
 package tfresource_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"log"
+	"os"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -63,3 +70,156 @@ func TestWaitUntil(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitForStateContext_progress(t *testing.T) {
+	var buffer syncBuffer
+
+	log.SetOutput(&buffer)
+	defer log.SetOutput(os.Stderr)
+
+	var refreshCount int32
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"modifying"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			if atomic.AddInt32(&refreshCount, 1) < 4 {
+				return "scaling storage", "modifying", nil
+			}
+
+			return "", "available", nil
+		},
+		Timeout:      10 * time.Second,
+		PollInterval: 50 * time.Millisecond,
+	}
+
+	_, err := tfresource.WaitForStateContext(context.Background(), stateConf, tfresource.ProgressOpts{
+		Description: "Example (test) update",
+		Interval:    20 * time.Millisecond,
+		Reason: func(v interface{}) string {
+			return v.(string)
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := buffer.String(), "[INFO] Waiting for Example (test) update: 0s elapsed, status: modifying, last transitional reason: scaling storage"; !strings.Contains(got, expected) {
+		t.Errorf("expected log to contain %q, got:\n%s", expected, got)
+	}
+}
+
+func TestWaitForStateContext_canceled(t *testing.T) {
+	var buffer syncBuffer
+
+	log.SetOutput(&buffer)
+	defer log.SetOutput(os.Stderr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	blocked := make(chan struct{})
+	defer close(blocked)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			// Simulate a hung API call.
+			cancel()
+			<-blocked
+
+			return "", "available", nil
+		},
+		Timeout: 1 * time.Minute,
+	}
+
+	start := time.Now()
+	_, err := tfresource.WaitForStateContext(ctx, stateConf, tfresource.ProgressOpts{Description: "Example (test) create"})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected prompt return, took %s", elapsed)
+	}
+
+	if got, expected := buffer.String(), "[WARN] Stopped waiting for Example (test) create after 0s: context canceled"; !strings.Contains(got, expected) {
+		t.Errorf("expected log to contain %q, got:\n%s", expected, got)
+	}
+}
+
+// syncBuffer is a bytes.Buffer that is safe for concurrent use by loggers.
+type syncBuffer struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buffer.String()
+}
+
+func TestWaitForStateStateChangeConfHook(t *testing.T) {
+	defer func(hook func(*resource.StateChangeConf)) {
+		tfresource.StateChangeConfHook = hook
+	}(tfresource.StateChangeConfHook)
+
+	var calls int32
+
+	tfresource.StateChangeConfHook = func(conf *resource.StateChangeConf) {
+		atomic.AddInt32(&calls, 1)
+
+		conf.Delay = 0
+		conf.MinTimeout = 0
+		conf.PollInterval = 0
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DONE"},
+		Refresh: func() (interface{}, string, error) {
+			return struct{}{}, "DONE", nil
+		},
+		Timeout:    time.Hour,
+		Delay:      time.Hour,
+		MinTimeout: time.Hour,
+	}
+
+	start := time.Now()
+
+	if _, err := tfresource.WaitForStateContext(context.Background(), stateConf, tfresource.ProgressOpts{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("waited %s, expected no delay", elapsed)
+	}
+
+	if got, expected := atomic.LoadInt32(&calls), int32(1); got != expected {
+		t.Errorf("got %d hook calls, expected %d", got, expected)
+	}
+
+	if got, expected := stateConf.Delay, time.Hour; got != expected {
+		t.Errorf("got delay %s, expected the configuration to be unchanged (%s)", got, expected)
+	}
+
+	if err := tfresource.RetryConfigContext(context.Background(), time.Hour, 0, time.Hour, 0, time.Hour, func() *resource.RetryError {
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := atomic.LoadInt32(&calls), int32(2); got != expected {
+		t.Errorf("got %d hook calls, expected %d", got, expected)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -20,9 +21,11 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+// The merged tags are checked against any provider-level tag policy.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tagPolicyConfig := meta.(*conns.AWSClient).TagPolicyConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -32,6 +35,22 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+	if err := checkTagPolicy(ctx, diff, allTags, tagPolicyConfig); err != nil {
+		return err
+	}
+
+	// Only the tag keys that the resource sets are managed, so that tags added outside of Terraform
+	// are neither shown as differences nor removed by UpdateTags.
+	if meta.(*conns.AWSClient).ManagedTagKeysOnly {
+		if !diff.NewValueKnown("tags") {
+			if err := diff.SetNewComputed(tftags.ManagedKeysAttribute); err != nil {
+				return fmt.Errorf("error setting %s to computed: %w", tftags.ManagedKeysAttribute, err)
+			}
+		} else if err := diff.SetNew(tftags.ManagedKeysAttribute, allTags.Keys()); err != nil {
+			return fmt.Errorf("error setting new %s diff: %w", tftags.ManagedKeysAttribute, err)
+		}
+	}
+
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when their is a known diff (excluding an empty map)
@@ -55,7 +74,53 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// checkTagPolicy returns an error naming the resource and tag key for each
+// violation of the tag policy, or logs a warning if violations are only warnings.
+// Warnings are also returned when the provider validates a resource's tags argument,
+// as a CustomizeDiff function cannot return warnings.
+func checkTagPolicy(ctx context.Context, diff *schema.ResourceDiff, tags tftags.KeyValueTags, config *tftags.PolicyConfig) error {
+	if config == nil {
+		return nil
+	}
+
+	// Tags that are not known until apply cannot be checked.
+	if !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	resource := "resource"
+	if v, ok := conns.ResourceTypeNameFromContext(ctx); ok {
+		resource = v
+	}
+	if id := diff.Id(); id != "" {
+		resource = fmt.Sprintf("%s (%s)", resource, id)
+	}
+
+	var errs *multierror.Error
+
+	for _, violation := range tags.PolicyViolations(config) {
+		if !diff.NewValueKnown("tags." + violation.Key) {
+			continue
+		}
+
+		if config.IsWarning() {
+			log.Printf("[WARN] %s: tag_policy: %s", resource, violation)
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s: tag_policy: %s", resource, violation))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// SuppressEquivalentPolicyDiffs suppresses differences between policy documents with the same canonical form,
+// see NormalizePolicy, or that awspolicy considers equivalent.
 func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if PoliciesAreEquivalent(old, new) {
+		return true
+	}
+
 	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
 	if err != nil {
 		return false
@@ -1,10 +1,15 @@
 package verify
 
 import (
+	"context"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestSuppressEquivalentJSONDiffsWhitespaceAndNoWhitespace(t *testing.T) {
@@ -362,3 +367,104 @@ func TestDiffStringMaps(t *testing.T) {
 		}
 	}
 }
+
+func TestSetTagsDiffTagPolicy(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Tags          map[string]interface{}
+		DefaultTags   map[string]string
+		IgnoreKeys    []string
+		ID            string
+		Enforcement   string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name: "compliant",
+			Tags: map[string]interface{}{"Owner": "team", "Environment": "prod"},
+		},
+		{
+			Name:          "missing required key",
+			Tags:          map[string]interface{}{"Environment": "prod"},
+			ExpectedError: regexp.MustCompile(`aws_test_resource: tag_policy: tag "Owner" is required`),
+		},
+		{
+			Name:        "required key from default tags",
+			Tags:        map[string]interface{}{"Environment": "prod"},
+			DefaultTags: map[string]string{"Owner": "team"},
+		},
+		{
+			Name:          "required key ignored",
+			Tags:          map[string]interface{}{"Owner": "team", "Environment": "prod"},
+			IgnoreKeys:    []string{"Owner"},
+			ExpectedError: regexp.MustCompile(`tag "Owner" is required`),
+		},
+		{
+			Name:          "invalid value",
+			Tags:          map[string]interface{}{"Owner": "team", "Environment": "production"},
+			ID:            "test-id",
+			ExpectedError: regexp.MustCompile(`aws_test_resource \(test-id\): tag_policy: tag "Environment" value "production" does not match pattern`),
+		},
+		{
+			Name:          "invalid key case",
+			Tags:          map[string]interface{}{"Owner": "team", "Environment": "prod", "cost-center": "1234"},
+			ExpectedError: regexp.MustCompile(`tag "cost-center" key is not pascal case`),
+		},
+		{
+			Name:        "warning",
+			Tags:        map[string]interface{}{"Environment": "production"},
+			Enforcement: tftags.PolicyEnforcementWarn,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags":     tftags.TagsSchema(),
+					"tags_all": tftags.TagsSchemaComputed(),
+				},
+				CustomizeDiff: SetTagsDiff,
+			}
+
+			meta := &conns.AWSClient{
+				IgnoreTagsConfig: &tftags.IgnoreConfig{Keys: tftags.New(testCase.IgnoreKeys)},
+				TagPolicyConfig: &tftags.PolicyConfig{
+					Enforcement:  testCase.Enforcement,
+					KeyCase:      tftags.PolicyKeyCasePascal,
+					RequiredKeys: []string{"Owner"},
+					ValuePatterns: map[string]*regexp.Regexp{
+						"Environment": regexp.MustCompile(`^(?:dev|prod)$`),
+					},
+				},
+			}
+
+			if testCase.DefaultTags != nil {
+				meta.DefaultTagsConfig = &tftags.DefaultConfig{Tags: tftags.New(testCase.DefaultTags)}
+			}
+
+			var state *terraform.InstanceState
+			if testCase.ID != "" {
+				state = &terraform.InstanceState{ID: testCase.ID}
+			}
+
+			ctx := conns.NewResourceContext(context.Background(), "aws_test_resource")
+			_, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{"tags": testCase.Tags}), meta)
+
+			if testCase.ExpectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %s", testCase.ExpectedError)
+			}
+
+			if !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Errorf("got error %q, expected match for %s", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var policyRootPrincipalRegexp = regexp.MustCompile(`^arn:[^:]+:iam::(\d{12}):root$`)
+
+// NormalizePolicy returns the canonical form of an IAM-style policy document, e.g. an IAM, S3 bucket or KMS key policy.
+// Policies that AWS considers the same have the same canonical form, which is compact JSON in which:
+//
+//   - Statement is a list of statements, sorted by their canonical form.
+//   - Action, NotAction, Resource, NotResource and the values of Principal, NotPrincipal and conditions are sorted lists without duplicates.
+//   - Condition values are strings, e.g. "true" for true.
+//   - The "*" principal is {"AWS": ["*"]} and account root principals, e.g. arn:aws:iam::123456789012:root, are account IDs.
+//   - Empty Sid elements are removed.
+//
+// Principals that AWS has replaced by unique IDs, e.g. AROAEXAMPLE, are not normalized: They refer to
+// principals that no longer exist, so a change back to the principal's ARN must be applied.
+func NormalizePolicy(policy string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+
+	var doc map[string]interface{}
+
+	if err := decoder.Decode(&doc); err != nil {
+		return "", fmt.Errorf("error parsing policy: %w", err)
+	}
+
+	if doc == nil {
+		return "", fmt.Errorf("error parsing policy: not a JSON object")
+	}
+
+	if v, ok := doc["Statement"]; ok {
+		statements, err := normalizePolicyStatements(v)
+
+		if err != nil {
+			return "", err
+		}
+
+		doc["Statement"] = statements
+	}
+
+	return marshalPolicy(doc)
+}
+
+// PoliciesAreEquivalent returns whether the policy documents have the same canonical form.
+func PoliciesAreEquivalent(policy1, policy2 string) bool {
+	normalized1, err := NormalizePolicy(policy1)
+
+	if err != nil {
+		return false
+	}
+
+	normalized2, err := NormalizePolicy(policy2)
+
+	if err != nil {
+		return false
+	}
+
+	return normalized1 == normalized2
+}
+
+// PolicyToSet returns the policy document to save in state, which is its canonical form.
+// Policies that are not valid JSON, e.g. empty policies, are returned unchanged.
+func PolicyToSet(policy string) string {
+	if strings.TrimSpace(policy) == "" {
+		return policy
+	}
+
+	normalized, err := NormalizePolicy(policy)
+
+	if err != nil {
+		return policy
+	}
+
+	return normalized
+}
+
+func normalizePolicyStatements(v interface{}) ([]interface{}, error) {
+	var statements []interface{}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	case []interface{}:
+		statements = v
+	default:
+		return nil, fmt.Errorf("error parsing policy: Statement must be an object or a list of objects")
+	}
+
+	type keyedStatement struct {
+		key       string
+		statement interface{}
+	}
+
+	keyed := make([]keyedStatement, 0, len(statements))
+
+	for i, v := range statements {
+		statement, ok := v.(map[string]interface{})
+
+		if !ok {
+			return nil, fmt.Errorf("error parsing policy: Statement[%d] must be an object", i)
+		}
+
+		if err := normalizePolicyStatement(statement); err != nil {
+			return nil, fmt.Errorf("error parsing policy: Statement[%d]: %w", i, err)
+		}
+
+		key, err := marshalPolicy(statement)
+
+		if err != nil {
+			return nil, err
+		}
+
+		keyed = append(keyed, keyedStatement{key: key, statement: statement})
+	}
+
+	sort.SliceStable(keyed, func(i, j int) bool {
+		return keyed[i].key < keyed[j].key
+	})
+
+	statements = make([]interface{}, len(keyed))
+
+	for i, v := range keyed {
+		statements[i] = v.statement
+	}
+
+	return statements, nil
+}
+
+func normalizePolicyStatement(statement map[string]interface{}) error {
+	if v, ok := statement["Sid"]; ok && v == "" {
+		delete(statement, "Sid")
+	}
+
+	for _, k := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+		if v, ok := statement[k]; ok {
+			values, err := normalizePolicyStringSet(v)
+
+			if err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+
+			statement[k] = values
+		}
+	}
+
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		if v, ok := statement[k]; ok {
+			principals, err := normalizePolicyPrincipals(v)
+
+			if err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+
+			statement[k] = principals
+		}
+	}
+
+	if v, ok := statement["Condition"]; ok {
+		operators, ok := v.(map[string]interface{})
+
+		if !ok {
+			return fmt.Errorf("Condition must be an object")
+		}
+
+		for operator, v := range operators {
+			keys, ok := v.(map[string]interface{})
+
+			if !ok {
+				return fmt.Errorf("Condition: %s must be an object", operator)
+			}
+
+			for key, v := range keys {
+				values, err := normalizePolicyStringSet(v)
+
+				if err != nil {
+					return fmt.Errorf("Condition: %s: %s: %w", operator, key, err)
+				}
+
+				keys[key] = values
+			}
+		}
+	}
+
+	return nil
+}
+
+func normalizePolicyPrincipals(v interface{}) (map[string]interface{}, error) {
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			return nil, fmt.Errorf("must be \"*\" or an object, got %q", v)
+		}
+
+		return map[string]interface{}{"AWS": []string{"*"}}, nil
+	case map[string]interface{}:
+		for principalType, identifiers := range v {
+			values, err := normalizePolicyStringSet(identifiers)
+
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", principalType, err)
+			}
+
+			if principalType == "AWS" {
+				for i, value := range values {
+					if m := policyRootPrincipalRegexp.FindStringSubmatch(value); m != nil {
+						values[i] = m[1]
+					}
+				}
+
+				values = sortedUniqueStrings(values)
+			}
+
+			v[principalType] = values
+		}
+
+		return v, nil
+	default:
+		return nil, fmt.Errorf("must be \"*\" or an object")
+	}
+}
+
+// normalizePolicyStringSet returns the sorted unique values of a policy element,
+// which is either a single value or a list of values.
+func normalizePolicyStringSet(v interface{}) ([]string, error) {
+	var values []string
+
+	switch v := v.(type) {
+	case []interface{}:
+		for _, v := range v {
+			s, err := policyValueString(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, s)
+		}
+	default:
+		s, err := policyValueString(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, s)
+	}
+
+	return sortedUniqueStrings(values), nil
+}
+
+func policyValueString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return fmt.Sprint(v), nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+func sortedUniqueStrings(values []string) []string {
+	sort.Strings(values)
+
+	result := make([]string, 0, len(values))
+
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// marshalPolicy returns the compact JSON encoding of a policy, with its object keys sorted
+// and without escaping HTML characters, which commonly occur in conditions.
+func marshalPolicy(v interface{}) (string, error) {
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
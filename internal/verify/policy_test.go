@@ -0,0 +1,265 @@
+package verify
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// TestNormalizePolicyGolden normalizes the policies of the cases in testdata/policies.
+// Each case is a policy as written in a configuration, <case>.config.json, and as returned
+// by the service, <case>.response.json, which must both normalize to <case>.golden.
+func TestNormalizePolicyGolden(t *testing.T) {
+	configs, err := filepath.Glob(filepath.Join("testdata", "policies", "*.config.json"))
+
+	if err != nil {
+		t.Fatalf("error listing test cases: %s", err)
+	}
+
+	if len(configs) == 0 {
+		t.Fatal("no test cases found")
+	}
+
+	for _, config := range configs {
+		name := strings.TrimSuffix(filepath.Base(config), ".config.json")
+
+		t.Run(name, func(t *testing.T) {
+			base := filepath.Join("testdata", "policies", name)
+
+			got := testNormalizePolicyFile(t, base+".config.json")
+			gotResponse := testNormalizePolicyFile(t, base+".response.json")
+
+			if gotResponse != got {
+				t.Errorf("normalized response does not match normalized config:\n%s\n%s", gotResponse, got)
+			}
+
+			again, err := NormalizePolicy(got)
+
+			if err != nil {
+				t.Fatalf("unexpected error normalizing normalized policy: %s", err)
+			}
+
+			if again != got {
+				t.Errorf("normalization is not idempotent:\n%s\n%s", again, got)
+			}
+
+			golden := base + ".golden"
+
+			if *update {
+				if err := ioutil.WriteFile(golden, []byte(got+"\n"), 0644); err != nil {
+					t.Fatalf("error writing golden file: %s", err)
+				}
+			}
+
+			expected, err := ioutil.ReadFile(golden)
+
+			if err != nil {
+				t.Fatalf("error reading golden file: %s", err)
+			}
+
+			if got+"\n" != string(expected) {
+				t.Errorf("normalized policy does not match %s, run with -update to update it:\n%s", golden, got)
+			}
+		})
+	}
+}
+
+func TestPoliciesAreEquivalent(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Policy1    string
+		Policy2    string
+		Equivalent bool
+	}{
+		{
+			Name:       "identical",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "duplicate values",
+			Policy1:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:GetObject"],"Resource":"*"}]}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "single statement object",
+			Policy1:    `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			Policy2:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:    "different actions",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+		},
+		{
+			Name:    "different effects",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name:    "different condition values",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":true}}}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+		},
+		{
+			Name:    "different versions",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name:    "different Sids",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name:    "role unique ID",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/reader"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"AROAEXAMPLEEXAMPLEEXA"},"Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name:    "role is not account root",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/root"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name:    "different principal types",
+			Policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			Policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		{
+			Name:    "invalid JSON",
+			Policy1: `{"Version":"2012-10-17","Statement":[`,
+			Policy2: `{"Version":"2012-10-17","Statement":[`,
+		},
+		{
+			Name:    "empty",
+			Policy1: ``,
+			Policy2: ``,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := PoliciesAreEquivalent(testCase.Policy1, testCase.Policy2); got != testCase.Equivalent {
+				t.Errorf("got %t, expected %t", got, testCase.Equivalent)
+			}
+
+			if got := PoliciesAreEquivalent(testCase.Policy2, testCase.Policy1); got != testCase.Equivalent {
+				t.Errorf("got %t in reverse, expected %t", got, testCase.Equivalent)
+			}
+		})
+	}
+}
+
+func TestNormalizePolicyInvalid(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Policy        string
+		ExpectedError string
+	}{
+		{
+			Name:          "not an object",
+			Policy:        `["Statement"]`,
+			ExpectedError: "error parsing policy: json: cannot unmarshal array into Go value of type map[string]interface {}",
+		},
+		{
+			Name:          "null",
+			Policy:        `null`,
+			ExpectedError: "error parsing policy: not a JSON object",
+		},
+		{
+			Name:          "statement not an object",
+			Policy:        `{"Version":"2012-10-17","Statement":["s3:GetObject"]}`,
+			ExpectedError: "error parsing policy: Statement[0] must be an object",
+		},
+		{
+			Name:          "principal string",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"123456789012","Action":"s3:GetObject","Resource":"*"}]}`,
+			ExpectedError: `error parsing policy: Statement[0]: Principal: must be "*" or an object, got "123456789012"`,
+		},
+		{
+			Name:          "unsupported condition value",
+			Policy:        `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":{"name":"a"}}}}]}`,
+			ExpectedError: "error parsing policy: Statement[0]: Condition: StringEquals: aws:PrincipalTag/team: unsupported value type map[string]interface {}",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := NormalizePolicy(testCase.Policy)
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if err.Error() != testCase.ExpectedError {
+				t.Errorf("got error %q, expected %q", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestPolicyToSet(t *testing.T) {
+	for policy, expected := range map[string]string{
+		``:               ``,
+		`{"Statement":[`: `{"Statement":[`,
+		`{"Version": "2012-10-17", "Statement": {"Effect": "Allow", "Action": "s3:*", "Resource": "*"}}`: `{"Statement":[{"Action":["s3:*"],"Effect":"Allow","Resource":["*"]}],"Version":"2012-10-17"}`,
+	} {
+		if got := PolicyToSet(policy); got != expected {
+			t.Errorf("PolicyToSet(%q) = %q, expected %q", policy, got, expected)
+		}
+	}
+}
+
+func TestSuppressEquivalentPolicyDiffs(t *testing.T) {
+	d := new(schema.ResourceData)
+
+	// Equivalent by canonical form only: awspolicy does not support boolean condition values.
+	old := `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`
+	new := `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"AWS":"*"},"Action":"s3:*","Resource":["*"],"Condition":{"Bool":{"aws:SecureTransport":false}}}]}`
+
+	if !SuppressEquivalentPolicyDiffs("policy", old, new, d) {
+		t.Errorf("expected difference to be suppressed:\n%s\n%s", old, new)
+	}
+
+	// Equivalent by awspolicy only: Effect is case insensitive.
+	old = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+	new = `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"s3:*","Resource":"*"}]}`
+
+	if !SuppressEquivalentPolicyDiffs("policy", old, new, d) {
+		t.Errorf("expected difference to be suppressed:\n%s\n%s", old, new)
+	}
+
+	new = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+
+	if SuppressEquivalentPolicyDiffs("policy", old, new, d) {
+		t.Errorf("expected difference not to be suppressed:\n%s\n%s", old, new)
+	}
+}
+
+func testNormalizePolicyFile(t *testing.T, path string) string {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		t.Fatalf("error reading %s: %s", path, err)
+	}
+
+	normalized, err := NormalizePolicy(string(b))
+
+	if err != nil {
+		t.Fatalf("unexpected error normalizing %s: %s", path, err)
+	}
+
+	return normalized
+}
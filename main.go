@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -15,12 +16,15 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	defer conns.CloseAPITracers()
+
 	opts := &plugin.ServeOpts{ProviderFunc: provider.Provider}
 
 	if debugMode {
 		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/aws", opts)
 
 		if err != nil {
+			conns.CloseAPITracers()
 			log.Fatal(err.Error())
 		}
 
@@ -0,0 +1,128 @@
+// Package AWSR003 defines an Analyzer that checks for
+// resource Read functions missing disappears handling
+package AWSR003
+
+import (
+	"go/ast"
+	"regexp"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/crudfuncinfo"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resource Read functions missing disappears handling
+
+The AWSR003 analyzer reports resource Read functions that do not remove the
+resource from the Terraform state when it no longer exists. The Read function
+should check for the resource not being found, e.g. with tfresource.NotFound(),
+and when the resource is not newly created, call d.SetId("") and return
+without error so that Terraform can plan to recreate the resource.
+
+Read functions are identified by their declaration name, e.g.
+resourceQueueRead. Data source Read functions are not checked.
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		crudfuncinfo.Analyzer,
+	},
+	Run: run,
+}
+
+var readFuncNameRegexp = regexp.MustCompile(`^resource[A-Z0-9]\w*Read(Context)?$`)
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	crudFuncs := pass.ResultOf[crudfuncinfo.Analyzer].([]*schema.CRUDFuncInfo)
+
+	for _, crudFunc := range crudFuncs {
+		if crudFunc.AstFuncDecl == nil || crudFunc.Body == nil {
+			continue
+		}
+
+		if !readFuncNameRegexp.MatchString(crudFunc.AstFuncDecl.Name.Name) {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, crudFunc.AstFuncDecl) {
+			continue
+		}
+
+		if hasDisappearsHandling(pass, crudFunc.Body) {
+			continue
+		}
+
+		pass.Reportf(crudFunc.AstFuncDecl.Name.Pos(), "%s: missing d.SetId(\"\") when the resource is not found and !d.IsNewResource()", analyzerName)
+	}
+
+	return nil, nil
+}
+
+// hasDisappearsHandling returns whether the function body contains an if statement
+// whose condition checks (schema.ResourceData).IsNewResource() and whose body calls
+// (schema.ResourceData).SetId("").
+func hasDisappearsHandling(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	var found bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		ifStmt, ok := n.(*ast.IfStmt)
+
+		if !ok {
+			return true
+		}
+
+		if !containsCall(ifStmt.Cond, func(callExpr *ast.CallExpr) bool {
+			return schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "IsNewResource")
+		}) {
+			return true
+		}
+
+		found = containsCall(ifStmt.Body, func(callExpr *ast.CallExpr) bool {
+			if !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "SetId") {
+				return false
+			}
+
+			if len(callExpr.Args) != 1 {
+				return false
+			}
+
+			id := astutils.ExprStringValue(callExpr.Args[0])
+
+			return id != nil && *id == ""
+		})
+
+		return !found
+	})
+
+	return found
+}
+
+func containsCall(node ast.Node, match func(*ast.CallExpr) bool) bool {
+	var found bool
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		if callExpr, ok := n.(*ast.CallExpr); ok && match(callExpr) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
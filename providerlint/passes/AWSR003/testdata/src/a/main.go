@@ -0,0 +1,87 @@
+package a
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var errNotFound = errors.New("not found")
+
+func find(id string) error {
+	return errNotFound
+}
+
+func notFound(err error) bool {
+	return errors.Is(err, errNotFound)
+}
+
+/* Passing cases */
+
+func resourceExampleCreate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourcePassingRead(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	if !d.IsNewResource() && notFound(err) {
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return err
+}
+
+func resourcePassingNestedRead(d *schema.ResourceData, meta interface{}) error {
+	err := find(d.Id())
+
+	if notFound(err) {
+		if !d.IsNewResource() {
+			d.SetId("")
+			return nil
+		}
+	}
+
+	return err
+}
+
+func dataSourceExampleRead(d *schema.ResourceData, meta interface{}) error {
+	return find(d.Id())
+}
+
+/* Comment ignored cases */
+
+// lintignore:AWSR003
+func resourceIgnoredRead(d *schema.ResourceData, meta interface{}) error {
+	return find(d.Id())
+}
+
+/* Failing cases */
+
+func resourceFailingRead(d *schema.ResourceData, meta interface{}) error { // want "missing d.SetId"
+	return find(d.Id())
+}
+
+func resourceFailingNoIsNewResourceRead(d *schema.ResourceData, meta interface{}) error { // want "missing d.SetId"
+	err := find(d.Id())
+
+	if notFound(err) {
+		d.SetId("")
+		return nil
+	}
+
+	return err
+}
+
+func resourceFailingNoSetIdRead(d *schema.ResourceData, meta interface{}) error { // want "missing d.SetId"
+	err := find(d.Id())
+
+	if !d.IsNewResource() && notFound(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,119 @@
+// Package AWSR004 defines an Analyzer that checks for
+// ResourceData.Set() calls of aggregate values discarding errors
+package AWSR004
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourcedatasetcallexpr"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() calls of list, set and map values discarding the error
+
+The AWSR004 analyzer reports (schema.ResourceData).Set() calls with a slice,
+map or *schema.Set value whose returned error is discarded, either by not
+assigning it or by assigning it to the blank identifier. Setting a TypeList,
+TypeSet or TypeMap attribute, e.g. a nested block, can fail when the value
+does not match the schema, which silently leaves the attribute unset and
+prevents drift detection.
+
+Unlike XR004, values of other non-primitive types, e.g. *time.Time, are not
+reported.
+`
+
+const analyzerName = "AWSR004"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		resourcedatasetcallexpr.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	callExprs := pass.ResultOf[resourcedatasetcallexpr.Analyzer].([]*ast.CallExpr)
+
+	discarded := make(map[*ast.CallExpr]bool)
+
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return
+			}
+
+			if ident, ok := n.Lhs[0].(*ast.Ident); !ok || ident.Name != "_" {
+				return
+			}
+
+			if callExpr, ok := n.Rhs[0].(*ast.CallExpr); ok {
+				discarded[callExpr] = true
+			}
+		case *ast.ExprStmt:
+			if callExpr, ok := n.X.(*ast.CallExpr); ok {
+				discarded[callExpr] = true
+			}
+		}
+	})
+
+	for _, callExpr := range callExprs {
+		if !discarded[callExpr] {
+			continue
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			continue
+		}
+
+		if len(callExpr.Args) < 2 {
+			continue
+		}
+
+		if !isAggregateType(pass.TypesInfo.TypeOf(callExpr.Args[1])) {
+			continue
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: d.Set() of list, set or map value should check the returned error", analyzerName)
+	}
+
+	return nil, nil
+}
+
+// isAggregateType returns whether the type is a slice, a map or *schema.Set.
+func isAggregateType(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	if pointer, ok := t.(*types.Pointer); ok {
+		if named, ok := pointer.Elem().(*types.Named); ok && schema.IsNamedType(named, schema.TypeNameSet) {
+			return true
+		}
+
+		return false
+	}
+
+	switch t.Underlying().(type) {
+	case *types.Map, *types.Slice:
+		return true
+	}
+
+	return false
+}
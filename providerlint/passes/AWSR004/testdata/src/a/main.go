@@ -0,0 +1,57 @@
+package a
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func flattenExample() []interface{} {
+	return []interface{}{map[string]interface{}{"name": "test"}}
+}
+
+func f() error {
+	var d schema.ResourceData
+
+	/* Passing cases */
+
+	d.Set("name", "test")
+
+	d.Set("count", 1)
+
+	d.Set("enabled", true)
+
+	d.Set("created", time.Now())
+
+	if err := d.Set("example", flattenExample()); err != nil {
+		return fmt.Errorf("error setting example: %w", err)
+	}
+
+	err := d.Set("tags", map[string]string{"key": "value"})
+
+	if err != nil {
+		return err
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR004
+	d.Set("example", flattenExample())
+
+	d.Set("example", flattenExample()) //lintignore:AWSR004
+
+	/* Failing cases */
+
+	d.Set("example", flattenExample()) // want "d.Set\\(\\) of list, set or map value should check the returned error"
+
+	_ = d.Set("example", flattenExample()) // want "d.Set\\(\\) of list, set or map value should check the returned error"
+
+	d.Set("tags", map[string]string{"key": "value"}) // want "d.Set\\(\\) of list, set or map value should check the returned error"
+
+	d.Set("security_groups", schema.NewSet(schema.HashString, nil)) // want "d.Set\\(\\) of list, set or map value should check the returned error"
+
+	d.Set("names", []string{"test"}) // want "d.Set\\(\\) of list, set or map value should check the returned error"
+
+	return nil
+}
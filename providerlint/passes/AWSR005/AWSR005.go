@@ -0,0 +1,174 @@
+// Package AWSR005 defines an Analyzer that checks for
+// resource.Retry() calls missing the final call on timeout
+package AWSR005
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/resource"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for resource.Retry() calls missing the final call on timeout
+
+The AWSR005 analyzer reports resource.Retry() and resource.RetryContext()
+calls whose error is not checked with tfresource.TimedOut() afterwards.
+
+The retry function may not have been called, or only been called once, before
+the timeout elapses, e.g. when the provider is slowed down by API rate
+limiting. The retry function body should then be called one final time:
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		...
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.Operation(input)
+	}
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.BlockStmt)(nil),
+		(*ast.CaseClause)(nil),
+		(*ast.CommClause)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var stmts []ast.Stmt
+
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			stmts = n.List
+		case *ast.CaseClause:
+			stmts = n.Body
+		case *ast.CommClause:
+			stmts = n.Body
+		}
+
+		for i, stmt := range stmts {
+			callExpr := retryCallExpr(pass, stmt)
+
+			if callExpr == nil {
+				continue
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+				continue
+			}
+
+			if hasTimedOutCheck(pass, stmts[i+1:]) {
+				continue
+			}
+
+			pass.Reportf(callExpr.Pos(), "%s: resource.Retry() error should be checked with tfresource.TimedOut() and the retry function body called one final time", analyzerName)
+		}
+	})
+
+	return nil, nil
+}
+
+// retryCallExpr returns the resource.Retry() or resource.RetryContext() call
+// of an assignment, declaration, expression or return statement.
+func retryCallExpr(pass *analysis.Pass, stmt ast.Stmt) *ast.CallExpr {
+	var exprs []ast.Expr
+
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		exprs = stmt.Rhs
+	case *ast.DeclStmt:
+		genDecl, ok := stmt.Decl.(*ast.GenDecl)
+
+		if !ok {
+			return nil
+		}
+
+		for _, spec := range genDecl.Specs {
+			if valueSpec, ok := spec.(*ast.ValueSpec); ok {
+				exprs = append(exprs, valueSpec.Values...)
+			}
+		}
+	case *ast.ExprStmt:
+		exprs = []ast.Expr{stmt.X}
+	case *ast.ReturnStmt:
+		exprs = stmt.Results
+	}
+
+	for _, expr := range exprs {
+		callExpr, ok := expr.(*ast.CallExpr)
+
+		if !ok {
+			continue
+		}
+
+		if resource.IsFunc(callExpr.Fun, pass.TypesInfo, "Retry") || resource.IsFunc(callExpr.Fun, pass.TypesInfo, "RetryContext") {
+			return callExpr
+		}
+	}
+
+	return nil
+}
+
+// hasTimedOutCheck returns whether any of the statements before the next
+// resource.Retry() call is an if statement whose condition calls a TimedOut()
+// function, e.g. tfresource.TimedOut().
+func hasTimedOutCheck(pass *analysis.Pass, stmts []ast.Stmt) bool {
+	for _, stmt := range stmts {
+		if retryCallExpr(pass, stmt) != nil {
+			return false
+		}
+
+		ifStmt, ok := stmt.(*ast.IfStmt)
+
+		if !ok {
+			continue
+		}
+
+		var found bool
+
+		ast.Inspect(ifStmt.Cond, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return !found
+			}
+
+			switch fun := callExpr.Fun.(type) {
+			case *ast.Ident:
+				if fun.Name == "TimedOut" {
+					found = true
+				}
+			case *ast.SelectorExpr:
+				if fun.Sel.Name == "TimedOut" {
+					found = true
+				}
+			}
+
+			return !found
+		})
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
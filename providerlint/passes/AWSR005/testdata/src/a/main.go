@@ -0,0 +1,87 @@
+package a
+
+import (
+	"context"
+	"time"
+
+	"a/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func create() error {
+	return nil
+}
+
+func retryable() bool {
+	return true
+}
+
+func f() error {
+	/* Passing cases */
+
+	err := resource.Retry(time.Minute, func() *resource.RetryError {
+		if err := create(); err != nil {
+			return resource.RetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = create()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+		return resource.NonRetryableError(create())
+	})
+
+	if err != nil && tfresource.TimedOut(err) {
+		err = create()
+	}
+
+	err = resource.Retry(time.Minute, func() *resource.RetryError {
+		return resource.NonRetryableError(create())
+	})
+
+	if tfresource.TimedOut(err) && retryable() {
+		err = create()
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	err = resource.Retry(time.Minute, func() *resource.RetryError {
+		return resource.NonRetryableError(create())
+	})
+
+	err = resource.Retry(time.Minute, func() *resource.RetryError {
+		return resource.NonRetryableError(create())
+	}) //lintignore:AWSR005
+
+	/* Failing cases */
+
+	err = resource.Retry(time.Minute, func() *resource.RetryError { // want "resource.Retry\\(\\) error should be checked with tfresource.TimedOut\\(\\)"
+		return resource.NonRetryableError(create())
+	})
+
+	if err != nil {
+		return err
+	}
+
+	var retryErr = resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError { // want "resource.Retry\\(\\) error should be checked with tfresource.TimedOut\\(\\)"
+		return resource.NonRetryableError(create())
+	})
+
+	if retryErr != nil {
+		return retryErr
+	}
+
+	return resource.Retry(time.Minute, func() *resource.RetryError { // want "resource.Retry\\(\\) error should be checked with tfresource.TimedOut\\(\\)"
+		return resource.NonRetryableError(create())
+	})
+}